@@ -17,6 +17,8 @@ limitations under the License.
 package common
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 )
 
@@ -78,6 +80,9 @@ const (
 	LabelSensorName = "sensor-name"
 	// Port for the sensor server to listen events on
 	SensorServerPort = 9300
+	// DefaultCompletionTimeout is the time to wait for the resource created by a trigger to complete,
+	// if its completion policy doesn't set a timeout
+	DefaultCompletionTimeout = time.Hour
 )
 
 // Gateway constants
@@ -102,6 +107,49 @@ var (
 	ErrNilEventSource = errors.New("event source can't be nil")
 )
 
+// EventBus constants
+const (
+	// DefaultEventBusName is the name of the EventBus used when none is specified
+	DefaultEventBusName = "default"
+	// EnvVarEventBusConfig refers to the env var for the JSON encoded EventBus configuration
+	EnvVarEventBusConfig = "EVENT_BUS_CONFIG"
+	// EnvVarEventBusSubject refers to the env var for the prefix of the EventBus subjects
+	EnvVarEventBusSubject = "EVENT_BUS_SUBJECT"
+	// EnvVarEventBusAuth refers to the env var for the EventBus client auth credentials
+	EnvVarEventBusAuth = "EVENT_BUS_AUTH"
+	// DefaultEventBusAckWait is the min time the EventBus waits for the ack of a message before redelivering it
+	DefaultEventBusAckWait = 5 * time.Minute
+)
+
+// Metrics constants
+const (
+	// EnvVarMetricsPort refers to the env var to override the port of the metrics endpoint
+	EnvVarMetricsPort = "METRICS_PORT"
+	// DefaultMetricsPort is the default port of the metrics endpoint
+	DefaultMetricsPort = "9090"
+	// GatewayServerMetricsPort is the default port of the gateway server metrics endpoint,
+	// it's different from the default one as the gateway server runs in the same pod as the gateway client
+	GatewayServerMetricsPort = "9091"
+	// MetricsPath is the path of the metrics endpoint
+	MetricsPath = "/metrics"
+	// ReadinessPath is the path of the readiness endpoint of the gateway server, served on the metrics port
+	ReadinessPath = "/ready"
+	// AnnotationPrometheusScrape is the pod annotation telling Prometheus to scrape the metrics of the pod
+	AnnotationPrometheusScrape = "prometheus.io/scrape"
+	// AnnotationPrometheusPort is the pod annotation of the port of the metrics endpoint scraped by Prometheus
+	AnnotationPrometheusPort = "prometheus.io/port"
+	// AnnotationPrometheusPath is the pod annotation of the path of the metrics endpoint scraped by Prometheus
+	AnnotationPrometheusPath = "prometheus.io/path"
+)
+
+// Tracing constants
+const (
+	// EnvVarTracingExporter refers to the env var to select the tracing exporter, i.e. "stdout" or "file"
+	EnvVarTracingExporter = "TRACING_EXPORTER"
+	// EnvVarTracingFile refers to the env var for the path of the file the "file" tracing exporter writes to
+	EnvVarTracingFile = "TRACING_FILE"
+)
+
 // Miscellaneous Labels
 const (
 	// LabelEventSource is label for event name
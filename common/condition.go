@@ -0,0 +1,143 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Condition is a condition on the value at a JSONPath of a resource
+type Condition struct {
+	expression string
+	path       *jsonpath.JSONPath
+	operator   string
+	values     []string
+}
+
+// inOperator matches the conditions on a list of values, such as `.status.phase in (Failed, Error)`
+var inOperator = regexp.MustCompile(`^(.+)\s+in\s+\((.*)\)$`)
+
+// ParseCondition parses a condition, such as `.status.phase == Succeeded`
+// or `.status.phase in (Failed, Error)`
+func ParseCondition(expression string) (*Condition, error) {
+	expr := strings.TrimSpace(expression)
+	path := expr
+	c := &Condition{expression: expr}
+	if match := inOperator.FindStringSubmatch(expr); match != nil {
+		path = strings.TrimSpace(match[1])
+		c.operator = "=="
+		for _, value := range strings.Split(match[2], ",") {
+			c.values = append(c.values, unquote(value))
+		}
+	} else {
+		// the last operator is taken, the JSONPath may hold operators in its filters
+		index := -1
+		for _, operator := range []string{"==", "!="} {
+			if i := strings.LastIndex(expr, operator); i > index {
+				index = i
+				c.operator = operator
+			}
+		}
+		if index >= 0 {
+			path = strings.TrimSpace(expr[:index])
+			c.values = []string{unquote(expr[index+len(c.operator):])}
+		}
+	}
+	if path == "" {
+		return nil, errors.Errorf("condition %q doesn't have a JSONPath", expression)
+	}
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	c.path = jsonpath.New("condition").AllowMissingKeys(true)
+	if err := c.path.Parse(path); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the jsonpath of the condition %q", expression)
+	}
+	return c, nil
+}
+
+// Holds checks whether the condition holds for the object.
+// A missing value is an empty string, so that `.status.phase != Running` holds before the phase is set.
+func (c *Condition) Holds(obj map[string]interface{}) (bool, error) {
+	results, err := c.path.FindResults(obj)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate the condition %q", c.expression)
+	}
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			if !value.IsValid() || !value.CanInterface() {
+				continue
+			}
+			if str, ok := value.Interface().(string); ok {
+				values = append(values, str)
+				continue
+			}
+			encoded, err := json.Marshal(value.Interface())
+			if err != nil {
+				return false, err
+			}
+			values = append(values, string(encoded))
+		}
+	}
+	if len(values) == 0 {
+		values = []string{""}
+	}
+
+	for _, value := range values {
+		switch c.operator {
+		case "==":
+			if c.matches(value) {
+				return true, nil
+			}
+		case "!=":
+			if c.matches(value) {
+				return false, nil
+			}
+		default:
+			if value != "" && value != "false" {
+				return true, nil
+			}
+		}
+	}
+	return c.operator == "!=", nil
+}
+
+// matches checks whether the value is one of the values of the condition
+func (c *Condition) matches(value string) bool {
+	for _, v := range c.values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// unquote trims the spaces and the quotes around the value of a condition
+func unquote(value string) string {
+	return strings.Trim(strings.TrimSpace(value), `"'`)
+}
+
+// String returns the expression of the condition
+func (c *Condition) String() string {
+	return c.expression
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCondition(t *testing.T) {
+	obj := map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase":     "Succeeded",
+			"succeeded": int64(1),
+			"active":    false,
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+				map[string]interface{}{"type": "Failed", "status": "False"},
+			},
+		},
+	}
+
+	tests := []struct {
+		expression string
+		holds      bool
+	}{
+		{`.status.phase == Succeeded`, true},
+		{`.status.phase == "Succeeded"`, true},
+		{`{.status.phase} == Failed`, false},
+		{`.status.phase != Running`, true},
+		{`.status.message != Running`, true},
+		{`.status.message == ""`, true},
+		{`.status.succeeded == 1`, true},
+		{`.status.phase in (Failed, Error)`, false},
+		{`.status.phase in (Succeeded, "Skipped")`, true},
+		{`.status.succeeded`, true},
+		{`.status.active`, false},
+		{`.status.message`, false},
+		{`.status.conditions[?(@.type=="Ready")].status == True`, true},
+		{`.status.conditions[?(@.type=="Failed")].status == True`, false},
+	}
+	for _, test := range tests {
+		condition, err := ParseCondition(test.expression)
+		assert.Nil(t, err, test.expression)
+		holds, err := condition.Holds(obj)
+		assert.Nil(t, err, test.expression)
+		assert.Equal(t, test.holds, holds, test.expression)
+	}
+
+	_, err := ParseCondition(`== Succeeded`)
+	assert.NotNil(t, err)
+	_, err = ParseCondition(`.status.phase[ == Succeeded`)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"github.com/pkg/errors"
+)
+
+// exprFunctions are the string functions available to the expressions, in addition to the builtins of the language
+// See https://github.com/antonmedv/expr/blob/master/docs/Language-Definition.md
+var exprFunctions = map[string]interface{}{
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"trim":      strings.TrimSpace,
+	"split":     strings.Split,
+	"join":      strings.Join,
+	"replace":   func(s, old, new string) string { return strings.Replace(s, old, new, -1) },
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+}
+
+// CompileExpr compiles a boolean expression. The variables of the expression are only known when it's evaluated,
+// so that an undefined variable is nil rather than an error.
+func CompileExpr(expression string) (*vm.Program, error) {
+	return expr.Compile(expression, expr.Env(exprFunctions), expr.AllowUndefinedVariables(), expr.AsBool())
+}
+
+// compiledExprs caches the programs of the expressions by their source, as the same expressions are evaluated for every event
+var compiledExprs sync.Map
+
+// compiledExpr returns the program of the expression, compiling it on its first evaluation
+func compiledExpr(expression string) (*vm.Program, error) {
+	if program, ok := compiledExprs.Load(expression); ok {
+		return program.(*vm.Program), nil
+	}
+	program, err := CompileExpr(expression)
+	if err != nil {
+		return nil, err
+	}
+	compiledExprs.Store(expression, program)
+	return program, nil
+}
+
+// EvalExpr evaluates a boolean expression with the variables. The expression is compiled once and cached.
+func EvalExpr(expression string, variables map[string]interface{}) (bool, error) {
+	program, err := compiledExpr(expression)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to compile the expression %s", expression)
+	}
+	env := make(map[string]interface{}, len(variables)+len(exprFunctions))
+	for name, value := range variables {
+		env[name] = value
+	}
+	for name, fn := range exprFunctions {
+		env[name] = fn
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate the expression %s", expression)
+	}
+	ok, isBool := result.(bool)
+	if !isBool {
+		return false, errors.Errorf("expression %s doesn't evaluate to a boolean", expression)
+	}
+	return ok, nil
+}
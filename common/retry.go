@@ -17,6 +17,7 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"time"
 
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -65,3 +66,25 @@ func GetConnectionBackoff(backoff *apicommon.Backoff) *wait.Backoff {
 	}
 	return &result
 }
+
+// ExponentialBackoff checks the condition with the backoff, as wait.ExponentialBackoff does, unless the context is done.
+// It returns the error of the context if it's done before the condition is met, without waiting for the next step.
+func ExponentialBackoff(ctx context.Context, backoff wait.Backoff, condition wait.ConditionFunc) error {
+	for backoff.Steps > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if ok, err := condition(); err != nil || ok {
+			return err
+		}
+		if backoff.Steps == 1 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff.Step()):
+		}
+	}
+	return wait.ErrWaitTimeout
+}
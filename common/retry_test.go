@@ -16,11 +16,14 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 func TestRetryableKubeAPIError(t *testing.T) {
@@ -36,3 +39,32 @@ func TestRetryableKubeAPIError(t *testing.T) {
 	assert.False(t, IsRetryableKubeAPIError(errInvalid))
 	assert.False(t, IsRetryableKubeAPIError(errMethodNotSupported))
 }
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+	checks := 0
+	err := ExponentialBackoff(context.Background(), backoff, func() (bool, error) {
+		checks++
+		return checks == 2, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, checks)
+
+	checks = 0
+	err = ExponentialBackoff(context.Background(), backoff, func() (bool, error) {
+		checks++
+		return false, nil
+	})
+	assert.Equal(t, wait.ErrWaitTimeout, err)
+	assert.Equal(t, 3, checks)
+
+	// the next step isn't waited for once the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = ExponentialBackoff(ctx, wait.Backoff{Duration: time.Minute, Factor: 1, Steps: 3}, func() (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second)
+}
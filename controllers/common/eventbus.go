@@ -0,0 +1,81 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventbusclientset "github.com/argoproj/argo-events/pkg/client/eventbus/clientset/versioned"
+)
+
+// GetEventBus returns the EventBus with the given name in the namespace.
+// It returns nil if the EventBus doesn't exist.
+func GetEventBus(client eventbusclientset.Interface, namespace, name string) (*eventbusv1alpha1.EventBus, error) {
+	if name == "" {
+		name = common.DefaultEventBusName
+	}
+	eventBus, err := client.ArgoprojV1alpha1().EventBus(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return eventBus, nil
+}
+
+// BuildEventBusEnvVars returns the env vars required by a gateway or a sensor pod to connect to the EventBus
+func BuildEventBusEnvVars(eventBus *eventbusv1alpha1.EventBus) ([]corev1.EnvVar, error) {
+	busConfig := eventBus.Status.Config
+	if busConfig.NATS == nil {
+		return nil, errors.Errorf("eventbus %s is not configured yet", eventBus.Name)
+	}
+	configBytes, err := json.Marshal(busConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the eventbus config")
+	}
+	envVars := []corev1.EnvVar{
+		{
+			Name:  common.EnvVarEventBusConfig,
+			Value: string(configBytes),
+		},
+		{
+			Name:  common.EnvVarEventBusSubject,
+			Value: eventbus.SubjectPrefix(eventBus.Namespace),
+		},
+	}
+	if busConfig.NATS.Auth != nil && *busConfig.NATS.Auth != eventbusv1alpha1.AuthStrategyNone {
+		if busConfig.NATS.AccessSecret == nil {
+			return nil, errors.Errorf("access secret for eventbus %s is not set", eventBus.Name)
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name: common.EnvVarEventBusAuth,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: busConfig.NATS.AccessSecret.DeepCopy(),
+			},
+		})
+	}
+	return envVars, nil
+}
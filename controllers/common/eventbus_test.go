@@ -0,0 +1,81 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	fakeeventbus "github.com/argoproj/argo-events/pkg/client/eventbus/clientset/versioned/fake"
+)
+
+func TestGetEventBus(t *testing.T) {
+	client := fakeeventbus.NewSimpleClientset()
+	eventBus, err := GetEventBus(client, "fake-namespace", "")
+	assert.Nil(t, err)
+	assert.Nil(t, eventBus)
+
+	_, err = client.ArgoprojV1alpha1().EventBus("fake-namespace").Create(&v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.DefaultEventBusName,
+			Namespace: "fake-namespace",
+		},
+	})
+	assert.Nil(t, err)
+	eventBus, err = GetEventBus(client, "fake-namespace", "")
+	assert.Nil(t, err)
+	assert.NotNil(t, eventBus)
+}
+
+func TestBuildEventBusEnvVars(t *testing.T) {
+	eventBus := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.DefaultEventBusName,
+			Namespace: "fake-namespace",
+		},
+	}
+	_, err := BuildEventBusEnvVars(eventBus)
+	assert.NotNil(t, err)
+
+	token := v1alpha1.AuthStrategyToken
+	eventBus.Status.Config.NATS = &v1alpha1.NATSConfig{
+		URL:  "nats://eventbus-default-stan-svc:4222",
+		Auth: &token,
+	}
+	_, err = BuildEventBusEnvVars(eventBus)
+	assert.NotNil(t, err)
+
+	eventBus.Status.Config.NATS.AccessSecret = &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: "eventbus-default-client",
+		},
+		Key: "client-auth",
+	}
+	envVars, err := BuildEventBusEnvVars(eventBus)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(envVars))
+	assert.Equal(t, common.EnvVarEventBusConfig, envVars[0].Name)
+	assert.Equal(t, common.EnvVarEventBusSubject, envVars[1].Name)
+	assert.Equal(t, "eventbus-fake-namespace", envVars[1].Value)
+	assert.Equal(t, common.EnvVarEventBusAuth, envVars[2].Name)
+	assert.Equal(t, "eventbus-default-client", envVars[2].ValueFrom.SecretKeyRef.Name)
+}
@@ -18,8 +18,10 @@ package common
 
 import (
 	"github.com/argoproj/argo-events/common"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // SetObjectMeta sets ObjectMeta of child resource
@@ -57,3 +59,43 @@ func SetObjectMeta(owner, obj metav1.Object, gvk schema.GroupVersionKind) error
 
 	return nil
 }
+
+// MetricsPort returns the port of the metrics endpoint of the container, i.e. the port set by its METRICS_PORT env var
+// if any, or the default port
+func MetricsPort(container *corev1.Container, defaultPort string) intstr.IntOrString {
+	port := defaultPort
+	for _, env := range container.Env {
+		if env.Name == common.EnvVarMetricsPort && env.Value != "" {
+			port = env.Value
+		}
+	}
+	return intstr.Parse(port)
+}
+
+// AddMetricsPort declares the port of the metrics endpoint on the container, unless the container already declares it
+func AddMetricsPort(container *corev1.Container, name string, port intstr.IntOrString) {
+	for _, containerPort := range container.Ports {
+		if containerPort.ContainerPort == int32(port.IntValue()) {
+			return
+		}
+	}
+	container.Ports = append(container.Ports, corev1.ContainerPort{
+		Name:          name,
+		ContainerPort: int32(port.IntValue()),
+		Protocol:      corev1.ProtocolTCP,
+	})
+}
+
+// MetricsAnnotations returns the annotations of a pod for Prometheus to scrape the metrics endpoint on the port,
+// along with the given annotations, which take precedence
+func MetricsAnnotations(port intstr.IntOrString, annotations map[string]string) map[string]string {
+	result := map[string]string{
+		common.AnnotationPrometheusScrape: "true",
+		common.AnnotationPrometheusPort:   port.String(),
+		common.AnnotationPrometheusPath:   common.MetricsPath,
+	}
+	for key, value := range annotations {
+		result[key] = value
+	}
+	return result
+}
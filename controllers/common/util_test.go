@@ -24,6 +24,7 @@ import (
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestSetObjectMeta(t *testing.T) {
@@ -47,3 +48,10 @@ func TestSetObjectMeta(t *testing.T) {
 	assert.NotEmpty(t, pod.Labels)
 	assert.Equal(t, owner.Name, pod.Labels[common.LabelOwnerName])
 }
+
+func TestMetricsPort(t *testing.T) {
+	container := &corev1.Container{}
+	assert.Equal(t, intstr.FromInt(9090), MetricsPort(container, common.DefaultMetricsPort))
+	container.Env = []corev1.EnvVar{{Name: common.EnvVarMetricsPort, Value: "8000"}}
+	assert.Equal(t, intstr.FromInt(8000), MetricsPort(container, common.DefaultMetricsPort))
+}
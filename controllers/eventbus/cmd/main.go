@@ -14,9 +14,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/controllers/eventbus"
+	"github.com/argoproj/argo-events/metrics"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
@@ -47,7 +50,10 @@ func main() {
 	if !defined {
 		panic(fmt.Errorf("required environment variable '%s' not defined", natsStreamingEnvVar))
 	}
-	opts := ctrl.Options{}
+	opts := ctrl.Options{
+		// the metrics of the manager are served along with the controller ones
+		MetricsBindAddress: "0",
+	}
 	if namespaced {
 		opts.Namespace = managedNamespace
 	}
@@ -99,6 +105,13 @@ func main() {
 		panic(err)
 	}
 
+	go func() {
+		if err := metrics.Serve(metrics.Port(common.DefaultMetricsPort), ctrlmetrics.Registry); err != nil {
+			mainLog.Error(err, "unable to serve the metrics")
+			panic(err)
+		}
+	}()
+
 	mainLog.Info("starting manager")
 	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
 		mainLog.Error(err, "unable to run manager")
@@ -3,6 +3,7 @@ package eventbus
 import (
 	"context"
 	"errors"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/argoproj/argo-events/controllers/eventbus/installer"
+	"github.com/argoproj/argo-events/metrics"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 
 	"github.com/go-logr/logr"
@@ -55,7 +57,9 @@ func (r *reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	if !ok {
 		return ctrl.Result{}, errors.New("convert error")
 	}
+	start := time.Now()
 	reconcileErr := r.reconcile(ctx, busCopy)
+	metrics.ControllerReconciled(ControllerName, start, reconcileErr)
 	if reconcileErr != nil {
 		log.Error(reconcileErr, "reconcile error")
 	}
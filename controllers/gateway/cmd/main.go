@@ -23,6 +23,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/controllers/gateway"
+	"github.com/argoproj/argo-events/metrics"
 )
 
 func main() {
@@ -60,6 +61,12 @@ func main() {
 		panic(err)
 	}
 
+	go func() {
+		if err := metrics.Serve(metrics.Port(common.DefaultMetricsPort)); err != nil {
+			panic(err)
+		}
+	}()
+
 	go controller.Run(context.Background(), 1)
 	select {}
 }
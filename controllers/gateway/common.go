@@ -25,3 +25,9 @@ const (
 	// LabelGatewayKeyPhase is a label applied to gateways to indicate the current phase of the controller (for filtering purposes)
 	LabelPhase = gateway.FullName + "/phase"
 )
+
+// controllerName is the name of the gateway controller in the metrics
+const controllerName = "gateway-controller"
+
+// gatewayClientContainerName is the name of the gateway client container in the gateway pod
+const gatewayClientContainerName = "gateway-client"
@@ -122,6 +122,38 @@ func (ctx *gatewayContext) makeDeploymentSpec() (*appv1.DeploymentSpec, error) {
 			return nil, err
 		}
 	}
+	serverMetricsPort := controllerscommon.MetricsPort(&eventContainer, common.GatewayServerMetricsPort)
+	controllerscommon.AddMetricsPort(&eventContainer, "server-metrics", serverMetricsPort)
+	if eventContainer.ReadinessProbe == nil {
+		// the gateway server is ready once the routes of its event sources are active
+		eventContainer.ReadinessProbe = &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: common.ReadinessPath,
+					Port: serverMetricsPort,
+				},
+			},
+			PeriodSeconds: 5,
+		}
+	}
+
+	clientContainer := corev1.Container{
+		Name:            gatewayClientContainerName,
+		Image:           ctx.controller.clientImage,
+		ImagePullPolicy: corev1.PullAlways,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    apiresource.MustParse("5m"),
+				corev1.ResourceMemory: apiresource.MustParse("10Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    apiresource.MustParse("50m"),
+				corev1.ResourceMemory: apiresource.MustParse("128Mi"),
+			},
+		},
+	}
+	clientMetricsPort := controllerscommon.MetricsPort(&clientContainer, common.DefaultMetricsPort)
+	controllerscommon.AddMetricsPort(&clientContainer, "metrics", clientMetricsPort)
 
 	return &appv1.DeploymentSpec{
 		Selector: &metav1.LabelSelector{
@@ -130,27 +162,15 @@ func (ctx *gatewayContext) makeDeploymentSpec() (*appv1.DeploymentSpec, error) {
 		Replicas: &replicas,
 		Template: corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels:      podTemplateLabels,
-				Annotations: ctx.gateway.Spec.Template.Metadata.Annotations,
+				Labels: podTemplateLabels,
+				// the annotations only point Prometheus to the metrics of the gateway client, the metrics of the
+				// gateway server are scraped through the server-metrics port of the pod
+				Annotations: controllerscommon.MetricsAnnotations(clientMetricsPort, ctx.gateway.Spec.Template.Metadata.Annotations),
 			},
 			Spec: corev1.PodSpec{
 				ServiceAccountName: ctx.gateway.Spec.Template.ServiceAccountName,
 				Containers: []corev1.Container{
-					{
-						Name:            "gateway-client",
-						Image:           ctx.controller.clientImage,
-						ImagePullPolicy: corev1.PullAlways,
-						Resources: corev1.ResourceRequirements{
-							Requests: corev1.ResourceList{
-								corev1.ResourceCPU:    apiresource.MustParse("5m"),
-								corev1.ResourceMemory: apiresource.MustParse("10Mi"),
-							},
-							Limits: corev1.ResourceList{
-								corev1.ResourceCPU:    apiresource.MustParse("50m"),
-								corev1.ResourceMemory: apiresource.MustParse("128Mi"),
-							},
-						},
-					},
+					clientContainer,
 					eventContainer,
 				},
 				Affinity:        ctx.gateway.Spec.Template.Affinity,
@@ -238,8 +258,17 @@ func (ctx *gatewayContext) buildDeploymentResource() (*appv1.Deployment, error)
 		},
 	}
 
+	eventBusEnvVars, err := ctx.buildEventBusEnvVars()
+	if err != nil {
+		return nil, err
+	}
+
 	for i, container := range deployment.Spec.Template.Spec.Containers {
 		container.Env = append(container.Env, envVars...)
+		// only the gateway client publishes events to the eventbus
+		if container.Name == gatewayClientContainerName {
+			container.Env = append(container.Env, eventBusEnvVars...)
+		}
 		deployment.Spec.Template.Spec.Containers[i] = container
 	}
 
@@ -250,6 +279,20 @@ func (ctx *gatewayContext) buildDeploymentResource() (*appv1.Deployment, error)
 	return deployment, nil
 }
 
+// buildEventBusEnvVars returns the env vars for the gateway client to publish events to the eventbus.
+// Gateways still relying on subscribers keep working if the eventbus doesn't exist.
+func (ctx *gatewayContext) buildEventBusEnvVars() ([]corev1.EnvVar, error) {
+	eventBus, err := controllerscommon.GetEventBus(ctx.controller.eventBusClient, ctx.gateway.Namespace, ctx.gateway.Spec.EventBusName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the eventbus")
+	}
+	if eventBus == nil {
+		ctx.logger.WithField("eventbus", ctx.gateway.Spec.EventBusName).Warnln("eventbus not found, events are only dispatched to the subscribers")
+		return nil, nil
+	}
+	return controllerscommon.BuildEventBusEnvVars(eventBus)
+}
+
 // createGatewayResources creates gateway deployment and service
 func (ctx *gatewayContext) createGatewayResources() error {
 	if ctx.gateway.Status.Resources == nil {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -130,7 +131,18 @@ func TestResource_BuildDeploymentResource(t *testing.T) {
 			assert.Equal(t, container.Env[3].Value, ctx.controller.Config.InstanceID)
 			assert.Equal(t, container.Env[4].Name, common.EnvVarGatewayServerPort)
 			assert.Equal(t, container.Env[4].Value, ctx.gateway.Spec.ProcessorPort)
+			if container.Name != gatewayClientContainerName {
+				assert.NotNil(t, container.ReadinessProbe)
+				assert.Equal(t, common.ReadinessPath, container.ReadinessProbe.HTTPGet.Path)
+				assert.Equal(t, 9091, container.ReadinessProbe.HTTPGet.Port.IntValue())
+				assert.Equal(t, int32(9091), container.Ports[0].ContainerPort)
+			} else {
+				assert.Equal(t, int32(9090), container.Ports[0].ContainerPort)
+			}
 		}
+		assert.Equal(t, "true", deployment.Spec.Template.Annotations[common.AnnotationPrometheusScrape])
+		assert.Equal(t, common.DefaultMetricsPort, deployment.Spec.Template.Annotations[common.AnnotationPrometheusPort])
+		assert.Equal(t, common.MetricsPath, deployment.Spec.Template.Annotations[common.AnnotationPrometheusPath])
 
 		newDeployment, err := controller.k8sClient.AppsV1().Deployments(deployment.Namespace).Create(deployment)
 		assert.Nil(t, err)
@@ -140,6 +152,40 @@ func TestResource_BuildDeploymentResource(t *testing.T) {
 	}
 }
 
+func TestResource_BuildDeploymentResourceWithEventBus(t *testing.T) {
+	controller := newController()
+	_, err := controller.eventBusClient.ArgoprojV1alpha1().EventBus(gatewayObj.Namespace).Create(&eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.DefaultEventBusName,
+			Namespace: gatewayObj.Namespace,
+		},
+		Status: eventbusv1alpha1.EventBusStatus{
+			Config: eventbusv1alpha1.BusConfig{
+				NATS: &eventbusv1alpha1.NATSConfig{
+					URL: "nats://eventbus-default-stan-svc:4222",
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	ctx := newGatewayContext(gatewayObj, controller)
+	deployment, err := ctx.buildDeploymentResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, deployment)
+
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		envNames := map[string]bool{}
+		for _, env := range container.Env {
+			envNames[env.Name] = true
+		}
+		isClient := container.Name == gatewayClientContainerName
+		assert.Equal(t, isClient, envNames[common.EnvVarEventBusConfig])
+		assert.Equal(t, isClient, envNames[common.EnvVarEventBusSubject])
+		assert.False(t, envNames[common.EnvVarEventBusAuth])
+	}
+}
+
 func TestResource_CreateGatewayResourceNoTemplate(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -17,6 +17,7 @@ limitations under the License.
 package gateway
 
 import (
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
 	"github.com/pkg/errors"
 )
@@ -50,7 +51,69 @@ func validateSubscribers(subscribers *v1alpha1.Subscribers) error {
 			if subscriber.ServerURL == "" {
 				return errors.New("NATS server url must be specified")
 			}
+			if err := validateRetryStrategy(subscriber.RetryStrategy); err != nil {
+				return errors.Wrapf(err, "retry strategy for subscriber %s is not valid", subscriber.Name)
+			}
+			if err := validateDeadLetter(subscriber.DeadLetter); err != nil {
+				return errors.Wrapf(err, "dead-letter for subscriber %s is not valid", subscriber.Name)
+			}
+		}
+	}
+	for _, subscriber := range subscribers.GetHTTPSubscribers() {
+		if subscriber.URL == "" {
+			return errors.New("url must be specified")
+		}
+		if err := validateRetryStrategy(subscriber.RetryStrategy); err != nil {
+			return errors.Wrapf(err, "retry strategy for subscriber %s is not valid", subscriber.URL)
+		}
+		if err := validateDeadLetter(subscriber.DeadLetter); err != nil {
+			return errors.Wrapf(err, "dead-letter for subscriber %s is not valid", subscriber.URL)
+		}
+	}
+	if err := validateRetryStrategy(subscribers.RetryStrategy); err != nil {
+		return errors.Wrap(err, "retry strategy is not valid")
+	}
+	if err := validateDeadLetter(subscribers.DeadLetter); err != nil {
+		return errors.Wrap(err, "dead-letter is not valid")
+	}
+	return nil
+}
+
+func validateRetryStrategy(retryStrategy *apicommon.Backoff) error {
+	if retryStrategy == nil {
+		return nil
+	}
+	if retryStrategy.Duration < 0 {
+		return errors.New("duration can't be negative")
+	}
+	if retryStrategy.Steps < 0 {
+		return errors.New("steps can't be negative")
+	}
+	return nil
+}
+
+func validateDeadLetter(deadLetter *v1alpha1.DeadLetter) error {
+	if deadLetter == nil {
+		return nil
+	}
+	targets := 0
+	if deadLetter.NATS != nil {
+		if deadLetter.NATS.ServerURL == "" {
+			return errors.New("NATS server url must be specified")
+		}
+		if deadLetter.NATS.Subject == "" {
+			return errors.New("subject must be specified")
 		}
+		targets++
+	}
+	if deadLetter.File != "" {
+		targets++
+	}
+	if deadLetter.URL != "" {
+		targets++
+	}
+	if targets != 1 {
+		return errors.New("exactly one of nats, file or url must be specified")
 	}
 	return nil
 }
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
@@ -40,3 +42,42 @@ func TestValidate(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestValidateSubscribers(t *testing.T) {
+	subscribers := &v1alpha1.Subscribers{
+		HTTPSubscribers: []v1alpha1.HTTPSubscriber{
+			{
+				URL: "http://subscriber:8080",
+				RetryStrategy: &apicommon.Backoff{
+					Duration: time.Second,
+					Steps:    3,
+				},
+				DeadLetter: &v1alpha1.DeadLetter{
+					File: "/data/dead-letter.json",
+				},
+			},
+		},
+	}
+	assert.Nil(t, validateSubscribers(subscribers))
+
+	subscribers.HTTPSubscribers[0].RetryStrategy.Steps = -1
+	assert.NotNil(t, validateSubscribers(subscribers))
+	subscribers.HTTPSubscribers[0].RetryStrategy.Steps = 3
+
+	subscribers.HTTPSubscribers[0].DeadLetter.URL = "http://dead-letter:8080"
+	assert.NotNil(t, validateSubscribers(subscribers))
+
+	subscribers.HTTPSubscribers[0].DeadLetter = nil
+	subscribers.DeadLetter = &v1alpha1.DeadLetter{
+		NATS: &v1alpha1.NATSDeadLetter{
+			ServerURL: "nats://nats:4222",
+		},
+	}
+	assert.NotNil(t, validateSubscribers(subscribers))
+
+	subscribers.DeadLetter.NATS.Subject = "dead-letter"
+	assert.Nil(t, validateSubscribers(subscribers))
+
+	subscribers.HTTPSubscribers[0].URL = ""
+	assert.NotNil(t, validateSubscribers(subscribers))
+}
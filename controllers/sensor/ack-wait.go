@@ -0,0 +1,95 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensor
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// TriggerCycleDuration returns the worst-case duration of a trigger cycle of the sensor, i.e. the sum over its triggers
+// of the debounce window and of the timeout, or of the waits of the retry strategy and of the policies if the trigger
+// has no timeout. The attempts of the triggers without timeout, and the time waiting for the trigger pool, aren't
+// accounted for, as they aren't bounded.
+func TriggerCycleDuration(sensor *v1alpha1.Sensor) time.Duration {
+	var total time.Duration
+	for _, trigger := range sensor.Spec.Triggers {
+		if trigger.RateLimit != nil && trigger.RateLimit.Debounce > 0 {
+			total += time.Duration(trigger.RateLimit.Debounce) * time.Second
+		}
+		if trigger.Timeout > 0 {
+			total += time.Duration(trigger.Timeout) * time.Second
+			continue
+		}
+		if trigger.RetryStrategy != nil {
+			total += backoffDuration(*common.GetConnectionBackoff(trigger.RetryStrategy))
+		}
+		if trigger.Policy == nil {
+			continue
+		}
+		if policy := trigger.Policy.K8s; policy != nil && policy.Labels != nil {
+			backoff := wait.Backoff{
+				Duration: policy.Backoff.Duration,
+				Steps:    int(policy.Backoff.Steps),
+			}
+			backoff.Factor, _ = policy.Backoff.Factor.Float64()
+			if policy.Backoff.Jitter != nil {
+				backoff.Jitter, _ = policy.Backoff.Jitter.Float64()
+			}
+			total += backoffDuration(backoff)
+		}
+		if policy := trigger.Policy.Completion; policy != nil {
+			if policy.Timeout > 0 {
+				total += time.Duration(policy.Timeout) * time.Second
+			} else {
+				total += common.DefaultCompletionTimeout
+			}
+		}
+	}
+	return total
+}
+
+// backoffDuration returns the max time waited between the steps of the backoff
+func backoffDuration(backoff wait.Backoff) time.Duration {
+	var total time.Duration
+	duration := backoff.Duration
+	for step := 1; step < backoff.Steps; step++ {
+		total += duration
+		if backoff.Jitter > 0 {
+			total += time.Duration(backoff.Jitter * float64(duration))
+		}
+		if backoff.Factor != 0 {
+			duration = time.Duration(float64(duration) * backoff.Factor)
+		}
+	}
+	return total
+}
+
+// EventBusAckWait returns the time the eventbus waits for the ack of an event of the sensor before redelivering it
+func EventBusAckWait(sensor *v1alpha1.Sensor) time.Duration {
+	if sensor.Spec.EventBusAckWait > 0 {
+		return time.Duration(sensor.Spec.EventBusAckWait) * time.Second
+	}
+	if duration := TriggerCycleDuration(sensor); duration > common.DefaultEventBusAckWait {
+		return duration
+	}
+	return common.DefaultEventBusAckWait
+}
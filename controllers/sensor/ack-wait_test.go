@@ -0,0 +1,92 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestTriggerCycleDuration(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{Name: "timeout"},
+					Timeout:  30,
+					// the retries are bounded by the timeout
+					RetryStrategy: &apicommon.Backoff{
+						Duration: time.Hour,
+						Factor:   apicommon.NewAmount("1"),
+						Steps:    5,
+					},
+					RateLimit: &v1alpha1.RateLimit{
+						Debounce: 10,
+					},
+				},
+				{
+					Template: &v1alpha1.TriggerTemplate{Name: "retry"},
+					RetryStrategy: &apicommon.Backoff{
+						Duration: time.Second,
+						Factor:   apicommon.NewAmount("2"),
+						Steps:    4,
+					},
+				},
+				{
+					Template: &v1alpha1.TriggerTemplate{Name: "completion"},
+					Policy: &v1alpha1.TriggerPolicy{
+						Completion: &v1alpha1.CompletionPolicy{
+							SuccessCondition: ".status.phase == Succeeded",
+						},
+					},
+				},
+			},
+		},
+	}
+	// 40s for the first trigger, 1s + 2s + 4s with the default jitter of 10% for the second
+	// and the default completion timeout for the third
+	assert.Equal(t, 47700*time.Millisecond+common.DefaultCompletionTimeout, TriggerCycleDuration(sensor))
+
+	sensor.Spec.Triggers[2].Policy.Completion.Timeout = 60
+	assert.Equal(t, 107700*time.Millisecond, TriggerCycleDuration(sensor))
+}
+
+func TestEventBusAckWait(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger"},
+					Timeout:  60,
+				},
+			},
+		},
+	}
+	assert.Equal(t, common.DefaultEventBusAckWait, EventBusAckWait(sensor))
+
+	sensor.Spec.Triggers[0].Timeout = 600
+	assert.Equal(t, 10*time.Minute, EventBusAckWait(sensor))
+
+	sensor.Spec.EventBusAckWait = 900
+	assert.Equal(t, 15*time.Minute, EventBusAckWait(sensor))
+}
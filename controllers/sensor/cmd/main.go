@@ -23,6 +23,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/metrics"
 )
 
 func main() {
@@ -57,6 +58,12 @@ func main() {
 		panic(err)
 	}
 
+	go func() {
+		if err := metrics.Serve(metrics.Port(common.DefaultMetricsPort)); err != nil {
+			panic(err)
+		}
+	}()
+
 	go controller.Run(context.Background(), 1)
 	select {}
 }
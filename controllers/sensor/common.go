@@ -27,3 +27,6 @@ const (
 	// LabelComplete is the label to mark sensors as complete
 	LabelComplete = sensor.FullName + "/complete"
 )
+
+// controllerName is the name of the sensor controller in the metrics
+const controllerName = "sensor-controller"
@@ -31,7 +31,9 @@ import (
 
 	base "github.com/argoproj/argo-events"
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/metrics"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	eventbusclientset "github.com/argoproj/argo-events/pkg/client/eventbus/clientset/versioned"
 	clientset "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned"
 )
 
@@ -71,6 +73,8 @@ type Controller struct {
 	k8sClient kubernetes.Interface
 	// sensorClient is the client for operations on the sensor custom resource
 	sensorClient clientset.Interface
+	// eventBusClient is the client for the eventbus custom resource
+	eventBusClient eventbusclientset.Interface
 	// informer for sensor resource updates
 	informer cache.SharedIndexInformer
 	// queue to process watched sensor resources
@@ -81,14 +85,15 @@ type Controller struct {
 func NewController(rest *rest.Config, configMap, namespace, sensorImage string) *Controller {
 	rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(rateLimiterBaseDelay, rateLimiterMaxDelay)
 	return &Controller{
-		ConfigMap:    configMap,
-		Namespace:    namespace,
-		sensorImage:  sensorImage,
-		kubeConfig:   rest,
-		k8sClient:    kubernetes.NewForConfigOrDie(rest),
-		sensorClient: clientset.NewForConfigOrDie(rest),
-		queue:        workqueue.NewRateLimitingQueue(rateLimiter),
-		logger:       common.NewArgoEventsLogger(),
+		ConfigMap:      configMap,
+		Namespace:      namespace,
+		sensorImage:    sensorImage,
+		kubeConfig:     rest,
+		k8sClient:      kubernetes.NewForConfigOrDie(rest),
+		sensorClient:   clientset.NewForConfigOrDie(rest),
+		eventBusClient: eventbusclientset.NewForConfigOrDie(rest),
+		queue:          workqueue.NewRateLimitingQueue(rateLimiter),
+		logger:         common.NewArgoEventsLogger(),
 	}
 }
 
@@ -120,7 +125,9 @@ func (controller *Controller) processNextItem() bool {
 
 	ctx := newSensorContext(s, controller)
 
+	start := time.Now()
 	err = ctx.operate()
+	metrics.ControllerReconciled(controllerName, start, err)
 	if err != nil {
 		ctx.logger.WithError(err).WithField("sensor", s.Name).Errorln("failed to operate on the sensor object")
 	}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	fakeeventbus "github.com/argoproj/argo-events/pkg/client/eventbus/clientset/versioned/fake"
 	fakesensor "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
 )
 
@@ -57,11 +58,12 @@ func getController() *Controller {
 				ServiceAccountName: "fake-sa",
 			},
 		},
-		sensorImage:  "sensor-image",
-		k8sClient:    clientset,
-		sensorClient: fakesensor.NewSimpleClientset(),
-		queue:        workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		logger:       common.NewArgoEventsLogger(),
+		sensorImage:    "sensor-image",
+		k8sClient:      clientset,
+		sensorClient:   fakesensor.NewSimpleClientset(),
+		eventBusClient: fakeeventbus.NewSimpleClientset(),
+		queue:          workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		logger:         common.NewArgoEventsLogger(),
 	}
 	informer, err := controller.newSensorInformer()
 	if err != nil {
@@ -176,3 +176,58 @@ func MarkResolvedAt(sensor *v1alpha1.Sensor, nodeName string) *v1alpha1.NodeStat
 	sensor.Status.Nodes[node.ID] = *node
 	return node
 }
+
+// MarkAttempts records the number of attempts of the last execution of a trigger
+func MarkAttempts(sensor *v1alpha1.Sensor, nodeName string, attempts int32) *v1alpha1.NodeStatus {
+	node := GetNodeByName(sensor, nodeName)
+	if node == nil {
+		return nil
+	}
+	node.Attempts = attempts
+	sensor.Status.Nodes[node.ID] = *node
+	return node
+}
+
+// MarkOutcome records the outcome of the resource created by the last execution of a trigger
+func MarkOutcome(sensor *v1alpha1.Sensor, nodeName string, outcome v1alpha1.CompletionOutcome) *v1alpha1.NodeStatus {
+	node := GetNodeByName(sensor, nodeName)
+	if node == nil {
+		return nil
+	}
+	node.Outcome = outcome
+	sensor.Status.Nodes[node.ID] = *node
+	return node
+}
+
+// MarkExpiresAt records the time at which the event of a dependency expires
+func MarkExpiresAt(sensor *v1alpha1.Sensor, nodeName string, expiresAt time.Time) *v1alpha1.NodeStatus {
+	node := GetNodeByName(sensor, nodeName)
+	if node == nil {
+		return nil
+	}
+	node.ExpiresAt = &metav1.MicroTime{Time: expiresAt.UTC()}
+	sensor.Status.Nodes[node.ID] = *node
+	return node
+}
+
+// ExpireNode discards the event of a dependency, which is re-activated to wait for a new event
+func ExpireNode(sensor *v1alpha1.Sensor, nodeName string, logger *logrus.Logger, message string) *v1alpha1.NodeStatus {
+	if node := MarkNodePhase(sensor, nodeName, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, logger, message); node == nil {
+		return nil
+	}
+	node := MarkResolvedAt(sensor, nodeName)
+	node.ExpiresAt = nil
+	sensor.Status.Nodes[node.ID] = *node
+	return node
+}
+
+// MarkCorrelationValue records the value of the correlation key shared by the events that resolved a dependency group
+func MarkCorrelationValue(sensor *v1alpha1.Sensor, nodeName string, value string) *v1alpha1.NodeStatus {
+	node := GetNodeByName(sensor, nodeName)
+	if node == nil {
+		return nil
+	}
+	node.CorrelationValue = value
+	sensor.Status.Nodes[node.ID] = *node
+	return node
+}
@@ -18,6 +18,7 @@ package sensor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -197,3 +198,63 @@ func TestAreAllDependenciesResolved(t *testing.T) {
 	ok = AreAllDependenciesResolved(fakeSensor)
 	assert.Equal(t, true, ok)
 }
+
+func TestMarkAttempts(t *testing.T) {
+	logger := common.NewArgoEventsLogger()
+	fakeSensor := &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-sensor",
+			Namespace: "test",
+		},
+	}
+
+	trigger1 := InitializeNode(fakeSensor, "trigger1", v1alpha1.NodeTypeTrigger, logger)
+	assert.Equal(t, int32(0), trigger1.Attempts)
+
+	trigger1 = MarkAttempts(fakeSensor, trigger1.Name, 3)
+	assert.Equal(t, int32(3), trigger1.Attempts)
+	assert.Equal(t, int32(3), GetNodeByName(fakeSensor, "trigger1").Attempts)
+
+	assert.Nil(t, MarkAttempts(fakeSensor, "unknown", 1))
+}
+
+func TestMarkOutcome(t *testing.T) {
+	logger := common.NewArgoEventsLogger()
+	fakeSensor := &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-sensor",
+			Namespace: "test",
+		},
+	}
+
+	trigger1 := InitializeNode(fakeSensor, "trigger1", v1alpha1.NodeTypeTrigger, logger)
+	assert.Equal(t, v1alpha1.CompletionOutcome(""), trigger1.Outcome)
+
+	MarkOutcome(fakeSensor, trigger1.Name, v1alpha1.CompletionFailed)
+	assert.Equal(t, v1alpha1.CompletionFailed, GetNodeByName(fakeSensor, "trigger1").Outcome)
+
+	assert.Nil(t, MarkOutcome(fakeSensor, "unknown", v1alpha1.CompletionSucceeded))
+}
+
+func TestExpireNode(t *testing.T) {
+	logger := common.NewArgoEventsLogger()
+	fakeSensor := &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-sensor",
+			Namespace: "test",
+		},
+	}
+
+	dep1 := InitializeNode(fakeSensor, "dep1", v1alpha1.NodeTypeEventDependency, logger)
+	MarkNodePhase(fakeSensor, dep1.Name, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, nil, logger, "dependency is complete")
+	MarkUpdatedAt(fakeSensor, dep1.Name)
+	dep1 = MarkExpiresAt(fakeSensor, dep1.Name, time.Now())
+	assert.NotNil(t, dep1.ExpiresAt)
+	assert.True(t, IsDependencyResolved(fakeSensor, dep1.Name))
+
+	dep1 = ExpireNode(fakeSensor, dep1.Name, logger, "event expired")
+	assert.Equal(t, v1alpha1.NodePhaseActive, dep1.Phase)
+	assert.Equal(t, "event expired", dep1.Message)
+	assert.Nil(t, dep1.ExpiresAt)
+	assert.False(t, IsDependencyResolved(fakeSensor, dep1.Name))
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	"github.com/argoproj/argo-events/eventbus"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/pkg/errors"
 	appv1 "k8s.io/api/apps/v1"
@@ -35,7 +36,7 @@ import (
 // generateServiceSpec returns a K8s service spec for the sensor
 func (ctx *sensorContext) generateServiceSpec() *corev1.Service {
 	port := common.SensorServerPort
-	if ctx.sensor.Spec.Subscription.HTTP != nil {
+	if ctx.sensor.Spec.Subscription != nil && ctx.sensor.Spec.Subscription.HTTP != nil {
 		port = int(ctx.sensor.Spec.Subscription.HTTP.Port)
 	}
 
@@ -102,6 +103,8 @@ func (ctx *sensorContext) makeDeploymentSpec() (*appv1.DeploymentSpec, error) {
 		}
 	}
 	sensorContainer.Name = "main"
+	metricsPort := controllerscommon.MetricsPort(&sensorContainer, common.DefaultMetricsPort)
+	controllerscommon.AddMetricsPort(&sensorContainer, "metrics", metricsPort)
 	return &appv1.DeploymentSpec{
 		Selector: &metav1.LabelSelector{
 			MatchLabels: labels,
@@ -109,7 +112,8 @@ func (ctx *sensorContext) makeDeploymentSpec() (*appv1.DeploymentSpec, error) {
 		Replicas: &replicas,
 		Template: corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: labels,
+				Labels:      labels,
+				Annotations: controllerscommon.MetricsAnnotations(metricsPort, nil),
 			},
 			Spec: corev1.PodSpec{
 				ServiceAccountName: ctx.sensor.Spec.Template.ServiceAccountName,
@@ -171,6 +175,11 @@ func (ctx *sensorContext) deploymentBuilder() (*appv1.Deployment, error) {
 			Value: ctx.controller.Config.InstanceID,
 		},
 	}
+	eventBusEnvVars, err := ctx.buildEventBusEnvVars()
+	if err != nil {
+		return nil, err
+	}
+	envVars = append(envVars, eventBusEnvVars...)
 	for i, container := range deployment.Spec.Template.Spec.Containers {
 		container.Env = append(container.Env, envVars...)
 		deployment.Spec.Template.Spec.Containers[i] = container
@@ -181,6 +190,26 @@ func (ctx *sensorContext) deploymentBuilder() (*appv1.Deployment, error) {
 	return deployment, nil
 }
 
+// buildEventBusEnvVars returns the env vars for the sensor to subscribe to the eventbus.
+// Sensors with an explicit subscription don't require the eventbus.
+func (ctx *sensorContext) buildEventBusEnvVars() ([]corev1.EnvVar, error) {
+	eventBus, err := controllerscommon.GetEventBus(ctx.controller.eventBusClient, ctx.sensor.Namespace, ctx.sensor.Spec.EventBusName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the eventbus")
+	}
+	if eventBus == nil {
+		if ctx.sensor.Spec.Subscription == nil {
+			return nil, errors.New("eventbus not found and no subscription is specified for the sensor")
+		}
+		return nil, nil
+	}
+	// the sensor subscribes to the subjects of its dependencies, which is validated before the pod is deployed
+	if _, err := eventbus.DependencySubjects(&eventBus.Status.Config, "", ctx.sensor.Spec.Dependencies); err != nil {
+		return nil, errors.Wrapf(err, "the dependencies are not supported by the eventbus %s", eventBus.Name)
+	}
+	return controllerscommon.BuildEventBusEnvVars(eventBus)
+}
+
 // createDeployment creates a deployment for the sensor
 func (ctx *sensorContext) createDeployment(deployment *appv1.Deployment) (*appv1.Deployment, error) {
 	return ctx.controller.k8sClient.AppsV1().Deployments(ctx.sensor.Namespace).Create(deployment)
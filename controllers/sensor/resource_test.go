@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/argoproj/argo-events/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -162,9 +163,47 @@ func TestResource_BuildDeployment(t *testing.T) {
 		assert.NotNil(t, deployment)
 		assert.NotEmpty(t, deployment.Annotations[common.AnnotationResourceSpecHash])
 		assert.Equal(t, int(*deployment.Spec.Replicas), 1)
+		// the metrics endpoint is discoverable by Prometheus
+		assert.Equal(t, "true", deployment.Spec.Template.Annotations[common.AnnotationPrometheusScrape])
+		assert.Equal(t, common.DefaultMetricsPort, deployment.Spec.Template.Annotations[common.AnnotationPrometheusPort])
+		assert.Equal(t, int32(9090), deployment.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort)
 	}
 }
 
+func TestResource_BuildDeploymentWithStreamingEventBus(t *testing.T) {
+	controller := getController()
+	clusterID := "eventbus-default"
+	_, err := controller.eventBusClient.ArgoprojV1alpha1().EventBus(sensorObj.Namespace).Create(&eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.DefaultEventBusName,
+			Namespace: sensorObj.Namespace,
+		},
+		Status: eventbusv1alpha1.EventBusStatus{
+			Config: eventbusv1alpha1.BusConfig{
+				NATS: &eventbusv1alpha1.NATSConfig{
+					URL:       "nats://eventbus-default-stan-svc:4222",
+					ClusterID: &clusterID,
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	opctx := newSensorContext(sensorObj.DeepCopy(), controller)
+	deployment, err := opctx.deploymentBuilder()
+	assert.Nil(t, err)
+	envNames := map[string]bool{}
+	for _, env := range deployment.Spec.Template.Spec.Containers[0].Env {
+		envNames[env.Name] = true
+	}
+	assert.True(t, envNames[common.EnvVarEventBusSubject])
+
+	// the streaming eventbus doesn't support the subjects of glob dependencies
+	opctx.sensor.Spec.Dependencies[0].EventName = "fake-*"
+	_, err = opctx.deploymentBuilder()
+	assert.NotNil(t, err)
+}
+
 func TestResource_SetupContainers(t *testing.T) {
 	sensorObjs := []*v1alpha1.Sensor{sensorObj, sensorObjNoTemplate}
 	for _, sObj := range sensorObjs {
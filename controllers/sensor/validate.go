@@ -23,8 +23,11 @@ import (
 
 	"github.com/Knetic/govaluate"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
@@ -40,13 +43,28 @@ func ValidateSensor(s *v1alpha1.Sensor) error {
 	if err != nil {
 		return err
 	}
-	if s.Spec.Subscription == nil {
-		return errors.New("at least one subscription must be specified")
+	if s.Spec.TriggerConcurrency < 0 {
+		return errors.New("trigger concurrency can't be negative")
 	}
-	if err := validateSubscription(s.Spec.Subscription); err != nil {
-		return errors.Wrap(err, "subscription is invalid")
+	if s.Spec.EventBusAckWait < 0 {
+		return errors.New("eventbus ack wait can't be negative")
+	}
+	if s.Spec.EventBusAckWait > 0 {
+		if duration := TriggerCycleDuration(s); EventBusAckWait(s) < duration {
+			return errors.Errorf("eventbus ack wait of %ds is shorter than the worst-case trigger cycle of %s", s.Spec.EventBusAckWait, duration)
+		}
+	}
+	if s.Spec.Subscription != nil {
+		if err := validateSubscription(s.Spec.Subscription); err != nil {
+			return errors.Wrap(err, "subscription is invalid")
+		}
 	}
 	if s.Spec.DependencyGroups != nil {
+		for _, group := range s.Spec.DependencyGroups {
+			if group.Window < 0 {
+				return errors.Errorf("window of dependency group %s can't be negative", group.Name)
+			}
+		}
 		if s.Spec.Circuit == "" {
 			return errors.Errorf("no circuit expression provided to resolve dependency groups")
 		}
@@ -99,6 +117,162 @@ func validateTriggers(triggers []v1alpha1.Trigger) error {
 		if err := validateTriggerTemplateParameters(&trigger); err != nil {
 			return err
 		}
+		if trigger.Timeout < 0 {
+			return errors.Errorf("timeout of trigger %s can't be negative", trigger.Template.Name)
+		}
+		if err := validateTriggerRetryStrategy(trigger.RetryStrategy); err != nil {
+			return errors.Wrapf(err, "retry strategy of trigger %s is invalid", trigger.Template.Name)
+		}
+		if err := validateTriggerRateLimit(trigger.RateLimit); err != nil {
+			return errors.Wrapf(err, "rate limit of trigger %s is invalid", trigger.Template.Name)
+		}
+	}
+	return validateTriggerDependencies(triggers)
+}
+
+// validateTriggerDependencies validates the dependencies between the triggers form a DAG,
+// and the parameters resolved from the result of a trigger refer to one of the triggers it depends on
+func validateTriggerDependencies(triggers []v1alpha1.Trigger) error {
+	dependencies := make(map[string][]string)
+	names := make(map[string]int)
+	for _, trigger := range triggers {
+		names[trigger.Template.Name]++
+	}
+	for _, trigger := range triggers {
+		name := trigger.Template.Name
+		dependsOn := make(map[string]bool)
+		for _, dependency := range trigger.DependsOn {
+			switch {
+			case dependency.Name == name:
+				return errors.Errorf("trigger %s can't depend on itself", name)
+			case names[dependency.Name] == 0:
+				return errors.Errorf("trigger %s depends on the unknown trigger %s", name, dependency.Name)
+			case names[dependency.Name] > 1:
+				return errors.Errorf("trigger %s depends on the trigger %s, whose name isn't unique", name, dependency.Name)
+			case dependsOn[dependency.Name]:
+				return errors.Errorf("trigger %s depends on the trigger %s more than once", name, dependency.Name)
+			}
+			switch dependency.Condition {
+			case "", v1alpha1.TriggerSucceeded, v1alpha1.TriggerFailed, v1alpha1.TriggerCompleted:
+			default:
+				return errors.Errorf("unknown condition %s of the dependency of trigger %s on %s", dependency.Condition, name, dependency.Name)
+			}
+			dependsOn[dependency.Name] = true
+			dependencies[name] = append(dependencies[name], dependency.Name)
+		}
+		for _, parameter := range triggerParameters(&trigger) {
+			if parameter.Src != nil && parameter.Src.TriggerName != "" && !dependsOn[parameter.Src.TriggerName] {
+				return errors.Errorf("trigger %s doesn't depend on the trigger %s its parameter is resolved from", name, parameter.Src.TriggerName)
+			}
+		}
+	}
+
+	// the triggers on the current path of the depth first search are visiting, the triggers without cycles are visited
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := make(map[string]int)
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch states[name] {
+		case visiting:
+			return errors.Errorf("dependencies of trigger %s form a cycle", name)
+		case visited:
+			return nil
+		}
+		states[name] = visiting
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		states[name] = visited
+		return nil
+	}
+	for _, trigger := range triggers {
+		if err := visit(trigger.Template.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// triggerParameters returns the template and resource parameters of the trigger, along with its payload parameters
+func triggerParameters(trigger *v1alpha1.Trigger) []v1alpha1.TriggerParameter {
+	parameters := append([]v1alpha1.TriggerParameter{}, trigger.Parameters...)
+	template := trigger.Template
+	if template.K8s != nil {
+		parameters = append(parameters, template.K8s.Parameters...)
+	}
+	if template.ArgoWorkflow != nil {
+		parameters = append(parameters, template.ArgoWorkflow.Parameters...)
+	}
+	if template.HTTP != nil {
+		parameters = append(parameters, template.HTTP.Parameters...)
+		parameters = append(parameters, template.HTTP.Payload...)
+	}
+	if template.AWSLambda != nil {
+		parameters = append(parameters, template.AWSLambda.Parameters...)
+		parameters = append(parameters, template.AWSLambda.Payload...)
+	}
+	if template.Kafka != nil {
+		parameters = append(parameters, template.Kafka.Parameters...)
+		parameters = append(parameters, template.Kafka.Payload...)
+	}
+	if template.NATS != nil {
+		parameters = append(parameters, template.NATS.Parameters...)
+		parameters = append(parameters, template.NATS.Payload...)
+	}
+	if template.CustomTrigger != nil {
+		parameters = append(parameters, template.CustomTrigger.Parameters...)
+		parameters = append(parameters, template.CustomTrigger.Payload...)
+	}
+	if template.Slack != nil {
+		parameters = append(parameters, template.Slack.Parameters...)
+	}
+	if template.OpenWhisk != nil {
+		parameters = append(parameters, template.OpenWhisk.Parameters...)
+		parameters = append(parameters, template.OpenWhisk.Payload...)
+	}
+	return parameters
+}
+
+// validateTriggerRetryStrategy validates the retry strategy of a trigger
+func validateTriggerRetryStrategy(retryStrategy *apicommon.Backoff) error {
+	if retryStrategy == nil {
+		return nil
+	}
+	if retryStrategy.Duration < 0 {
+		return errors.New("duration can't be negative")
+	}
+	if retryStrategy.Steps < 0 {
+		return errors.New("steps can't be negative")
+	}
+	return nil
+}
+
+// validateTriggerRateLimit validates the rate limit of a trigger
+func validateTriggerRateLimit(rateLimit *v1alpha1.RateLimit) error {
+	if rateLimit == nil {
+		return nil
+	}
+	switch rateLimit.Unit {
+	case "", v1alpha1.Second, v1alpha1.Minute, v1alpha1.Hour:
+	default:
+		return errors.Errorf("unknown unit %s", rateLimit.Unit)
+	}
+	if rateLimit.RequestsPerUnit < 0 {
+		return errors.New("requests per unit can't be negative")
+	}
+	if rateLimit.Burst < 0 {
+		return errors.New("burst can't be negative")
+	}
+	if rateLimit.Debounce < 0 {
+		return errors.New("debounce can't be negative")
+	}
+	if rateLimit.RequestsPerUnit == 0 && rateLimit.Debounce == 0 {
+		return errors.New("either requests per unit or debounce must be specified")
 	}
 	return nil
 }
@@ -174,10 +348,22 @@ func validateK8sTrigger(trigger *v1alpha1.StandardK8STrigger) error {
 		return errors.New("must provide group, version and resource for the resource")
 	}
 	switch trigger.Operation {
-	case "", v1alpha1.Create, v1alpha1.Patch, v1alpha1.Update:
+	case "", v1alpha1.Create, v1alpha1.Patch, v1alpha1.Update, v1alpha1.Delete, v1alpha1.Apply:
 	default:
 		return errors.Errorf("unknown operation type %s", string(trigger.Operation))
 	}
+	if trigger.DeleteOptions != nil {
+		switch trigger.DeleteOptions.PropagationPolicy {
+		case "", metav1.DeletePropagationOrphan, metav1.DeletePropagationBackground, metav1.DeletePropagationForeground:
+		default:
+			return errors.Errorf("unknown propagation policy %s", string(trigger.DeleteOptions.PropagationPolicy))
+		}
+		if trigger.DeleteOptions.LabelSelector != "" {
+			if _, err := labels.Parse(trigger.DeleteOptions.LabelSelector); err != nil {
+				return errors.Wrapf(err, "invalid label selector %s", trigger.DeleteOptions.LabelSelector)
+			}
+		}
+	}
 	if trigger.Parameters != nil {
 		for i, parameter := range trigger.Parameters {
 			if err := validateTriggerParameter(&parameter); err != nil {
@@ -193,17 +379,28 @@ func validateArgoWorkflowTrigger(trigger *v1alpha1.ArgoWorkflowTrigger) error {
 	if trigger == nil {
 		return errors.New("k8s trigger for can't be nil")
 	}
-	if trigger.Source == nil {
+	if trigger.Source == nil && trigger.SubmitFrom == nil {
 		return errors.New("k8s trigger for does not contain an absolute action")
 	}
 	if trigger.GroupVersionResource.Size() == 0 {
 		return errors.New("must provide group, version and resource for the resource")
 	}
 	switch trigger.Operation {
-	case v1alpha1.Submit, v1alpha1.Suspend, v1alpha1.Retry, v1alpha1.Resume, v1alpha1.Resubmit:
+	case v1alpha1.Submit, v1alpha1.Suspend, v1alpha1.Retry, v1alpha1.Resume, v1alpha1.Resubmit, v1alpha1.Terminate, v1alpha1.Stop:
 	default:
 		return errors.Errorf("unknown operation type %s", string(trigger.Operation))
 	}
+	if trigger.SubmitFrom != nil {
+		if trigger.Operation != v1alpha1.Submit {
+			return errors.Errorf("submitFrom is only valid for the %s operation", string(v1alpha1.Submit))
+		}
+		if trigger.SubmitFrom.Kind != "WorkflowTemplate" && trigger.SubmitFrom.Kind != "CronWorkflow" {
+			return errors.Errorf("unknown kind %s of submitFrom, must be WorkflowTemplate or CronWorkflow", trigger.SubmitFrom.Kind)
+		}
+		if trigger.SubmitFrom.Name == "" {
+			return errors.New("submitFrom must specify the name of the resource")
+		}
+	}
 	if trigger.Parameters != nil {
 		for i, parameter := range trigger.Parameters {
 			if err := validateTriggerParameter(&parameter); err != nil {
@@ -425,9 +622,12 @@ func validateTriggerParameter(parameter *v1alpha1.TriggerParameter) error {
 	if parameter.Src == nil {
 		return errors.Errorf("parameter source can't be empty")
 	}
-	if parameter.Src.DependencyName == "" {
+	if parameter.Src.DependencyName == "" && parameter.Src.TriggerName == "" {
 		return errors.Errorf("parameter dependency name can't be empty")
 	}
+	if parameter.Src.DependencyName != "" && parameter.Src.TriggerName != "" {
+		return errors.Errorf("parameter source can't refer to both a dependency and a trigger")
+	}
 	if parameter.Dest == "" {
 		return errors.Errorf("parameter destination can't be empty")
 	}
@@ -479,6 +679,11 @@ func validateEventFilter(filter *v1alpha1.EventDependencyFilter) error {
 			return err
 		}
 	}
+	for _, expression := range filter.Exprs {
+		if _, err := common.CompileExpr(expression); err != nil {
+			return errors.Wrapf(err, "invalid event filter expression %s", expression)
+		}
+	}
 	return nil
 }
 
@@ -518,6 +723,11 @@ func validateTriggerPolicy(trigger *v1alpha1.Trigger) error {
 	if trigger.Policy == nil {
 		return nil
 	}
+	if trigger.Policy.Completion != nil {
+		if err := validateCompletionPolicy(trigger); err != nil {
+			return err
+		}
+	}
 	if trigger.Template.K8s != nil {
 		return validateK8sTriggerPolicy(trigger.Policy.K8s)
 	}
@@ -547,6 +757,35 @@ func validateK8sTriggerPolicy(policy *v1alpha1.K8SResourcePolicy) error {
 	return nil
 }
 
+// validateCompletionPolicy validates the completion policy of a K8s based trigger
+func validateCompletionPolicy(trigger *v1alpha1.Trigger) error {
+	completion := trigger.Policy.Completion
+	switch {
+	case trigger.Template.ArgoWorkflow != nil:
+	case trigger.Template.K8s != nil:
+		if trigger.Template.K8s.Operation == v1alpha1.Delete {
+			return errors.New("completion policy is not supported by the delete operation")
+		}
+	default:
+		return errors.New("completion policy is only supported by the k8s and argo workflow triggers")
+	}
+	if completion.SuccessCondition == "" {
+		return errors.New("success condition of the completion policy is not specified")
+	}
+	if _, err := common.ParseCondition(completion.SuccessCondition); err != nil {
+		return err
+	}
+	if completion.FailureCondition != "" {
+		if _, err := common.ParseCondition(completion.FailureCondition); err != nil {
+			return err
+		}
+	}
+	if completion.Timeout < 0 {
+		return errors.New("timeout of the completion policy can't be negative")
+	}
+	return nil
+}
+
 // validateStatusPolicy validates a http trigger policy
 func validateStatusPolicy(policy *v1alpha1.StatusPolicy) error {
 	if policy == nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -41,3 +42,189 @@ func TestValidateSensor(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestValidateEventBusAckWait(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{
+				{
+					Name:            "dep",
+					EventSourceName: "webhook",
+					EventName:       "example",
+				},
+			},
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "fake-trigger",
+						HTTP: &v1alpha1.HTTPTrigger{
+							URL: "http://fake-trigger",
+						},
+					},
+					Timeout: 600,
+				},
+			},
+		},
+	}
+	assert.Nil(t, ValidateSensor(sensor))
+
+	sensor.Spec.EventBusAckWait = 900
+	assert.Nil(t, ValidateSensor(sensor))
+
+	// the events would be redelivered while their triggers are executed
+	sensor.Spec.EventBusAckWait = 300
+	assert.NotNil(t, ValidateSensor(sensor))
+
+	sensor.Spec.EventBusAckWait = -1
+	assert.NotNil(t, ValidateSensor(sensor))
+}
+
+func TestValidateEventFilter(t *testing.T) {
+	filter := &v1alpha1.EventDependencyFilter{
+		Exprs: []string{`body.action == "opened" && body.ref matches "^release/"`},
+	}
+	assert.Nil(t, validateEventFilter(filter))
+
+	filter.Exprs = append(filter.Exprs, `body.action ==`)
+	assert.NotNil(t, validateEventFilter(filter))
+
+	filter.Exprs = []string{`body.action`}
+	assert.NotNil(t, validateEventFilter(filter))
+}
+
+func TestValidateK8sTrigger(t *testing.T) {
+	trigger := &v1alpha1.StandardK8STrigger{
+		GroupVersionResource: metav1.GroupVersionResource{Version: "v1", Resource: "pods"},
+		Source:               &v1alpha1.ArtifactLocation{},
+		Operation:            v1alpha1.Delete,
+		DeleteOptions: &v1alpha1.K8SDeleteOptions{
+			LabelSelector:     "app=hello-world",
+			PropagationPolicy: metav1.DeletePropagationBackground,
+		},
+	}
+	assert.Nil(t, validateK8sTrigger(trigger))
+
+	trigger.DeleteOptions.PropagationPolicy = "Cascade"
+	assert.NotNil(t, validateK8sTrigger(trigger))
+	trigger.DeleteOptions.PropagationPolicy = ""
+	trigger.DeleteOptions.LabelSelector = "app in hello-world"
+	assert.NotNil(t, validateK8sTrigger(trigger))
+
+	trigger.DeleteOptions = nil
+	trigger.Operation = v1alpha1.Apply
+	assert.Nil(t, validateK8sTrigger(trigger))
+	trigger.Operation = "replace"
+	assert.NotNil(t, validateK8sTrigger(trigger))
+}
+
+func TestValidateArgoWorkflowTrigger(t *testing.T) {
+	trigger := &v1alpha1.ArgoWorkflowTrigger{
+		GroupVersionResource: metav1.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"},
+		Operation:            v1alpha1.Submit,
+		SubmitFrom: &v1alpha1.WorkflowReference{
+			Kind: "WorkflowTemplate",
+			Name: "hello-world",
+		},
+	}
+	assert.Nil(t, validateArgoWorkflowTrigger(trigger))
+
+	trigger.SubmitFrom.Kind = "ClusterWorkflowTemplate"
+	assert.NotNil(t, validateArgoWorkflowTrigger(trigger))
+	trigger.SubmitFrom.Kind = "CronWorkflow"
+	trigger.SubmitFrom.Name = ""
+	assert.NotNil(t, validateArgoWorkflowTrigger(trigger))
+	trigger.SubmitFrom.Name = "hello-world"
+	trigger.Operation = v1alpha1.Terminate
+	assert.NotNil(t, validateArgoWorkflowTrigger(trigger))
+
+	trigger.SubmitFrom = nil
+	assert.NotNil(t, validateArgoWorkflowTrigger(trigger))
+	trigger.Source = &v1alpha1.ArtifactLocation{}
+	assert.Nil(t, validateArgoWorkflowTrigger(trigger))
+	trigger.Operation = v1alpha1.Stop
+	assert.Nil(t, validateArgoWorkflowTrigger(trigger))
+}
+
+func TestValidateCompletionPolicy(t *testing.T) {
+	trigger := &v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "fake-trigger",
+			K8s: &v1alpha1.StandardK8STrigger{
+				Operation: v1alpha1.Create,
+			},
+		},
+		Policy: &v1alpha1.TriggerPolicy{
+			Completion: &v1alpha1.CompletionPolicy{
+				SuccessCondition: ".status.phase == Succeeded",
+				FailureCondition: ".status.phase in (Failed, Error)",
+				Timeout:          600,
+			},
+		},
+	}
+	assert.Nil(t, validateTriggerPolicy(trigger))
+
+	trigger.Policy.Completion.FailureCondition = ".status.phase[ == Failed"
+	assert.NotNil(t, validateTriggerPolicy(trigger))
+	trigger.Policy.Completion.FailureCondition = ""
+	trigger.Policy.Completion.SuccessCondition = ""
+	assert.NotNil(t, validateTriggerPolicy(trigger))
+	trigger.Policy.Completion.SuccessCondition = ".status.succeeded"
+	trigger.Template.K8s.Operation = v1alpha1.Delete
+	assert.NotNil(t, validateTriggerPolicy(trigger))
+
+	trigger.Template.K8s = nil
+	trigger.Template.HTTP = &v1alpha1.HTTPTrigger{URL: "http://fake.url"}
+	assert.NotNil(t, validateTriggerPolicy(trigger))
+}
+
+func TestValidateTriggerDependencies(t *testing.T) {
+	newTrigger := func(name string, dependsOn ...string) v1alpha1.Trigger {
+		trigger := v1alpha1.Trigger{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: name,
+				HTTP: &v1alpha1.HTTPTrigger{URL: "http://fake.url"},
+			},
+		}
+		for _, dependency := range dependsOn {
+			trigger.DependsOn = append(trigger.DependsOn, v1alpha1.TriggerDependency{Name: dependency})
+		}
+		return trigger
+	}
+
+	triggers := []v1alpha1.Trigger{
+		newTrigger("create"),
+		newTrigger("deploy", "create"),
+		newTrigger("notify", "create", "deploy"),
+	}
+	triggers[1].Template.HTTP.Payload = []v1alpha1.TriggerParameter{
+		{
+			Src:  &v1alpha1.TriggerParameterSource{TriggerName: "create", DataKey: "metadata.name"},
+			Dest: "namespace",
+		},
+	}
+	triggers[2].DependsOn[0].Condition = v1alpha1.TriggerFailed
+	assert.Nil(t, validateTriggers(triggers))
+
+	triggers[2].DependsOn[0].Condition = "Skipped"
+	assert.NotNil(t, validateTriggers(triggers))
+	triggers[2].DependsOn[0].Condition = v1alpha1.TriggerCompleted
+
+	// the parameter is resolved from a trigger it doesn't depend on
+	triggers[1].Template.HTTP.Payload[0].Src.TriggerName = "notify"
+	assert.NotNil(t, validateTriggers(triggers))
+	triggers[1].Template.HTTP.Payload[0].Src.TriggerName = "create"
+	triggers[1].Template.HTTP.Payload[0].Src.DependencyName = "fake-dep"
+	assert.NotNil(t, validateTriggers(triggers))
+	triggers[1].Template.HTTP.Payload[0].Src.DependencyName = ""
+
+	triggers[0].DependsOn = []v1alpha1.TriggerDependency{{Name: "notify"}}
+	assert.NotNil(t, validateTriggers(triggers))
+	triggers[0].DependsOn = []v1alpha1.TriggerDependency{{Name: "create"}}
+	assert.NotNil(t, validateTriggers(triggers))
+	triggers[0].DependsOn = []v1alpha1.TriggerDependency{{Name: "unknown"}}
+	assert.NotNil(t, validateTriggers(triggers))
+	triggers[0].DependsOn = nil
+
+	triggers = append(triggers, newTrigger("create"))
+	assert.NotNil(t, validateTriggers(triggers))
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// Connection is an interface of an event bus driver connection
+type Connection interface {
+	// Close closes the connection
+	Close() error
+	// IsClosed tells whether the connection is closed
+	IsClosed() bool
+}
+
+// Handler is invoked with the payload of each message received from the event bus.
+// The message is acknowledged once ack is called, which may happen after the handler returns,
+// so that a subscriber can handle the next message while the current one is still being processed.
+type Handler func(data []byte, ack func()) error
+
+// Subscription holds the options of a subscription to the event bus
+type Subscription struct {
+	// Subjects to subscribe to
+	Subjects []string
+	// Group is shared by the replicas of a subscriber so that each message is handled by only one of them
+	Group string
+	// AckWait is the time the event bus waits for the ack of a message before redelivering it, if it redelivers
+	// the messages. Defaults to common.DefaultEventBusAckWait.
+	AckWait time.Duration
+}
+
+// Driver is an interface of an event bus driver
+type Driver interface {
+	// Connect establishes a connection to the event bus
+	Connect() (Connection, error)
+	// Publish publishes a message on the event bus subject
+	Publish(conn Connection, subject string, data []byte) error
+	// Subscribe subscribes to the subjects of the subscription and invokes the handler for each message,
+	// it blocks until the close channel is closed
+	Subscribe(conn Connection, closeCh <-chan struct{}, subscription Subscription, handler Handler) error
+}
+
+// Auth holds the credentials to connect to the event bus
+type Auth struct {
+	// Strategy is the auth strategy of the event bus
+	Strategy v1alpha1.AuthStrategy
+	// Token is the token used by the token auth strategy
+	Token string
+}
+
+// GetDriver returns an event bus driver implementation for the given configuration
+func GetDriver(busConfig *v1alpha1.BusConfig, clientID string, auth *Auth, logger *logrus.Logger) (Driver, error) {
+	if busConfig == nil {
+		return nil, errors.New("event bus config can't be nil")
+	}
+	if busConfig.NATS != nil {
+		if isStreaming(busConfig) {
+			return NewSTANDriver(busConfig.NATS.URL, *busConfig.NATS.ClusterID, clientID, auth, logger), nil
+		}
+		return NewNATSDriver(busConfig.NATS.URL, clientID, auth, logger), nil
+	}
+	return nil, errors.New("invalid event bus configuration")
+}
+
+// ClientID returns a unique client ID to connect to the event bus, e.g. "sensor-my-sensor-<random>".
+// Characters not allowed in a NATS Streaming client ID are replaced with "_".
+func ClientID(prefix, name string) string {
+	id := []byte(fmt.Sprintf("%s-%s-%s", prefix, name, uuid.New().String()))
+	for i, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			id[i] = '_'
+		}
+	}
+	return string(id)
+}
+
+// GetConfigFromEnv reads the event bus configuration and the subject prefix injected by the controllers.
+// It returns nil if the pod is not configured to use an event bus.
+func GetConfigFromEnv() (*v1alpha1.BusConfig, string, *Auth, error) {
+	configStr, ok := os.LookupEnv(common.EnvVarEventBusConfig)
+	if !ok || configStr == "" {
+		return nil, "", nil, nil
+	}
+	busConfig := &v1alpha1.BusConfig{}
+	if err := json.Unmarshal([]byte(configStr), busConfig); err != nil {
+		return nil, "", nil, errors.Wrap(err, "failed to parse the event bus configuration")
+	}
+	prefix, ok := os.LookupEnv(common.EnvVarEventBusSubject)
+	if !ok || prefix == "" {
+		return nil, "", nil, errors.New("event bus subject prefix is not provided")
+	}
+	auth := &Auth{
+		Strategy: v1alpha1.AuthStrategyNone,
+	}
+	if busConfig.NATS != nil && busConfig.NATS.Auth != nil {
+		auth.Strategy = *busConfig.NATS.Auth
+	}
+	if auth.Strategy == v1alpha1.AuthStrategyToken {
+		token, err := ParseToken(os.Getenv(common.EnvVarEventBusAuth))
+		if err != nil {
+			return nil, "", nil, err
+		}
+		auth.Token = token
+	}
+	return busConfig, prefix, auth, nil
+}
+
+// ParseToken parses the token from the client auth secret content generated by the event bus controller,
+// i.e. "token=<token>"
+func ParseToken(credentials string) (string, error) {
+	credentials = strings.TrimSpace(credentials)
+	if credentials == "" {
+		return "", errors.New("event bus auth credentials are not provided")
+	}
+	token := strings.TrimPrefix(credentials, "token=")
+	token = strings.Trim(strings.TrimSpace(token), "\"")
+	if token == "" {
+		return "", errors.New("event bus auth token is empty")
+	}
+	return token, nil
+}
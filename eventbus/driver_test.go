@@ -0,0 +1,139 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nats-io/stan.go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestParseToken(t *testing.T) {
+	token, err := ParseToken("token=abc")
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", token)
+
+	token, err = ParseToken("token=\"abc\"\n")
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", token)
+
+	_, err = ParseToken("")
+	assert.NotNil(t, err)
+
+	_, err = ParseToken("token=")
+	assert.NotNil(t, err)
+}
+
+func TestGetDriver(t *testing.T) {
+	logger := common.NewArgoEventsLogger()
+
+	_, err := GetDriver(nil, "client", nil, logger)
+	assert.NotNil(t, err)
+
+	busConfig := &v1alpha1.BusConfig{}
+	_, err = GetDriver(busConfig, "client", nil, logger)
+	assert.NotNil(t, err)
+
+	busConfig.NATS = &v1alpha1.NATSConfig{
+		URL: "nats://localhost:4222",
+	}
+	driver, err := GetDriver(busConfig, "client", nil, logger)
+	assert.Nil(t, err)
+	_, ok := driver.(*natsDriver)
+	assert.True(t, ok)
+
+	clusterID := "stan"
+	busConfig.NATS.ClusterID = &clusterID
+	driver, err = GetDriver(busConfig, "client", nil, logger)
+	assert.Nil(t, err)
+	_, ok = driver.(*stanDriver)
+	assert.True(t, ok)
+}
+
+func TestClientID(t *testing.T) {
+	id := ClientID("sensor", "my.sensor")
+	assert.True(t, strings.HasPrefix(id, "sensor-my_sensor-"))
+	assert.NotEqual(t, id, ClientID("sensor", "my.sensor"))
+}
+
+func TestGetConfigFromEnv(t *testing.T) {
+	defer func() {
+		_ = os.Unsetenv(common.EnvVarEventBusConfig)
+		_ = os.Unsetenv(common.EnvVarEventBusSubject)
+		_ = os.Unsetenv(common.EnvVarEventBusAuth)
+	}()
+
+	busConfig, _, _, err := GetConfigFromEnv()
+	assert.Nil(t, err)
+	assert.Nil(t, busConfig)
+
+	err = os.Setenv(common.EnvVarEventBusConfig, `{"nats":{"url":"nats://eventbus-default-stan-svc:4222","clusterID":"eventbus-default","auth":"token"}}`)
+	assert.Nil(t, err)
+	_, _, _, err = GetConfigFromEnv()
+	assert.NotNil(t, err)
+
+	err = os.Setenv(common.EnvVarEventBusSubject, SubjectPrefix("argo-events"))
+	assert.Nil(t, err)
+	_, _, _, err = GetConfigFromEnv()
+	assert.NotNil(t, err)
+
+	err = os.Setenv(common.EnvVarEventBusAuth, "token=abc")
+	assert.Nil(t, err)
+	busConfig, prefix, auth, err := GetConfigFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, "nats://eventbus-default-stan-svc:4222", busConfig.NATS.URL)
+	assert.Equal(t, "eventbus-argo-events", prefix)
+	assert.Equal(t, v1alpha1.AuthStrategyToken, auth.Strategy)
+	assert.Equal(t, "abc", auth.Token)
+}
+
+// fakeSTANConn is a NATS Streaming connection recording whether it's closed
+type fakeSTANConn struct {
+	stan.Conn
+	closed bool
+}
+
+func (c *fakeSTANConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestSTANConnectionLostWhileConnecting(t *testing.T) {
+	// the connection is lost before the streaming connection is set
+	conn := &stanConnection{}
+	assert.Nil(t, conn.Close())
+	assert.True(t, conn.IsClosed())
+
+	sc := &fakeSTANConn{}
+	assert.False(t, conn.setSTANConn(sc))
+	assert.True(t, sc.closed)
+	assert.Nil(t, conn.stanConn)
+
+	conn = &stanConnection{}
+	sc = &fakeSTANConn{}
+	assert.True(t, conn.setSTANConn(sc))
+	assert.False(t, sc.closed)
+	assert.Nil(t, conn.Close())
+	assert.True(t, sc.closed)
+	assert.True(t, conn.IsClosed())
+}
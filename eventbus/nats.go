@@ -0,0 +1,130 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	natslib "github.com/nats-io/go-nats"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// natsConnection wraps a NATS client connection
+type natsConnection struct {
+	conn *natslib.Conn
+}
+
+// Close closes the NATS connection
+func (c *natsConnection) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	c.conn.Close()
+	return nil
+}
+
+// IsClosed tells whether the NATS connection is closed
+func (c *natsConnection) IsClosed() bool {
+	return c.conn == nil || c.conn.IsClosed()
+}
+
+// natsDriver is the event bus driver for NATS
+type natsDriver struct {
+	url      string
+	clientID string
+	auth     *Auth
+	logger   *logrus.Logger
+}
+
+// NewNATSDriver returns a NATS event bus driver
+func NewNATSDriver(url, clientID string, auth *Auth, logger *logrus.Logger) Driver {
+	return &natsDriver{
+		url:      url,
+		clientID: clientID,
+		auth:     auth,
+		logger:   logger,
+	}
+}
+
+// Connect establishes a connection to the NATS server
+func (d *natsDriver) Connect() (Connection, error) {
+	log := d.logger.WithFields(logrus.Fields{
+		"url":      d.url,
+		"clientID": d.clientID,
+	})
+	opts := []natslib.Option{
+		natslib.Name(d.clientID),
+		// retry forever
+		natslib.MaxReconnects(-1),
+		natslib.DisconnectHandler(func(conn *natslib.Conn) {
+			log.Warnln("disconnected from the event bus")
+		}),
+		natslib.ReconnectHandler(func(conn *natslib.Conn) {
+			log.Infoln("reconnected to the event bus")
+		}),
+	}
+	if d.auth != nil && d.auth.Strategy == v1alpha1.AuthStrategyToken {
+		opts = append(opts, natslib.Token(d.auth.Token))
+	}
+	conn, err := natslib.Connect(d.url, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the event bus")
+	}
+	log.Infoln("connected to the event bus")
+	return &natsConnection{conn: conn}, nil
+}
+
+// Publish publishes the data on the event bus subject
+func (d *natsDriver) Publish(conn Connection, subject string, data []byte) error {
+	natsConn, ok := conn.(*natsConnection)
+	if !ok {
+		return errors.New("not a NATS connection")
+	}
+	return natsConn.conn.Publish(subject, data)
+}
+
+// Subscribe subscribes to the event bus subjects with a queue group, it blocks until the close channel is closed.
+// Core NATS doesn't persist messages, so they are lost if no subscriber is connected.
+func (d *natsDriver) Subscribe(conn Connection, closeCh <-chan struct{}, subscription Subscription, handler Handler) error {
+	natsConn, ok := conn.(*natsConnection)
+	if !ok {
+		return errors.New("not a NATS connection")
+	}
+	subs := make([]*natslib.Subscription, 0, len(subscription.Subjects))
+	defer func() {
+		for _, sub := range subs {
+			_ = sub.Unsubscribe()
+		}
+	}()
+	for _, subject := range subscription.Subjects {
+		log := d.logger.WithField("subject", subject)
+		sub, err := natsConn.conn.QueueSubscribe(subject, subscription.Group, func(msg *natslib.Msg) {
+			// core NATS messages are not acknowledged
+			if err := handler(msg.Data, func() {}); err != nil {
+				log.WithError(err).Errorln("failed to handle the message from the event bus")
+			}
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to subscribe to the event bus subject %s", subject)
+		}
+		subs = append(subs, sub)
+		log.Infoln("subscribed to the event bus")
+	}
+	<-closeCh
+	return nil
+}
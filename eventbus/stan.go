@@ -0,0 +1,223 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"sync"
+	"time"
+
+	natsio "github.com/nats-io/nats.go"
+	"github.com/nats-io/stan.go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// stanMaxInflight is the max number of unacknowledged messages delivered to a subscriber
+const stanMaxInflight = 16
+
+// stanConnection wraps a NATS Streaming connection and the underlying NATS connection
+type stanConnection struct {
+	natsConn *natsio.Conn
+	// lock protects stanConn and closed, the connection may be lost while it's established
+	lock     sync.Mutex
+	stanConn stan.Conn
+	// closed is set once the connection is lost or closed
+	closed bool
+}
+
+// Close closes the NATS Streaming connection
+func (c *stanConnection) Close() error {
+	c.lock.Lock()
+	if c.closed {
+		c.lock.Unlock()
+		return nil
+	}
+	c.closed = true
+	sc := c.stanConn
+	c.lock.Unlock()
+
+	defer c.natsConn.Close()
+	if sc != nil {
+		return sc.Close()
+	}
+	return nil
+}
+
+// setSTANConn sets the NATS Streaming connection once it's established.
+// If the connection was lost in the meantime, the NATS Streaming connection is closed and false is returned.
+func (c *stanConnection) setSTANConn(sc stan.Conn) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.closed {
+		_ = sc.Close()
+		return false
+	}
+	c.stanConn = sc
+	return true
+}
+
+// IsClosed tells whether the NATS Streaming connection is closed
+func (c *stanConnection) IsClosed() bool {
+	c.lock.Lock()
+	closed := c.closed
+	c.lock.Unlock()
+	return closed || c.natsConn == nil || c.natsConn.IsClosed()
+}
+
+// stanDriver is the event bus driver for NATS Streaming. Messages are persisted by the streaming server,
+// published synchronously and delivered to durable queue subscriptions that require manual acks.
+type stanDriver struct {
+	url       string
+	clusterID string
+	clientID  string
+	auth      *Auth
+	logger    *logrus.Logger
+}
+
+// NewSTANDriver returns a NATS Streaming event bus driver
+func NewSTANDriver(url, clusterID, clientID string, auth *Auth, logger *logrus.Logger) Driver {
+	return &stanDriver{
+		url:       url,
+		clusterID: clusterID,
+		clientID:  clientID,
+		auth:      auth,
+		logger:    logger,
+	}
+}
+
+// Connect establishes a connection to the NATS Streaming server
+func (d *stanDriver) Connect() (Connection, error) {
+	log := d.logger.WithFields(logrus.Fields{
+		"url":       d.url,
+		"clusterID": d.clusterID,
+		"clientID":  d.clientID,
+	})
+	opts := []natsio.Option{
+		natsio.Name(d.clientID),
+		// retry forever
+		natsio.MaxReconnects(-1),
+		natsio.DisconnectErrHandler(func(nc *natsio.Conn, err error) {
+			log.WithError(err).Warnln("disconnected from the event bus")
+		}),
+		natsio.ReconnectHandler(func(nc *natsio.Conn) {
+			log.Infoln("reconnected to the event bus")
+		}),
+	}
+	if d.auth != nil && d.auth.Strategy == v1alpha1.AuthStrategyToken {
+		opts = append(opts, natsio.Token(d.auth.Token))
+	}
+	nc, err := natsio.Connect(d.url, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the event bus")
+	}
+	conn := &stanConnection{
+		natsConn: nc,
+	}
+	sc, err := stan.Connect(d.clusterID, d.clientID, stan.NatsConn(nc), stan.SetConnectionLostHandler(func(_ stan.Conn, reason error) {
+		log.WithError(reason).Errorln("lost the connection to the event bus")
+		_ = conn.Close()
+	}))
+	if err != nil {
+		nc.Close()
+		return nil, errors.Wrap(err, "failed to connect to the streaming event bus")
+	}
+	if !conn.setSTANConn(sc) {
+		nc.Close()
+		return nil, errors.New("lost the connection to the streaming event bus while connecting")
+	}
+	log.Infoln("connected to the streaming event bus")
+	return conn, nil
+}
+
+// Publish publishes the data on the event bus subject and waits for the streaming server to persist it
+func (d *stanDriver) Publish(conn Connection, subject string, data []byte) error {
+	sConn, ok := conn.(*stanConnection)
+	if !ok {
+		return errors.New("not a NATS Streaming connection")
+	}
+	return sConn.stanConn.Publish(subject, data)
+}
+
+// Subscribe creates a durable queue subscription on each of the event bus subjects, it blocks until the close channel
+// is closed or the connection is lost. A message is acked only once the handler calls ack, otherwise it's redelivered
+// after the ack wait.
+func (d *stanDriver) Subscribe(conn Connection, closeCh <-chan struct{}, subscription Subscription, handler Handler) error {
+	sConn, ok := conn.(*stanConnection)
+	if !ok {
+		return errors.New("not a NATS Streaming connection")
+	}
+	ackWait := subscription.AckWait
+	if ackWait <= 0 {
+		ackWait = common.DefaultEventBusAckWait
+	}
+	subs := make([]stan.Subscription, 0, len(subscription.Subjects))
+	// closing, instead of unsubscribing, keeps the durable subscriptions on the server
+	closeSubs := func() error {
+		var err error
+		for _, sub := range subs {
+			if closeErr := sub.Close(); closeErr != nil {
+				err = closeErr
+			}
+		}
+		return err
+	}
+	for _, subject := range subscription.Subjects {
+		log := d.logger.WithFields(logrus.Fields{
+			"subject": subject,
+			"durable": subscription.Group,
+			"ackWait": ackWait,
+		})
+		sub, err := sConn.stanConn.QueueSubscribe(subject, subscription.Group, func(msg *stan.Msg) {
+			if msg.Redelivered {
+				log.WithField("sequence", msg.Sequence).Infoln("received a redelivered message")
+			}
+			ack := func() {
+				if err := msg.Ack(); err != nil {
+					log.WithError(err).WithField("sequence", msg.Sequence).Errorln("failed to ack the message")
+				}
+			}
+			if err := handler(msg.Data, ack); err != nil {
+				log.WithError(err).WithField("sequence", msg.Sequence).Errorln("failed to handle the message, it will be redelivered")
+			}
+		}, stan.DurableName(subscription.Group),
+			stan.SetManualAckMode(),
+			stan.AckWait(ackWait),
+			stan.MaxInflight(stanMaxInflight))
+		if err != nil {
+			_ = closeSubs()
+			return errors.Wrapf(err, "failed to subscribe to the streaming event bus subject %s", subject)
+		}
+		subs = append(subs, sub)
+		log.Infoln("subscribed to the streaming event bus")
+	}
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closeCh:
+			return closeSubs()
+		case <-ticker.C:
+			if conn.IsClosed() {
+				return errors.New("connection to the streaming event bus is closed")
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// globChars are the characters of the glob syntax of the event source and event names of the dependencies
+const globChars = "*?[]{}\\"
+
+// SubjectPrefix returns the prefix of the event bus subjects used by gateways and sensors in a namespace
+func SubjectPrefix(namespace string) string {
+	return fmt.Sprintf("eventbus-%s", namespace)
+}
+
+// EventSubject returns the subject the events of an event source are published on,
+// e.g. "eventbus-argo-events.webhook.example".
+func EventSubject(prefix, eventSource, eventName string) string {
+	return fmt.Sprintf("%s.%s.%s", prefix, subjectToken(eventSource), subjectToken(eventName))
+}
+
+// DependencySubjects returns the subjects to subscribe to for the events of the dependencies, without duplicates.
+// The event source and event names that are globs are mapped to the token wildcard, so that a subject may match more
+// events than the dependency, which are then filtered out by the sensor. NATS Streaming doesn't support wildcards,
+// so the dependencies can't use globs with a streaming event bus.
+func DependencySubjects(busConfig *v1alpha1.BusConfig, prefix string, dependencies []sensorv1alpha1.EventDependency) ([]string, error) {
+	wildcards := !isStreaming(busConfig)
+	var subjects []string
+	added := make(map[string]bool)
+	for _, dependency := range dependencies {
+		eventSource := dependency.EventSourceName
+		if eventSource == "" {
+			// DEPRECATED: the gateway name is matched against the event source name
+			eventSource = dependency.GatewayName
+		}
+		eventSourceToken, err := subscriptionToken(eventSource, wildcards)
+		if err != nil {
+			return nil, errors.Wrapf(err, "event source name of the dependency %s is invalid", dependency.Name)
+		}
+		eventNameToken, err := subscriptionToken(dependency.EventName, wildcards)
+		if err != nil {
+			return nil, errors.Wrapf(err, "event name of the dependency %s is invalid", dependency.Name)
+		}
+		subject := fmt.Sprintf("%s.%s.%s", prefix, eventSourceToken, eventNameToken)
+		if !added[subject] {
+			added[subject] = true
+			subjects = append(subjects, subject)
+		}
+	}
+	return subjects, nil
+}
+
+// subscriptionToken returns the subject token matching the events of the name, which may be a glob
+func subscriptionToken(name string, wildcards bool) (string, error) {
+	if !strings.ContainsAny(name, globChars) {
+		return subjectToken(name), nil
+	}
+	if !wildcards {
+		return "", errors.Errorf("%s is a glob, which the streaming event bus doesn't support", name)
+	}
+	return "*", nil
+}
+
+// subjectToken returns the name as a subject token, i.e. with the characters other than letters, digits, "-" and "_"
+// replaced with "_", as "." separates the tokens and the wildcards aren't allowed in the published subjects.
+func subjectToken(name string) string {
+	token := []byte(name)
+	for i, c := range token {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			token[i] = '_'
+		}
+	}
+	return string(token)
+}
+
+// isStreaming tells whether the event bus is a NATS Streaming server, i.e. has a cluster ID
+func isStreaming(busConfig *v1alpha1.BusConfig) bool {
+	return busConfig != nil && busConfig.NATS != nil && busConfig.NATS.ClusterID != nil && *busConfig.NATS.ClusterID != ""
+}
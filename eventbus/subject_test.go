@@ -0,0 +1,72 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestEventSubject(t *testing.T) {
+	assert.Equal(t, "eventbus-argo-events.webhook.example", EventSubject(SubjectPrefix("argo-events"), "webhook", "example"))
+	assert.Equal(t, "eventbus-argo-events.my_webhook.example_com", EventSubject(SubjectPrefix("argo-events"), "my.webhook", "example.com"))
+}
+
+func TestDependencySubjects(t *testing.T) {
+	prefix := SubjectPrefix("argo-events")
+	dependencies := []sensorv1alpha1.EventDependency{
+		{
+			Name:            "dep1",
+			EventSourceName: "webhook",
+			EventName:       "example.com",
+		},
+		{
+			Name:        "dep2",
+			GatewayName: "webhook",
+			EventName:   "example.com",
+		},
+		{
+			Name:            "dep3",
+			EventSourceName: "calendar",
+			EventName:       "example-*",
+		},
+	}
+	busConfig := &v1alpha1.BusConfig{
+		NATS: &v1alpha1.NATSConfig{
+			URL: "nats://localhost:4222",
+		},
+	}
+	subjects, err := DependencySubjects(busConfig, prefix, dependencies)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"eventbus-argo-events.webhook.example_com", "eventbus-argo-events.calendar.*"}, subjects)
+	// the subjects of the dependencies match the subjects of their events
+	assert.Equal(t, subjects[0], EventSubject(prefix, "webhook", "example.com"))
+
+	// the streaming event bus doesn't support wildcards
+	clusterID := "stan"
+	busConfig.NATS.ClusterID = &clusterID
+	_, err = DependencySubjects(busConfig, prefix, dependencies)
+	assert.NotNil(t, err)
+
+	subjects, err = DependencySubjects(busConfig, prefix, dependencies[:2])
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"eventbus-argo-events.webhook.example_com"}, subjects)
+}
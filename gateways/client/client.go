@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/metrics"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
@@ -52,6 +53,13 @@ func main() {
 		panic(fmt.Errorf("failed to connect to server on port %s", serverPort))
 	}
 
+	// expose the metrics
+	go func() {
+		if err := metrics.Serve(metrics.Port(common.DefaultMetricsPort)); err != nil {
+			ctx.logger.WithError(err).Errorln("failed to serve the metrics")
+		}
+	}()
+
 	// handle gateway status updates
 	go func() {
 		for status := range ctx.statusCh {
@@ -62,6 +70,13 @@ func main() {
 	// initialize the subscription clients
 	ctx.updateSubscriberClients()
 
+	// connect to the eventbus
+	if ctx.eventBusDriver != nil {
+		if _, err := ctx.connectToEventBus(); err != nil {
+			panic(err)
+		}
+	}
+
 	// watch updates to gateway resource
 	gwWatcher := ctx.WatchGatewayUpdates()
 	go gwWatcher.Run(context.Background().Done())
@@ -18,21 +18,32 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"time"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
 	"github.com/argoproj/argo-events/gateways"
+	"github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/tracing"
 	cloudevents "github.com/cloudevents/sdk-go"
 	"github.com/google/uuid"
 	"github.com/nats-io/go-nats"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // updateSubscriberClients updates the active clients for event subscribers
 func (gatewayContext *GatewayContext) updateSubscriberClients() {
+	gatewayContext.closeSubscriberQueues(gatewayContext.gateway.Spec.Subscribers)
 	if gatewayContext.gateway.Spec.Subscribers == nil {
 		return
 	}
@@ -57,83 +68,229 @@ func (gatewayContext *GatewayContext) updateSubscriberClients() {
 }
 
 // dispatchEvent dispatches event to gateway transformer for further processing
-func (gatewayContext *GatewayContext) dispatchEvent(gatewayEvent *gateways.Event) error {
+func (gatewayContext *GatewayContext) dispatchEvent(gatewayEvent *gateways.Event) (err error) {
+	// continue the trace started by the gateway server
+	ctx, span := tracing.StartSpan(context.Background(), "gateway-client.dispatch-event", gatewayEvent.GetMetadata()[tracing.TraceParent], trace.WithSpanKind(trace.SpanKindServer))
+	span.AddAttributes(trace.StringAttribute(common.LabelEventSource, gatewayEvent.Name))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	logger := gatewayContext.logger.WithField(common.LabelEventSource, gatewayEvent.Name)
 	logger.Infoln("dispatching event to subscribers")
+	metrics.GatewayEventReceived(gatewayContext.name, gatewayEvent.Name)
 
-	if gatewayContext.gateway.Spec.Subscribers == nil {
-		logger.Warnln("no active subscribers to send event to.")
+	if gatewayContext.eventBusDriver == nil && gatewayContext.gateway.Spec.Subscribers == nil {
+		logger.Warnln("no eventbus or active subscribers to send event to.")
 		return nil
 	}
 
-	cloudEvent, err := gatewayContext.transformEvent(gatewayEvent)
+	cloudEvent, err := gatewayContext.transformEvent(ctx, gatewayEvent)
 	if err != nil {
 		return err
 	}
 
-	completeSuccess := true
-
 	eventBody, err := json.Marshal(cloudEvent)
 	if err != nil {
 		logger.WithError(err).Errorln("failed to marshal the event")
 		return err
 	}
 
-	// http subscribers
-	for _, subscriber := range gatewayContext.gateway.Spec.Subscribers.HTTP {
-		request, err := http.NewRequest(http.MethodPost, subscriber, bytes.NewReader(eventBody))
+	if gatewayContext.eventBusDriver != nil {
+		err := gatewayContext.publishToEventBus(gatewayEvent.Name, eventBody)
+		metrics.GatewayEventDispatched(gatewayContext.name, gatewayEvent.Name, eventBusSubscriber, err)
 		if err != nil {
-			logger.WithError(err).WithField("subscriber", subscriber).Warnln("failed to construct http request for the event")
-			completeSuccess = false
-			continue
+			logger.WithError(err).Errorln("failed to publish the event to the eventbus")
+			return err
 		}
+		logger.Infoln("successfully published the event to the eventbus")
+	}
 
-		response, err := gatewayContext.httpClient.Do(request)
-		if err != nil {
-			logger.WithError(err).WithField("subscriber", subscriber).Warnln("failed to send http request for the event")
-			completeSuccess = false
-			continue
-		}
+	if gatewayContext.gateway.Spec.Subscribers == nil {
+		return nil
+	}
 
-		logger.WithFields(logrus.Fields{
-			"status":     response.Status,
-			"subscriber": subscriber,
-		}).Infoln("successfully sent event to the subscriber")
+	subscribers := gatewayContext.gateway.Spec.Subscribers
+
+	// http subscribers
+	for _, subscriber := range subscribers.GetHTTPSubscribers() {
+		url := subscriber.URL
+		retryStrategy, deadLetter := subscriber.RetryStrategy, subscriber.DeadLetter
+		if retryStrategy == nil {
+			retryStrategy = subscribers.RetryStrategy
+		}
+		if deadLetter == nil {
+			deadLetter = subscribers.DeadLetter
+		}
+		gatewayContext.enqueueToSubscriber(url, &subscriberDispatch{
+			logger:        logger.WithField("subscriber", url),
+			eventSource:   gatewayEvent.Name,
+			retryStrategy: retryStrategy,
+			deadLetter:    deadLetter,
+			eventBody:     eventBody,
+			send: func() error {
+				return gatewayContext.postEvent(url, eventBody)
+			},
+		})
 	}
 
 	// NATS subscribers
-	for _, subscriber := range gatewayContext.gateway.Spec.Subscribers.NATS {
-		conn, ok := gatewayContext.natsSubscribers[subscriber.Name]
-		if !ok {
-			gatewayContext.logger.WithField("subscriber", subscriber).Warnln("unable to send event. no client found for the subscriber")
-			completeSuccess = false
-			continue
+	for _, subscriber := range subscribers.NATS {
+		subscriber := subscriber
+		retryStrategy, deadLetter := subscriber.RetryStrategy, subscriber.DeadLetter
+		if retryStrategy == nil {
+			retryStrategy = subscribers.RetryStrategy
 		}
+		if deadLetter == nil {
+			deadLetter = subscribers.DeadLetter
+		}
+		gatewayContext.enqueueToSubscriber(subscriber.Name, &subscriberDispatch{
+			logger: logger.WithFields(logrus.Fields{
+				"subscriber": subscriber.Name,
+				"subject":    subscriber.Subject,
+			}),
+			eventSource:   gatewayEvent.Name,
+			retryStrategy: retryStrategy,
+			deadLetter:    deadLetter,
+			eventBody:     eventBody,
+			send: func() error {
+				conn, ok := gatewayContext.natsSubscribers[subscriber.Name]
+				if !ok {
+					return errors.New("no client found for the subscriber")
+				}
+				return conn.Publish(subscriber.Subject, eventBody)
+			},
+		})
+	}
+
+	logger.Infoln("queued the event for the subscribers")
+	return nil
+}
+
+// eventBusSubscriber is the subscriber label value of the events published to the eventbus
+const eventBusSubscriber = "eventbus"
+
+// subscriberError is an error sending an event to a subscriber
+type subscriberError struct {
+	err error
+	// statusCode is the status code of the HTTP response, if any
+	statusCode int
+	// retryable tells whether sending the event again may succeed
+	retryable bool
+}
+
+func (e *subscriberError) Error() string {
+	return e.err.Error()
+}
 
-		if err := conn.Publish(subscriber.Subject, eventBody); err != nil {
-			logger.WithError(err).WithField("subscriber", subscriber).Warnln("failed to publish the event")
-			completeSuccess = false
-			continue
+// isRetryableStatusCode tells whether a request that received the status code may succeed if retried
+func isRetryableStatusCode(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests
+}
+
+// postEvent posts the event to the HTTP endpoint, a non 2xx response is considered as a failure
+func (gatewayContext *GatewayContext) postEvent(url string, eventBody []byte) error {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(eventBody))
+	if err != nil {
+		return &subscriberError{err: errors.Wrap(err, "failed to construct http request for the event")}
+	}
+	response, err := gatewayContext.httpClient.Do(request)
+	if err != nil {
+		return &subscriberError{err: errors.Wrap(err, "failed to send http request for the event"), retryable: true}
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return &subscriberError{
+			err:        errors.Errorf("received the response status %s", response.Status),
+			statusCode: response.StatusCode,
+			retryable:  isRetryableStatusCode(response.StatusCode),
 		}
+	}
+	return nil
+}
 
-		logger.WithFields(logrus.Fields{
-			"subscriber": subscriber.Name,
-			"subject":    subscriber.Subject,
-		}).Infoln("successfully published event on the subject")
+// sendWithRetry invokes send until it succeeds, fails with an error that is not retryable or the retry strategy is exhausted.
+// It returns the number of attempts and the last error. Without a retry strategy, send is invoked only once.
+func sendWithRetry(retryStrategy *apicommon.Backoff, send func() error) (int, error) {
+	backoff := wait.Backoff{Steps: 1}
+	if retryStrategy != nil {
+		backoff = *common.GetConnectionBackoff(retryStrategy)
 	}
+	attempts := 0
+	var lastErr error
+	_ = wait.ExponentialBackoff(backoff, func() (bool, error) {
+		attempts++
+		lastErr = send()
+		if lastErr == nil {
+			return true, nil
+		}
+		if subErr, ok := lastErr.(*subscriberError); ok && !subErr.retryable {
+			return false, lastErr
+		}
+		return false, nil
+	})
+	return attempts, lastErr
+}
 
-	response := "dispatched event"
-	if !completeSuccess {
-		response = fmt.Sprintf("%s.%s", response, " although some of the dispatch operations failed, check logs for more info")
+// dispatchToSubscriber sends the event to a subscriber with the retry strategy. If the event can't be delivered,
+// it's written to the dead-letter target, if any.
+func (gatewayContext *GatewayContext) dispatchToSubscriber(subscriber string, dispatch *subscriberDispatch) {
+	attempts, err := sendWithRetry(dispatch.retryStrategy, dispatch.send)
+	metrics.GatewayEventDispatched(gatewayContext.name, dispatch.eventSource, subscriber, err)
+	if err == nil {
+		dispatch.logger.WithField("attempts", attempts).Infoln("successfully sent event to the subscriber")
+		return
 	}
+	dispatch.logger.WithError(err).WithField("attempts", attempts).Warnln("failed to send event to the subscriber")
+	gatewayContext.deadLetterUndelivered(subscriber, dispatch, attempts, err)
+}
 
-	logger.Infoln(response)
-	return nil
+// deadLetterUndelivered writes the event that couldn't be delivered to the subscriber to the dead-letter target, if any
+func (gatewayContext *GatewayContext) deadLetterUndelivered(subscriber string, dispatch *subscriberDispatch, attempts int, dispatchErr error) {
+	if dispatch.deadLetter == nil {
+		return
+	}
+	if err := gatewayContext.sendToDeadLetter(dispatch.deadLetter, dispatch.eventBody, subscriber, attempts, dispatchErr); err != nil {
+		dispatch.logger.WithError(err).Errorln("failed to write the event to the dead-letter target, the event is dropped")
+		return
+	}
+	metrics.GatewayEventDeadLettered(gatewayContext.name, dispatch.eventSource, subscriber)
+	dispatch.logger.Infoln("wrote the event to the dead-letter target")
+}
+
+// connectToEventBus returns the active eventbus connection, connecting to the eventbus if there is none
+func (gatewayContext *GatewayContext) connectToEventBus() (eventbus.Connection, error) {
+	gatewayContext.eventBusLock.Lock()
+	defer gatewayContext.eventBusLock.Unlock()
+	if gatewayContext.eventBusConn != nil && !gatewayContext.eventBusConn.IsClosed() {
+		return gatewayContext.eventBusConn, nil
+	}
+	conn, err := gatewayContext.eventBusDriver.Connect()
+	if err != nil {
+		return nil, err
+	}
+	gatewayContext.eventBusConn = conn
+	return conn, nil
+}
+
+// publishToEventBus publishes the event to the eventbus, on the subject of the event source and of the event,
+// so that the sensors only receive the events of their dependencies. It reconnects if the connection is lost.
+func (gatewayContext *GatewayContext) publishToEventBus(eventName string, eventBody []byte) error {
+	conn, err := gatewayContext.connectToEventBus()
+	if err != nil {
+		return err
+	}
+	subject := eventbus.EventSubject(gatewayContext.eventBusSubjectPrefix, gatewayContext.gateway.Spec.EventSourceRef.Name, eventName)
+	return gatewayContext.eventBusDriver.Publish(conn, subject, eventBody)
 }
 
 // transformEvent transforms an event from gateway server into a CloudEvent
 // See https://github.com/cloudevents/spec for more info.
-func (gatewayContext *GatewayContext) transformEvent(gatewayEvent *gateways.Event) (*cloudevents.Event, error) {
+// The tracing context of the span in the context, if any, is propagated with the distributed tracing extension.
+// See https://github.com/cloudevents/spec/blob/v1.0/extensions/distributed-tracing.md
+func (gatewayContext *GatewayContext) transformEvent(ctx context.Context, gatewayEvent *gateways.Event) (*cloudevents.Event, error) {
 	event := cloudevents.NewEvent(cloudevents.VersionV03)
 	event.SetID(fmt.Sprintf("%x", uuid.New()))
 	event.SetSpecVersion(cloudevents.VersionV03)
@@ -142,6 +299,9 @@ func (gatewayContext *GatewayContext) transformEvent(gatewayEvent *gateways.Even
 	event.SetDataContentType("application/json")
 	event.SetSubject(gatewayEvent.Name)
 	event.SetTime(time.Now())
+	if span := trace.FromContext(ctx); span != nil {
+		event.SetExtension(tracing.TraceParent, tracing.FormatTraceParent(span.SpanContext()))
+	}
 	if err := event.SetData(gatewayEvent.Payload); err != nil {
 		return nil, err
 	}
@@ -17,11 +17,27 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
 	"github.com/argoproj/argo-events/gateways"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+	"github.com/argoproj/argo-events/tracing"
+	cloudevents "github.com/cloudevents/sdk-go"
 	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -43,7 +59,7 @@ func TestTransformEvent(t *testing.T) {
 			},
 		},
 	}
-	cloudevent, err := ctx.transformEvent(event)
+	cloudevent, err := ctx.transformEvent(context.Background(), event)
 	assert.Nil(t, err)
 	assert.NotNil(t, cloudevent.Context.AsV03())
 	assert.Equal(t, "test-event-source", cloudevent.Source())
@@ -53,4 +69,267 @@ func TestTransformEvent(t *testing.T) {
 	data, err := cloudevent.DataBytes()
 	assert.Nil(t, err)
 	assert.Equal(t, string(data), "{\"name\": \"hello\"}")
+
+	// the tracing context is propagated
+	spanCtx, span := trace.StartSpan(context.Background(), "test")
+	defer span.End()
+	cloudevent, err = ctx.transformEvent(spanCtx, event)
+	assert.Nil(t, err)
+	var traceParent string
+	err = cloudevent.ExtensionAs(tracing.TraceParent, &traceParent)
+	assert.Nil(t, err)
+	assert.Equal(t, tracing.FormatTraceParent(span.SpanContext()), traceParent)
+}
+
+func TestDispatchEventWithRetry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		// fail twice before succeeding
+		if atomic.AddInt32(&requests, 1) < 3 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "dead-letter")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	deadLetterFile := filepath.Join(dir, "events.json")
+
+	ctx := &GatewayContext{
+		logger:     common.NewArgoEventsLogger(),
+		httpClient: &http.Client{},
+		gateway: &v1alpha1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-gateway",
+			},
+			Spec: v1alpha1.GatewaySpec{
+				Type: "webhook",
+				EventSourceRef: &v1alpha1.EventSourceRef{
+					Name: "test-event-source",
+				},
+				Subscribers: &v1alpha1.Subscribers{
+					HTTPSubscribers: []v1alpha1.HTTPSubscriber{
+						{
+							URL: server.URL,
+							RetryStrategy: &apicommon.Backoff{
+								Duration: 10 * time.Millisecond,
+								Steps:    3,
+							},
+						},
+					},
+					DeadLetter: &v1alpha1.DeadLetter{
+						File: deadLetterFile,
+					},
+				},
+			},
+		},
+	}
+	event := &gateways.Event{
+		Name:    "hello",
+		Payload: []byte("{\"name\": \"hello\"}"),
+	}
+
+	err = ctx.dispatchEvent(event)
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requests) == 3
+	}, time.Second, 10*time.Millisecond)
+	_, err = os.Stat(deadLetterFile)
+	assert.True(t, os.IsNotExist(err))
+
+	// the retries are exhausted
+	atomic.StoreInt32(&requests, -10)
+	err = ctx.dispatchEvent(event)
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(deadLetterFile)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(-7), atomic.LoadInt32(&requests))
+
+	content, err := ioutil.ReadFile(deadLetterFile)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Equal(t, 1, len(lines))
+
+	var deadLetter cloudevents.Event
+	err = json.Unmarshal([]byte(lines[0]), &deadLetter)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", deadLetter.Subject())
+	extensions := deadLetter.Extensions()
+	assert.Equal(t, server.URL, extensions[deadLetterExtensionSubscriber])
+	assert.EqualValues(t, 3, extensions[deadLetterExtensionAttempts])
+	assert.EqualValues(t, http.StatusServiceUnavailable, extensions[deadLetterExtensionStatusCode])
+
+	// the deprecated HTTP URLs are sent the events with the default retry strategy
+	subscribers := ctx.gateway.Spec.Subscribers
+	subscribers.HTTP = []string{server.URL}
+	subscribers.RetryStrategy = subscribers.HTTPSubscribers[0].RetryStrategy
+	subscribers.HTTPSubscribers = nil
+	atomic.StoreInt32(&requests, 0)
+	err = ctx.dispatchEvent(event)
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requests) == 3
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDispatchEventSlowSubscriber(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		<-release
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+	defer close(release)
+	var requests int32
+	fast := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer fast.Close()
+
+	dir, err := ioutil.TempDir("", "dead-letter")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	deadLetterFile := filepath.Join(dir, "events.json")
+
+	ctx := &GatewayContext{
+		logger:     common.NewArgoEventsLogger(),
+		httpClient: &http.Client{},
+		gateway: &v1alpha1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-gateway",
+			},
+			Spec: v1alpha1.GatewaySpec{
+				Type: "webhook",
+				EventSourceRef: &v1alpha1.EventSourceRef{
+					Name: "test-event-source",
+				},
+				Subscribers: &v1alpha1.Subscribers{
+					HTTPSubscribers: []v1alpha1.HTTPSubscriber{
+						{
+							URL: slow.URL,
+							DeadLetter: &v1alpha1.DeadLetter{
+								File: deadLetterFile,
+							},
+						},
+						{
+							URL: fast.URL,
+						},
+					},
+				},
+			},
+		},
+	}
+	event := &gateways.Event{
+		Name:    "hello",
+		Payload: []byte("{\"name\": \"hello\"}"),
+	}
+
+	// the slow subscriber takes the first event and queues the next ones until its queue is full,
+	// while the other subscriber is sent every event
+	for i := 1; i <= subscriberQueueSize+3; i++ {
+		err = ctx.dispatchEvent(event)
+		assert.Nil(t, err)
+		assert.Eventually(t, func() bool {
+			return atomic.LoadInt32(&requests) == int32(i)
+		}, time.Second, time.Millisecond)
+	}
+
+	content, err := ioutil.ReadFile(deadLetterFile)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.True(t, len(lines) >= 2)
+
+	ctx.closeSubscriberQueues(nil)
+	assert.Empty(t, ctx.subscriberQueues)
+}
+
+// fakeConnection is an eventbus connection that is never closed
+type fakeConnection struct{}
+
+func (c *fakeConnection) Close() error {
+	return nil
+}
+
+func (c *fakeConnection) IsClosed() bool {
+	return false
+}
+
+// fakeDriver is an eventbus driver recording the subjects of the published messages
+type fakeDriver struct {
+	subjects []string
+}
+
+func (d *fakeDriver) Connect() (eventbus.Connection, error) {
+	return &fakeConnection{}, nil
+}
+
+func (d *fakeDriver) Publish(conn eventbus.Connection, subject string, data []byte) error {
+	d.subjects = append(d.subjects, subject)
+	return nil
+}
+
+func (d *fakeDriver) Subscribe(conn eventbus.Connection, closeCh <-chan struct{}, subscription eventbus.Subscription, handler eventbus.Handler) error {
+	<-closeCh
+	return nil
+}
+
+func TestDispatchEventToEventBus(t *testing.T) {
+	driver := &fakeDriver{}
+	ctx := &GatewayContext{
+		logger: common.NewArgoEventsLogger(),
+		gateway: &v1alpha1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-gateway",
+			},
+			Spec: v1alpha1.GatewaySpec{
+				Type: "webhook",
+				EventSourceRef: &v1alpha1.EventSourceRef{
+					Name: "test-event-source",
+				},
+			},
+		},
+		eventBusDriver:        driver,
+		eventBusSubjectPrefix: eventbus.SubjectPrefix("argo-events"),
+	}
+	err := ctx.dispatchEvent(&gateways.Event{
+		Name:    "hello",
+		Payload: []byte("{\"name\": \"hello\"}"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"eventbus-argo-events.test-event-source.hello"}, driver.subjects)
+}
+
+func TestSendWithRetry(t *testing.T) {
+	retryStrategy := &apicommon.Backoff{
+		Duration: time.Millisecond,
+		Steps:    5,
+	}
+
+	attempts, err := sendWithRetry(nil, func() error {
+		return &subscriberError{err: assert.AnError, retryable: true}
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts, err = sendWithRetry(retryStrategy, func() error {
+		return &subscriberError{err: assert.AnError, statusCode: http.StatusBadRequest}
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts, err = sendWithRetry(retryStrategy, func() error {
+		return &subscriberError{err: assert.AnError, statusCode: http.StatusInternalServerError, retryable: true}
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 5, attempts)
+
+	assert.True(t, isRetryableStatusCode(http.StatusTooManyRequests))
+	assert.True(t, isRetryableStatusCode(http.StatusBadGateway))
+	assert.False(t, isRetryableStatusCode(http.StatusNotFound))
 }
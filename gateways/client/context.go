@@ -20,12 +20,15 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"sync"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
 	"github.com/argoproj/argo-events/gateways"
 	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
 	eventsourceClientset "github.com/argoproj/argo-events/pkg/client/eventsource/clientset/versioned"
 	gwclientset "github.com/argoproj/argo-events/pkg/client/gateway/clientset/versioned"
+	"github.com/argoproj/argo-events/tracing"
 	"github.com/nats-io/go-nats"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -65,6 +68,22 @@ type GatewayContext struct {
 	httpClient *http.Client
 	// natsSubscribers holds the active clients for NATS subscribers
 	natsSubscribers map[string]*nats.Conn
+	// eventBusDriver is the driver to publish events to the eventbus
+	eventBusDriver eventbus.Driver
+	// eventBusSubjectPrefix is the prefix of the eventbus subjects the events are published on
+	eventBusSubjectPrefix string
+	// eventBusConn is the connection to the eventbus
+	eventBusConn eventbus.Connection
+	// eventBusLock guards the eventbus connection shared by the event sources
+	eventBusLock sync.Mutex
+	// deadLetterNATSConns holds the active clients for the NATS dead-letter targets
+	deadLetterNATSConns map[string]*nats.Conn
+	// deadLetterLock guards the dead-letter clients and files
+	deadLetterLock sync.Mutex
+	// subscriberQueues holds the events waiting to be sent to the subscribers
+	subscriberQueues map[string]chan *subscriberDispatch
+	// subscriberLock guards the subscriber queues
+	subscriberLock sync.Mutex
 }
 
 // EventSourceContext contains information of a event source for gateway to run.
@@ -135,5 +154,22 @@ func NewGatewayContext() *GatewayContext {
 		natsSubscribers:      make(map[string]*nats.Conn),
 	}
 
+	if err := tracing.Init("gateway-client"); err != nil {
+		panic(err)
+	}
+
+	busConfig, subjectPrefix, auth, err := eventbus.GetConfigFromEnv()
+	if err != nil {
+		panic(err)
+	}
+	if busConfig != nil {
+		driver, err := eventbus.GetDriver(busConfig, eventbus.ClientID("gateway", name), auth, gatewayConfig.logger)
+		if err != nil {
+			panic(err)
+		}
+		gatewayConfig.eventBusDriver = driver
+		gatewayConfig.eventBusSubjectPrefix = subjectPrefix
+	}
+
 	return gatewayConfig
 }
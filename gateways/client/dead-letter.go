@@ -0,0 +1,117 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go"
+	"github.com/nats-io/go-nats"
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+)
+
+// CloudEvent extensions holding the failure metadata of a dead-lettered event
+const (
+	deadLetterExtensionSubscriber = "deadlettersubscriber"
+	deadLetterExtensionError      = "deadlettererror"
+	deadLetterExtensionAttempts   = "deadletterattempts"
+	deadLetterExtensionStatusCode = "deadletterstatuscode"
+	deadLetterExtensionTime       = "deadlettertime"
+)
+
+// deadLetterEvent returns the event annotated with the failure metadata as CloudEvent extensions
+func deadLetterEvent(eventBody []byte, subscriber string, attempts int, dispatchErr error) ([]byte, error) {
+	var event cloudevents.Event
+	if err := json.Unmarshal(eventBody, &event); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the event")
+	}
+	event.SetExtension(deadLetterExtensionSubscriber, subscriber)
+	event.SetExtension(deadLetterExtensionError, dispatchErr.Error())
+	event.SetExtension(deadLetterExtensionAttempts, attempts)
+	if subErr, ok := dispatchErr.(*subscriberError); ok && subErr.statusCode != 0 {
+		event.SetExtension(deadLetterExtensionStatusCode, subErr.statusCode)
+	}
+	event.SetExtension(deadLetterExtensionTime, time.Now().UTC().Format(time.RFC3339))
+	return json.Marshal(&event)
+}
+
+// sendToDeadLetter writes the event that couldn't be delivered to a subscriber to the dead-letter target
+func (gatewayContext *GatewayContext) sendToDeadLetter(target *v1alpha1.DeadLetter, eventBody []byte, subscriber string, attempts int, dispatchErr error) error {
+	body, err := deadLetterEvent(eventBody, subscriber, attempts, dispatchErr)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case target.NATS != nil:
+		conn, err := gatewayContext.getDeadLetterNATSConn(target.NATS.ServerURL)
+		if err != nil {
+			return err
+		}
+		return conn.Publish(target.NATS.Subject, body)
+
+	case target.File != "":
+		return gatewayContext.appendToDeadLetterFile(target.File, body)
+
+	case target.URL != "":
+		return gatewayContext.postEvent(target.URL, body)
+
+	default:
+		return errors.New("dead-letter target is not specified")
+	}
+}
+
+// getDeadLetterNATSConn returns the connection to the NATS server of a dead-letter target, connecting to it if there is none
+func (gatewayContext *GatewayContext) getDeadLetterNATSConn(serverURL string) (*nats.Conn, error) {
+	gatewayContext.deadLetterLock.Lock()
+	defer gatewayContext.deadLetterLock.Unlock()
+	if conn, ok := gatewayContext.deadLetterNATSConns[serverURL]; ok && !conn.IsClosed() {
+		return conn, nil
+	}
+	conn, err := nats.Connect(serverURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to the dead-letter NATS server %s", serverURL)
+	}
+	if gatewayContext.deadLetterNATSConns == nil {
+		gatewayContext.deadLetterNATSConns = make(map[string]*nats.Conn)
+	}
+	gatewayContext.deadLetterNATSConns[serverURL] = conn
+	return conn, nil
+}
+
+// appendToDeadLetterFile appends the event as a line to the dead-letter file
+func (gatewayContext *GatewayContext) appendToDeadLetterFile(path string, body []byte) error {
+	gatewayContext.deadLetterLock.Lock()
+	defer gatewayContext.deadLetterLock.Unlock()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "failed to create the directory for the dead-letter file %s", path)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open the dead-letter file %s", path)
+	}
+	if _, err := file.Write(append(body, '\n')); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "failed to write to the dead-letter file %s", path)
+	}
+	return file.Close()
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1"
+)
+
+// subscriberQueueSize is the max number of events waiting to be sent to a subscriber
+const subscriberQueueSize = 100
+
+// subscriberDispatch is an event waiting to be sent to a subscriber
+type subscriberDispatch struct {
+	logger        *logrus.Entry
+	eventSource   string
+	retryStrategy *apicommon.Backoff
+	deadLetter    *v1alpha1.DeadLetter
+	eventBody     []byte
+	send          func() error
+}
+
+// enqueueToSubscriber queues the event to be sent to the subscriber. Every subscriber is sent its events in order by
+// its own worker, so that a slow subscriber, or one whose events are retried, doesn't delay the other subscribers nor
+// the event sources. If the subscriber has already subscriberQueueSize events pending, the event is written to the
+// dead-letter target, or dropped if there is none.
+func (gatewayContext *GatewayContext) enqueueToSubscriber(subscriber string, dispatch *subscriberDispatch) {
+	if gatewayContext.queueToSubscriber(subscriber, dispatch) {
+		return
+	}
+	err := errors.Errorf("the subscriber has %d events pending", subscriberQueueSize)
+	dispatch.logger.WithError(err).Warnln("failed to queue the event for the subscriber")
+	metrics.GatewayEventDispatched(gatewayContext.name, dispatch.eventSource, subscriber, err)
+	gatewayContext.deadLetterUndelivered(subscriber, dispatch, 0, err)
+}
+
+// queueToSubscriber adds the event to the queue of the subscriber, starting its worker if there is none.
+// It returns false if the queue is full.
+func (gatewayContext *GatewayContext) queueToSubscriber(subscriber string, dispatch *subscriberDispatch) bool {
+	gatewayContext.subscriberLock.Lock()
+	defer gatewayContext.subscriberLock.Unlock()
+	if gatewayContext.subscriberQueues == nil {
+		gatewayContext.subscriberQueues = make(map[string]chan *subscriberDispatch)
+	}
+	queue, ok := gatewayContext.subscriberQueues[subscriber]
+	if !ok {
+		queue = make(chan *subscriberDispatch, subscriberQueueSize)
+		gatewayContext.subscriberQueues[subscriber] = queue
+		go func() {
+			for dispatch := range queue {
+				gatewayContext.dispatchToSubscriber(subscriber, dispatch)
+			}
+		}()
+	}
+	select {
+	case queue <- dispatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// closeSubscriberQueues stops the workers of the subscribers that were removed from the gateway, once they have sent
+// the events already queued
+func (gatewayContext *GatewayContext) closeSubscriberQueues(subscribers *v1alpha1.Subscribers) {
+	active := make(map[string]bool)
+	if subscribers != nil {
+		for _, subscriber := range subscribers.GetHTTPSubscribers() {
+			active[subscriber.URL] = true
+		}
+		for _, subscriber := range subscribers.NATS {
+			active[subscriber.Name] = true
+		}
+	}
+	gatewayContext.subscriberLock.Lock()
+	defer gatewayContext.subscriberLock.Unlock()
+	for subscriber, queue := range gatewayContext.subscriberQueues {
+		if !active[subscriber] {
+			close(queue)
+			delete(gatewayContext.subscriberQueues, subscriber)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -97,10 +99,12 @@ type Event struct {
 	// The event source name.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The event payload.
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Metadata of the event, e.g. the tracing context.
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
@@ -142,6 +146,13 @@ func (m *Event) GetPayload() []byte {
 	return nil
 }
 
+func (m *Event) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 //*
 // Represents if an event source is valid or not
 type ValidEventSource struct {
@@ -196,38 +207,44 @@ func (m *ValidEventSource) GetReason() string {
 func init() {
 	proto.RegisterType((*EventSource)(nil), "gateways.EventSource")
 	proto.RegisterType((*Event)(nil), "gateways.Event")
+	proto.RegisterMapType((map[string]string)(nil), "gateways.Event.MetadataEntry")
 	proto.RegisterType((*ValidEventSource)(nil), "gateways.ValidEventSource")
 }
 
-func init() { proto.RegisterFile("eventing.proto", fileDescriptor_2abcc01b0da84106) }
+func init() {
+	proto.RegisterFile("eventing.proto", fileDescriptor_2abcc01b0da84106)
+}
 
 var fileDescriptor_2abcc01b0da84106 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0x86, 0xd9, 0xd8, 0x8f, 0x38, 0x96, 0x5a, 0xc6, 0x0f, 0x96, 0x9e, 0x4a, 0x4e, 0x3d, 0x05,
-	0x51, 0xbc, 0x79, 0xb4, 0xe0, 0x39, 0x05, 0x2f, 0x9e, 0x46, 0x33, 0x94, 0x85, 0xb8, 0x1b, 0x36,
-	0xdb, 0x4a, 0xfe, 0x86, 0xbf, 0x58, 0x32, 0x4d, 0x74, 0xe9, 0xc9, 0xdb, 0xbc, 0xcf, 0x0e, 0xcf,
-	0xce, 0x0c, 0xcc, 0xf9, 0xc0, 0x36, 0x18, 0xbb, 0xcb, 0x6b, 0xef, 0x82, 0xc3, 0x74, 0x47, 0x81,
-	0xbf, 0xa8, 0x6d, 0xb2, 0x37, 0xb8, 0xd8, 0x74, 0x6f, 0x5b, 0xb7, 0xf7, 0x1f, 0x8c, 0x73, 0x48,
-	0x4c, 0xa9, 0xd5, 0x4a, 0xad, 0xcf, 0x8b, 0xc4, 0x94, 0x88, 0x30, 0xb2, 0xf4, 0xc9, 0x3a, 0x11,
-	0x22, 0x35, 0x5e, 0xc3, 0xf8, 0x40, 0xd5, 0x9e, 0xf5, 0xd9, 0x4a, 0xad, 0x67, 0xc5, 0x31, 0x74,
-	0x9d, 0xa1, 0xad, 0x59, 0x8f, 0x8e, 0x9d, 0x5d, 0x9d, 0x3d, 0xc2, 0x58, 0xe4, 0xbf, 0x1a, 0x15,
-	0x69, 0x34, 0x4c, 0x6b, 0x6a, 0x2b, 0x47, 0xa5, 0xd8, 0x67, 0xc5, 0x10, 0xb3, 0x67, 0x58, 0xbc,
-	0x52, 0x65, 0xca, 0x78, 0x30, 0x0d, 0x53, 0xd3, 0x08, 0x15, 0x49, 0x5a, 0x0c, 0x11, 0x6f, 0x61,
-	0xe2, 0x99, 0x1a, 0x67, 0xfb, 0x21, 0xfb, 0x74, 0xff, 0xad, 0x20, 0xdd, 0xf4, 0x6b, 0xe3, 0x13,
-	0x2c, 0xb6, 0x81, 0x7c, 0x88, 0x95, 0x37, 0xf9, 0x70, 0x85, 0x3c, 0xc2, 0xcb, 0xcb, 0x13, 0x7c,
-	0xa7, 0xf0, 0x05, 0xae, 0xe4, 0x2f, 0x0a, 0xfc, 0x0f, 0xc1, 0xf2, 0x0f, 0x9f, 0xae, 0xf1, 0x3e,
-	0x91, 0xfb, 0x3f, 0xfc, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xc0, 0xda, 0xf1, 0x91, 0x01, 0x00,
-	0x00,
+	// 294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4d, 0x6b, 0x83, 0x40,
+	0x10, 0x86, 0x59, 0xcd, 0x87, 0x9d, 0xa4, 0xa9, 0x6c, 0x3f, 0x58, 0x84, 0x82, 0x78, 0xf2, 0x24,
+	0x25, 0xbd, 0xf4, 0xeb, 0x58, 0xa1, 0x97, 0x5e, 0x0c, 0xf4, 0xd2, 0xd3, 0xb4, 0x0e, 0x41, 0x6a,
+	0x54, 0x74, 0x93, 0xe2, 0xdf, 0xe8, 0xff, 0xe8, 0x7f, 0x2c, 0xae, 0x6b, 0x62, 0x3d, 0xe5, 0x36,
+	0xef, 0x3b, 0xc3, 0xb3, 0xf3, 0xce, 0xc2, 0x82, 0x76, 0x94, 0xc9, 0x24, 0x5b, 0x07, 0x45, 0x99,
+	0xcb, 0x9c, 0x5b, 0x6b, 0x94, 0xf4, 0x8d, 0x75, 0xe5, 0xbd, 0xc3, 0x2c, 0x6c, 0x7a, 0xab, 0x7c,
+	0x5b, 0x7e, 0x12, 0x5f, 0x80, 0x91, 0xc4, 0x82, 0xb9, 0xcc, 0x3f, 0x89, 0x8c, 0x24, 0xe6, 0x1c,
+	0x46, 0x19, 0x6e, 0x48, 0x18, 0xca, 0x51, 0x35, 0xbf, 0x80, 0xf1, 0x0e, 0xd3, 0x2d, 0x09, 0xd3,
+	0x65, 0xfe, 0x3c, 0x6a, 0x45, 0x33, 0x29, 0xeb, 0x82, 0xc4, 0xa8, 0x9d, 0x6c, 0x6a, 0xef, 0x97,
+	0xc1, 0x58, 0xd1, 0xf7, 0x1c, 0xd6, 0xe3, 0x08, 0x98, 0x16, 0x58, 0xa7, 0x39, 0xc6, 0x0a, 0x3f,
+	0x8f, 0x3a, 0xc9, 0xef, 0xc1, 0xda, 0x90, 0xc4, 0x18, 0x25, 0x0a, 0xd3, 0x35, 0xfd, 0xd9, 0xf2,
+	0x3a, 0xe8, 0x36, 0x0e, 0x14, 0x30, 0x78, 0xd5, 0xfd, 0x30, 0x93, 0x65, 0x1d, 0xed, 0xc7, 0x9d,
+	0x47, 0x38, 0xfd, 0xd7, 0xe2, 0x36, 0x98, 0x5f, 0x54, 0xeb, 0x87, 0x9b, 0xf2, 0xb0, 0x7f, 0x1b,
+	0xaa, 0x15, 0x0f, 0xc6, 0x1d, 0xf3, 0x9e, 0xc1, 0x7e, 0xc3, 0x34, 0x89, 0xfb, 0x17, 0x11, 0x30,
+	0x4d, 0x2a, 0xe5, 0x2a, 0x86, 0x15, 0x75, 0x92, 0x5f, 0xc1, 0xa4, 0x24, 0xac, 0xf2, 0x4c, 0x83,
+	0xb4, 0x5a, 0xfe, 0x30, 0xb0, 0x42, 0x7d, 0x6f, 0xfe, 0x04, 0xf6, 0x4a, 0x62, 0x29, 0xfb, 0xc8,
+	0xcb, 0x41, 0x98, 0xd6, 0x76, 0xce, 0x06, 0xf6, 0x0d, 0xe3, 0x2f, 0x70, 0xae, 0xde, 0x42, 0x49,
+	0x47, 0x00, 0x9c, 0x83, 0x3d, 0x8c, 0xf1, 0x31, 0x51, 0x1f, 0x7f, 0xfb, 0x37, 0x00, 0xb6, 0xc7,
+	0xec, 0x30, 0x0a, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // EventingClient is the client API for Eventing service.
 //
@@ -240,10 +257,10 @@ type EventingClient interface {
 }
 
 type eventingClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewEventingClient(cc *grpc.ClientConn) EventingClient {
+func NewEventingClient(cc grpc.ClientConnInterface) EventingClient {
 	return &eventingClient{cc}
 }
 
@@ -296,6 +313,17 @@ type EventingServer interface {
 	ValidateEventSource(context.Context, *EventSource) (*ValidEventSource, error)
 }
 
+// UnimplementedEventingServer can be embedded to have forward compatible implementations.
+type UnimplementedEventingServer struct {
+}
+
+func (*UnimplementedEventingServer) StartEventSource(req *EventSource, srv Eventing_StartEventSourceServer) error {
+	return status.Errorf(codes.Unimplemented, "method StartEventSource not implemented")
+}
+func (*UnimplementedEventingServer) ValidateEventSource(ctx context.Context, req *EventSource) (*ValidEventSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateEventSource not implemented")
+}
+
 func RegisterEventingServer(s *grpc.Server, srv EventingServer) {
 	s.RegisterService(&_Eventing_serviceDesc, srv)
 }
@@ -18,6 +18,7 @@ package amqp
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
@@ -47,7 +48,7 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 		channels.Stop <- struct{}{}
 	}()
 
-	if err := listener.listenEvents(eventSource, channels); err != nil {
+	if err := listener.listenEvents(eventSource, eventStream, channels); err != nil {
 		listener.Logger.WithField(common.LabelEventSource, eventSource.Name).WithError(err).Errorln("failed to listen to events")
 		return err
 	}
@@ -56,7 +57,7 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 }
 
 // listenEvents listens to events from amqp server
-func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, channels *server.Channels) error {
+func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, eventStream gateways.Eventing_StartEventSourceServer, channels *server.Channels) error {
 	logger := listener.Logger.WithField(common.LabelEventSource, eventSource.Name)
 
 	logger.Infoln("parsing the event source...")
@@ -106,40 +107,21 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		logger.Infoln("assuming all events have a json body...")
 	}
 
+	autoAck := amqpEventSource.Consume != nil && amqpEventSource.Consume.AutoAck
+
 	logger.Info("listening to messages on channel...")
 	for {
 		select {
-		case msg := <-delivery:
-			logger.WithField("message-id", msg.MessageId).Infoln("received the message")
-			body := &events.AMQPEventData{
-				ContentType:     msg.ContentType,
-				ContentEncoding: msg.ContentEncoding,
-				DeliveryMode:    int(msg.DeliveryMode),
-				Priority:        int(msg.Priority),
-				CorrelationId:   msg.CorrelationId,
-				ReplyTo:         msg.ReplyTo,
-				Expiration:      msg.Expiration,
-				MessageId:       msg.MessageId,
-				Timestamp:       msg.Timestamp.String(),
-				Type:            msg.Type,
-				AppId:           msg.AppId,
-				Exchange:        msg.Exchange,
-				RoutingKey:      msg.RoutingKey,
-			}
-			if amqpEventSource.JSONBody {
-				body.Body = (*json.RawMessage)(&msg.Body)
-			} else {
-				body.Body = msg.Body
+		case msg, ok := <-delivery:
+			if !ok {
+				return errors.Errorf("delivery channel is closed for the event source %s", eventSource.Name)
 			}
-
-			bodyBytes, err := json.Marshal(body)
-			if err != nil {
-				logger.WithError(err).WithField("message-id", msg.MessageId).Errorln("failed to marshal the message")
-				continue
+			if err := listener.handleMessage(eventSource.Name, eventStream, amqpEventSource.JSONBody, autoAck, msg); err != nil {
+				if err := conn.Close(); err != nil {
+					logger.WithError(err).Info("failed to close connection")
+				}
+				return err
 			}
-
-			logger.Infoln("dispatching event on data channel...")
-			channels.Data <- bodyBytes
 		case <-channels.Done:
 			err = conn.Close()
 			if err != nil {
@@ -150,14 +132,122 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 	}
 }
 
+// handleMessage sends the event data of the message to the gateway client. Unless the messages are acknowledged
+// automatically, the message is acknowledged once it is sent, and requeued if it can't be sent.
+// It returns an error if the event can't be sent, as the stream to the gateway client is broken, so that the event source
+// is restarted rather than consuming the requeued messages over and over.
+func (listener *EventListener) handleMessage(name string, eventStream gateways.Eventing_StartEventSourceServer, jsonBody bool, autoAck bool, msg amqplib.Delivery) error {
+	logger := listener.Logger.WithFields(map[string]interface{}{
+		common.LabelEventSource: name,
+		"message-id":            msg.MessageId,
+	})
+	logger.Infoln("received the message")
+
+	body := &events.AMQPEventData{
+		ContentType:     msg.ContentType,
+		ContentEncoding: msg.ContentEncoding,
+		DeliveryMode:    int(msg.DeliveryMode),
+		Priority:        int(msg.Priority),
+		CorrelationId:   msg.CorrelationId,
+		ReplyTo:         msg.ReplyTo,
+		Expiration:      msg.Expiration,
+		MessageId:       msg.MessageId,
+		Timestamp:       msg.Timestamp.String(),
+		Type:            msg.Type,
+		AppId:           msg.AppId,
+		Exchange:        msg.Exchange,
+		RoutingKey:      msg.RoutingKey,
+	}
+	if jsonBody {
+		body.Body = (*json.RawMessage)(&msg.Body)
+	} else {
+		body.Body = msg.Body
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		logger.WithError(err).Errorln("failed to marshal the message")
+		if !autoAck {
+			// the message would fail again if requeued
+			if err := msg.Nack(false, false); err != nil {
+				logger.WithError(err).Errorln("failed to reject the message")
+			}
+		}
+		return nil
+	}
+
+	logger.Infoln("dispatching the event to the gateway client...")
+	if err := server.SendEvent(name, eventStream, bodyBytes); err != nil {
+		logger.WithError(err).Errorln("failed to send the event data to the gateway client")
+		if !autoAck {
+			if err := msg.Nack(false, true); err != nil {
+				logger.WithError(err).Errorln("failed to requeue the message")
+			}
+		}
+		return errors.Wrapf(err, "failed to send the event data of the event source %s to the gateway client", name)
+	}
+	if !autoAck {
+		if err := msg.Ack(false); err != nil {
+			logger.WithError(err).Errorln("failed to acknowledge the message")
+		}
+	}
+	return nil
+}
+
+// queueArguments parses the arguments of the queue. The whole numbers are passed as integers, as the server rejects
+// floating point values for arguments such as "x-message-ttl".
+func queueArguments(arguments string) (amqplib.Table, error) {
+	if arguments == "" {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(arguments))
+	decoder.UseNumber()
+	var values map[string]interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the queue arguments")
+	}
+	table := amqplib.Table{}
+	for key, value := range values {
+		if number, ok := value.(json.Number); ok {
+			if i, err := number.Int64(); err == nil {
+				table[key] = i
+				continue
+			}
+			f, err := number.Float64()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse the queue argument %s", key)
+			}
+			table[key] = f
+			continue
+		}
+		table[key] = value
+	}
+	if err := table.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid queue arguments")
+	}
+	return table, nil
+}
+
 // getDelivery sets up a channel for message deliveries
 func getDelivery(ch *amqplib.Channel, eventSource *v1alpha1.AMQPEventSource) (<-chan amqplib.Delivery, error) {
-	err := ch.ExchangeDeclare(eventSource.ExchangeName, eventSource.ExchangeType, true, false, false, false, nil)
+	exchange := eventSource.ExchangeDeclare
+	if exchange == nil {
+		exchange = &v1alpha1.AMQPExchangeDeclareConfig{Durable: true}
+	}
+	err := ch.ExchangeDeclare(eventSource.ExchangeName, eventSource.ExchangeType, exchange.Durable, exchange.AutoDelete, exchange.Internal, exchange.NoWait, nil)
 	if err != nil {
 		return nil, errors.Errorf("failed to declare exchange with name %s and type %s. err: %+v", eventSource.ExchangeName, eventSource.ExchangeType, err)
 	}
 
-	q, err := ch.QueueDeclare("", false, false, true, false, nil)
+	queue := eventSource.QueueDeclare
+	if queue == nil {
+		queue = &v1alpha1.AMQPQueueDeclareConfig{Exclusive: true}
+	}
+	arguments, err := queueArguments(queue.Arguments)
+	if err != nil {
+		return nil, err
+	}
+	q, err := ch.QueueDeclare(queue.Name, queue.Durable, queue.AutoDelete, queue.Exclusive, queue.NoWait, arguments)
 	if err != nil {
 		return nil, errors.Errorf("failed to declare queue: %s", err)
 	}
@@ -167,7 +257,17 @@ func getDelivery(ch *amqplib.Channel, eventSource *v1alpha1.AMQPEventSource) (<-
 		return nil, errors.Errorf("failed to bind %s exchange '%s' to queue with routingKey: %s: %s", eventSource.ExchangeType, eventSource.ExchangeName, eventSource.RoutingKey, err)
 	}
 
-	delivery, err := ch.Consume(q.Name, "", true, false, false, false, nil)
+	if eventSource.QoS != nil {
+		if err := ch.Qos(int(eventSource.QoS.PrefetchCount), int(eventSource.QoS.PrefetchSize), eventSource.QoS.Global); err != nil {
+			return nil, errors.Errorf("failed to set the qos of the channel: %s", err)
+		}
+	}
+
+	consume := eventSource.Consume
+	if consume == nil {
+		consume = &v1alpha1.AMQPConsumeConfig{}
+	}
+	delivery, err := ch.Consume(q.Name, consume.ConsumerTag, consume.AutoAck, consume.Exclusive, consume.NoLocal, consume.NoWait, nil)
 	if err != nil {
 		return nil, errors.Errorf("failed to begin consuming messages: %s", err)
 	}
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amqp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	amqplib "github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/gateways"
+	servercommon "github.com/argoproj/argo-events/gateways/server/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+)
+
+// fakeAcknowledger records the acknowledgements of the messages
+type fakeAcknowledger struct {
+	acked   []uint64
+	nacked  []uint64
+	requeue []bool
+}
+
+func (f *fakeAcknowledger) Ack(tag uint64, multiple bool) error {
+	f.acked = append(f.acked, tag)
+	return nil
+}
+
+func (f *fakeAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	f.nacked = append(f.nacked, tag)
+	f.requeue = append(f.requeue, requeue)
+	return nil
+}
+
+func (f *fakeAcknowledger) Reject(tag uint64, requeue bool) error {
+	return f.Nack(tag, false, requeue)
+}
+
+// failingStream fails to send the events
+type failingStream struct {
+	servercommon.FakeGRPCStream
+}
+
+func (f *failingStream) Send(event *gateways.Event) error {
+	return errors.New("fake error")
+}
+
+func TestHandleMessage(t *testing.T) {
+	listener := &EventListener{Logger: common.NewArgoEventsLogger()}
+	acknowledger := &fakeAcknowledger{}
+	msg := amqplib.Delivery{
+		Acknowledger: acknowledger,
+		DeliveryTag:  1,
+		MessageId:    "fake-id",
+		RoutingKey:   "hello",
+		Body:         []byte(`{"hello": "world"}`),
+	}
+
+	// the message is acknowledged once it is sent
+	stream := &servercommon.FakeGRPCStream{Ctx: context.Background()}
+	assert.Nil(t, listener.handleMessage("fake", stream, true, false, msg))
+	assert.Equal(t, []uint64{1}, acknowledger.acked)
+	assert.Empty(t, acknowledger.nacked)
+	var data events.AMQPEventData
+	assert.Nil(t, json.Unmarshal(stream.SentData.Payload, &data))
+	assert.Equal(t, "fake-id", data.MessageId)
+	assert.Equal(t, map[string]interface{}{"hello": "world"}, data.Body)
+
+	// the message is requeued if it can't be sent, and the event source is restarted
+	msg.DeliveryTag = 2
+	assert.NotNil(t, listener.handleMessage("fake", &failingStream{}, true, false, msg))
+	assert.Equal(t, []uint64{1}, acknowledger.acked)
+	assert.Equal(t, []uint64{2}, acknowledger.nacked)
+	assert.Equal(t, []bool{true}, acknowledger.requeue)
+
+	// the message is acknowledged by the server
+	msg.DeliveryTag = 3
+	assert.NotNil(t, listener.handleMessage("fake", &failingStream{}, true, true, msg))
+	assert.Nil(t, listener.handleMessage("fake", stream, true, true, msg))
+	assert.Equal(t, []uint64{1}, acknowledger.acked)
+	assert.Equal(t, []uint64{2}, acknowledger.nacked)
+}
+
+func TestQueueArguments(t *testing.T) {
+	arguments, err := queueArguments("")
+	assert.Nil(t, err)
+	assert.Nil(t, arguments)
+
+	arguments, err = queueArguments(`{"x-queue-type": "quorum", "x-message-ttl": 60000, "x-ratio": 0.5, "x-lazy": true}`)
+	assert.Nil(t, err)
+	assert.Equal(t, amqplib.Table{
+		"x-queue-type":  "quorum",
+		"x-message-ttl": int64(60000),
+		"x-ratio":       0.5,
+		"x-lazy":        true,
+	}, arguments)
+
+	_, err = queueArguments(`["x-queue-type"]`)
+	assert.NotNil(t, err)
+}
@@ -75,6 +75,14 @@ func validate(eventSource *v1alpha1.AMQPEventSource) error {
 	if eventSource.ExchangeType == "" {
 		return errors.New("exchange type must be specified")
 	}
+	if eventSource.QueueDeclare != nil {
+		if _, err := queueArguments(eventSource.QueueDeclare.Arguments); err != nil {
+			return err
+		}
+	}
+	if eventSource.QoS != nil && (eventSource.QoS.PrefetchCount < 0 || eventSource.QoS.PrefetchSize < 0) {
+		return errors.New("prefetch count and size can't be negative")
+	}
 	if eventSource.TLS != nil {
 		return v1alpha1.ValidateTLSConfig(eventSource.TLS)
 	}
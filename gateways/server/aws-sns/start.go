@@ -82,13 +82,25 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	var notification *httpNotification
-	err = yaml.Unmarshal(body, &notification)
+	err = json.Unmarshal(body, &notification)
 	if err != nil {
 		logger.WithError(err).Error("failed to convert request payload into sns notification")
 		common.SendErrorResponse(writer, err.Error())
 		return
 	}
 
+	// anyone who can reach the endpoint could post a message, so only the messages signed by SNS for the topic are accepted
+	if notification.TopicArn != router.eventSource.TopicArn {
+		logger.WithField("topic-arn", notification.TopicArn).Error("message is not for the topic of the event source")
+		common.SendErrorResponse(writer, "unexpected topic arn")
+		return
+	}
+	if err := router.verifier.verify(notification); err != nil {
+		logger.WithError(err).Error("failed to verify the message signature")
+		common.SendErrorResponse(writer, "invalid message signature")
+		return
+	}
+
 	switch notification.Type {
 	case messageTypeSubscriptionConfirmation:
 		awsSession := router.session
@@ -202,11 +214,16 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 	}
 
 	route := webhook.NewRoute(snsEventSource.Webhook, listener.Logger, eventSource)
+	if err := route.SetupAuth(listener.K8sClient, snsEventSource.Namespace); err != nil {
+		logger.WithError(err).Error("failed to set up the authentication of the webhook")
+		return err
+	}
 
 	logger.Infoln("operating on the route...")
 	return webhook.ManageRoute(&Router{
 		Route:       route,
 		eventSource: snsEventSource,
 		k8sClient:   listener.K8sClient,
+		verifier:    newSignatureVerifier(snsEventSource.SigningCertHosts),
 	}, controller, eventStream)
 }
@@ -17,8 +17,6 @@ limitations under the License.
 package aws_sns
 
 import (
-	"time"
-
 	"github.com/argoproj/argo-events/gateways/server/common/webhook"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	snslib "github.com/aws/aws-sdk-go/service/sns"
@@ -29,6 +27,7 @@ import (
 const (
 	messageTypeSubscriptionConfirmation = "SubscriptionConfirmation"
 	messageTypeNotification             = "Notification"
+	messageTypeUnsubscribeConfirmation  = "UnsubscribeConfirmation"
 )
 
 // EventListener implements Eventing for aws sns event source
@@ -52,6 +51,8 @@ type Router struct {
 	subscriptionArn *string
 	// k8sClient is Kubernetes client
 	k8sClient kubernetes.Interface
+	// verifier verifies the signatures of the messages
+	verifier *signatureVerifier
 }
 
 // Json http notifications
@@ -60,16 +61,16 @@ type Router struct {
 // http://docs.aws.amazon.com/sns/latest/dg/json-formats.html#http-notification-json
 // http://docs.aws.amazon.com/sns/latest/dg/json-formats.html#http-unsubscribe-confirmation-json
 type httpNotification struct {
-	Type             string    `json:"Type"`
-	MessageId        string    `json:"MessageId"`
-	Token            string    `json:"Token,omitempty"` // Only for subscribe and unsubscribe
-	TopicArn         string    `json:"TopicArn"`
-	Subject          string    `json:"Subject,omitempty"` // Only for Notification
-	Message          string    `json:"Message"`
-	SubscribeURL     string    `json:"SubscribeURL,omitempty"` // Only for subscribe and unsubscribe
-	Timestamp        time.Time `json:"Timestamp"`
-	SignatureVersion string    `json:"SignatureVersion"`
-	Signature        string    `json:"Signature"`
-	SigningCertURL   string    `json:"SigningCertURL"`
-	UnsubscribeURL   string    `json:"UnsubscribeURL,omitempty"` // Only for notifications
+	Type             string `json:"Type"`
+	MessageId        string `json:"MessageId"`
+	Token            string `json:"Token,omitempty"` // Only for subscribe and unsubscribe
+	TopicArn         string `json:"TopicArn"`
+	Subject          string `json:"Subject,omitempty"` // Only for Notification
+	Message          string `json:"Message"`
+	SubscribeURL     string `json:"SubscribeURL,omitempty"` // Only for subscribe and unsubscribe
+	Timestamp        string `json:"Timestamp"`              // kept as is, since it's part of the signed fields
+	SignatureVersion string `json:"SignatureVersion"`
+	Signature        string `json:"Signature"`
+	SigningCertURL   string `json:"SigningCertURL"`
+	UnsubscribeURL   string `json:"UnsubscribeURL,omitempty"` // Only for notifications
 }
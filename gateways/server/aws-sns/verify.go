@@ -0,0 +1,213 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws_sns
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	signatureVersion1 = "1"
+	signatureVersion2 = "2"
+	// maxCertSize is the maximum size of a signing certificate
+	maxCertSize = 64 * 1024
+)
+
+// defaultSigningCertHost matches the hosts SNS serves the signing certificates from, e.g. sns.us-east-1.amazonaws.com
+var defaultSigningCertHost = regexp.MustCompile(`^sns\.[a-z0-9\-]+\.amazonaws\.com(\.cn)?$`)
+
+// signatureVerifier verifies the signatures of the messages posted by SNS.
+// See https://docs.aws.amazon.com/sns/latest/dg/sns-verify-signature-of-message.html
+type signatureVerifier struct {
+	// hosts the signing certificates may be downloaded from, the SNS hosts if empty
+	hosts []string
+	// client downloads the signing certificates
+	client *http.Client
+	// lock protects certs
+	lock sync.Mutex
+	// certs caches the signing certificates by their url
+	certs map[string]*x509.Certificate
+}
+
+// newSignatureVerifier returns a verifier downloading the signing certificates from the hosts
+func newSignatureVerifier(hosts []string) *signatureVerifier {
+	return &signatureVerifier{
+		hosts: hosts,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		certs: make(map[string]*x509.Certificate),
+	}
+}
+
+// verify checks the signature of the message against its signing certificate
+func (v *signatureVerifier) verify(notification *httpNotification) error {
+	var hash crypto.Hash
+	switch notification.SignatureVersion {
+	case signatureVersion1:
+		hash = crypto.SHA1
+	case signatureVersion2:
+		hash = crypto.SHA256
+	default:
+		return errors.Errorf("unsupported signature version %s", notification.SignatureVersion)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(notification.Signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode the signature")
+	}
+	payload, err := stringToSign(notification)
+	if err != nil {
+		return err
+	}
+	cert, err := v.getCert(notification.SigningCertURL)
+	if err != nil {
+		return err
+	}
+	// the signature is checked against the public key, since x509 refuses to check SHA1 signatures
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return errors.New("signing certificate doesn't hold an RSA public key")
+	}
+	digest := hash.New()
+	digest.Write(payload)
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, digest.Sum(nil), signature); err != nil {
+		return errors.Wrap(err, "invalid message signature")
+	}
+	return nil
+}
+
+// stringToSign returns the fields of the message SNS signs, as per the type of the message
+func stringToSign(notification *httpNotification) ([]byte, error) {
+	var buf bytes.Buffer
+	add := func(name, value string) {
+		buf.WriteString(name + "\n" + value + "\n")
+	}
+	add("Message", notification.Message)
+	add("MessageId", notification.MessageId)
+	switch notification.Type {
+	case messageTypeNotification:
+		// the subject is only signed if the message has one
+		if notification.Subject != "" {
+			add("Subject", notification.Subject)
+		}
+	case messageTypeSubscriptionConfirmation, messageTypeUnsubscribeConfirmation:
+		add("SubscribeURL", notification.SubscribeURL)
+	default:
+		return nil, errors.Errorf("unsupported message type %s", notification.Type)
+	}
+	add("Timestamp", notification.Timestamp)
+	if notification.Type != messageTypeNotification {
+		add("Token", notification.Token)
+	}
+	add("TopicArn", notification.TopicArn)
+	add("Type", notification.Type)
+	return buf.Bytes(), nil
+}
+
+// getCert returns the signing certificate, downloading it unless it's cached
+func (v *signatureVerifier) getCert(certURL string) (*x509.Certificate, error) {
+	if err := v.validateCertURL(certURL); err != nil {
+		return nil, err
+	}
+
+	v.lock.Lock()
+	cert, ok := v.certs[certURL]
+	v.lock.Unlock()
+	if ok && time.Now().Before(cert.NotAfter) {
+		return cert, nil
+	}
+
+	cert, err := v.downloadCert(certURL)
+	if err != nil {
+		return nil, err
+	}
+	v.lock.Lock()
+	v.certs[certURL] = cert
+	v.lock.Unlock()
+	return cert, nil
+}
+
+// validateCertURL checks the signing certificate is served over https by an allowed host
+func (v *signatureVerifier) validateCertURL(certURL string) error {
+	u, err := url.Parse(certURL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the signing certificate url %s", certURL)
+	}
+	if u.Scheme != "https" {
+		return errors.Errorf("signing certificate url %s is not https", certURL)
+	}
+	host := u.Hostname()
+	if len(v.hosts) == 0 {
+		if !defaultSigningCertHost.MatchString(host) {
+			return errors.Errorf("signing certificate host %s is not an SNS host", host)
+		}
+		return nil
+	}
+	for _, allowed := range v.hosts {
+		if host == allowed {
+			return nil
+		}
+	}
+	return errors.Errorf("signing certificate host %s is not allowed", host)
+}
+
+// downloadCert downloads and parses the PEM encoded signing certificate
+func (v *signatureVerifier) downloadCert(certURL string) (*x509.Certificate, error) {
+	response, err := v.client.Get(certURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the signing certificate %s", certURL)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to download the signing certificate %s, status %s", certURL, response.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, maxCertSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the signing certificate %s", certURL)
+	}
+
+	block, _ := pem.Decode(body)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.Errorf("signing certificate %s is not a PEM encoded certificate", certURL)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the signing certificate %s", certURL)
+	}
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, errors.Errorf("signing certificate %s is not valid at %s", certURL, now.UTC().Format(time.RFC3339))
+	}
+	return cert, nil
+}
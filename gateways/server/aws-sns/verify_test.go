@@ -0,0 +1,200 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws_sns
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/gateways/server/common/webhook"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const fakeTopicArn = "arn:aws:sns:us-east-1:123456789012:fake-topic"
+
+// fakeSigner signs the messages with a locally generated certificate, served over https
+type fakeSigner struct {
+	key       *rsa.PrivateKey
+	server    *httptest.Server
+	downloads int32
+}
+
+func newFakeSigner(t *testing.T) *fakeSigner {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	signer := &fakeSigner{key: key}
+	signer.server = httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&signer.downloads, 1)
+		_, _ = writer.Write(certPEM)
+	}))
+	return signer
+}
+
+func (s *fakeSigner) verifier() *signatureVerifier {
+	verifier := newSignatureVerifier([]string{"127.0.0.1"})
+	verifier.client = s.server.Client()
+	return verifier
+}
+
+func (s *fakeSigner) sign(t *testing.T, notification *httpNotification) {
+	hash := crypto.SHA1
+	if notification.SignatureVersion == signatureVersion2 {
+		hash = crypto.SHA256
+	}
+	payload, err := stringToSign(notification)
+	assert.Nil(t, err)
+	digest := hash.New()
+	digest.Write(payload)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, hash, digest.Sum(nil))
+	assert.Nil(t, err)
+	notification.Signature = base64.StdEncoding.EncodeToString(signature)
+	notification.SigningCertURL = s.server.URL + "/SimpleNotificationService-fake.pem"
+}
+
+func fakeNotification(signatureVersion string) *httpNotification {
+	return &httpNotification{
+		Type:             messageTypeNotification,
+		MessageId:        "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324",
+		TopicArn:         fakeTopicArn,
+		Subject:          "fake subject",
+		Message:          `{"hello": "world"}`,
+		Timestamp:        "2020-05-01T12:00:00.000Z",
+		SignatureVersion: signatureVersion,
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	signer := newFakeSigner(t)
+	defer signer.server.Close()
+	verifier := signer.verifier()
+
+	for _, version := range []string{signatureVersion1, signatureVersion2} {
+		notification := fakeNotification(version)
+		signer.sign(t, notification)
+		assert.Nil(t, verifier.verify(notification))
+
+		notification.Message = `{"hello": "tampered"}`
+		assert.NotNil(t, verifier.verify(notification))
+	}
+
+	confirmation := &httpNotification{
+		Type:             messageTypeSubscriptionConfirmation,
+		MessageId:        "165545c9-2a5c-472c-8df2-7ff2be2b3b1b",
+		Token:            "fake-token",
+		TopicArn:         fakeTopicArn,
+		Message:          "You have chosen to subscribe to the topic",
+		SubscribeURL:     "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription",
+		Timestamp:        "2020-05-01T12:00:00.000Z",
+		SignatureVersion: signatureVersion2,
+	}
+	signer.sign(t, confirmation)
+	assert.Nil(t, verifier.verify(confirmation))
+	confirmation.Token = "another-token"
+	assert.NotNil(t, verifier.verify(confirmation))
+
+	// the certificate is downloaded once
+	assert.Equal(t, int32(1), atomic.LoadInt32(&signer.downloads))
+
+	unsupported := fakeNotification("3")
+	signer.sign(t, unsupported)
+	assert.NotNil(t, verifier.verify(unsupported))
+}
+
+func TestValidateCertURL(t *testing.T) {
+	verifier := newSignatureVerifier(nil)
+	assert.Nil(t, verifier.validateCertURL("https://sns.us-east-1.amazonaws.com/SimpleNotificationService-fake.pem"))
+	assert.Nil(t, verifier.validateCertURL("https://sns.cn-north-1.amazonaws.com.cn/SimpleNotificationService-fake.pem"))
+	assert.NotNil(t, verifier.validateCertURL("http://sns.us-east-1.amazonaws.com/SimpleNotificationService-fake.pem"))
+	assert.NotNil(t, verifier.validateCertURL("https://sns.us-east-1.amazonaws.com.evil.com/SimpleNotificationService-fake.pem"))
+	assert.NotNil(t, verifier.validateCertURL("https://evil.com/sns.us-east-1.amazonaws.com.pem"))
+
+	verifier = newSignatureVerifier([]string{"sns.internal"})
+	assert.Nil(t, verifier.validateCertURL("https://sns.internal:8443/cert.pem"))
+	assert.NotNil(t, verifier.validateCertURL("https://sns.us-east-1.amazonaws.com/SimpleNotificationService-fake.pem"))
+}
+
+func TestHandleRouteVerifiesSignature(t *testing.T) {
+	signer := newFakeSigner(t)
+	defer signer.server.Close()
+
+	router := &Router{
+		Route: webhook.GetFakeRoute(),
+		eventSource: &v1alpha1.SNSEventSource{
+			TopicArn: fakeTopicArn,
+		},
+		verifier: signer.verifier(),
+	}
+	router.Route.Active = true
+
+	post := func(notification *httpNotification) *webhook.FakeHttpWriter {
+		body, err := json.Marshal(notification)
+		assert.Nil(t, err)
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, &http.Request{
+			Method: http.MethodPost,
+			Body:   ioutil.NopCloser(bytes.NewReader(body)),
+		})
+		return writer
+	}
+
+	// unsigned messages are rejected
+	writer := post(fakeNotification(signatureVersion1))
+	assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+
+	// messages for another topic are rejected
+	notification := fakeNotification(signatureVersion1)
+	notification.TopicArn = "arn:aws:sns:us-east-1:123456789012:another-topic"
+	signer.sign(t, notification)
+	writer = post(notification)
+	assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+
+	notification = fakeNotification(signatureVersion1)
+	signer.sign(t, notification)
+	data := make(chan []byte, 1)
+	go func() {
+		data <- <-router.Route.DataCh
+	}()
+	writer = post(notification)
+	assert.NotEqual(t, http.StatusBadRequest, writer.HeaderStatus)
+	assert.Contains(t, string(<-data), "fake subject")
+}
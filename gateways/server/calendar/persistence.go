@@ -0,0 +1,88 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// scheduleStore persists the last scheduled time of an event source in a config map
+type scheduleStore struct {
+	client    kubernetes.Interface
+	namespace string
+	// name of the config map
+	name string
+	// key of the event source in the config map
+	key string
+}
+
+// load returns the persisted last scheduled time, nil if none is persisted yet
+func (s *scheduleStore) load() (*time.Time, error) {
+	configMap, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(s.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get the config map %s", s.name)
+	}
+	value, ok := configMap.Data[s.key]
+	if !ok {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the last scheduled time %s of the config map %s", value, s.name)
+	}
+	return &t, nil
+}
+
+// save persists the last scheduled time, creating the config map if it doesn't exist.
+// The config map may be shared by several event sources, the update is retried on conflicts.
+func (s *scheduleStore) save(t time.Time) error {
+	value := t.Format(time.RFC3339)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(s.name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "failed to get the config map %s", s.name)
+			}
+			_, err = s.client.CoreV1().ConfigMaps(s.namespace).Create(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.name,
+					Namespace: s.namespace,
+				},
+				Data: map[string]string{
+					s.key: value,
+				},
+			})
+			return err
+		}
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[s.key] = value
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Update(configMap)
+		return err
+	})
+}
@@ -29,12 +29,25 @@ import (
 	"github.com/pkg/errors"
 	cronlib "github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	catchUpNone = "none"
+	catchUpLast = "last"
+	catchUpAll  = "all"
+
+	defaultMaxCatchUpEvents = 10
 )
 
 // EventListener implements Eventing for calendar based events
 type EventListener struct {
 	// Logger to log stuff
 	Logger *logrus.Logger
+	// K8sClient is the kubernetes client, used to persist the last scheduled time
+	K8sClient kubernetes.Interface
+	// Namespace where the gateway is deployed
+	Namespace string
 }
 
 // Next is a function to compute the next event time from a given time
@@ -97,6 +110,19 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		return nextT
 	}
 
+	var store *scheduleStore
+	if calendarEventSource.Persistence != nil {
+		if listener.K8sClient == nil {
+			return errors.Errorf("persistence of event source %s requires a Kubernetes client", eventSource.Name)
+		}
+		store = &scheduleStore{
+			client:    listener.K8sClient,
+			namespace: listener.Namespace,
+			name:      calendarEventSource.Persistence.ConfigMap,
+			key:       eventSource.Name,
+		}
+	}
+
 	lastT := time.Now()
 	var location *time.Location
 	if calendarEventSource.Timezone != "" {
@@ -108,6 +134,51 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		lastT = lastT.In(location)
 	}
 
+	dispatch := func(scheduled, eventTime time.Time) error {
+		response := &events.CalendarEventData{
+			EventTime:     eventTime.String(),
+			ScheduledTime: scheduled.Format(time.RFC3339),
+			UserPayload:   calendarEventSource.UserPayload,
+		}
+		payload, err := json.Marshal(response)
+		if err != nil {
+			// no need to continue as further event payloads will suffer same fate as this one.
+			return errors.Wrapf(err, "failed to marshal the event data for event source %s", eventSource.Name)
+		}
+		logger.Infoln("event dispatched on data channel")
+		channels.Data <- payload
+		if store != nil {
+			if err := store.save(scheduled); err != nil {
+				logger.WithError(err).Errorln("failed to persist the last scheduled time")
+			}
+		}
+		return nil
+	}
+
+	if store != nil {
+		logger.Infoln("retrieving the last scheduled time...")
+		persisted, err := store.load()
+		if err != nil {
+			return err
+		}
+		if persisted != nil {
+			if location != nil {
+				*persisted = persisted.In(location)
+			}
+			var interval time.Duration
+			if constantDelay, ok := schedule.(cronlib.ConstantDelaySchedule); ok {
+				interval = constantDelay.Delay
+			}
+			missed := missedOccurrences(next, interval, *persisted, lastT, calendarEventSource.CatchUp)
+			logger.WithField(common.LabelTime, persisted.UTC().String()).WithField("missed", len(missed)).Infoln("catching up the schedule...")
+			for _, t := range missed {
+				if err := dispatch(t, time.Now()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	for {
 		t := next(lastT)
 		timer := time.After(time.Until(t))
@@ -118,23 +189,73 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 			if location != nil {
 				lastT = lastT.In(location)
 			}
-			response := &events.CalendarEventData{
-				EventTime:   tx.String(),
-				UserPayload: calendarEventSource.UserPayload,
+			if err := dispatch(t, tx); err != nil {
+				return err
 			}
-			payload, err := json.Marshal(response)
-			if err != nil {
-				// no need to continue as further event payloads will suffer same fate as this one.
-				return errors.Wrapf(err, "failed to marshal the event data for event source %s", eventSource.Name)
-			}
-			logger.Infoln("event dispatched on data channel")
-			channels.Data <- payload
 		case <-channels.Done:
 			return nil
 		}
 	}
 }
 
+// missedOccurrences returns the occurrences of the schedule after the last scheduled time and until now,
+// which are fired as per the catch-up policy. The interval is the delay between the occurrences of an interval
+// schedule, or zero for a cron schedule.
+func missedOccurrences(next Next, interval time.Duration, last, now time.Time, catchUp *v1alpha1.CalendarCatchUp) []time.Time {
+	if catchUp == nil {
+		return nil
+	}
+	var limit int
+	switch catchUp.Policy {
+	case catchUpLast:
+		limit = 1
+	case catchUpAll:
+		limit = int(catchUp.MaxEvents)
+		if limit <= 0 {
+			limit = defaultMaxCatchUpEvents
+		}
+	default:
+		return nil
+	}
+	var missed []time.Time
+	// a zero time means the schedule has no further occurrence
+	for t := next(catchUpStart(next, interval, last, now, limit)); !t.IsZero() && !t.After(now); t = next(t) {
+		missed = append(missed, t)
+		// only the most recent occurrences are kept
+		if len(missed) > limit {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
+
+// catchUpStart returns the time from which the most recent occurrences of the schedule before now are looked for,
+// so that the occurrences long before now aren't walked through one by one when the last scheduled time is far behind.
+func catchUpStart(next Next, interval time.Duration, last, now time.Time, limit int) time.Time {
+	if interval > 0 {
+		// the occurrences of an interval schedule follow the last scheduled time, so all but the last ones are skipped
+		if skipped := int64(now.Sub(last)/interval) - int64(limit); skipped > 0 {
+			return last.Add(time.Duration(skipped) * interval)
+		}
+		return last
+	}
+	// the occurrences of a cron schedule don't depend on the start time, so the start is moved back from now,
+	// doubling the span each time, until the span holds enough occurrences or reaches the last scheduled time
+	for span := time.Minute; ; span *= 2 {
+		start := now.Add(-span)
+		if !start.After(last) {
+			return last
+		}
+		count := 0
+		for t := next(start); !t.IsZero() && !t.After(now) && count < limit; t = next(t) {
+			count++
+		}
+		if count == limit {
+			return start
+		}
+	}
+}
+
 // resolveSchedule parses the schedule and returns a valid cron schedule
 func resolveSchedule(cal *v1alpha1.CalendarEventSource) (cronlib.Schedule, error) {
 	if cal.Schedule != "" {
@@ -19,6 +19,7 @@ package calendar
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
@@ -27,7 +28,11 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/events"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/ghodss/yaml"
+	cronlib "github.com/robfig/cron"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestResolveSchedule(t *testing.T) {
@@ -79,3 +84,155 @@ func TestListenEvents(t *testing.T) {
 	}, channels)
 	assert.Nil(t, err)
 }
+
+func TestMissedOccurrences(t *testing.T) {
+	schedule, err := resolveSchedule(&v1alpha1.CalendarEventSource{
+		Schedule: "0 * * * *",
+	})
+	assert.Nil(t, err)
+	last := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := time.Date(2020, 1, 1, 14, 30, 0, 0, time.UTC)
+
+	assert.Empty(t, missedOccurrences(schedule.Next, 0, last, now, nil))
+	assert.Empty(t, missedOccurrences(schedule.Next, 0, last, now, &v1alpha1.CalendarCatchUp{Policy: catchUpNone}))
+	assert.Equal(t, []time.Time{
+		time.Date(2020, 1, 1, 14, 0, 0, 0, time.UTC),
+	}, missedOccurrences(schedule.Next, 0, last, now, &v1alpha1.CalendarCatchUp{Policy: catchUpLast}))
+	assert.Equal(t, []time.Time{
+		time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 1, 13, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 1, 14, 0, 0, 0, time.UTC),
+	}, missedOccurrences(schedule.Next, 0, last, now, &v1alpha1.CalendarCatchUp{Policy: catchUpAll}))
+	// only the most recent occurrences are fired
+	assert.Equal(t, []time.Time{
+		time.Date(2020, 1, 1, 13, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 1, 14, 0, 0, 0, time.UTC),
+	}, missedOccurrences(schedule.Next, 0, last, now, &v1alpha1.CalendarCatchUp{Policy: catchUpAll, MaxEvents: 2}))
+	// nothing was missed
+	assert.Empty(t, missedOccurrences(schedule.Next, 0, now, now, &v1alpha1.CalendarCatchUp{Policy: catchUpAll}))
+}
+
+func TestMissedOccurrencesLongAgo(t *testing.T) {
+	last := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2020, 1, 1, 0, 0, 30, 0, time.UTC)
+	catchUp := &v1alpha1.CalendarCatchUp{Policy: catchUpAll, MaxEvents: 3}
+
+	// the occurrences between the last scheduled time and the most recent ones aren't walked through
+	calls := 0
+	counted := func(schedule cronlib.Schedule) Next {
+		calls = 0
+		return func(t time.Time) time.Time {
+			calls++
+			return schedule.Next(t)
+		}
+	}
+
+	interval, err := resolveSchedule(&v1alpha1.CalendarEventSource{
+		Interval: "1s",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2020, 1, 1, 0, 0, 28, 0, time.UTC),
+		time.Date(2020, 1, 1, 0, 0, 29, 0, time.UTC),
+		time.Date(2020, 1, 1, 0, 0, 30, 0, time.UTC),
+	}, missedOccurrences(counted(interval), time.Second, last, now, catchUp))
+	assert.True(t, calls < 10)
+
+	cron, err := resolveSchedule(&v1alpha1.CalendarEventSource{
+		Schedule: "* * * * *",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2019, 12, 31, 23, 58, 0, 0, time.UTC),
+		time.Date(2019, 12, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, missedOccurrences(counted(cron), 0, last, now, catchUp))
+	assert.True(t, calls < 100)
+}
+
+func TestScheduleStore(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	store := &scheduleStore{client: client, namespace: "fake", name: "calendar-schedules", key: "fake"}
+
+	persisted, err := store.load()
+	assert.Nil(t, err)
+	assert.Nil(t, persisted)
+
+	scheduled := time.Date(2020, 1, 1, 2, 0, 0, 0, time.UTC)
+	assert.Nil(t, store.save(scheduled))
+	persisted, err = store.load()
+	assert.Nil(t, err)
+	assert.True(t, scheduled.Equal(*persisted))
+
+	// the other event sources of the config map are left as they are
+	another := &scheduleStore{client: client, namespace: "fake", name: "calendar-schedules", key: "another"}
+	assert.Nil(t, another.save(scheduled.Add(time.Hour)))
+	assert.Nil(t, store.save(scheduled.Add(24*time.Hour)))
+	configMap, err := client.CoreV1().ConfigMaps("fake").Get("calendar-schedules", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"fake":    "2020-01-02T02:00:00Z",
+		"another": "2020-01-01T03:00:00Z",
+	}, configMap.Data)
+}
+
+func TestListenEventsCatchUp(t *testing.T) {
+	lastScheduled := time.Now().Truncate(time.Minute).Add(-5 * time.Minute)
+	client := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "calendar-schedules", Namespace: "fake"},
+		Data: map[string]string{
+			"fake": lastScheduled.Format(time.RFC3339),
+		},
+	})
+	listener := &EventListener{
+		Logger:    common.NewArgoEventsLogger(),
+		K8sClient: client,
+		Namespace: "fake",
+	}
+
+	body, err := yaml.Marshal(&v1alpha1.CalendarEventSource{
+		Schedule: "* * * * *",
+		Persistence: &v1alpha1.CalendarPersistence{
+			ConfigMap: "calendar-schedules",
+		},
+		CatchUp: &v1alpha1.CalendarCatchUp{
+			Policy:    catchUpAll,
+			MaxEvents: 2,
+		},
+	})
+	assert.Nil(t, err)
+
+	channels := &server.Channels{
+		Data: make(chan []byte),
+		Stop: make(chan struct{}),
+		Done: make(chan struct{}),
+	}
+	var scheduled []string
+	go func() {
+		for i := 0; i < 2; i++ {
+			var cal *events.CalendarEventData
+			assert.Nil(t, json.Unmarshal(<-channels.Data, &cal))
+			scheduled = append(scheduled, cal.ScheduledTime)
+		}
+		channels.Done <- struct{}{}
+	}()
+
+	err = listener.listenEvents(&gateways.EventSource{
+		Name:  "fake",
+		Value: body,
+		Id:    "1234",
+		Type:  string(apicommon.CalendarEvent),
+	}, channels)
+	assert.Nil(t, err)
+
+	// the two most recent of the missed occurrences are fired
+	now := time.Now().Truncate(time.Minute)
+	assert.Equal(t, []string{
+		now.Add(-time.Minute).Format(time.RFC3339),
+		now.Format(time.RFC3339),
+	}, scheduled)
+	configMap, err := client.CoreV1().ConfigMaps("fake").Get("calendar-schedules", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, now.Format(time.RFC3339), configMap.Data["fake"])
+}
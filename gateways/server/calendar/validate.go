@@ -68,5 +68,21 @@ func validate(calendarEventSource *v1alpha1.CalendarEventSource) error {
 	if _, err := resolveSchedule(calendarEventSource); err != nil {
 		return err
 	}
+	if calendarEventSource.Persistence != nil && calendarEventSource.Persistence.ConfigMap == "" {
+		return fmt.Errorf("persistence must specify the config map")
+	}
+	if catchUp := calendarEventSource.CatchUp; catchUp != nil {
+		if calendarEventSource.Persistence == nil {
+			return fmt.Errorf("catch up requires the persistence of the last scheduled time")
+		}
+		switch catchUp.Policy {
+		case "", catchUpNone, catchUpLast, catchUpAll:
+		default:
+			return fmt.Errorf("unknown catch up policy %s, must be one of %s, %s or %s", catchUp.Policy, catchUpNone, catchUpLast, catchUpAll)
+		}
+		if catchUp.MaxEvents < 0 {
+			return fmt.Errorf("max events of the catch up must not be negative")
+		}
+	}
 	return nil
 }
@@ -61,3 +61,24 @@ func TestValidateEventSource(t *testing.T) {
 		assert.Equal(t, true, valid.IsValid)
 	}
 }
+
+func TestValidateCatchUp(t *testing.T) {
+	calendarEventSource := &v1alpha1.CalendarEventSource{
+		Schedule: "0 2 * * *",
+		CatchUp: &v1alpha1.CalendarCatchUp{
+			Policy: catchUpAll,
+		},
+	}
+	assert.NotNil(t, validate(calendarEventSource))
+
+	calendarEventSource.Persistence = &v1alpha1.CalendarPersistence{}
+	assert.NotNil(t, validate(calendarEventSource))
+	calendarEventSource.Persistence.ConfigMap = "calendar-schedules"
+	assert.Nil(t, validate(calendarEventSource))
+
+	calendarEventSource.CatchUp.MaxEvents = -1
+	assert.NotNil(t, validate(calendarEventSource))
+	calendarEventSource.CatchUp.MaxEvents = 5
+	calendarEventSource.CatchUp.Policy = "first"
+	assert.NotNil(t, validate(calendarEventSource))
+}
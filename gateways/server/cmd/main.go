@@ -69,7 +69,7 @@ func getEventingServer(eventType apicommon.EventSourceType, restConfig *rest.Con
 	case apicommon.AzureEventsHub:
 		return &azure_events_hub.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.CalendarEvent:
-		return &calendar.EventListener{Logger: log}, nil
+		return &calendar.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.EmitterEvent:
 		return &emitter.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.FileEvent:
@@ -83,7 +83,7 @@ func getEventingServer(eventType apicommon.EventSourceType, restConfig *rest.Con
 	case apicommon.HDFSEvent:
 		return &hdfs.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.KafkaEvent:
-		return &kafka.EventListener{Logger: log}, nil
+		return &kafka.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.MinioEvent:
 		return &minio.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.MQTTEvent:
@@ -103,7 +103,7 @@ func getEventingServer(eventType apicommon.EventSourceType, restConfig *rest.Con
 	case apicommon.StripeEvent:
 		return &stripe.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	case apicommon.WebhookEvent:
-		return &webhook.EventListener{Logger: log}, nil
+		return &webhook.EventListener{Logger: log, K8sClient: clientset, Namespace: namespace}, nil
 	default:
 		return nil, errors.New("invalid event type")
 	}
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	bearerPrefix = "Bearer "
+
+	// defaultHMACHeader is the header holding the HMAC signature if the webhook doesn't set it
+	defaultHMACHeader = "X-Signature"
+
+	hmacSHA1   = "sha1"
+	hmacSHA256 = "sha256"
+	hmacSHA512 = "sha512"
+
+	encodingHex    = "hex"
+	encodingBase64 = "base64"
+)
+
+// Authenticator authenticates the requests of a route, as per the auth configuration of the webhook
+type Authenticator struct {
+	bearerToken string
+	hmac        *hmacVerifier
+	username    string
+	password    string
+	clientCAs   *x509.CertPool
+}
+
+type hmacVerifier struct {
+	key      []byte
+	header   string
+	prefix   string
+	hash     func() hash.Hash
+	encoding string
+}
+
+// NewAuthenticator returns the authenticator of the auth configuration, reading its secrets from the namespace.
+// It returns nil if the requests are not authenticated.
+func NewAuthenticator(client kubernetes.Interface, namespace string, auth *v1alpha1.WebhookAuth) (*Authenticator, error) {
+	if auth == nil {
+		return nil, nil
+	}
+	if client == nil {
+		return nil, errors.New("authentication of the webhook requires a Kubernetes client")
+	}
+
+	a := &Authenticator{}
+	if auth.BearerToken != nil {
+		token, err := common.GetSecretValue(client, namespace, auth.BearerToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the bearer token")
+		}
+		a.bearerToken = token
+	}
+	if auth.HMAC != nil {
+		key, err := common.GetSecretValue(client, namespace, auth.HMAC.Secret)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the hmac secret")
+		}
+		hashFunc, err := hmacHash(auth.HMAC.Algorithm)
+		if err != nil {
+			return nil, err
+		}
+		a.hmac = &hmacVerifier{
+			key:      []byte(key),
+			header:   auth.HMAC.Header,
+			prefix:   auth.HMAC.Prefix,
+			hash:     hashFunc,
+			encoding: auth.HMAC.Encoding,
+		}
+		if a.hmac.header == "" {
+			a.hmac.header = defaultHMACHeader
+		}
+		if a.hmac.encoding == "" {
+			a.hmac.encoding = encodingHex
+		}
+	}
+	if auth.Basic != nil {
+		username, err := common.GetSecretValue(client, namespace, auth.Basic.Username)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the basic auth username")
+		}
+		password, err := common.GetSecretValue(client, namespace, auth.Basic.Password)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the basic auth password")
+		}
+		a.username, a.password = username, password
+	}
+	if auth.ClientCAPath != "" {
+		caCert, err := ioutil.ReadFile(auth.ClientCAPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the client CA certificates %s", auth.ClientCAPath)
+		}
+		a.clientCAs = x509.NewCertPool()
+		if !a.clientCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificate found in %s", auth.ClientCAPath)
+		}
+	}
+	return a, nil
+}
+
+// hmacHash returns the hash function of the HMAC algorithm
+func hmacHash(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case hmacSHA1:
+		return sha1.New, nil
+	case hmacSHA256, "":
+		return sha256.New, nil
+	case hmacSHA512:
+		return sha512.New, nil
+	default:
+		return nil, errors.Errorf("unsupported hmac algorithm %s", algorithm)
+	}
+}
+
+// Authenticate checks the request passes all the configured modes.
+// The request body is read to verify its signature, and replaced so that it can be read again.
+func (a *Authenticator) Authenticate(request *http.Request) error {
+	if a.bearerToken != "" {
+		header := request.Header.Get("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) || subtle.ConstantTimeCompare([]byte(header[len(bearerPrefix):]), []byte(a.bearerToken)) != 1 {
+			return errors.New("invalid bearer token")
+		}
+	}
+	if a.username != "" || a.password != "" {
+		username, password, ok := request.BasicAuth()
+		if !ok {
+			return errors.New("missing basic auth credentials")
+		}
+		// both are compared regardless of the outcome of the first comparison
+		validUsername := subtle.ConstantTimeCompare([]byte(username), []byte(a.username))
+		validPassword := subtle.ConstantTimeCompare([]byte(password), []byte(a.password))
+		if validUsername&validPassword != 1 {
+			return errors.New("invalid basic auth credentials")
+		}
+	}
+	if a.clientCAs != nil {
+		if err := a.verifyClientCert(request); err != nil {
+			return err
+		}
+	}
+	if a.hmac != nil {
+		if err := a.hmac.verify(request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyClientCert checks the client certificate of the request is signed by the client CAs
+func (a *Authenticator) verifyClientCert(request *http.Request) error {
+	if request.TLS == nil || len(request.TLS.PeerCertificates) == 0 {
+		return errors.New("missing client certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range request.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := request.TLS.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         a.clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return errors.Wrap(err, "invalid client certificate")
+	}
+	return nil
+}
+
+// verify checks the signature of the request body
+func (v *hmacVerifier) verify(request *http.Request) error {
+	header := request.Header.Get(v.header)
+	if header == "" {
+		return errors.Errorf("missing signature header %s", v.header)
+	}
+	if !strings.HasPrefix(header, v.prefix) {
+		return errors.Errorf("signature doesn't start with %s", v.prefix)
+	}
+	var signature []byte
+	var err error
+	switch v.encoding {
+	case encodingBase64:
+		signature, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(header, v.prefix))
+	default:
+		signature, err = hex.DecodeString(strings.TrimPrefix(header, v.prefix))
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to decode the signature")
+	}
+
+	if request.Body == nil {
+		return errors.New("missing request body")
+	}
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the request body")
+	}
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	mac := hmac.New(v.hash, v.key)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// SetupAuth sets up the authenticator of the route as per the auth configuration of the webhook,
+// reading its secrets from the namespace
+func (r *Route) SetupAuth(client kubernetes.Interface, namespace string) error {
+	if r.Context == nil || r.Context.Auth == nil {
+		return nil
+	}
+	authenticator, err := NewAuthenticator(client, namespace, r.Context.Auth)
+	if err != nil {
+		return err
+	}
+	r.Authenticator = authenticator
+	return nil
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func secretKey(key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-auth"},
+		Key:                  key,
+	}
+}
+
+func fakeAuthClient() *fake.Clientset {
+	return fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook-auth", Namespace: "fake"},
+		Data: map[string][]byte{
+			"token":    []byte("fake-token"),
+			"hmac":     []byte("fake-hmac-key"),
+			"username": []byte("fake-user"),
+			"password": []byte("fake-password"),
+		},
+	})
+}
+
+func newRequest(body string) *http.Request {
+	return &http.Request{
+		Method: http.MethodPost,
+		Header: http.Header{},
+		Body:   ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
+
+func TestAuthenticateBearerToken(t *testing.T) {
+	authenticator, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		BearerToken: secretKey("token"),
+	})
+	assert.Nil(t, err)
+
+	request := newRequest("{}")
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.Header.Set("Authorization", "fake-token")
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.Header.Set("Authorization", "Bearer another-token")
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.Header.Set("Authorization", "Bearer fake-token")
+	assert.Nil(t, authenticator.Authenticate(request))
+}
+
+func TestAuthenticateBasic(t *testing.T) {
+	authenticator, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		Basic: &v1alpha1.WebhookBasicAuth{
+			Username: secretKey("username"),
+			Password: secretKey("password"),
+		},
+	})
+	assert.Nil(t, err)
+
+	request := newRequest("{}")
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.SetBasicAuth("fake-user", "another-password")
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.SetBasicAuth("fake-user", "fake-password")
+	assert.Nil(t, authenticator.Authenticate(request))
+}
+
+func TestAuthenticateHMAC(t *testing.T) {
+	body := `{"hello": "world"}`
+
+	authenticator, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		HMAC: &v1alpha1.WebhookHMAC{
+			Secret: secretKey("hmac"),
+			Header: "X-Hub-Signature-256",
+			Prefix: "sha256=",
+		},
+	})
+	assert.Nil(t, err)
+	mac := hmac.New(sha256.New, []byte("fake-hmac-key"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	request := newRequest(body)
+	assert.NotNil(t, authenticator.Authenticate(request))
+
+	request = newRequest(body)
+	request.Header.Set("X-Hub-Signature-256", signature)
+	assert.Nil(t, authenticator.Authenticate(request))
+	// the body can be read again by the router
+	read, err := ioutil.ReadAll(request.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, body, string(read))
+
+	request = newRequest(`{"hello": "tampered"}`)
+	request.Header.Set("X-Hub-Signature-256", signature)
+	assert.NotNil(t, authenticator.Authenticate(request))
+
+	// base64 encoded sha1 signature in the default header
+	authenticator, err = NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		HMAC: &v1alpha1.WebhookHMAC{
+			Secret:    secretKey("hmac"),
+			Algorithm: "sha1",
+			Encoding:  "base64",
+		},
+	})
+	assert.Nil(t, err)
+	mac = hmac.New(sha1.New, []byte("fake-hmac-key"))
+	mac.Write([]byte(body))
+	request = newRequest(body)
+	request.Header.Set(defaultHMACHeader, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	assert.Nil(t, authenticator.Authenticate(request))
+}
+
+func newCert(t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return cert, key
+}
+
+func TestAuthenticateClientCert(t *testing.T) {
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	ca, caKey := newCert(t, caTemplate, nil, nil)
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "fake-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	client, _ := newCert(t, clientTemplate, ca, caKey)
+	untrusted, _ := newCert(t, clientTemplate, nil, nil)
+
+	caFile, err := ioutil.TempFile("", "client-ca")
+	assert.Nil(t, err)
+	defer os.Remove(caFile.Name())
+	assert.Nil(t, pem.Encode(caFile, &pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}))
+	assert.Nil(t, caFile.Close())
+
+	authenticator, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		ClientCAPath: caFile.Name(),
+	})
+	assert.Nil(t, err)
+
+	request := newRequest("{}")
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{untrusted}}
+	assert.NotNil(t, authenticator.Authenticate(request))
+	request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{client}}
+	assert.Nil(t, authenticator.Authenticate(request))
+}
+
+func TestNewAuthenticatorMissingSecret(t *testing.T) {
+	_, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		BearerToken: secretKey("missing"),
+	})
+	assert.NotNil(t, err)
+
+	authenticator, err := NewAuthenticator(nil, "fake", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, authenticator)
+}
+
+type recordingRouter struct {
+	FakeRouter
+	handled int
+}
+
+func (r *recordingRouter) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	r.handled++
+	writer.WriteHeader(http.StatusOK)
+}
+
+func TestAuthenticateHandler(t *testing.T) {
+	router := &recordingRouter{FakeRouter: FakeRouter{route: GetFakeRoute()}}
+	handler := handleRoute(router)
+
+	// the requests are not authenticated without an authenticator
+	recorder := httptest.NewRecorder()
+	handler(recorder, newRequest("{}"))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, 1, router.handled)
+
+	authenticator, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		BearerToken: secretKey("token"),
+	})
+	assert.Nil(t, err)
+	router.route.Authenticator = authenticator
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, newRequest("{}"))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Equal(t, 1, router.handled)
+
+	recorder = httptest.NewRecorder()
+	request := newRequest("{}")
+	request.Header.Set("Authorization", "Bearer fake-token")
+	handler(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, 2, router.handled)
+}
+
+func TestValidateAuth(t *testing.T) {
+	context := &v1alpha1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		Auth:     &v1alpha1.WebhookAuth{},
+	}
+	assert.NotNil(t, ValidateWebhookContext(context))
+
+	context.Auth.HMAC = &v1alpha1.WebhookHMAC{Secret: secretKey("hmac"), Algorithm: "md5"}
+	assert.NotNil(t, ValidateWebhookContext(context))
+	context.Auth.HMAC.Algorithm = "sha512"
+	assert.Nil(t, ValidateWebhookContext(context))
+
+	context.Auth.Basic = &v1alpha1.WebhookBasicAuth{Username: secretKey("username")}
+	assert.NotNil(t, ValidateWebhookContext(context))
+	context.Auth.Basic.Password = secretKey("password")
+	assert.Nil(t, ValidateWebhookContext(context))
+
+	context.Auth.ClientCAPath = "/fake/ca.crt"
+	assert.NotNil(t, ValidateWebhookContext(context))
+	context.ServerCertPath, context.ServerKeyPath = "/fake/tls.crt", "/fake/tls.key"
+	assert.Nil(t, ValidateWebhookContext(context))
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	defaultMaxPayloadSize = 10 * 1024 * 1024
+	defaultReadTimeout    = 30 * time.Second
+	defaultWriteTimeout   = 30 * time.Second
+	defaultIdleTimeout    = 120 * time.Second
+)
+
+// reasons of the rejections, as recorded by the metrics
+var rejectionReasons = map[int]string{
+	http.StatusMethodNotAllowed:      "method_not_allowed",
+	http.StatusTooManyRequests:       "rate_limited",
+	http.StatusRequestEntityTooLarge: "payload_too_large",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusBadRequest:            "bad_request",
+}
+
+// routeLimits holds the limits of the requests of a route
+type routeLimits struct {
+	// methods are the allowed methods, all the methods are allowed if empty
+	methods        map[string]bool
+	maxPayloadSize int64
+	// limiter is the token bucket of the rate limit, nil if the requests are not rate limited
+	limiter *rate.Limiter
+}
+
+// newRouteLimits returns the limits of the requests of the webhook
+func newRouteLimits(context *v1alpha1.WebhookContext) *routeLimits {
+	limits := &routeLimits{
+		methods:        make(map[string]bool),
+		maxPayloadSize: defaultMaxPayloadSize,
+	}
+	if context == nil {
+		return limits
+	}
+	if context.Method != "" {
+		limits.methods[strings.ToUpper(context.Method)] = true
+	}
+	for _, method := range context.Methods {
+		limits.methods[strings.ToUpper(method)] = true
+	}
+	if context.MaxPayloadSize > 0 {
+		limits.maxPayloadSize = context.MaxPayloadSize
+	}
+	if context.RateLimit != nil && context.RateLimit.RequestsPerSecond > 0 {
+		burst := int(context.RateLimit.Burst)
+		if burst <= 0 {
+			burst = int(context.RateLimit.RequestsPerSecond)
+		}
+		limits.limiter = rate.NewLimiter(rate.Limit(context.RateLimit.RequestsPerSecond), burst)
+	}
+	return limits
+}
+
+// allowedMethods returns the allowed methods, as listed in the Allow header
+func (limits *routeLimits) allowedMethods() string {
+	var methods []string
+	for method := range limits.methods {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ", ")
+}
+
+// admit checks the request is within the limits of the route and passes its authentication.
+// It returns the status of the response if the request is rejected.
+func (r *Route) admit(request *http.Request) (int, error) {
+	limits := r.limits
+	if limits == nil {
+		// the route wasn't created by NewRoute, only the default limits apply
+		limits = newRouteLimits(nil)
+	}
+	if len(limits.methods) > 0 && !limits.methods[request.Method] {
+		return http.StatusMethodNotAllowed, errors.Errorf("method %s is not allowed", request.Method)
+	}
+	if request.Body != nil {
+		if request.ContentLength > limits.maxPayloadSize {
+			return http.StatusRequestEntityTooLarge, errors.Errorf("request body is larger than %d bytes", limits.maxPayloadSize)
+		}
+		// the body is read up front, so that the routers never read more than the limit
+		body, err := ioutil.ReadAll(io.LimitReader(request.Body, limits.maxPayloadSize+1))
+		if err != nil {
+			return http.StatusBadRequest, errors.Wrap(err, "failed to read the request body")
+		}
+		if int64(len(body)) > limits.maxPayloadSize {
+			return http.StatusRequestEntityTooLarge, errors.Errorf("request body is larger than %d bytes", limits.maxPayloadSize)
+		}
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	if r.Authenticator != nil {
+		if err := r.Authenticator.Authenticate(request); err != nil {
+			return http.StatusUnauthorized, err
+		}
+	}
+	// the rate limit is charged last, so that the requests rejected otherwise don't consume the tokens of the legitimate ones
+	if limits.limiter != nil && !limits.limiter.Allow() {
+		return http.StatusTooManyRequests, errors.New("rate limit of the endpoint is exceeded")
+	}
+	return http.StatusOK, nil
+}
+
+// handleRoute returns the handler of the route, which rejects the requests exceeding the limits of the route
+// or failing its authentication, before they are processed by the router
+func handleRoute(router Router) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		route := router.GetRoute()
+		route.requests.RLock()
+		defer route.requests.RUnlock()
+
+		status, err := route.admit(request)
+		if err == nil {
+			router.HandleRoute(writer, request)
+			return
+		}
+
+		route.Logger.WithFields(map[string]interface{}{
+			common.LabelEventSource: route.EventSource.Name,
+			common.LabelEndpoint:    route.Context.Endpoint,
+			common.LabelPort:        route.Context.Port,
+			common.LabelHTTPMethod:  request.Method,
+		}).WithError(err).Warnln("rejecting the request")
+		metrics.GatewayServerRequestRejected(route.EventSource.Name, route.Context.Endpoint, rejectionReasons[status])
+
+		if status == http.StatusMethodNotAllowed && route.limits != nil {
+			writer.Header().Set("Allow", route.limits.allowedMethods())
+		}
+		writer.WriteHeader(status)
+		if _, err := writer.Write([]byte(http.StatusText(status))); err != nil {
+			route.Logger.WithError(err).Errorln("failed to write the response")
+		}
+	}
+}
+
+// serverTimeouts returns the read, write and idle timeouts of the server of the webhook
+func serverTimeouts(context *v1alpha1.WebhookContext) (time.Duration, time.Duration, time.Duration) {
+	timeout := func(seconds int64, defaultTimeout time.Duration) time.Duration {
+		if seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		return defaultTimeout
+	}
+	return timeout(context.ReadTimeout, defaultReadTimeout),
+		timeout(context.WriteTimeout, defaultWriteTimeout),
+		timeout(context.IdleTimeout, defaultIdleTimeout)
+}
+
+// validateServerTimeouts checks the timeouts set on the webhook are those of the server already running on its port,
+// if any, as the timeouts apply to the server and so to all the routes of the port
+func (c *Controller) validateServerTimeouts(context *v1alpha1.WebhookContext) error {
+	Lock.Lock()
+	defer Lock.Unlock()
+	server, ok := c.servers[context.Port]
+	if !ok {
+		return nil
+	}
+	conflicts := func(seconds int64, timeout time.Duration) bool {
+		return seconds > 0 && time.Duration(seconds)*time.Second != timeout
+	}
+	if conflicts(context.ReadTimeout, server.server.ReadTimeout) ||
+		conflicts(context.WriteTimeout, server.server.WriteTimeout) ||
+		conflicts(context.IdleTimeout, server.server.IdleTimeout) {
+		return errors.Errorf("the timeouts of the webhook conflict with those of the server already running on port %s, i.e. read %s, write %s and idle %s",
+			context.Port, server.server.ReadTimeout, server.server.WriteTimeout, server.server.IdleTimeout)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// bodyRouter records the bodies of the requests it handles
+type bodyRouter struct {
+	FakeRouter
+	bodies []string
+}
+
+func (r *bodyRouter) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	body, _ := ioutil.ReadAll(request.Body)
+	r.bodies = append(r.bodies, string(body))
+	writer.WriteHeader(http.StatusOK)
+}
+
+func newLimitedRouter(context *v1alpha1.WebhookContext) *bodyRouter {
+	route := GetFakeRoute()
+	route.Context = context
+	route.limits = newRouteLimits(context)
+	return &bodyRouter{FakeRouter: FakeRouter{route: route}}
+}
+
+func TestHandleRouteMethods(t *testing.T) {
+	router := newLimitedRouter(&v1alpha1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		Method:   "post",
+		Methods:  []string{"PUT"},
+	})
+	handler := handleRoute(router)
+
+	recorder := httptest.NewRecorder()
+	request := newRequest("{}")
+	request.Method = http.MethodGet
+	handler(recorder, request)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(t, "POST, PUT", recorder.Header().Get("Allow"))
+
+	for _, method := range []string{http.MethodPost, http.MethodPut} {
+		recorder = httptest.NewRecorder()
+		request = newRequest("{}")
+		request.Method = method
+		handler(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	}
+	assert.Len(t, router.bodies, 2)
+}
+
+func TestHandleRoutePayloadSize(t *testing.T) {
+	router := newLimitedRouter(&v1alpha1.WebhookContext{
+		Endpoint:       "/fake",
+		Port:           "12000",
+		MaxPayloadSize: 8,
+	})
+	handler := handleRoute(router)
+
+	// the declared length is over the limit
+	recorder := httptest.NewRecorder()
+	request := newRequest(`{"hello": "world"}`)
+	request.ContentLength = 18
+	handler(recorder, request)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+
+	// the length is unknown, the body is read up to the limit
+	recorder = httptest.NewRecorder()
+	handler(recorder, newRequest(`{"hello": "world"}`))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	assert.Empty(t, router.bodies)
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, newRequest(`{"a": 1}`))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []string{`{"a": 1}`}, router.bodies)
+}
+
+func TestHandleRouteRateLimit(t *testing.T) {
+	router := newLimitedRouter(&v1alpha1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		RateLimit: &v1alpha1.WebhookRateLimit{
+			RequestsPerSecond: 1,
+			Burst:             2,
+		},
+	})
+	handler := handleRoute(router)
+
+	var codes []int
+	for i := 0; i < 3; i++ {
+		recorder := httptest.NewRecorder()
+		handler(recorder, newRequest("{}"))
+		codes = append(codes, recorder.Code)
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+	assert.Len(t, router.bodies, 2)
+}
+
+func TestHandleRouteRateLimitAfterAuth(t *testing.T) {
+	router := newLimitedRouter(&v1alpha1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		Method:   http.MethodPost,
+		RateLimit: &v1alpha1.WebhookRateLimit{
+			RequestsPerSecond: 1,
+			Burst:             1,
+		},
+	})
+	authenticator, err := NewAuthenticator(fakeAuthClient(), "fake", &v1alpha1.WebhookAuth{
+		BearerToken: secretKey("token"),
+	})
+	assert.Nil(t, err)
+	router.route.Authenticator = authenticator
+	handler := handleRoute(router)
+
+	// the rejected requests don't consume the tokens of the rate limit
+	for i := 0; i < 3; i++ {
+		recorder := httptest.NewRecorder()
+		handler(recorder, newRequest("{}"))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		request := newRequest("{}")
+		request.Method = http.MethodGet
+		request.Header.Set("Authorization", "Bearer fake-token")
+		handler(recorder, request)
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	}
+
+	recorder := httptest.NewRecorder()
+	request := newRequest("{}")
+	request.Header.Set("Authorization", "Bearer fake-token")
+	handler(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Len(t, router.bodies, 1)
+}
+
+func TestServerTimeouts(t *testing.T) {
+	read, write, idle := serverTimeouts(&v1alpha1.WebhookContext{})
+	assert.Equal(t, defaultReadTimeout, read)
+	assert.Equal(t, defaultWriteTimeout, write)
+	assert.Equal(t, defaultIdleTimeout, idle)
+
+	read, write, idle = serverTimeouts(&v1alpha1.WebhookContext{ReadTimeout: 5, WriteTimeout: 10, IdleTimeout: 60})
+	assert.Equal(t, 5*time.Second, read)
+	assert.Equal(t, 10*time.Second, write)
+	assert.Equal(t, time.Minute, idle)
+}
+
+func TestValidateServerTimeouts(t *testing.T) {
+	controller := NewController()
+	context := &v1alpha1.WebhookContext{
+		Endpoint:    "/fake",
+		Port:        "12000",
+		ReadTimeout: 5,
+	}
+	// no server is running on the port yet
+	assert.Nil(t, controller.validateServerTimeouts(context))
+
+	read, write, idle := serverTimeouts(context)
+	controller.servers[context.Port] = &portServer{
+		server: &http.Server{ReadTimeout: read, WriteTimeout: write, IdleTimeout: idle},
+	}
+	assert.Nil(t, controller.validateServerTimeouts(context))
+	// the timeouts which aren't set are those of the server
+	assert.Nil(t, controller.validateServerTimeouts(&v1alpha1.WebhookContext{Endpoint: "/other", Port: "12000"}))
+	assert.NotNil(t, controller.validateServerTimeouts(&v1alpha1.WebhookContext{Endpoint: "/other", Port: "12000", ReadTimeout: 10}))
+	assert.NotNil(t, controller.validateServerTimeouts(&v1alpha1.WebhookContext{Endpoint: "/other", Port: "12000", IdleTimeout: 10}))
+	assert.Nil(t, controller.validateServerTimeouts(&v1alpha1.WebhookContext{Endpoint: "/other", Port: "13000", ReadTimeout: 10}))
+}
+
+func TestValidateLimits(t *testing.T) {
+	context := &v1alpha1.WebhookContext{
+		Endpoint:       "/fake",
+		Port:           "12000",
+		MaxPayloadSize: -1,
+	}
+	assert.NotNil(t, ValidateWebhookContext(context))
+	context.MaxPayloadSize = 1024
+	context.ReadTimeout = -1
+	assert.NotNil(t, ValidateWebhookContext(context))
+	context.ReadTimeout = 10
+
+	context.RateLimit = &v1alpha1.WebhookRateLimit{}
+	err := ValidateWebhookContext(context)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "rate limit"))
+	context.RateLimit.RequestsPerSecond = 10
+	assert.Nil(t, ValidateWebhookContext(context))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+// drainTimeout is the maximum duration the requests in flight are waited for when a route is removed
+const drainTimeout = 30 * time.Second
+
+// drain waits for the requests in flight on the route to be processed. It returns false if they are not processed
+// within the timeout.
+func (r *Route) drain(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		r.requests.Lock()
+		r.requests.Unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// flush waits for the events received on the data channel of the route to be sent to the gateway client
+func (r *Route) flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case r.flushCh <- done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown stops the http servers of the controller from accepting new requests, waits for the requests in flight
+// to be processed and for their events to be sent to the gateway client.
+func (c *Controller) Shutdown(ctx context.Context) error {
+	Lock.Lock()
+	c.shuttingDown = true
+	var servers []*portServer
+	for port, server := range c.servers {
+		servers = append(servers, server)
+		delete(c.servers, port)
+		delete(c.ActiveServerHandlers, port)
+	}
+	var routes []*Route
+	for _, router := range c.routes {
+		routes = append(routes, router.GetRoute())
+	}
+	Lock.Unlock()
+
+	for _, server := range servers {
+		if err := server.server.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "failed to shut down the http server on %s", server.server.Addr)
+		}
+	}
+	for _, route := range routes {
+		if err := route.flush(ctx); err != nil {
+			return errors.Wrapf(err, "failed to flush the events of the endpoint %s", route.Context.Endpoint)
+		}
+	}
+	return nil
+}
+
+// Shutdown shuts down the webhook controllers of the gateway server
+func Shutdown(ctx context.Context) error {
+	Lock.Lock()
+	all := append([]*Controller{}, controllers...)
+	Lock.Unlock()
+
+	for _, controller := range all {
+		if err := controller.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// routeStatus is the status of a route, as reported by the readiness endpoint
+type routeStatus struct {
+	EventSource string `json:"eventSource"`
+	Port        string `json:"port"`
+	Endpoint    string `json:"endpoint"`
+	Active      bool   `json:"active"`
+}
+
+// readiness is the response of the readiness endpoint
+type readiness struct {
+	Ready  bool          `json:"ready"`
+	Routes []routeStatus `json:"routes"`
+}
+
+// ReadinessHandler reports the routes registered with the http servers. The gateway server is ready if all the routes
+// are active, and it is not shutting down.
+func ReadinessHandler(writer http.ResponseWriter, request *http.Request) {
+	status := readiness{
+		Ready:  true,
+		Routes: []routeStatus{},
+	}
+
+	Lock.Lock()
+	for _, controller := range controllers {
+		if controller.shuttingDown {
+			status.Ready = false
+		}
+		for _, router := range controller.routes {
+			route := router.GetRoute()
+			status.Routes = append(status.Routes, routeStatus{
+				EventSource: route.EventSource.Name,
+				Port:        route.Context.Port,
+				Endpoint:    route.Context.Endpoint,
+				Active:      route.Active,
+			})
+			if !route.Active {
+				status.Ready = false
+			}
+		}
+	}
+	Lock.Unlock()
+
+	sort.Slice(status.Routes, func(i, j int) bool {
+		return status.Routes[i].Port+status.Routes[i].Endpoint < status.Routes[j].Port+status.Routes[j].Endpoint
+	})
+	body, err := json.Marshal(status)
+	if err != nil {
+		common.SendInternalErrorResponse(writer, err.Error())
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	if !status.Ready {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = writer.Write(body)
+}
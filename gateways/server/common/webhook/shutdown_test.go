@@ -0,0 +1,131 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/gateways"
+	servercommon "github.com/argoproj/argo-events/gateways/server/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// newPortRouter returns a router listening on a random port
+func newPortRouter(endpoint string) *recordingRouter {
+	route := NewRoute(&v1alpha1.WebhookContext{
+		Endpoint: endpoint,
+		Port:     "0",
+	}, common.NewArgoEventsLogger(), &gateways.EventSource{Name: "fake" + endpoint})
+	return &recordingRouter{FakeRouter: FakeRouter{route: route}}
+}
+
+func serve(controller *Controller, endpoint string) int {
+	Lock.Lock()
+	server := controller.servers["0"]
+	Lock.Unlock()
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, endpoint, nil))
+	return recorder.Code
+}
+
+func TestRemoveRoute(t *testing.T) {
+	controller := NewController()
+	foo, bar := newPortRouter("/foo"), newPortRouter("/bar")
+	startServer(foo, controller)
+	startServer(bar, controller)
+	assert.Len(t, controller.servers, 1)
+
+	assert.Equal(t, http.StatusOK, serve(controller, "/foo"))
+	assert.Equal(t, http.StatusOK, serve(controller, "/bar"))
+
+	removeRoute(foo, controller)
+	assert.Equal(t, http.StatusNotFound, serve(controller, "/foo"))
+	assert.Equal(t, http.StatusOK, serve(controller, "/bar"))
+	assert.Equal(t, 1, foo.handled)
+	assert.Equal(t, 2, bar.handled)
+
+	// the server is shut down with its last route
+	removeRoute(bar, controller)
+	assert.Empty(t, controller.servers)
+	assert.Empty(t, controller.ActiveServerHandlers)
+	assert.Empty(t, controller.routes)
+}
+
+func TestDrain(t *testing.T) {
+	route := GetFakeRoute()
+	route.requests.RLock()
+	assert.False(t, route.drain(10*time.Millisecond))
+	route.requests.RUnlock()
+	assert.True(t, route.drain(time.Second))
+}
+
+func TestShutdown(t *testing.T) {
+	defer func(all []*Controller) {
+		controllers = all
+	}(append([]*Controller{}, controllers...))
+
+	controller := NewController()
+	router := newPortRouter("/foo")
+	startServer(router, controller)
+
+	ready := func() (int, readiness) {
+		recorder := httptest.NewRecorder()
+		ReadinessHandler(recorder, httptest.NewRequest(http.MethodGet, common.ReadinessPath, nil))
+		var status readiness
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+		return recorder.Code, status
+	}
+
+	code, status := ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, []routeStatus{{EventSource: "fake/foo", Port: "0", Endpoint: "/foo"}}, status.Routes)
+
+	router.route.Active = true
+	code, status = ready()
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, status.Ready)
+
+	stream := &servercommon.FakeGRPCStream{Ctx: context.Background()}
+	go manageRouteChannels(router, stream)
+	// the event is received, but not necessarily sent yet
+	router.route.DataCh <- []byte("hello")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Nil(t, controller.Shutdown(ctx))
+	// the event is sent before the shutdown completes
+	assert.NotNil(t, stream.SentData)
+	assert.Equal(t, "hello", string(stream.SentData.Payload))
+	assert.Empty(t, controller.servers)
+
+	code, _ = ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	// no route is registered once the controller is shut down
+	startServer(newPortRouter("/bar"), controller)
+	assert.Len(t, controller.routes, 1)
+
+	router.route.StopChan <- struct{}{}
+}
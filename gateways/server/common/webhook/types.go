@@ -30,6 +30,9 @@ import (
 var (
 	// Mutex synchronizes ActiveServerHandlers
 	Lock sync.Mutex
+
+	// controllers are all the controllers of the gateway server, shut down together on termination
+	controllers []*Controller
 )
 
 // Router is an interface to manage the route
@@ -61,6 +64,14 @@ type Route struct {
 	DataCh chan []byte
 	// Stop channel to signal the end of the event source.
 	StopChan chan struct{}
+	// Authenticator authenticates the requests, as per the auth configuration of the webhook
+	Authenticator *Authenticator
+	// limits are the limits of the requests, as per the webhook context
+	limits *routeLimits
+	// requests is read locked by the requests in flight, so that the route can be drained
+	requests sync.RWMutex
+	// flushCh receives the flush requests of the data channel
+	flushCh chan chan struct{}
 }
 
 // Controller controls the active servers and endpoints
@@ -73,4 +84,19 @@ type Controller struct {
 	RouteActivateChan chan Router
 	// RouteDeactivateChan handles inactivation of routes
 	RouteDeactivateChan chan Router
+	// servers are the http servers, per port
+	servers map[string]*portServer
+	// routes are the routes registered with the servers, per port and endpoint
+	routes map[string]Router
+	// shuttingDown is set once the controller is shut down, no route is registered afterwards
+	shuttingDown bool
+}
+
+// portServer is the http server of a port
+type portServer struct {
+	server *http.Server
+	// lock synchronizes router
+	lock sync.RWMutex
+	// router dispatches the requests to the routes registered on the port
+	router *mux.Router
 }
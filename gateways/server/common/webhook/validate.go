@@ -40,6 +40,51 @@ func ValidateWebhookContext(context *v1alpha12.WebhookContext) error {
 			return fmt.Errorf("failed to parse server port %s. err: %+v", context.Port, err)
 		}
 	}
+	if context.Auth != nil {
+		if err := validateAuth(context); err != nil {
+			return err
+		}
+	}
+	if context.MaxPayloadSize < 0 {
+		return fmt.Errorf("max payload size can't be negative")
+	}
+	if context.ReadTimeout < 0 || context.WriteTimeout < 0 || context.IdleTimeout < 0 {
+		return fmt.Errorf("timeouts can't be negative")
+	}
+	if context.RateLimit != nil {
+		if context.RateLimit.RequestsPerSecond <= 0 {
+			return fmt.Errorf("rate limit must allow a positive number of requests per second")
+		}
+		if context.RateLimit.Burst < 0 {
+			return fmt.Errorf("rate limit burst can't be negative")
+		}
+	}
+	return nil
+}
+
+// validateAuth validates the auth configuration of a webhook context
+func validateAuth(context *v1alpha12.WebhookContext) error {
+	auth := context.Auth
+	if auth.BearerToken == nil && auth.HMAC == nil && auth.Basic == nil && auth.ClientCAPath == "" {
+		return fmt.Errorf("auth must specify at least one of bearerToken, hmac, basic or clientCAPath")
+	}
+	if auth.HMAC != nil {
+		if auth.HMAC.Secret == nil {
+			return fmt.Errorf("hmac secret can't be empty")
+		}
+		if _, err := hmacHash(auth.HMAC.Algorithm); err != nil {
+			return err
+		}
+		if auth.HMAC.Encoding != "" && auth.HMAC.Encoding != encodingHex && auth.HMAC.Encoding != encodingBase64 {
+			return fmt.Errorf("unsupported hmac signature encoding %s", auth.HMAC.Encoding)
+		}
+	}
+	if auth.Basic != nil && (auth.Basic.Username == nil || auth.Basic.Password == nil) {
+		return fmt.Errorf("basic auth requires both username and password")
+	}
+	if auth.ClientCAPath != "" && (context.ServerCertPath == "" || context.ServerKeyPath == "") {
+		return fmt.Errorf("client certificate verification requires serverCertPath and serverKeyPath")
+	}
 	return nil
 }
 
@@ -17,25 +17,37 @@ limitations under the License.
 package webhook
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
+	"github.com/argoproj/argo-events/metrics"
 	v1alpha12 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	"github.com/argoproj/argo-events/tracing"
 )
 
 // NewController returns a webhook controller
 func NewController() *Controller {
-	return &Controller{
+	controller := &Controller{
 		AllRoutes:            make(map[string]*mux.Route),
 		ActiveServerHandlers: make(map[string]*mux.Router),
 		RouteActivateChan:    make(chan Router),
 		RouteDeactivateChan:  make(chan Router),
+		servers:              make(map[string]*portServer),
+		routes:               make(map[string]Router),
 	}
+	Lock.Lock()
+	controllers = append(controllers, controller)
+	Lock.Unlock()
+	return controller
 }
 
 // NewRoute returns a vanilla route
@@ -48,6 +60,8 @@ func NewRoute(hookContext *v1alpha12.WebhookContext, logger *logrus.Logger, even
 		DataCh:      make(chan []byte),
 		StartCh:     make(chan struct{}),
 		StopChan:    make(chan struct{}),
+		limits:      newRouteLimits(hookContext),
+		flushCh:     make(chan chan struct{}),
 	}
 }
 
@@ -60,55 +74,132 @@ func ProcessRouteStatus(ctrl *Controller) {
 			startServer(router, ctrl)
 			// to allow route process incoming requests
 			router.GetRoute().StartCh <- struct{}{}
-
 		case router := <-ctrl.RouteDeactivateChan:
-			router.GetRoute().Active = false
+			removeRoute(router, ctrl)
+		}
+	}
+}
+
+// routeName returns the name of the route, unique per port and endpoint
+func routeName(route *Route) string {
+	return route.Context.Port + route.Context.Endpoint
+}
+
+// ServeHTTP dispatches the request to the route registered for its endpoint
+func (s *portServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	s.lock.RLock()
+	router := s.router
+	s.lock.RUnlock()
+	router.ServeHTTP(writer, request)
+}
+
+// updateRouter replaces the router of the server with one for the routes currently registered on the port.
+// It returns false if no route is registered on the port. The caller must hold the Lock.
+func (c *Controller) updateRouter(port string, server *portServer) bool {
+	handler := mux.NewRouter()
+	count := 0
+	for name, router := range c.routes {
+		if router.GetRoute().Context.Port != port {
+			continue
 		}
+		handler.NewRoute().Name(name).Path(router.GetRoute().Context.Endpoint).HandlerFunc(handleRoute(router))
+		count++
+	}
+	if count == 0 {
+		delete(c.ActiveServerHandlers, port)
+		return false
 	}
+	server.lock.Lock()
+	server.router = handler
+	server.lock.Unlock()
+	c.ActiveServerHandlers[port] = handler
+	return true
 }
 
 // starts a http server
 func startServer(router Router, controller *Controller) {
 	// start a http server only if no other configuration previously started the server on given port
 	Lock.Lock()
+	defer Lock.Unlock()
+
 	route := router.GetRoute()
-	if _, ok := controller.ActiveServerHandlers[route.Context.Port]; !ok {
-		handler := mux.NewRouter()
-		server := &http.Server{
-			Addr:    fmt.Sprintf(":%s", route.Context.Port),
-			Handler: handler,
+	if controller.shuttingDown {
+		route.Logger.WithField(common.LabelEventSource, route.EventSource.Name).Warnln("the gateway server is shutting down, won't register the route")
+		return
+	}
+	controller.routes[routeName(route)] = router
+
+	server, ok := controller.servers[route.Context.Port]
+	if !ok {
+		readTimeout, writeTimeout, idleTimeout := serverTimeouts(route.Context)
+		server = &portServer{}
+		server.server = &http.Server{
+			Addr:              fmt.Sprintf(":%s", route.Context.Port),
+			Handler:           server,
+			ReadHeaderTimeout: readTimeout,
+			ReadTimeout:       readTimeout,
+			WriteTimeout:      writeTimeout,
+			IdleTimeout:       idleTimeout,
+			// the client certificates are verified by the routes which require them, since the routes of a server
+			// may trust different CAs
+			TLSConfig: &tls.Config{
+				ClientAuth: tls.RequestClientCert,
+			},
 		}
-
-		controller.ActiveServerHandlers[route.Context.Port] = handler
+		controller.servers[route.Context.Port] = server
+		controller.updateRouter(route.Context.Port, server)
 
 		// start http server
 		go func() {
 			var err error
 			if route.Context.ServerCertPath == "" || route.Context.ServerKeyPath == "" {
-				err = server.ListenAndServe()
+				err = server.server.ListenAndServe()
 			} else {
-				err = server.ListenAndServeTLS(route.Context.ServerCertPath, route.Context.ServerKeyPath)
+				err = server.server.ListenAndServeTLS(route.Context.ServerCertPath, route.Context.ServerKeyPath)
 			}
-			route.Logger.WithField(common.LabelEventSource, route.EventSource.Name).WithError(err).Error("http server stopped")
-			if err != nil {
-				route.Logger.WithError(err).WithField("port", route.Context.Port).Errorln("failed to listen and serve")
+			if err == http.ErrServerClosed {
+				route.Logger.WithField(common.LabelPort, route.Context.Port).Info("http server is stopped")
+				return
 			}
+			route.Logger.WithError(err).WithField(common.LabelPort, route.Context.Port).Errorln("failed to listen and serve")
 		}()
+		return
 	}
 
-	handler := controller.ActiveServerHandlers[route.Context.Port]
+	controller.updateRouter(route.Context.Port, server)
+}
 
-	routeName := route.Context.Port + route.Context.Endpoint
+// removeRoute unregisters the route from the server of its port. The server is shut down once no route is left on
+// the port, after the requests in flight are processed.
+func removeRoute(router Router, controller *Controller) {
+	Lock.Lock()
+	defer Lock.Unlock()
 
-	r := handler.GetRoute(routeName)
-	if r == nil {
-		r = handler.NewRoute().Name(routeName)
-		r = r.Path(route.Context.Endpoint)
-	}
+	route := router.GetRoute()
+	route.Active = false
 
-	r.HandlerFunc(router.HandleRoute)
+	name := routeName(route)
+	if registered, ok := controller.routes[name]; !ok || registered != router {
+		// the endpoint has been taken over by another route
+		return
+	}
+	delete(controller.routes, name)
 
-	Lock.Unlock()
+	server, ok := controller.servers[route.Context.Port]
+	if !ok || controller.updateRouter(route.Context.Port, server) {
+		return
+	}
+	delete(controller.servers, route.Context.Port)
+
+	log := route.Logger.WithField(common.LabelPort, route.Context.Port)
+	log.Info("no route is left on the port, shutting down the http server...")
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+		defer cancel()
+		if err := server.server.Shutdown(ctx); err != nil {
+			log.WithError(err).Errorln("failed to shut down the http server gracefully")
+		}
+	}()
 }
 
 // activateRoute activates a route to process incoming requests
@@ -129,7 +220,9 @@ func activateRoute(router Router, controller *Controller) {
 			common.LabelEndpoint:    route.Context.Endpoint,
 		})
 
+	Lock.Lock()
 	route.Active = true
+	Lock.Unlock()
 	log.Info("route is activated")
 }
 
@@ -141,15 +234,23 @@ func manageRouteChannels(router Router, eventStream gateways.Eventing_StartEvent
 		select {
 		case data := <-route.DataCh:
 			route.Logger.WithField(common.LabelEventSource, route.EventSource.Name).Info("new event received, dispatching to gateway client")
+			_, span := tracing.StartSpan(context.Background(), "gateway-server.send-event", "", trace.WithSpanKind(trace.SpanKindClient))
+			span.AddAttributes(trace.StringAttribute(common.LabelEventSource, route.EventSource.Name))
 			err := eventStream.Send(&gateways.Event{
-				Name:    route.EventSource.Name,
-				Payload: data,
+				Name:     route.EventSource.Name,
+				Payload:  data,
+				Metadata: tracing.Metadata(span),
 			})
+			tracing.EndSpan(span, err)
+			metrics.GatewayServerEventSent(route.EventSource.Name, err)
 			if err != nil {
 				route.Logger.WithField(common.LabelEventSource, route.EventSource.Name).WithError(err).Error("failed to send event")
 				continue
 			}
 
+		case done := <-route.flushCh:
+			// the events received before are sent
+			close(done)
 		case <-route.StopChan:
 			route.Logger.WithField(common.LabelEventSource, route.EventSource.Name).Infoln("event source is stopped")
 			return
@@ -175,6 +276,15 @@ func ManageRoute(router Router, controller *Controller, eventStream gateways.Eve
 		logger.WithError(err).Error("route is invalid, won't initialize it")
 		return err
 	}
+	if err := controller.validateServerTimeouts(route.Context); err != nil {
+		logger.WithError(err).Error("route is invalid, won't initialize it")
+		return err
+	}
+	if route.Context.Auth != nil && route.Authenticator == nil {
+		err := errors.New("authentication of the webhook is not set up by the gateway")
+		logger.WithError(err).Error("route is invalid, won't initialize it")
+		return err
+	}
 
 	logger.Info("listening to payloads for the route...")
 	go manageRouteChannels(router, eventStream)
@@ -198,6 +308,11 @@ func ManageRoute(router Router, controller *Controller, eventStream gateways.Eve
 	route.Logger.WithField(common.LabelEventSource, route.EventSource.Name).Info("marking route as inactive")
 	controller.RouteDeactivateChan <- router
 
+	logger.Info("draining the requests in flight...")
+	if !route.drain(drainTimeout) {
+		logger.Warnln("timed out draining the requests in flight")
+	}
+
 	logger.Info("running operations post route inactivation...")
 	if err := router.PostInactivate(); err != nil {
 		logger.WithError(err).Error("error occurred while running operations post route inactivation")
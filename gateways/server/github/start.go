@@ -288,6 +288,10 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 	}
 
 	route := webhook.NewRoute(githubEventSource.Webhook, listener.Logger, eventSource)
+	if err := route.SetupAuth(listener.K8sClient, githubEventSource.Namespace); err != nil {
+		listener.Logger.WithError(err).WithField(common.LabelEventSource, eventSource.Name).Error("failed to set up the authentication of the webhook")
+		return err
+	}
 
 	return webhook.ManageRoute(&Router{
 		route:             route,
@@ -17,10 +17,12 @@ limitations under the License.
 package gitlab
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
@@ -86,6 +88,15 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
+	if router.secretToken != "" {
+		token := request.Header.Get(gitlabTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(router.secretToken)) != 1 {
+			logger.Error("request doesn't have the secret token of the hook")
+			common.SendErrorResponse(writer, "invalid token")
+			return
+		}
+	}
+
 	body, err := ioutil.ReadAll(request.Body)
 	if err != nil {
 		logger.WithError(err).Error("failed to parse request body")
@@ -117,15 +128,14 @@ func (router *Router) PostActivate() error {
 	route := router.GetRoute()
 	gitlabEventSource := router.gitlabEventSource
 
-	// In order to set up a hook for the GitLab project,
+	// In order to set up the hooks for the GitLab projects and groups,
 	// 1. Get the API access token for client
 	// 2. Set up GitLab client
 	// 3. Configure Hook with given event type
-	// 4. Create project hook
+	// 4. Create or update the project and group hooks
 
 	logger := route.Logger.WithFields(map[string]interface{}{
 		common.LabelEventSource: route.EventSource.Name,
-		"project-id":            gitlabEventSource.ProjectID,
 	})
 
 	logger.Infoln("retrieving the access token credentials...")
@@ -142,96 +152,127 @@ func (router *Router) PostActivate() error {
 
 	formattedUrl := common.FormattedURL(gitlabEventSource.Webhook.URL, gitlabEventSource.Webhook.Endpoint)
 
-	hooks, _, err := router.gitlabClient.Projects.ListProjectHooks(gitlabEventSource.ProjectID, &gitlab.ListProjectHooksOptions{})
-	if err != nil {
-		return errors.Wrapf(err, "failed to list existing hooks to check for duplicates for project id %s", router.gitlabEventSource.ProjectID)
+	router.projectHooks = make(map[string]*gitlab.ProjectHook)
+	for _, projectID := range gitlabEventSource.GetProjectIDs() {
+		hook, err := router.registerProjectHook(projectID, formattedUrl)
+		if err != nil {
+			return err
+		}
+		router.projectHooks[projectID] = hook
+		logger.WithFields(map[string]interface{}{
+			"project-id": projectID,
+			"hook-id":    hook.ID,
+		}).Info("hook registered for the project")
 	}
 
-	var existingHook *gitlab.ProjectHook
-	isAlreadyExists := false
-
-	for _, hook := range hooks {
-		if hook.URL == formattedUrl {
-			existingHook = hook
-			isAlreadyExists = true
+	router.groupHooks = make(map[string]*gitlab.GroupHook)
+	for _, groupID := range gitlabEventSource.GroupIDs {
+		hook, err := router.registerGroupHook(groupID, formattedUrl)
+		if err != nil {
+			return err
 		}
+		router.groupHooks[groupID] = hook
+		logger.WithFields(map[string]interface{}{
+			"group-id": groupID,
+			"hook-id":  hook.ID,
+		}).Info("hook registered for the group")
 	}
+	return nil
+}
 
-	defaultEventValue := false
-
-	editOpt := &gitlab.EditProjectHookOptions{
-		URL:                      &formattedUrl,
-		ConfidentialNoteEvents:   &defaultEventValue,
-		PushEvents:               &defaultEventValue,
-		IssuesEvents:             &defaultEventValue,
-		ConfidentialIssuesEvents: &defaultEventValue,
-		MergeRequestsEvents:      &defaultEventValue,
-		TagPushEvents:            &defaultEventValue,
-		NoteEvents:               &defaultEventValue,
-		JobEvents:                &defaultEventValue,
-		PipelineEvents:           &defaultEventValue,
-		WikiPageEvents:           &defaultEventValue,
-		EnableSSLVerification:    &router.gitlabEventSource.EnableSSLVerification,
-		Token:                    &c.token,
+// registerProjectHook creates the hook of the project, or updates it if it already exists
+func (router *Router) registerProjectHook(projectID string, url string) (*gitlab.ProjectHook, error) {
+	hooks, _, err := router.gitlabClient.Projects.ListProjectHooks(projectID, &gitlab.ListProjectHooksOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list existing hooks to check for duplicates for project id %s", projectID)
 	}
 
-	addOpt := &gitlab.AddProjectHookOptions{
-		URL:                      &formattedUrl,
-		Token:                    &c.token,
-		EnableSSLVerification:    &router.gitlabEventSource.EnableSSLVerification,
-		ConfidentialNoteEvents:   &defaultEventValue,
-		PushEvents:               &defaultEventValue,
-		IssuesEvents:             &defaultEventValue,
-		ConfidentialIssuesEvents: &defaultEventValue,
-		MergeRequestsEvents:      &defaultEventValue,
-		TagPushEvents:            &defaultEventValue,
-		NoteEvents:               &defaultEventValue,
-		JobEvents:                &defaultEventValue,
-		PipelineEvents:           &defaultEventValue,
-		WikiPageEvents:           &defaultEventValue,
+	for _, hook := range hooks {
+		if hook.URL == url {
+			opt := &gitlab.EditProjectHookOptions{}
+			if err := router.configureHook(opt, url); err != nil {
+				return nil, err
+			}
+			hook, _, err = router.gitlabClient.Projects.EditProjectHook(projectID, hook.ID, opt)
+			if err != nil {
+				return nil, errors.Errorf("failed to update project hook for project id %s. err: %+v", projectID, err)
+			}
+			return hook, nil
+		}
 	}
 
-	var opt interface{}
-
-	opt = addOpt
-	if isAlreadyExists {
-		opt = editOpt
+	opt := &gitlab.AddProjectHookOptions{}
+	if err := router.configureHook(opt, url); err != nil {
+		return nil, err
+	}
+	hook, _, err := router.gitlabClient.Projects.AddProjectHook(projectID, opt)
+	if err != nil {
+		return nil, errors.Errorf("failed to add project hook for project id %s. err: %+v", projectID, err)
 	}
+	return hook, nil
+}
 
-	logger.Infoln("configuring the GitLab events for the hook...")
+// registerGroupHook creates the hook of the group, or updates it if it already exists
+func (router *Router) registerGroupHook(groupID string, url string) (*gitlab.GroupHook, error) {
+	hooks, _, err := router.gitlabClient.Groups.ListGroupHooks(groupID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list existing hooks to check for duplicates for group id %s", groupID)
+	}
 
-	for _, event := range gitlabEventSource.Events {
-		elem := reflect.ValueOf(opt).Elem().FieldByName(event)
-		if ok := elem.IsValid(); !ok {
-			return errors.Errorf("unknown event %s", event)
+	for _, hook := range hooks {
+		if hook.URL == url {
+			opt := &gitlab.EditGroupHookOptions{}
+			if err := router.configureHook(opt, url); err != nil {
+				return nil, err
+			}
+			hook, _, err = router.gitlabClient.Groups.EditGroupHook(groupID, hook.ID, opt)
+			if err != nil {
+				return nil, errors.Errorf("failed to update group hook for group id %s. err: %+v", groupID, err)
+			}
+			return hook, nil
 		}
+	}
 
-		iev := reflect.New(elem.Type().Elem())
-		reflect.Indirect(iev).SetBool(true)
-		elem.Set(iev)
+	opt := &gitlab.AddGroupHookOptions{}
+	if err := router.configureHook(opt, url); err != nil {
+		return nil, err
+	}
+	hook, _, err := router.gitlabClient.Groups.AddGroupHook(groupID, opt)
+	if err != nil {
+		return nil, errors.Errorf("failed to add group hook for group id %s. err: %+v", groupID, err)
 	}
+	return hook, nil
+}
 
-	var newHook *gitlab.ProjectHook
+// configureHook sets the url, the secret token and the events of the hook options.
+// The project and group hook options share the same fields, so they are set by name.
+func (router *Router) configureHook(opt interface{}, url string) error {
+	elem := reflect.ValueOf(opt).Elem()
+	elem.FieldByName("URL").Set(reflect.ValueOf(&url))
+	enableSSLVerification := router.gitlabEventSource.EnableSSLVerification
+	elem.FieldByName("EnableSSLVerification").Set(reflect.ValueOf(&enableSSLVerification))
+	if router.secretToken != "" {
+		secretToken := router.secretToken
+		elem.FieldByName("Token").Set(reflect.ValueOf(&secretToken))
+	}
 
-	if !isAlreadyExists {
-		logger.Infoln("creating project hook...")
-		newHook, _, err = router.gitlabClient.Projects.AddProjectHook(router.gitlabEventSource.ProjectID, opt.(*gitlab.AddProjectHookOptions))
-		if err != nil {
-			return errors.Errorf("failed to add project hook. err: %+v", err)
-		}
-	} else {
-		logger.Infoln("project hook already exists, updating it...")
-		if existingHook == nil {
-			return errors.Errorf("existing hook contents are empty, unable to edit existing webhook")
+	// the events are disabled unless they are listed in the event source
+	boolType := reflect.TypeOf((*bool)(nil))
+	for i := 0; i < elem.NumField(); i++ {
+		field := elem.Type().Field(i)
+		if field.Type == boolType && strings.HasSuffix(field.Name, "Events") {
+			disabled := false
+			elem.Field(i).Set(reflect.ValueOf(&disabled))
 		}
-		newHook, _, err = router.gitlabClient.Projects.EditProjectHook(router.gitlabEventSource.ProjectID, existingHook.ID, opt.(*gitlab.EditProjectHookOptions))
-		if err != nil {
-			return errors.Errorf("failed to add project hook. err: %+v", err)
+	}
+	for _, event := range router.gitlabEventSource.Events {
+		field := elem.FieldByName(event)
+		if !field.IsValid() || field.Type() != boolType || !strings.HasSuffix(event, "Events") {
+			return errors.Errorf("unknown event %s", event)
 		}
+		enabled := true
+		field.Set(reflect.ValueOf(&enabled))
 	}
-
-	router.hook = newHook
-	logger.WithField("hook-id", newHook.ID).Info("hook registered for the project")
 	return nil
 }
 
@@ -243,16 +284,28 @@ func (router *Router) PostInactivate() error {
 	if gitlabEventSource.DeleteHookOnFinish {
 		logger := route.Logger.WithFields(map[string]interface{}{
 			common.LabelEventSource: route.EventSource.Name,
-			"project-id":            gitlabEventSource.ProjectID,
-			"hook-id":               router.hook.ID,
 		})
 
-		logger.Infoln("deleting project hook...")
-		if _, err := router.gitlabClient.Projects.DeleteProjectHook(router.gitlabEventSource.ProjectID, router.hook.ID); err != nil {
-			return errors.Errorf("failed to delete hook. err: %+v", err)
+		for projectID, hook := range router.projectHooks {
+			logger.WithFields(map[string]interface{}{
+				"project-id": projectID,
+				"hook-id":    hook.ID,
+			}).Infoln("deleting project hook...")
+			if _, err := router.gitlabClient.Projects.DeleteProjectHook(projectID, hook.ID); err != nil {
+				return errors.Errorf("failed to delete hook for project id %s. err: %+v", projectID, err)
+			}
+		}
+		for groupID, hook := range router.groupHooks {
+			logger.WithFields(map[string]interface{}{
+				"group-id": groupID,
+				"hook-id":  hook.ID,
+			}).Infoln("deleting group hook...")
+			if _, err := router.gitlabClient.Groups.DeleteGroupHook(groupID, hook.ID); err != nil {
+				return errors.Errorf("failed to delete hook for group id %s. err: %+v", groupID, err)
+			}
 		}
 
-		logger.Infoln("gitlab hook deleted")
+		logger.Infoln("gitlab hooks deleted")
 	}
 	return nil
 }
@@ -271,12 +324,25 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 		return err
 	}
 
-	route := webhook.NewRoute(gitlabEventSource.Webhook, listener.Logger, eventSource)
-
-	return webhook.ManageRoute(&Router{
-		route:             route,
+	router := &Router{
+		route:             webhook.NewRoute(gitlabEventSource.Webhook, listener.Logger, eventSource),
 		k8sClient:         listener.K8sClient,
 		gitlabEventSource: gitlabEventSource,
 		namespace:         listener.Namespace,
-	}, controller, eventStream)
+	}
+	if err := router.route.SetupAuth(listener.K8sClient, listener.Namespace); err != nil {
+		logger.WithError(err).Error("failed to set up the authentication of the webhook")
+		return err
+	}
+
+	if gitlabEventSource.SecretToken != nil {
+		secretToken, err := common.GetSecretValue(listener.K8sClient, listener.Namespace, gitlabEventSource.SecretToken)
+		if err != nil {
+			logger.WithError(err).Error("failed to retrieve the secret token")
+			return err
+		}
+		router.secretToken = secretToken
+	}
+
+	return webhook.ManageRoute(router, controller, eventStream)
 }
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/gateways/server/common/webhook"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestConfigureHook(t *testing.T) {
+	router := &Router{
+		gitlabEventSource: &v1alpha1.GitlabEventSource{
+			Events:                []string{"PushEvents", "MergeRequestsEvents"},
+			EnableSSLVerification: true,
+		},
+		secretToken: "fake-token",
+	}
+
+	opt := &gitlab.AddGroupHookOptions{}
+	assert.Nil(t, router.configureHook(opt, "https://fake.url/push"))
+	assert.Equal(t, "https://fake.url/push", *opt.URL)
+	assert.Equal(t, "fake-token", *opt.Token)
+	assert.True(t, *opt.EnableSSLVerification)
+	assert.True(t, *opt.PushEvents)
+	assert.True(t, *opt.MergeRequestsEvents)
+	assert.False(t, *opt.TagPushEvents)
+	assert.False(t, *opt.PipelineEvents)
+
+	router.gitlabEventSource.Events = []string{"Token"}
+	assert.NotNil(t, router.configureHook(&gitlab.AddProjectHookOptions{}, "https://fake.url/push"))
+	router.gitlabEventSource.Events = []string{"FakeEvents"}
+	assert.NotNil(t, router.configureHook(&gitlab.EditProjectHookOptions{}, "https://fake.url/push"))
+}
+
+func TestHandleRouteVerifiesSecretToken(t *testing.T) {
+	router := &Router{
+		route:             webhook.GetFakeRoute(),
+		gitlabEventSource: &v1alpha1.GitlabEventSource{},
+		secretToken:       "fake-token",
+	}
+	router.route.Active = true
+
+	post := func(token string) *webhook.FakeHttpWriter {
+		writer := &webhook.FakeHttpWriter{}
+		header := http.Header{}
+		if token != "" {
+			header.Set(gitlabTokenHeader, token)
+		}
+		router.HandleRoute(writer, &http.Request{
+			Method: http.MethodPost,
+			Header: header,
+			Body:   ioutil.NopCloser(bytes.NewReader([]byte(`{"object_kind": "push"}`))),
+		})
+		return writer
+	}
+
+	assert.Equal(t, http.StatusBadRequest, post("").HeaderStatus)
+	assert.Equal(t, http.StatusBadRequest, post("another-token").HeaderStatus)
+
+	data := make(chan []byte, 1)
+	go func() {
+		data <- <-router.route.DataCh
+	}()
+	assert.Equal(t, http.StatusOK, post("fake-token").HeaderStatus)
+	assert.Contains(t, string(<-data), "push")
+}
+
+// fakeGitlab serves the hooks api of GitLab, recording the hooks added or edited
+type fakeGitlab struct {
+	lock  sync.Mutex
+	hooks map[string]map[string]interface{}
+}
+
+func (f *fakeGitlab) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	switch request.Method {
+	case http.MethodGet:
+		// an existing hook for the project 2, with the same url
+		if request.URL.Path == "/api/v4/projects/2/hooks" {
+			_, _ = writer.Write([]byte(`[{"id": 20, "url": "http://fake.url/push"}]`))
+			return
+		}
+		_, _ = writer.Write([]byte(`[]`))
+	case http.MethodPost, http.MethodPut:
+		var hook map[string]interface{}
+		_ = json.NewDecoder(request.Body).Decode(&hook)
+		f.hooks[request.Method+" "+request.URL.Path] = hook
+		_, _ = writer.Write([]byte(`{"id": 10, "url": "http://fake.url/push"}`))
+	case http.MethodDelete:
+		f.hooks[request.Method+" "+request.URL.Path] = nil
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestPostActivate(t *testing.T) {
+	api := &fakeGitlab{hooks: make(map[string]map[string]interface{})}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := fake.NewSimpleClientset()
+	_, err := client.CoreV1().Secrets("fake").Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gitlab-access", Namespace: "fake"},
+		Data: map[string][]byte{
+			"token": []byte("fake-access-token"),
+		},
+	})
+	assert.Nil(t, err)
+
+	router := &Router{
+		route:     webhook.GetFakeRoute(),
+		k8sClient: client,
+		namespace: "fake",
+		gitlabEventSource: &v1alpha1.GitlabEventSource{
+			Webhook: &v1alpha1.WebhookContext{
+				Endpoint: "/push",
+				URL:      "http://fake.url",
+			},
+			ProjectID:  "1",
+			ProjectIDs: []string{"1", "2"},
+			GroupIDs:   []string{"3"},
+			Events:     []string{"PushEvents"},
+			AccessToken: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "gitlab-access"},
+				Key:                  "token",
+			},
+			GitlabBaseURL:      server.URL,
+			DeleteHookOnFinish: true,
+		},
+		secretToken: "fake-token",
+	}
+
+	assert.Nil(t, router.PostActivate())
+	assert.Len(t, router.projectHooks, 2)
+	assert.Len(t, router.groupHooks, 1)
+	for _, key := range []string{"POST /api/v4/projects/1/hooks", "PUT /api/v4/projects/2/hooks/20", "POST /api/v4/groups/3/hooks"} {
+		hook, ok := api.hooks[key]
+		assert.True(t, ok, key)
+		// the hooks send the secret token rather than the access token
+		assert.Equal(t, "fake-token", hook["token"], key)
+		assert.Equal(t, true, hook["push_events"], key)
+		assert.Equal(t, false, hook["tag_push_events"], key)
+	}
+
+	assert.Nil(t, router.PostInactivate())
+	for _, key := range []string{"DELETE /api/v4/projects/1/hooks/10", "DELETE /api/v4/projects/2/hooks/10", "DELETE /api/v4/groups/3/hooks/10"} {
+		_, ok := api.hooks[key]
+		assert.True(t, ok, fmt.Sprintf("%s %v", key, api.hooks))
+	}
+}
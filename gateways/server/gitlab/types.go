@@ -24,6 +24,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
+// gitlabTokenHeader is the header holding the secret token of the hook
+const gitlabTokenHeader = "X-Gitlab-Token"
+
 // EventListener implements ConfigExecutor
 type EventListener struct {
 	Logger    *logrus.Logger
@@ -40,10 +43,16 @@ type Router struct {
 	k8sClient kubernetes.Interface
 	// gitlabClient is the client to connect to GitLab
 	gitlabClient *gitlab.Client
-	// hook is gitlab project hook
+	// projectHooks are the gitlab project hooks, by project id
 	// GitLab API docs:
 	// https://docs.gitlab.com/ce/api/projects.html#list-project-hooks
-	hook *gitlab.ProjectHook
+	projectHooks map[string]*gitlab.ProjectHook
+	// groupHooks are the gitlab group hooks, by group id
+	// GitLab API docs:
+	// https://docs.gitlab.com/ee/api/groups.html#hooks
+	groupHooks map[string]*gitlab.GroupHook
+	// secretToken is the secret token of the hooks
+	secretToken string
 	// gitlabEventSource is the event source that contains configuration necessary to consume events from GitLab
 	gitlabEventSource *v1alpha1.GitlabEventSource
 	namespace         string
@@ -60,8 +60,8 @@ func validate(eventSource *v1alpha1.GitlabEventSource) error {
 	if eventSource == nil {
 		return common.ErrNilEventSource
 	}
-	if eventSource.ProjectID == "" {
-		return fmt.Errorf("project id can't be empty")
+	if len(eventSource.GetProjectIDs()) == 0 && len(eventSource.GroupIDs) == 0 {
+		return fmt.Errorf("either project ids or group ids must be specified")
 	}
 	if eventSource.Events == nil {
 		return fmt.Errorf("events can't be empty")
@@ -17,24 +17,45 @@ limitations under the License.
 package kafka
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/Shopify/sarama"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
 	"github.com/argoproj/argo-events/gateways/server"
 	"github.com/argoproj/argo-events/pkg/apis/events"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
-	"github.com/ghodss/yaml"
-	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 )
 
+const (
+	offsetOldest = "oldest"
+	offsetNewest = "newest"
+
+	rebalanceRange      = "range"
+	rebalanceRoundRobin = "roundrobin"
+	rebalanceSticky     = "sticky"
+)
+
+// defaultVersion is the version of the kafka cluster if the event source doesn't set it
+var defaultVersion = sarama.V1_0_0_0
+
 // EventListener implements Eventing kafka event source
 type EventListener struct {
 	// Logger logs stuff
 	Logger *logrus.Logger
+	// K8sClient is the Kubernetes client, used to read the SASL credentials
+	K8sClient kubernetes.Interface
+	// Namespace is the namespace of the gateway
+	Namespace string
 }
 
 func verifyPartitionAvailable(part int32, partitions []int32) bool {
@@ -58,7 +79,7 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 		channels.Stop <- struct{}{}
 	}()
 
-	if err := listener.listenEvents(eventSource, channels); err != nil {
+	if err := listener.listenEvents(eventSource, eventStream, channels); err != nil {
 		listener.Logger.WithField(common.LabelEventSource, eventSource.Name).WithError(err).Errorln("failed to listen to events")
 		return err
 	}
@@ -66,7 +87,117 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 	return nil
 }
 
-func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, channels *server.Channels) error {
+// brokers returns the addresses of the brokers of the event source
+func brokers(eventSource *v1alpha1.KafkaEventSource) []string {
+	var addrs []string
+	if eventSource.URL != "" {
+		addrs = append(addrs, eventSource.URL)
+	}
+	return append(addrs, eventSource.Brokers...)
+}
+
+// newConfig returns the configuration of the kafka client of the event source
+func (listener *EventListener) newConfig(eventSource *v1alpha1.KafkaEventSource) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+
+	config.Version = defaultVersion
+	if eventSource.Version != "" {
+		version, err := sarama.ParseKafkaVersion(eventSource.Version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the kafka version %s", eventSource.Version)
+		}
+		config.Version = version
+	}
+
+	if eventSource.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(eventSource.TLS.CACertPath, eventSource.TLS.ClientCertPath, eventSource.TLS.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the tls configuration")
+		}
+		config.Net.TLS.Config = tlsConfig
+		config.Net.TLS.Enable = true
+	}
+
+	if eventSource.SASL != nil {
+		user, err := common.GetSecretValue(listener.K8sClient, listener.Namespace, eventSource.SASL.User)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the sasl user")
+		}
+		password, err := common.GetSecretValue(listener.K8sClient, listener.Namespace, eventSource.SASL.Password)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the sasl password")
+		}
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = user
+		config.Net.SASL.Password = password
+		switch sarama.SASLMechanism(eventSource.SASL.Mechanism) {
+		case sarama.SASLTypePlaintext, "":
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case sarama.SASLTypeSCRAMSHA256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGenerator: sha256Generator}
+			}
+		case sarama.SASLTypeSCRAMSHA512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGenerator: sha512Generator}
+			}
+		default:
+			return nil, errors.Errorf("unsupported sasl mechanism %s", eventSource.SASL.Mechanism)
+		}
+	}
+
+	switch eventSource.InitialOffset {
+	case offsetOldest:
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	case offsetNewest, "":
+		config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	default:
+		return nil, errors.Errorf("unsupported initial offset %s", eventSource.InitialOffset)
+	}
+
+	if eventSource.ConsumerGroup != nil {
+		switch eventSource.ConsumerGroup.RebalanceStrategy {
+		case rebalanceRange, "":
+			config.Consumer.Group.Rebalance.Strategy = sarama.BalanceStrategyRange
+		case rebalanceRoundRobin:
+			config.Consumer.Group.Rebalance.Strategy = sarama.BalanceStrategyRoundRobin
+		case rebalanceSticky:
+			config.Consumer.Group.Rebalance.Strategy = sarama.BalanceStrategySticky
+		default:
+			return nil, errors.Errorf("unsupported rebalance strategy %s", eventSource.ConsumerGroup.RebalanceStrategy)
+		}
+		config.Consumer.Return.Errors = true
+	}
+
+	return config, nil
+}
+
+// eventData returns the event data of the message
+func eventData(msg *sarama.ConsumerMessage, jsonBody bool) ([]byte, error) {
+	data := &events.KafkaEventData{
+		Topic:     msg.Topic,
+		Partition: int(msg.Partition),
+		Timestamp: msg.Timestamp.String(),
+		Key:       string(msg.Key),
+		Offset:    msg.Offset,
+	}
+	if len(msg.Headers) > 0 {
+		data.Headers = make(map[string]string)
+		for _, header := range msg.Headers {
+			data.Headers[string(header.Key)] = string(header.Value)
+		}
+	}
+	if jsonBody {
+		data.Body = (*json.RawMessage)(&msg.Value)
+	} else {
+		data.Body = msg.Value
+	}
+	return json.Marshal(data)
+}
+
+func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, eventStream gateways.Eventing_StartEventSourceServer, channels *server.Channels) error {
 	logger := listener.Logger.WithField(common.LabelEventSource, eventSource.Name)
 
 	logger.Infoln("parsing the event source...")
@@ -75,32 +206,28 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		return errors.Wrapf(err, "failed to parse event source %s", eventSource.Name)
 	}
 
+	config, err := listener.newConfig(kafkaEventSource)
+	if err != nil {
+		return errors.Wrapf(err, "failed to configure the kafka client for event source %s", eventSource.Name)
+	}
+
+	if kafkaEventSource.ConsumerGroup != nil {
+		return listener.consumeGroup(eventSource, kafkaEventSource, config, eventStream, channels)
+	}
+	return listener.consumePartition(eventSource, kafkaEventSource, config, channels)
+}
+
+// consumePartition consumes the messages of the partition of the event source
+func (listener *EventListener) consumePartition(eventSource *gateways.EventSource, kafkaEventSource *v1alpha1.KafkaEventSource, config *sarama.Config, channels *server.Channels) error {
+	logger := listener.Logger.WithField(common.LabelEventSource, eventSource.Name)
+
 	var consumer sarama.Consumer
 
 	logger.Infoln("connecting to Kafka cluster...")
 	if err := server.Connect(common.GetConnectionBackoff(kafkaEventSource.ConnectionBackoff), func() error {
 		var err error
-		config := sarama.NewConfig()
-
-		if kafkaEventSource.TLS != nil {
-			tlsConfig, err := common.GetTLSConfig(kafkaEventSource.TLS.CACertPath, kafkaEventSource.TLS.ClientCertPath, kafkaEventSource.TLS.ClientKeyPath)
-			if err != nil {
-				return errors.Wrap(err, "failed to get the tls configuration")
-			}
-			config.Net.TLS.Config = tlsConfig
-			config.Net.TLS.Enable = true
-		} else {
-			consumer, err = sarama.NewConsumer([]string{kafkaEventSource.URL}, nil)
-			if err != nil {
-				return err
-			}
-		}
-
-		consumer, err = sarama.NewConsumer([]string{kafkaEventSource.URL}, config)
-		if err != nil {
-			return err
-		}
-		return nil
+		consumer, err = sarama.NewConsumer(brokers(kafkaEventSource), config)
+		return err
 	}); err != nil {
 		return errors.Wrapf(err, "failed to connect to Kafka broker for event source %s", eventSource.Name)
 	}
@@ -122,11 +249,11 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 
 	logger.Infoln("verifying the partition exists within available partitions...")
 	if ok := verifyPartitionAvailable(partition, availablePartitions); !ok {
-		return errors.Wrapf(err, "partition %d is not available. event source %s", partition, eventSource.Name)
+		return errors.Errorf("partition %d is not available. event source %s", partition, eventSource.Name)
 	}
 
 	logger.Infoln("getting partition consumer...")
-	partitionConsumer, err := consumer.ConsumePartition(kafkaEventSource.Topic, partition, sarama.OffsetNewest)
+	partitionConsumer, err := consumer.ConsumePartition(kafkaEventSource.Topic, partition, config.Consumer.Offsets.Initial)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create consumer partition for event source %s", eventSource.Name)
 	}
@@ -136,17 +263,7 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		select {
 		case msg := <-partitionConsumer.Messages():
 			logger.Infoln("dispatching event on the data channel...")
-			eventData := &events.KafkaEventData{
-				Topic:     msg.Topic,
-				Partition: int(msg.Partition),
-				Timestamp: msg.Timestamp.String(),
-			}
-			if kafkaEventSource.JSONBody {
-				eventData.Body = (*json.RawMessage)(&msg.Value)
-			} else {
-				eventData.Body = msg.Value
-			}
-			eventBody, err := json.Marshal(eventData)
+			eventBody, err := eventData(msg, kafkaEventSource.JSONBody)
 			if err != nil {
 				logger.WithError(err).Errorln("failed to marshal the event data, rejecting the event...")
 				continue
@@ -166,3 +283,117 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		}
 	}
 }
+
+// consumeGroup consumes the messages of the topic of the event source as a member of its consumer group
+func (listener *EventListener) consumeGroup(eventSource *gateways.EventSource, kafkaEventSource *v1alpha1.KafkaEventSource, config *sarama.Config, eventStream gateways.Eventing_StartEventSourceServer, channels *server.Channels) error {
+	logger := listener.Logger.WithFields(map[string]interface{}{
+		common.LabelEventSource: eventSource.Name,
+		"consumer-group":        kafkaEventSource.ConsumerGroup.GroupName,
+	})
+
+	var group sarama.ConsumerGroup
+
+	logger.Infoln("connecting to Kafka cluster...")
+	if err := server.Connect(common.GetConnectionBackoff(kafkaEventSource.ConnectionBackoff), func() error {
+		var err error
+		group, err = sarama.NewConsumerGroup(brokers(kafkaEventSource), kafkaEventSource.ConsumerGroup.GroupName, config)
+		return err
+	}); err != nil {
+		return errors.Wrapf(err, "failed to connect to Kafka broker for event source %s", eventSource.Name)
+	}
+	defer func() {
+		if err := group.Close(); err != nil {
+			logger.WithError(err).Errorln("failed to close the consumer group")
+		}
+	}()
+
+	handler := &consumerGroupHandler{
+		name:        eventSource.Name,
+		eventStream: eventStream,
+		jsonBody:    kafkaEventSource.JSONBody,
+		logger:      logger,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumeErrCh := make(chan error, 1)
+	go func() {
+		for {
+			// the session ends on rebalance, the group must be joined again
+			if err := group.Consume(ctx, []string{kafkaEventSource.Topic}, handler); err != nil {
+				consumeErrCh <- err
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+
+	logger.Info("listening to messages of the consumer group...")
+	for {
+		select {
+		case err := <-group.Errors():
+			logger.WithError(err).Errorln("failed to consume messages")
+
+		case err := <-consumeErrCh:
+			return errors.Wrapf(err, "failed to consume messages for event source %s", eventSource.Name)
+
+		case <-channels.Done:
+			logger.Infoln("event source is stopped, leaving the consumer group")
+			return nil
+		}
+	}
+}
+
+// consumerGroupHandler dispatches the messages claimed by the consumer group, and commits their offsets once they are
+// sent to the gateway client
+type consumerGroupHandler struct {
+	name        string
+	eventStream gateways.Eventing_StartEventSourceServer
+	jsonBody    bool
+	logger      *logrus.Entry
+	// lock synchronizes the event stream, as the claims are consumed concurrently
+	lock sync.Mutex
+}
+
+// Setup is run at the beginning of a new session
+func (handler *consumerGroupHandler) Setup(session sarama.ConsumerGroupSession) error {
+	var partitions []string
+	for topic, claimed := range session.Claims() {
+		for _, partition := range claimed {
+			partitions = append(partitions, topic+"/"+strconv.Itoa(int(partition)))
+		}
+	}
+	handler.logger.WithField("partitions", strings.Join(partitions, ",")).Infoln("joined the consumer group")
+	return nil
+}
+
+// Cleanup is run at the end of a session
+func (handler *consumerGroupHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim dispatches the messages of the claim. The offset of a message is marked only once it is sent, so that
+// the messages which failed to be sent are consumed again when the partition is claimed next.
+func (handler *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		eventBody, err := eventData(msg, handler.jsonBody)
+		if err != nil {
+			handler.logger.WithError(err).Errorln("failed to marshal the event data, rejecting the event...")
+			session.MarkMessage(msg, "")
+			continue
+		}
+
+		handler.logger.WithField("partition-id", msg.Partition).Infoln("dispatching the event to the gateway client...")
+		handler.lock.Lock()
+		err = server.SendEvent(handler.name, handler.eventStream, eventBody)
+		handler.lock.Unlock()
+		if err != nil {
+			return errors.Wrapf(err, "failed to send the event of partition %d offset %d", msg.Partition, msg.Offset)
+		}
+		session.MarkMessage(msg, "")
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/gateways"
+	servercommon "github.com/argoproj/argo-events/gateways/server/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestNewConfig(t *testing.T) {
+	listener := &EventListener{
+		Logger: common.NewArgoEventsLogger(),
+		K8sClient: fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "kafka-sasl", Namespace: "fake"},
+			Data: map[string][]byte{
+				"user":     []byte("fake-user"),
+				"password": []byte("fake-password"),
+			},
+		}),
+		Namespace: "fake",
+	}
+
+	config, err := listener.newConfig(&v1alpha1.KafkaEventSource{})
+	assert.Nil(t, err)
+	assert.Equal(t, defaultVersion, config.Version)
+	assert.Equal(t, sarama.OffsetNewest, config.Consumer.Offsets.Initial)
+	assert.False(t, config.Net.SASL.Enable)
+
+	config, err = listener.newConfig(&v1alpha1.KafkaEventSource{
+		Version:       "2.4.0",
+		InitialOffset: "oldest",
+		ConsumerGroup: &v1alpha1.KafkaConsumerGroup{
+			GroupName:         "fake-group",
+			RebalanceStrategy: "sticky",
+		},
+		SASL: &v1alpha1.SASLConfig{
+			Mechanism: "SCRAM-SHA-512",
+			User: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-sasl"},
+				Key:                  "user",
+			},
+			Password: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-sasl"},
+				Key:                  "password",
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, config.Validate())
+	assert.Equal(t, sarama.V2_4_0_0, config.Version)
+	assert.Equal(t, sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+	assert.Equal(t, sarama.BalanceStrategySticky, config.Consumer.Group.Rebalance.Strategy)
+	assert.True(t, config.Net.SASL.Enable)
+	assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), config.Net.SASL.Mechanism)
+	assert.Equal(t, "fake-user", config.Net.SASL.User)
+	assert.Equal(t, "fake-password", config.Net.SASL.Password)
+	assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc())
+
+	_, err = listener.newConfig(&v1alpha1.KafkaEventSource{Version: "fake"})
+	assert.NotNil(t, err)
+}
+
+func TestEventData(t *testing.T) {
+	msg := &sarama.ConsumerMessage{
+		Topic:     "fake-topic",
+		Partition: 2,
+		Offset:    10,
+		Key:       []byte("fake-key"),
+		Value:     []byte(`{"hello": "world"}`),
+		Timestamp: time.Now(),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("fake-header"), Value: []byte("fake-value")},
+		},
+	}
+	body, err := eventData(msg, true)
+	assert.Nil(t, err)
+
+	var data events.KafkaEventData
+	assert.Nil(t, json.Unmarshal(body, &data))
+	assert.Equal(t, "fake-topic", data.Topic)
+	assert.Equal(t, 2, data.Partition)
+	assert.Equal(t, int64(10), data.Offset)
+	assert.Equal(t, "fake-key", data.Key)
+	assert.Equal(t, map[string]string{"fake-header": "fake-value"}, data.Headers)
+	assert.Equal(t, map[string]interface{}{"hello": "world"}, data.Body)
+}
+
+// fakeSession records the messages marked by the consumer group handler
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	marked []int64
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+// failingStream fails to send the events
+type failingStream struct {
+	servercommon.FakeGRPCStream
+}
+
+func (f *failingStream) Send(event *gateways.Event) error {
+	return errors.New("fake error")
+}
+
+func TestConsumeClaim(t *testing.T) {
+	claim := func() *fakeClaim {
+		messages := make(chan *sarama.ConsumerMessage, 2)
+		messages <- &sarama.ConsumerMessage{Topic: "fake-topic", Offset: 1, Value: []byte("hello")}
+		messages <- &sarama.ConsumerMessage{Topic: "fake-topic", Offset: 2, Value: []byte("world")}
+		close(messages)
+		return &fakeClaim{messages: messages}
+	}
+
+	stream := &servercommon.FakeGRPCStream{Ctx: context.Background()}
+	handler := &consumerGroupHandler{
+		name:        "fake",
+		eventStream: stream,
+		logger:      common.NewArgoEventsLogger().WithField(common.LabelEventSource, "fake"),
+	}
+	session := &fakeSession{}
+	assert.Nil(t, handler.ConsumeClaim(session, claim()))
+	assert.Equal(t, []int64{1, 2}, session.marked)
+	assert.Contains(t, string(stream.SentData.Payload), `"offset":2`)
+
+	// the offsets of the messages which are not sent are not committed
+	handler.eventStream = &failingStream{}
+	session = &fakeSession{}
+	assert.NotNil(t, handler.ConsumeClaim(session, claim()))
+	assert.Empty(t, session.marked)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Shopify/sarama"
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
@@ -62,15 +63,49 @@ func validate(eventSource *v1alpha1.KafkaEventSource) error {
 	if eventSource == nil {
 		return common.ErrNilEventSource
 	}
-	if eventSource.URL == "" {
-		return fmt.Errorf("url must be specified")
+	if eventSource.URL == "" && len(eventSource.Brokers) == 0 {
+		return fmt.Errorf("either url or brokers must be specified")
 	}
 	if eventSource.Topic == "" {
 		return fmt.Errorf("topic must be specified")
 	}
-	if eventSource.Partition == "" {
+	if eventSource.ConsumerGroup == nil && eventSource.Partition == "" {
 		return fmt.Errorf("partition must be specified")
 	}
+	if eventSource.ConsumerGroup != nil {
+		if eventSource.ConsumerGroup.GroupName == "" {
+			return fmt.Errorf("consumer group name must be specified")
+		}
+		switch eventSource.ConsumerGroup.RebalanceStrategy {
+		case "", rebalanceRange, rebalanceRoundRobin, rebalanceSticky:
+		default:
+			return fmt.Errorf("rebalance strategy must be one of %s, %s or %s", rebalanceRange, rebalanceRoundRobin, rebalanceSticky)
+		}
+	}
+	switch eventSource.InitialOffset {
+	case "", offsetOldest, offsetNewest:
+	default:
+		return fmt.Errorf("initial offset must be either %s or %s", offsetOldest, offsetNewest)
+	}
+	if eventSource.Version != "" {
+		version, err := sarama.ParseKafkaVersion(eventSource.Version)
+		if err != nil {
+			return err
+		}
+		if eventSource.ConsumerGroup != nil && !version.IsAtLeast(sarama.V0_10_2_0) {
+			return fmt.Errorf("consumer groups require kafka version %s or later", sarama.V0_10_2_0)
+		}
+	}
+	if eventSource.SASL != nil {
+		if eventSource.SASL.User == nil || eventSource.SASL.Password == nil {
+			return fmt.Errorf("sasl user and password must be specified")
+		}
+		switch sarama.SASLMechanism(eventSource.SASL.Mechanism) {
+		case "", sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512:
+		default:
+			return fmt.Errorf("sasl mechanism must be one of %s, %s or %s", sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512)
+		}
+	}
 	if eventSource.TLS != nil {
 		return v1alpha1.ValidateTLSConfig(eventSource.TLS)
 	}
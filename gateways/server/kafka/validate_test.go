@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestValidateEventSource(t *testing.T) {
@@ -63,3 +64,35 @@ func TestValidateEventSource(t *testing.T) {
 		assert.Equal(t, true, valid.IsValid)
 	}
 }
+
+func TestValidate(t *testing.T) {
+	eventSource := &v1alpha1.KafkaEventSource{
+		Brokers: []string{"kafka:9092"},
+		Topic:   "fake-topic",
+	}
+	assert.NotNil(t, validate(eventSource))
+
+	eventSource.ConsumerGroup = &v1alpha1.KafkaConsumerGroup{}
+	assert.NotNil(t, validate(eventSource))
+	eventSource.ConsumerGroup.GroupName = "fake-group"
+	assert.Nil(t, validate(eventSource))
+	eventSource.ConsumerGroup.RebalanceStrategy = "fake"
+	assert.NotNil(t, validate(eventSource))
+	eventSource.ConsumerGroup.RebalanceStrategy = "roundrobin"
+
+	eventSource.InitialOffset = "latest"
+	assert.NotNil(t, validate(eventSource))
+	eventSource.InitialOffset = "oldest"
+
+	eventSource.Version = "0.10.0.0"
+	assert.NotNil(t, validate(eventSource))
+	eventSource.Version = "2.4.0"
+
+	eventSource.SASL = &v1alpha1.SASLConfig{Mechanism: "GSSAPI"}
+	assert.NotNil(t, validate(eventSource))
+	eventSource.SASL.Mechanism = "PLAIN"
+	assert.NotNil(t, validate(eventSource))
+	eventSource.SASL.User = &corev1.SecretKeySelector{Key: "user"}
+	eventSource.SASL.Password = &corev1.SecretKeySelector{Key: "password"}
+	assert.Nil(t, validate(eventSource))
+}
@@ -19,6 +19,8 @@ package resource
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"gomodules.xyz/jsonpatch/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
@@ -40,6 +43,7 @@ import (
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/jsonpath"
 )
 
 // InformerEvent holds event generated from resource state change
@@ -123,10 +127,18 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		*op = *options
 	}
 
-	logger.Infoln("setting up informer factory...")
-	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, resourceEventSource.Namespace, tweakListOptions)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
 
-	informer := factory.ForResource(gvr)
+	namespaces := watchedNamespaces(resourceEventSource)
+	var namespaceStore cache.Store
+	if len(resourceEventSource.NamespaceSelector) > 0 {
+		logger.Infoln("watching the namespaces matching the namespace selector...")
+		namespaceStore, err = listener.watchNamespaces(client, resourceEventSource.NamespaceSelector, doneCh)
+		if err != nil {
+			return errors.Wrapf(err, "failed to watch the namespaces for the event source %s", eventSource.Name)
+		}
+	}
 
 	informerEventCh := make(chan *InformerEvent)
 	stopCh := make(chan struct{})
@@ -137,27 +149,17 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		for {
 			select {
 			case event := <-informerEventCh:
-				objBody, err := json.Marshal(event.Obj)
-				if err != nil {
-					logger.WithError(err).Errorln("failed to marshal the resource, rejecting the event...")
+				if namespaceStore != nil && !inNamespaces(event, namespaceStore) {
 					continue
 				}
-
-				eventData := &events.ResourceEventData{
-					EventType: string(event.Type),
-					Body:      (*json.RawMessage)(&objBody),
-					Group:     resourceEventSource.Group,
-					Version:   resourceEventSource.Version,
-					Resource:  resourceEventSource.Resource,
+				if !passFilters(event, resourceEventSource.Filter, startTime, logger) {
+					continue
 				}
-				eventBody, err := json.Marshal(eventData)
+				eventBody, err := eventData(event, resourceEventSource)
 				if err != nil {
 					logger.WithError(err).Errorln("failed to marshal the event. rejecting the event...")
 					continue
 				}
-				if !passFilters(event, resourceEventSource.Filter, startTime, logger) {
-					continue
-				}
 				channels.Data <- eventBody
 			case <-stopCh:
 				return
@@ -165,6 +167,14 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		}
 	}()
 
+	// the informers stop sending the events once the event source is stopped
+	send := func(event *InformerEvent) {
+		select {
+		case informerEventCh <- event:
+		case <-doneCh:
+		}
+	}
+
 	handlerFuncs := cache.ResourceEventHandlerFuncs{}
 
 	for _, eventType := range resourceEventSource.EventTypes {
@@ -172,27 +182,30 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		case v1alpha1.ADD:
 			handlerFuncs.AddFunc = func(obj interface{}) {
 				logger.Infoln("detected create event")
-				informerEventCh <- &InformerEvent{
+				send(&InformerEvent{
 					Obj:  obj,
 					Type: v1alpha1.ADD,
-				}
+				})
 			}
 		case v1alpha1.UPDATE:
 			handlerFuncs.UpdateFunc = func(oldObj, newObj interface{}) {
 				logger.Infoln("detected update event")
-				informerEventCh <- &InformerEvent{
+				send(&InformerEvent{
 					Obj:    newObj,
 					OldObj: oldObj,
 					Type:   v1alpha1.UPDATE,
-				}
+				})
 			}
 		case v1alpha1.DELETE:
 			handlerFuncs.DeleteFunc = func(obj interface{}) {
 				logger.Infoln("detected delete event")
-				informerEventCh <- &InformerEvent{
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				send(&InformerEvent{
 					Obj:  obj,
 					Type: v1alpha1.DELETE,
-				}
+				})
 			}
 		default:
 			stopCh <- struct{}{}
@@ -200,24 +213,108 @@ func (listener *EventListener) listenEvents(eventSource *gateways.EventSource, c
 		}
 	}
 
-	sharedInformer := informer.Informer()
-	sharedInformer.AddEventHandler(handlerFuncs)
-
-	doneCh := make(chan struct{})
-
-	logger.Infoln("running informer...")
-	sharedInformer.Run(doneCh)
+	for _, namespace := range namespaces {
+		logger.WithField("namespace", namespace).Infoln("running informer...")
+		factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, namespace, tweakListOptions)
+		sharedInformer := factory.ForResource(gvr).Informer()
+		sharedInformer.AddEventHandler(handlerFuncs)
+		go sharedInformer.Run(doneCh)
+	}
 
 	<-channels.Done
-	doneCh <- struct{}{}
 	stopCh <- struct{}{}
 
 	logger.Infoln("event source is stopped")
-	close(informerEventCh)
 
 	return nil
 }
 
+// watchedNamespaces returns the namespaces the resources are watched in, the empty namespace watches all of them
+func watchedNamespaces(eventSource *v1alpha1.ResourceEventSource) []string {
+	if len(eventSource.NamespaceSelector) > 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	var namespaces []string
+	seen := make(map[string]bool)
+	for _, namespace := range append([]string{eventSource.Namespace}, eventSource.Namespaces...) {
+		if namespace == "" || seen[namespace] {
+			continue
+		}
+		seen[namespace] = true
+		namespaces = append(namespaces, namespace)
+	}
+	if len(namespaces) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	return namespaces
+}
+
+// watchNamespaces watches the namespaces matching the selectors, and returns their store once it is synced
+func (listener *EventListener) watchNamespaces(client dynamic.Interface, selectors []v1alpha1.Selector, doneCh chan struct{}) (cache.Store, error) {
+	sel, err := LabelSelector(selectors)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the namespace selector")
+	}
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, metav1.NamespaceAll, func(op *metav1.ListOptions) {
+		op.LabelSelector = sel.String()
+	})
+	informer := factory.ForResource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).Informer()
+	go informer.Run(doneCh)
+	if !cache.WaitForCacheSync(doneCh, informer.HasSynced) {
+		return nil, errors.New("failed to sync the namespaces")
+	}
+	return informer.GetStore(), nil
+}
+
+// inNamespaces checks the resource of the event is in one of the namespaces of the store
+func inNamespaces(event *InformerEvent, store cache.Store) bool {
+	uObj, ok := event.Obj.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	_, exists, err := store.GetByKey(uObj.GetNamespace())
+	return err == nil && exists
+}
+
+// eventData returns the event data of the informer event. The UPDATE events hold the old resource along with the
+// JSON patch from the old to the new one.
+func eventData(event *InformerEvent, eventSource *v1alpha1.ResourceEventSource) ([]byte, error) {
+	objBody, err := json.Marshal(event.Obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the resource")
+	}
+
+	data := &events.ResourceEventData{
+		EventType: string(event.Type),
+		Body:      (*json.RawMessage)(&objBody),
+		Group:     eventSource.Group,
+		Version:   eventSource.Version,
+		Resource:  eventSource.Resource,
+	}
+
+	if event.Type == v1alpha1.UPDATE && event.OldObj != nil {
+		oldBody, err := json.Marshal(event.OldObj)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal the old resource")
+		}
+		patch, err := jsonpatch.CreatePatch(oldBody, objBody)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compute the diff of the resource")
+		}
+		if patch == nil {
+			patch = []jsonpatch.Operation{}
+		}
+		diff, err := json.Marshal(patch)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal the diff of the resource")
+		}
+		data.OldBody = (*json.RawMessage)(&oldBody)
+		data.Diff = (*json.RawMessage)(&diff)
+	}
+
+	return json.Marshal(data)
+}
+
 // LabelReq returns label requirements
 func LabelReq(sel v1alpha1.Selector) (*labels.Requirement, error) {
 	op := selection.Equals
@@ -281,5 +378,114 @@ func passFilters(event *InformerEvent, filter *v1alpha1.ResourceFilter, startTim
 		log.Infof("resource is created before service start time. creation-timestamp: %s, start-timestamp: %s\n", created.UTC().String(), startTime.UTC().String())
 		return false
 	}
+	for _, jsonPathFilter := range filter.JSONPaths {
+		ok, err := matchJSONPath(uObj.Object, jsonPathFilter)
+		if err != nil {
+			log.WithError(err).Errorf("failed to apply the jsonpath filter %s\n", jsonPathFilter.Path)
+			return false
+		}
+		if !ok {
+			log.Infof("resource does not match the jsonpath filter. path: %s\n", jsonPathFilter.Path)
+			return false
+		}
+	}
+	if event.Type == v1alpha1.UPDATE && len(filter.ChangedFields) > 0 {
+		oldObj, ok := event.OldObj.(*unstructured.Unstructured)
+		if !ok {
+			return false
+		}
+		changed, err := fieldsChanged(oldObj.Object, uObj.Object, filter.ChangedFields)
+		if err != nil {
+			log.WithError(err).Errorln("failed to compare the fields of the resource")
+			return false
+		}
+		if !changed {
+			log.Infof("none of the fields is changed. fields: %s\n", strings.Join(filter.ChangedFields, ", "))
+			return false
+		}
+	}
 	return true
 }
+
+// parseJSONPath parses the JSONPath, the braces of the template are optional
+func parseJSONPath(path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	parser := jsonpath.New("filter").AllowMissingKeys(true)
+	if err := parser.Parse(path); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the jsonpath %s", path)
+	}
+	return parser, nil
+}
+
+// jsonPathValues returns the values at the JSONPath of the object. The values which are not strings are JSON encoded.
+func jsonPathValues(obj map[string]interface{}, path string) ([]string, error) {
+	parser, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	results, err := parser.FindResults(obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find the values of the jsonpath %s", path)
+	}
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			if !value.IsValid() || !value.CanInterface() {
+				continue
+			}
+			if str, ok := value.Interface().(string); ok {
+				values = append(values, str)
+				continue
+			}
+			encoded, err := json.Marshal(value.Interface())
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, string(encoded))
+		}
+	}
+	return values, nil
+}
+
+// matchJSONPath checks one of the values at the path of the filter matches one of the values of the filter
+func matchJSONPath(obj map[string]interface{}, filter v1alpha1.ResourceJSONPathFilter) (bool, error) {
+	values, err := jsonPathValues(obj, filter.Path)
+	if err != nil {
+		return false, err
+	}
+	if len(filter.Values) == 0 {
+		return len(values) > 0, nil
+	}
+	for _, pattern := range filter.Values {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to compile the regular expression %s", pattern)
+		}
+		for _, value := range values {
+			if re.MatchString(value) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// fieldsChanged checks the values at one of the paths differ between the old and the new object
+func fieldsChanged(oldObj, newObj map[string]interface{}, paths []string) (bool, error) {
+	for _, path := range paths {
+		oldValues, err := jsonPathValues(oldObj, path)
+		if err != nil {
+			return false, err
+		}
+		newValues, err := jsonPathValues(newObj, path)
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(oldValues, newValues) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
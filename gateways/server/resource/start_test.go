@@ -17,6 +17,7 @@ limitations under the License.
 package resource
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -24,10 +25,14 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/mitchellh/mapstructure"
 	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-events/pkg/apis/events"
 )
 
 func TestFilter(t *testing.T) {
@@ -78,3 +83,108 @@ func TestFilter(t *testing.T) {
 		convey.So(pass, convey.ShouldBeTrue)
 	})
 }
+
+func newPod(phase string, restarts int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "fake",
+			"namespace": "fake",
+		},
+		"status": map[string]interface{}{
+			"phase": phase,
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "main", "restartCount": restarts},
+			},
+		},
+	}}
+}
+
+func TestJSONPathFilter(t *testing.T) {
+	logger := common.NewArgoEventsLogger().WithField(common.LabelEventSource, "fake")
+	event := &InformerEvent{Obj: newPod("Running", 1), Type: v1alpha1.ADD}
+
+	filter := &v1alpha1.ResourceFilter{
+		JSONPaths: []v1alpha1.ResourceJSONPathFilter{
+			{Path: ".status.phase", Values: []string{"Running", "Succeeded"}},
+			{Path: `{.status.containerStatuses[?(@.name=="main")].restartCount}`, Values: []string{"[1-9][0-9]*"}},
+		},
+	}
+	assert.True(t, passFilters(event, filter, time.Now(), logger))
+
+	// the whole value must match
+	filter.JSONPaths[0].Values = []string{"Run"}
+	assert.False(t, passFilters(event, filter, time.Now(), logger))
+
+	// the path must exist if no value is specified
+	filter.JSONPaths = []v1alpha1.ResourceJSONPathFilter{{Path: ".status.phase"}}
+	assert.True(t, passFilters(event, filter, time.Now(), logger))
+	filter.JSONPaths = []v1alpha1.ResourceJSONPathFilter{{Path: ".status.reason"}}
+	assert.False(t, passFilters(event, filter, time.Now(), logger))
+}
+
+func TestChangedFields(t *testing.T) {
+	logger := common.NewArgoEventsLogger().WithField(common.LabelEventSource, "fake")
+	filter := &v1alpha1.ResourceFilter{
+		ChangedFields: []string{".status.phase"},
+	}
+
+	event := &InformerEvent{Obj: newPod("Running", 1), OldObj: newPod("Running", 0), Type: v1alpha1.UPDATE}
+	assert.False(t, passFilters(event, filter, time.Now(), logger))
+
+	event.OldObj = newPod("Pending", 0)
+	assert.True(t, passFilters(event, filter, time.Now(), logger))
+
+	// the other events are not filtered
+	assert.True(t, passFilters(&InformerEvent{Obj: newPod("Running", 1), Type: v1alpha1.ADD}, filter, time.Now(), logger))
+}
+
+func TestEventData(t *testing.T) {
+	eventSource := &v1alpha1.ResourceEventSource{
+		GroupVersionResource: metav1.GroupVersionResource{Version: "v1", Resource: "pods"},
+	}
+
+	body, err := eventData(&InformerEvent{Obj: newPod("Running", 0), Type: v1alpha1.ADD}, eventSource)
+	assert.Nil(t, err)
+	var data events.ResourceEventData
+	assert.Nil(t, json.Unmarshal(body, &data))
+	assert.Equal(t, "ADD", data.EventType)
+	assert.Nil(t, data.OldBody)
+	assert.Nil(t, data.Diff)
+
+	body, err = eventData(&InformerEvent{Obj: newPod("Running", 0), OldObj: newPod("Pending", 0), Type: v1alpha1.UPDATE}, eventSource)
+	assert.Nil(t, err)
+	data = events.ResourceEventData{}
+	assert.Nil(t, json.Unmarshal(body, &data))
+	assert.NotNil(t, data.OldBody)
+	assert.Contains(t, string(*data.OldBody), "Pending")
+	var diff []map[string]interface{}
+	assert.Nil(t, json.Unmarshal(*data.Diff, &diff))
+	assert.Equal(t, []map[string]interface{}{
+		{"op": "replace", "path": "/status/phase", "value": "Running"},
+	}, diff)
+}
+
+func TestWatchedNamespaces(t *testing.T) {
+	assert.Equal(t, []string{""}, watchedNamespaces(&v1alpha1.ResourceEventSource{}))
+	assert.Equal(t, []string{"foo", "bar"}, watchedNamespaces(&v1alpha1.ResourceEventSource{
+		Namespace:  "foo",
+		Namespaces: []string{"bar", "foo"},
+	}))
+	assert.Equal(t, []string{""}, watchedNamespaces(&v1alpha1.ResourceEventSource{
+		NamespaceSelector: []v1alpha1.Selector{{Key: "team", Value: "fake"}},
+	}))
+}
+
+func TestInNamespaces(t *testing.T) {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	assert.Nil(t, store.Add(&unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fake"},
+	}}))
+
+	pod := newPod("Running", 0)
+	assert.True(t, inNamespaces(&InformerEvent{Obj: pod}, store))
+	pod.SetNamespace("other")
+	assert.False(t, inNamespaces(&InformerEvent{Obj: pod}, store))
+}
@@ -19,6 +19,7 @@ package resource
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
@@ -83,6 +84,32 @@ func validate(eventSource *v1alpha1.ResourceEventSource) error {
 				return err
 			}
 		}
+		for _, filter := range eventSource.Filter.JSONPaths {
+			if filter.Path == "" {
+				return fmt.Errorf("path can't be empty for jsonpath filter")
+			}
+			if _, err := parseJSONPath(filter.Path); err != nil {
+				return err
+			}
+			for _, value := range filter.Values {
+				if _, err := regexp.Compile(value); err != nil {
+					return fmt.Errorf("invalid regular expression %s for jsonpath filter %s", value, filter.Path)
+				}
+			}
+		}
+		for _, field := range eventSource.Filter.ChangedFields {
+			if _, err := parseJSONPath(field); err != nil {
+				return err
+			}
+		}
+	}
+	if len(eventSource.NamespaceSelector) > 0 {
+		if eventSource.Namespace != "" || len(eventSource.Namespaces) > 0 {
+			return fmt.Errorf("namespace selector can't be combined with namespaces")
+		}
+		if err := validateSelectors(eventSource.NamespaceSelector); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestValidateEventSource(t *testing.T) {
@@ -65,3 +66,29 @@ func TestValidateEventSource(t *testing.T) {
 		assert.Equal(t, true, valid.IsValid)
 	}
 }
+
+func TestValidate(t *testing.T) {
+	eventSource := &v1alpha1.ResourceEventSource{
+		GroupVersionResource: metav1.GroupVersionResource{Version: "v1", Resource: "pods"},
+		EventTypes:           []v1alpha1.ResourceEventType{v1alpha1.UPDATE},
+		Filter: &v1alpha1.ResourceFilter{
+			JSONPaths: []v1alpha1.ResourceJSONPathFilter{
+				{Path: ".status.phase", Values: []string{"Running"}},
+			},
+			ChangedFields: []string{".status.phase"},
+		},
+	}
+	assert.Nil(t, validate(eventSource))
+
+	eventSource.Filter.JSONPaths[0].Values = []string{"(Running"}
+	assert.NotNil(t, validate(eventSource))
+	eventSource.Filter.JSONPaths[0].Values = nil
+	eventSource.Filter.JSONPaths[0].Path = ".status[phase"
+	assert.NotNil(t, validate(eventSource))
+	eventSource.Filter.JSONPaths = nil
+
+	eventSource.NamespaceSelector = []v1alpha1.Selector{{Key: "team", Value: "fake"}}
+	assert.Nil(t, validate(eventSource))
+	eventSource.Namespaces = []string{"fake"}
+	assert.NotNil(t, validate(eventSource))
+}
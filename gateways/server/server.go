@@ -17,16 +17,28 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
+	"github.com/argoproj/argo-events/gateways/server/common/webhook"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/tracing"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
 )
 
+// shutdownTimeout is the maximum duration the gateway server drains the requests and events in flight on termination
+const shutdownTimeout = 30 * time.Second
+
 // Channels holds the necessary channels for gateway server to process.
 type Channels struct {
 	Data chan []byte
@@ -53,9 +65,30 @@ func StartGateway(es gateways.EventingServer) {
 	if err != nil {
 		panic(err)
 	}
+	if err := tracing.Init("gateway-server"); err != nil {
+		panic(err)
+	}
+
 	srv := grpc.NewServer()
 	gateways.RegisterEventingServer(srv, es)
 
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle(common.MetricsPath, metrics.Handler())
+		mux.HandleFunc(common.ReadinessPath, webhook.ReadinessHandler)
+		if err := http.ListenAndServe(fmt.Sprintf(":%s", metrics.Port(common.GatewayServerMetricsPort)), mux); err != nil {
+			fmt.Printf("failed to serve the metrics. err: %+v\n", err)
+		}
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	go func() {
+		sig := <-signals
+		fmt.Printf("received %s, shutting down the gateway server\n", sig)
+		shutdown(srv)
+	}()
+
 	fmt.Println("starting gateway server")
 
 	if err := srv.Serve(lis); err != nil {
@@ -63,6 +96,29 @@ func StartGateway(es gateways.EventingServer) {
 	}
 }
 
+// shutdown stops the webhook servers from accepting new requests, drains the requests and events in flight,
+// then stops the grpc server, forcibly if the event streams are not closed by the gateway client in time
+func shutdown(srv *grpc.Server) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := webhook.Shutdown(ctx); err != nil {
+		fmt.Printf("failed to drain the webhook servers. err: %+v\n", err)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		fmt.Println("timed out waiting for the event streams to close, stopping the gateway server")
+		srv.Stop()
+	}
+}
+
 // Recover recovers from panics in event sources
 func Recover(eventSource string) {
 	if r := recover(); r != nil {
@@ -76,11 +132,7 @@ func HandleEventsFromEventSource(name string, eventStream gateways.Eventing_Star
 		select {
 		case data := <-channels.Data:
 			logger.WithField(common.LabelEventSource, name).Info("new event received, dispatching to gateway client")
-			err := eventStream.Send(&gateways.Event{
-				Name:    name,
-				Payload: data,
-			})
-			if err != nil {
+			if err := SendEvent(name, eventStream, data); err != nil {
 				logger.WithField(common.LabelEventSource, name).WithError(err).Errorln("failed to send the event data to the gateway client")
 			}
 
@@ -94,3 +146,17 @@ func HandleEventsFromEventSource(name string, eventStream gateways.Eventing_Star
 		}
 	}
 }
+
+// SendEvent sends the event data of the event source to the gateway client
+func SendEvent(name string, eventStream gateways.Eventing_StartEventSourceServer, data []byte) error {
+	_, span := tracing.StartSpan(context.Background(), "gateway-server.send-event", "", trace.WithSpanKind(trace.SpanKindClient))
+	span.AddAttributes(trace.StringAttribute(common.LabelEventSource, name))
+	err := eventStream.Send(&gateways.Event{
+		Name:     name,
+		Payload:  data,
+		Metadata: tracing.Metadata(span),
+	})
+	tracing.EndSpan(span, err)
+	metrics.GatewayServerEventSent(name, err)
+	return err
+}
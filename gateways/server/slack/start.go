@@ -266,6 +266,10 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 	}
 
 	route := webhook.NewRoute(slackEventSource.Webhook, listener.Logger, eventSource)
+	if err := route.SetupAuth(listener.K8sClient, slackEventSource.Namespace); err != nil {
+		logger.WithError(err).Error("failed to set up the authentication of the webhook")
+		return err
+	}
 
 	return webhook.ManageRoute(&Router{
 		route:            route,
@@ -314,6 +314,10 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 	}
 
 	route := webhook.NewRoute(storagegridEventSource.Webhook, listener.Logger, eventSource)
+	if err := route.SetupAuth(listener.K8sClient, listener.Namespace); err != nil {
+		log.WithError(err).Error("failed to set up the authentication of the webhook")
+		return err
+	}
 
 	return webhook.ManageRoute(&Router{
 		route:                  route,
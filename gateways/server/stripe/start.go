@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
@@ -27,11 +28,20 @@ import (
 	"github.com/argoproj/argo-events/gateways/server/common/webhook"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stripe/stripe-go"
+	stripewebhook "github.com/stripe/stripe-go/webhook"
 	"github.com/stripe/stripe-go/webhookendpoint"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
+// stripeSignatureHeader is the header holding the signature of the request
+const stripeSignatureHeader = "Stripe-Signature"
+
 // controller controls the webhook operations
 var (
 	controller = webhook.NewController()
@@ -81,6 +91,12 @@ func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request)
 		return
 	}
 
+	if err := rc.verifyPayload(payload, request.Header.Get(stripeSignatureHeader)); err != nil {
+		logger.WithError(err).Errorln("failed to verify the request signature")
+		common.SendErrorResponse(writer, "invalid signature")
+		return
+	}
+
 	var event *stripe.Event
 	if err := json.Unmarshal(payload, &event); err != nil {
 		logger.WithError(err).Errorln("failed to parse request body")
@@ -141,10 +157,67 @@ func (rc *Router) PostActivate() error {
 		}
 
 		logger.WithField("endpoint-id", endpoint.ID).Infoln("new stripe webhook endpoint created")
+
+		// the signing secret of the endpoint is only returned when it's created
+		rc.setSigningSecret(endpoint.Secret)
+		if stripeEventSource.WebhookSecret != nil {
+			if err := storeSigningSecret(rc.k8sClient, stripeEventSource.Namespace, stripeEventSource.WebhookSecret, endpoint.Secret); err != nil {
+				return err
+			}
+			logger.WithField("secret-name", stripeEventSource.WebhookSecret.Name).Infoln("stored the signing secret of the webhook endpoint")
+		}
 	}
 	return nil
 }
 
+// verifyPayload checks the signature of the payload against the signing secret of the webhook endpoint,
+// and rejects the signatures older than the tolerance
+func (rc *Router) verifyPayload(payload []byte, signature string) error {
+	rc.lock.RLock()
+	signingSecret := rc.signingSecret
+	rc.lock.RUnlock()
+	if signingSecret == "" {
+		return errors.New("signing secret of the webhook endpoint is not available")
+	}
+	tolerance := stripewebhook.DefaultTolerance
+	if rc.stripeEventSource.Tolerance > 0 {
+		tolerance = time.Duration(rc.stripeEventSource.Tolerance) * time.Second
+	}
+	return stripewebhook.ValidatePayloadWithTolerance(payload, signature, signingSecret, tolerance)
+}
+
+func (rc *Router) setSigningSecret(signingSecret string) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	rc.signingSecret = signingSecret
+}
+
+// storeSigningSecret stores the signing secret in the K8s secret, which is created if it doesn't exist
+func storeSigningSecret(client kubernetes.Interface, namespace string, selector *corev1.SecretKeySelector, signingSecret string) error {
+	secret, err := client.CoreV1().Secrets(namespace).Get(selector.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get the secret %s", selector.Name)
+		}
+		_, err = client.CoreV1().Secrets(namespace).Create(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      selector.Name,
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				selector.Key: []byte(signingSecret),
+			},
+		})
+		return errors.Wrapf(err, "failed to create the secret %s", selector.Name)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[selector.Key] = []byte(signingSecret)
+	_, err = client.CoreV1().Secrets(namespace).Update(secret)
+	return errors.Wrapf(err, "failed to update the secret %s", selector.Name)
+}
+
 // PostInactivate performs operations after the route is inactivated
 func (rc *Router) PostInactivate() error {
 	return nil
@@ -182,11 +255,25 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 		stripeEventSource.Namespace = listener.Namespace
 	}
 
-	route := webhook.NewRoute(stripeEventSource.Webhook, listener.Logger, eventSource)
-
-	return webhook.ManageRoute(&Router{
-		route:             route,
+	router := &Router{
+		route:             webhook.NewRoute(stripeEventSource.Webhook, listener.Logger, eventSource),
 		k8sClient:         listener.K8sClient,
 		stripeEventSource: stripeEventSource,
-	}, controller, eventStream)
+	}
+	if err := router.route.SetupAuth(listener.K8sClient, stripeEventSource.Namespace); err != nil {
+		logger.WithError(err).Errorln("failed to set up the authentication of the webhook")
+		return err
+	}
+
+	// if the webhook is created by the gateway, the signing secret is set once it's created
+	if !stripeEventSource.CreateWebhook {
+		signingSecret, err := common.GetSecretValue(listener.K8sClient, stripeEventSource.Namespace, stripeEventSource.WebhookSecret)
+		if err != nil {
+			logger.WithError(err).Errorln("failed to retrieve the signing secret of the webhook")
+			return err
+		}
+		router.setSigningSecret(signingSecret)
+	}
+
+	return webhook.ManageRoute(router, controller, eventStream)
 }
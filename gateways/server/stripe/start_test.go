@@ -0,0 +1,135 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stripe
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	stripewebhook "github.com/stripe/stripe-go/webhook"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/gateways/server/common/webhook"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const fakeSigningSecret = "whsec_fake"
+
+func signPayload(payload []byte, timestamp time.Time, secret string) string {
+	signature := stripewebhook.ComputeSignature(timestamp, payload, secret)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp.Unix(), hex.EncodeToString(signature))
+}
+
+func TestHandleRouteVerifiesSignature(t *testing.T) {
+	router := &Router{
+		route:             webhook.GetFakeRoute(),
+		stripeEventSource: &v1alpha1.StripeEventSource{},
+	}
+	router.route.Active = true
+	router.setSigningSecret(fakeSigningSecret)
+
+	payload := []byte(`{"id": "evt_fake", "type": "charge.succeeded", "data": {"object": {"id": "ch_fake"}}}`)
+	post := func(payload []byte, signature string) *webhook.FakeHttpWriter {
+		writer := &webhook.FakeHttpWriter{}
+		header := http.Header{}
+		if signature != "" {
+			header.Set(stripeSignatureHeader, signature)
+		}
+		router.HandleRoute(writer, &http.Request{
+			Method: http.MethodPost,
+			Header: header,
+			Body:   ioutil.NopCloser(bytes.NewReader(payload)),
+		})
+		return writer
+	}
+
+	// unsigned payload
+	writer := post(payload, "")
+	assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+
+	// signed with another secret
+	writer = post(payload, signPayload(payload, time.Now(), "whsec_another"))
+	assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+
+	// tampered payload
+	writer = post([]byte(`{"id": "evt_fake", "type": "charge.refunded"}`), signPayload(payload, time.Now(), fakeSigningSecret))
+	assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+
+	// replayed payload, older than the tolerance
+	writer = post(payload, signPayload(payload, time.Now().Add(-10*time.Minute), fakeSigningSecret))
+	assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+
+	// the tolerance is configurable
+	router.stripeEventSource.Tolerance = 3600
+	data := make(chan []byte, 1)
+	go func() {
+		data <- <-router.route.DataCh
+	}()
+	writer = post(payload, signPayload(payload, time.Now().Add(-10*time.Minute), fakeSigningSecret))
+	assert.Equal(t, http.StatusOK, writer.HeaderStatus)
+	assert.Contains(t, string(<-data), "evt_fake")
+}
+
+func TestVerifyPayloadWithoutSigningSecret(t *testing.T) {
+	router := &Router{
+		stripeEventSource: &v1alpha1.StripeEventSource{},
+	}
+	payload := []byte(`{"id": "evt_fake"}`)
+	assert.NotNil(t, router.verifyPayload(payload, signPayload(payload, time.Now(), "")))
+}
+
+func TestStoreSigningSecret(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	selector := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: "stripe-webhook",
+		},
+		Key: "secret",
+	}
+
+	// the secret is created
+	err := storeSigningSecret(client, "fake", selector, fakeSigningSecret)
+	assert.Nil(t, err)
+	value, err := common.GetSecretValue(client, "fake", selector)
+	assert.Nil(t, err)
+	assert.Equal(t, fakeSigningSecret, value)
+
+	// the key is updated, leaving the other keys as they are
+	_, err = client.CoreV1().Secrets("fake").Update(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "stripe-webhook", Namespace: "fake"},
+		Data: map[string][]byte{
+			"secret": []byte(fakeSigningSecret),
+			"other":  []byte("value"),
+		},
+	})
+	assert.Nil(t, err)
+	err = storeSigningSecret(client, "fake", selector, "whsec_new")
+	assert.Nil(t, err)
+	secret, err := client.CoreV1().Secrets("fake").Get("stripe-webhook", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "whsec_new", string(secret.Data["secret"]))
+	assert.Equal(t, "value", string(secret.Data["other"]))
+}
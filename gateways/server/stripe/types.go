@@ -17,6 +17,8 @@ limitations under the License.
 package stripe
 
 import (
+	"sync"
+
 	"github.com/argoproj/argo-events/gateways/server/common/webhook"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/sirupsen/logrus"
@@ -40,4 +42,8 @@ type Router struct {
 	stripeEventSource *v1alpha1.StripeEventSource
 	// k8sClient is the Kubernetes client
 	k8sClient kubernetes.Interface
+	// lock protects signingSecret
+	lock sync.RWMutex
+	// signingSecret is the signing secret of the webhook endpoint
+	signingSecret string
 }
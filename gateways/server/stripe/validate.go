@@ -70,6 +70,11 @@ func validate(eventSource *v1alpha1.StripeEventSource) error {
 		if eventSource.Namespace == "" {
 			return errors.New("namespace to retrieve the api key K8s secret not provided")
 		}
+	} else if eventSource.WebhookSecret == nil {
+		return errors.New("webhook secret K8s secret selector not provided, it's required to verify the requests unless the webhook is created by the gateway")
+	}
+	if eventSource.Tolerance < 0 {
+		return errors.New("tolerance can't be negative")
 	}
 	return webhook.ValidateWebhookContext(eventSource.Webhook)
 }
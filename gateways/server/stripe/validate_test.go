@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestValidateEventSource(t *testing.T) {
@@ -65,3 +66,26 @@ func TestValidateEventSource(t *testing.T) {
 		assert.Equal(t, true, valid.IsValid)
 	}
 }
+
+func TestValidateWebhookSecret(t *testing.T) {
+	eventSource := &v1alpha1.StripeEventSource{
+		Webhook: &v1alpha1.WebhookContext{
+			Endpoint: "/example",
+			Method:   "POST",
+			Port:     "12000",
+			URL:      "http://myfakeurl.fake",
+		},
+	}
+	err := validate(eventSource)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "webhook secret")
+
+	eventSource.WebhookSecret = &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "stripe-webhook-secret"},
+		Key:                  "signing-secret",
+	}
+	assert.Nil(t, validate(eventSource))
+
+	eventSource.Tolerance = -1
+	assert.NotNil(t, validate(eventSource))
+}
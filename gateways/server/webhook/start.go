@@ -23,6 +23,7 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/gateways"
@@ -35,6 +36,10 @@ import (
 type EventListener struct {
 	// Logger logs stuff
 	Logger *logrus.Logger
+	// K8sClient is the Kubernetes client, used to read the secrets of the webhook authentication
+	K8sClient kubernetes.Interface
+	// Namespace is the namespace of the gateway
+	Namespace string
 }
 
 // Router contains the configuration information for a route
@@ -132,6 +137,10 @@ func (listener *EventListener) StartEventSource(eventSource *gateways.EventSourc
 	}
 
 	route := webhook.NewRoute(webhookEventSource, listener.Logger, eventSource)
+	if err := route.SetupAuth(listener.K8sClient, listener.Namespace); err != nil {
+		log.WithError(err).Error("failed to set up the authentication of the webhook")
+		return err
+	}
 
 	return webhook.ManageRoute(&Router{
 		route: route,
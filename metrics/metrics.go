@@ -0,0 +1,247 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+const (
+	namespace = "argo_events"
+
+	// OutcomeSuccess is the outcome label value of a successful operation
+	OutcomeSuccess = "success"
+	// OutcomeFailure is the outcome label value of a failed operation
+	OutcomeFailure = "failure"
+)
+
+// labelName converts a log label to a valid Prometheus label name, e.g. "event-source" to "event_source"
+func labelName(label string) string {
+	return strings.Replace(label, "-", "_", -1)
+}
+
+// label names, derived from the log labels so that the metrics can be correlated with the logs.
+// As in the logs, the event source label is the name of the event within its event source, in all the components.
+var (
+	labelGatewayName = labelName(common.LabelGatewayName)
+	labelEventSource = labelName(common.LabelEventSource)
+	labelSensorName  = labelName(common.LabelSensorName)
+	labelTriggerName = labelName("trigger-name")
+	labelTriggerType = labelName("trigger-type")
+	labelSubscriber  = "subscriber"
+	labelDependency  = "dependency"
+	labelOutcome     = "outcome"
+	labelController  = "controller"
+	labelEndpoint    = labelName(common.LabelEndpoint)
+	labelReason      = "reason"
+)
+
+var (
+	gatewayEventsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gateway",
+		Name:      "events_received_total",
+		Help:      "Number of events received by the gateway client from the gateway server.",
+	}, []string{labelGatewayName, labelEventSource})
+
+	gatewayEventsDispatched = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gateway",
+		Name:      "events_dispatched_total",
+		Help:      "Number of events dispatched by the gateway client to the eventbus or a subscriber, by outcome.",
+	}, []string{labelGatewayName, labelEventSource, labelSubscriber, labelOutcome})
+
+	gatewayEventsDeadLettered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gateway",
+		Name:      "events_dead_lettered_total",
+		Help:      "Number of events written to a dead-letter target after failing to be dispatched to a subscriber.",
+	}, []string{labelGatewayName, labelEventSource, labelSubscriber})
+
+	gatewayServerEventsSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gateway_server",
+		Name:      "events_sent_total",
+		Help:      "Number of events sent by the gateway server to the gateway client, by outcome.",
+	}, []string{labelEventSource, labelOutcome})
+
+	gatewayServerRequestsRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "gateway_server",
+		Name:      "requests_rejected_total",
+		Help:      "Number of requests rejected by the webhook server of the gateway, by reason.",
+	}, []string{labelEventSource, labelEndpoint, labelReason})
+
+	sensorEventsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sensor",
+		Name:      "events_received_total",
+		Help:      "Number of events received by the sensor that match one of its dependencies.",
+	}, []string{labelSensorName, labelEventSource, labelDependency})
+
+	sensorDependencyResolutions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sensor",
+		Name:      "dependency_resolutions_total",
+		Help:      "Number of times the dependencies of the sensor were resolved and the triggers were evaluated.",
+	}, []string{labelSensorName})
+
+	sensorFilterRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sensor",
+		Name:      "filter_rejections_total",
+		Help:      "Number of events rejected by the filters of a dependency.",
+	}, []string{labelSensorName, labelEventSource, labelDependency})
+
+	sensorTriggerExecutions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sensor",
+		Name:      "trigger_executions_total",
+		Help:      "Number of trigger executions, by trigger type and outcome.",
+	}, []string{labelSensorName, labelTriggerName, labelTriggerType, labelOutcome})
+
+	sensorTriggerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "sensor",
+		Name:      "trigger_duration_seconds",
+		Help:      "Time taken to execute a trigger, from fetching the resource to applying the policy.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{labelSensorName, labelTriggerName, labelTriggerType})
+
+	controllerReconciliations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "controller",
+		Name:      "reconciliations_total",
+		Help:      "Number of reconciliations of a resource by the controller, by outcome.",
+	}, []string{labelController, labelOutcome})
+
+	controllerReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "controller",
+		Name:      "reconcile_duration_seconds",
+		Help:      "Time taken to reconcile a resource by the controller.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{labelController})
+)
+
+func init() {
+	prometheus.MustRegister(
+		gatewayEventsReceived,
+		gatewayEventsDispatched,
+		gatewayEventsDeadLettered,
+		gatewayServerEventsSent,
+		gatewayServerRequestsRejected,
+		sensorEventsReceived,
+		sensorDependencyResolutions,
+		sensorFilterRejections,
+		sensorTriggerExecutions,
+		sensorTriggerDuration,
+		controllerReconciliations,
+		controllerReconcileDuration,
+	)
+}
+
+// outcome returns the outcome label value for an error
+func outcome(err error) string {
+	if err != nil {
+		return OutcomeFailure
+	}
+	return OutcomeSuccess
+}
+
+// GatewayEventReceived records an event received by the gateway client
+func GatewayEventReceived(gatewayName, eventSource string) {
+	gatewayEventsReceived.WithLabelValues(gatewayName, eventSource).Inc()
+}
+
+// GatewayEventDispatched records the outcome of dispatching an event to the eventbus or a subscriber
+func GatewayEventDispatched(gatewayName, eventSource, subscriber string, err error) {
+	gatewayEventsDispatched.WithLabelValues(gatewayName, eventSource, subscriber, outcome(err)).Inc()
+}
+
+// GatewayEventDeadLettered records an event written to the dead-letter target of a subscriber
+func GatewayEventDeadLettered(gatewayName, eventSource, subscriber string) {
+	gatewayEventsDeadLettered.WithLabelValues(gatewayName, eventSource, subscriber).Inc()
+}
+
+// GatewayServerEventSent records the outcome of sending an event from the gateway server to the gateway client
+func GatewayServerEventSent(eventSource string, err error) {
+	gatewayServerEventsSent.WithLabelValues(eventSource, outcome(err)).Inc()
+}
+
+// GatewayServerRequestRejected records a request rejected by the webhook server of the gateway
+func GatewayServerRequestRejected(eventSource, endpoint, reason string) {
+	gatewayServerRequestsRejected.WithLabelValues(eventSource, endpoint, reason).Inc()
+}
+
+// SensorEventReceived records an event received by the sensor for a dependency
+func SensorEventReceived(sensorName, eventSource, dependency string) {
+	sensorEventsReceived.WithLabelValues(sensorName, eventSource, dependency).Inc()
+}
+
+// SensorDependenciesResolved records a resolution of the sensor dependencies
+func SensorDependenciesResolved(sensorName string) {
+	sensorDependencyResolutions.WithLabelValues(sensorName).Inc()
+}
+
+// SensorFilterRejected records an event rejected by the filters of a dependency
+func SensorFilterRejected(sensorName, eventSource, dependency string) {
+	sensorFilterRejections.WithLabelValues(sensorName, eventSource, dependency).Inc()
+}
+
+// SensorTriggerExecuted records the outcome and the duration of a trigger execution
+func SensorTriggerExecuted(sensorName, triggerName, triggerType string, start time.Time, err error) {
+	sensorTriggerExecutions.WithLabelValues(sensorName, triggerName, triggerType, outcome(err)).Inc()
+	sensorTriggerDuration.WithLabelValues(sensorName, triggerName, triggerType).Observe(time.Since(start).Seconds())
+}
+
+// ControllerReconciled records the outcome and the duration of a reconciliation
+func ControllerReconciled(controller string, start time.Time, err error) {
+	controllerReconciliations.WithLabelValues(controller, outcome(err)).Inc()
+	controllerReconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+}
+
+// Port returns the port of the metrics endpoint, which can be overridden by an env var
+func Port(defaultPort string) string {
+	if port, ok := os.LookupEnv(common.EnvVarMetricsPort); ok && port != "" {
+		return port
+	}
+	return defaultPort
+}
+
+// Serve serves the metrics registered with the default registry, and any additional gatherers, on the metrics path.
+// It blocks until the server fails.
+func Serve(port string, gatherers ...prometheus.Gatherer) error {
+	mux := http.NewServeMux()
+	mux.Handle(common.MetricsPath, Handler(gatherers...))
+	return http.ListenAndServe(fmt.Sprintf(":%s", port), mux)
+}
+
+// Handler returns the handler of the metrics endpoint, serving the default registry along with the given gatherers
+func Handler(gatherers ...prometheus.Gatherer) http.Handler {
+	gatherer := prometheus.Gatherers(append([]prometheus.Gatherer{prometheus.DefaultGatherer}, gatherers...))
+	return promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+func TestLabelName(t *testing.T) {
+	assert.Equal(t, "event_source", labelEventSource)
+	assert.Equal(t, "sensor_name", labelSensorName)
+	assert.Equal(t, "trigger_name", labelTriggerName)
+}
+
+func TestPort(t *testing.T) {
+	assert.Equal(t, common.DefaultMetricsPort, Port(common.DefaultMetricsPort))
+	_ = os.Setenv(common.EnvVarMetricsPort, "8888")
+	defer os.Unsetenv(common.EnvVarMetricsPort)
+	assert.Equal(t, "8888", Port(common.DefaultMetricsPort))
+}
+
+func TestGatewayEventDispatched(t *testing.T) {
+	GatewayEventDispatched("test-gateway", "test-event-source", "eventbus", nil)
+	GatewayEventDispatched("test-gateway", "test-event-source", "eventbus", errors.New("failed"))
+	GatewayEventDispatched("test-gateway", "test-event-source", "eventbus", errors.New("failed"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(gatewayEventsDispatched.WithLabelValues("test-gateway", "test-event-source", "eventbus", OutcomeSuccess)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(gatewayEventsDispatched.WithLabelValues("test-gateway", "test-event-source", "eventbus", OutcomeFailure)))
+}
+
+func TestSensorTriggerExecuted(t *testing.T) {
+	SensorTriggerExecuted("test-sensor", "test-trigger", "k8s", time.Now(), nil)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(sensorTriggerExecutions.WithLabelValues("test-sensor", "test-trigger", "k8s", OutcomeSuccess)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(sensorTriggerExecutions.WithLabelValues("test-sensor", "test-trigger", "k8s", OutcomeFailure)))
+}
+
+func TestGatewayServerRequestRejected(t *testing.T) {
+	GatewayServerRequestRejected("test-event-source", "/test", "rate_limited")
+	GatewayServerRequestRejected("test-event-source", "/test", "rate_limited")
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(gatewayServerRequestsRejected.WithLabelValues("test-event-source", "/test", "rate_limited")))
+}
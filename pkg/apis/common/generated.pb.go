@@ -287,57 +287,57 @@ func init() {
 var fileDescriptor_02aae6165a434fa7 = []byte{
 	// 831 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0xb1, 0xbd, 0xb1, 0x27, 0x0d, 0xa0, 0xb9, 0xb2, 0x22, 0x75, 0x6d, 0x16, 0x81,
-	0x5c, 0x68, 0x67, 0x95, 0xb4, 0x12, 0x1f, 0x12, 0x42, 0x59, 0x68, 0x25, 0x48, 0x41, 0x68, 0x5c,
-	0x71, 0x51, 0x84, 0xaa, 0xc9, 0xe6, 0x78, 0xbb, 0x75, 0x76, 0x67, 0x35, 0x33, 0x6b, 0xd5, 0x77,
-	0x3c, 0x02, 0xd7, 0xbc, 0x02, 0x2f, 0x92, 0xcb, 0xde, 0xd1, 0x2b, 0x8b, 0x98, 0xb7, 0xe8, 0x15,
-	0x9a, 0x8f, 0xdd, 0x0d, 0x21, 0x12, 0x32, 0xdc, 0x8d, 0xff, 0xe7, 0xcc, 0xef, 0x9c, 0xfd, 0x9f,
-	0x33, 0x46, 0x5f, 0xa4, 0x99, 0x7a, 0x5e, 0x9d, 0x92, 0x84, 0xe7, 0x11, 0x13, 0x29, 0x2f, 0x05,
-	0x7f, 0x61, 0x0e, 0xf7, 0x60, 0x09, 0x85, 0x92, 0x51, 0xb9, 0x48, 0x23, 0x56, 0x66, 0x32, 0x4a,
-	0x78, 0x9e, 0xf3, 0x22, 0x4a, 0xa1, 0x00, 0xc1, 0x14, 0x9c, 0x91, 0x52, 0x70, 0xc5, 0x71, 0xd4,
-	0x02, 0x48, 0x0d, 0x30, 0x87, 0x67, 0x16, 0x40, 0xca, 0x45, 0x4a, 0x34, 0x80, 0x58, 0xc0, 0xc1,
-	0xbd, 0x2b, 0x15, 0x53, 0x9e, 0xf2, 0xc8, 0x70, 0x4e, 0xab, 0xb9, 0xf9, 0x65, 0x7e, 0x98, 0x93,
-	0xe5, 0x1f, 0x84, 0x8b, 0x4f, 0x24, 0xc9, 0xb8, 0xee, 0x21, 0x4a, 0xb8, 0x80, 0x68, 0x79, 0x78,
-	0xbd, 0x87, 0x83, 0x07, 0x6d, 0x4e, 0xce, 0x92, 0xe7, 0x59, 0x01, 0x62, 0xd5, 0x36, 0x9e, 0x83,
-	0x62, 0x37, 0xdc, 0x0a, 0xef, 0x20, 0xff, 0x38, 0xe7, 0x55, 0xa1, 0xf0, 0x18, 0xf5, 0x97, 0xec,
-	0xbc, 0x82, 0x91, 0x37, 0xf1, 0xa6, 0xb7, 0xe2, 0xe1, 0x66, 0x3d, 0xee, 0xff, 0xa0, 0x05, 0x6a,
-	0xf5, 0xf0, 0xb7, 0x1d, 0xb4, 0x1b, 0xb3, 0x64, 0xc1, 0xe7, 0x73, 0xfc, 0x39, 0x1a, 0x9c, 0x55,
-	0x82, 0xa9, 0x8c, 0x17, 0x26, 0xbf, 0x1b, 0xbf, 0x7b, 0xb1, 0x1e, 0x77, 0x36, 0xeb, 0xf1, 0xe0,
-	0x2b, 0xa7, 0xbf, 0x59, 0x8f, 0xf7, 0x55, 0x96, 0x03, 0xa9, 0x05, 0xda, 0x5c, 0xc1, 0xcf, 0x90,
-	0x3f, 0x67, 0x89, 0xe2, 0x62, 0xb4, 0x33, 0xf1, 0xa6, 0x7b, 0x47, 0x1f, 0x93, 0x2d, 0x0d, 0x24,
-	0xb6, 0xe9, 0xf8, 0x2d, 0x57, 0xd5, 0x7f, 0x64, 0x70, 0xd4, 0x61, 0xf1, 0x8f, 0xc8, 0x7f, 0x91,
-	0x29, 0x05, 0x62, 0xd4, 0xfd, 0x7f, 0x05, 0x90, 0x86, 0x7f, 0x63, 0x50, 0xd4, 0x21, 0xf1, 0x7b,
-	0xa8, 0x2f, 0x15, 0x94, 0x72, 0xd4, 0x9b, 0x78, 0xd3, 0x7e, 0xbc, 0xef, 0x7a, 0xe8, 0xcf, 0xb4,
-	0x48, 0x6d, 0x2c, 0xfc, 0x7d, 0x07, 0x0d, 0xbf, 0xe4, 0xc5, 0x59, 0x66, 0x3e, 0xf8, 0x10, 0xf5,
-	0xd4, 0xaa, 0xb4, 0xde, 0x0e, 0xe3, 0xdb, 0xee, 0x46, 0xef, 0xc9, 0xaa, 0x04, 0xed, 0x53, 0x93,
-	0xa8, 0x05, 0x6a, 0x52, 0xf1, 0x63, 0xe4, 0x4b, 0xc5, 0x54, 0x25, 0x8d, 0x47, 0xc3, 0xf8, 0x41,
-	0xfd, 0xa9, 0x33, 0xa3, 0xbe, 0x59, 0x8f, 0x6f, 0xd8, 0x0a, 0xd2, 0x90, 0x6c, 0x16, 0x75, 0x0c,
-	0xbc, 0x44, 0xf8, 0x9c, 0x49, 0xf5, 0x44, 0xb0, 0x42, 0xda, 0x4a, 0x59, 0x0e, 0xce, 0x9c, 0x0f,
-	0x89, 0x05, 0x91, 0xab, 0xab, 0xd3, 0x1a, 0xa2, 0x57, 0x87, 0x2c, 0x0f, 0x89, 0xbe, 0x11, 0x1f,
-	0xb8, 0x2e, 0xf0, 0xe3, 0x7f, 0xd0, 0xe8, 0x0d, 0x15, 0xf0, 0x07, 0xc8, 0x17, 0xc0, 0x24, 0x2f,
-	0x8c, 0x59, 0xc3, 0x76, 0x60, 0xd4, 0xa8, 0xd4, 0x45, 0xf1, 0x1d, 0xb4, 0x9b, 0x83, 0x94, 0x2c,
-	0x85, 0x51, 0xdf, 0x24, 0xbe, 0xed, 0x12, 0x77, 0xbf, 0xb5, 0x32, 0xad, 0xe3, 0xe1, 0x47, 0x68,
-	0x40, 0x41, 0xf2, 0x4a, 0x24, 0xf0, 0xef, 0x4b, 0xfb, 0x6b, 0x0f, 0xa1, 0xd9, 0xfd, 0x63, 0xa1,
-	0x32, 0xbd, 0x19, 0xf8, 0x2e, 0x1a, 0x40, 0x71, 0x56, 0xf2, 0xac, 0x50, 0x6e, 0x16, 0xef, 0xd4,
-	0x7b, 0xfb, 0xd0, 0xe9, 0xb4, 0xc9, 0xc0, 0x3f, 0x21, 0xff, 0xb4, 0x4a, 0x16, 0xa0, 0xdc, 0x9a,
-	0x7e, 0xba, 0xf5, 0x16, 0xcd, 0xee, 0xc7, 0x06, 0x60, 0xf7, 0xc8, 0x9e, 0xa9, 0x83, 0x5a, 0x6f,
-	0x52, 0xfd, 0x84, 0xba, 0xd7, 0xbd, 0xd1, 0x2a, 0x75, 0x51, 0xdd, 0x74, 0x56, 0x48, 0x48, 0x2a,
-	0x01, 0xc6, 0xc5, 0x41, 0xdb, 0xf4, 0xd7, 0x4e, 0xa7, 0x4d, 0x06, 0xa6, 0x68, 0xc8, 0x92, 0x04,
-	0xa4, 0x3c, 0x81, 0x95, 0xf1, 0x72, 0xef, 0xe8, 0xfd, 0x2b, 0x03, 0x26, 0x7a, 0x53, 0xf4, 0x38,
-	0x67, 0x90, 0x08, 0x50, 0x27, 0xb0, 0x9a, 0xc1, 0x39, 0xe8, 0x47, 0x13, 0xef, 0x6f, 0xd6, 0xe3,
-	0xe1, 0x71, 0x7d, 0x97, 0xb6, 0x18, 0xcd, 0x94, 0x75, 0xfa, 0xc8, 0xdf, 0x9a, 0xd9, 0xc8, 0xb4,
-	0xc5, 0xe0, 0x10, 0xf9, 0xd6, 0xb4, 0xd1, 0xee, 0xa4, 0x3b, 0x1d, 0x5a, 0x87, 0x1e, 0x1a, 0x85,
-	0xba, 0x88, 0x1e, 0xc0, 0x3c, 0x3b, 0xd7, 0xcf, 0x78, 0xf0, 0x9f, 0x07, 0xf0, 0xc8, 0x00, 0x2c,
-	0xde, 0x9e, 0xa9, 0x83, 0x86, 0x27, 0x68, 0x50, 0x0f, 0x08, 0xdf, 0x46, 0xdd, 0x05, 0xac, 0xdc,
-	0x52, 0xec, 0x39, 0x7f, 0xbb, 0xba, 0x67, 0xad, 0xe3, 0x09, 0xea, 0x15, 0x2c, 0x07, 0xf7, 0x16,
-	0x6f, 0xd5, 0x0f, 0xf8, 0x3b, 0x96, 0x03, 0x35, 0x91, 0xf0, 0xa9, 0x86, 0xd9, 0x02, 0x7a, 0xb2,
-	0xa5, 0x80, 0x79, 0xf6, 0xd2, 0xf1, 0x9a, 0xc9, 0x7e, 0x6f, 0x54, 0xea, 0xa2, 0x3a, 0x4f, 0x56,
-	0x73, 0x9d, 0xb7, 0xf3, 0xf7, 0xbc, 0x99, 0x51, 0xa9, 0x8b, 0x86, 0x2f, 0x91, 0x7b, 0xf5, 0xb8,
-	0x40, 0x28, 0xa9, 0x9f, 0xb8, 0x1c, 0x79, 0x93, 0xee, 0x74, 0xef, 0xe8, 0xb3, 0xad, 0x5d, 0x69,
-	0xfe, 0x25, 0x62, 0xec, 0x2a, 0xa2, 0x46, 0x92, 0xf4, 0x4a, 0x85, 0xf8, 0xee, 0xc5, 0x65, 0xd0,
-	0x79, 0x75, 0x19, 0x74, 0x5e, 0x5f, 0x06, 0x9d, 0x9f, 0x37, 0x81, 0x77, 0xb1, 0x09, 0xbc, 0x57,
-	0x9b, 0xc0, 0x7b, 0xbd, 0x09, 0xbc, 0x3f, 0x36, 0x81, 0xf7, 0xcb, 0x9f, 0x41, 0xe7, 0xa9, 0x6f,
-	0xb9, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x7b, 0xad, 0x8f, 0x49, 0x07, 0x00, 0x00,
+	0x14, 0xc7, 0xbd, 0xb1, 0xbd, 0xb1, 0x27, 0x0d, 0xa0, 0xb9, 0x5a, 0x45, 0xea, 0xda, 0x2c, 0x02,
+	0xb9, 0xd0, 0xce, 0x2a, 0x69, 0x25, 0x3e, 0x24, 0x84, 0xb2, 0xd0, 0x4a, 0x90, 0x82, 0xd0, 0xb8,
+	0xe2, 0xa2, 0x08, 0x55, 0x93, 0xcd, 0xf1, 0x76, 0xeb, 0xec, 0xce, 0x6a, 0x66, 0xd6, 0xaa, 0xef,
+	0x78, 0x04, 0xae, 0x79, 0x05, 0x5e, 0x24, 0x97, 0xbd, 0xa3, 0x57, 0x16, 0x31, 0x6f, 0xd1, 0x2b,
+	0x34, 0x1f, 0xeb, 0x4d, 0x4b, 0x24, 0x64, 0x7a, 0x95, 0xf1, 0x7f, 0xce, 0xfc, 0xce, 0xd9, 0xff,
+	0x39, 0x27, 0xe8, 0xab, 0x2c, 0x57, 0x4f, 0xeb, 0x53, 0x92, 0xf2, 0x22, 0x66, 0x22, 0xe3, 0x95,
+	0xe0, 0xcf, 0xcc, 0xe1, 0x0e, 0x2c, 0xa0, 0x54, 0x32, 0xae, 0xe6, 0x59, 0xcc, 0xaa, 0x5c, 0xc6,
+	0x29, 0x2f, 0x0a, 0x5e, 0xc6, 0x19, 0x94, 0x20, 0x98, 0x82, 0x33, 0x52, 0x09, 0xae, 0x38, 0x8e,
+	0x5b, 0x00, 0x69, 0x00, 0xe6, 0xf0, 0xc4, 0x02, 0x48, 0x35, 0xcf, 0x88, 0x06, 0x10, 0x0b, 0x38,
+	0xb8, 0x73, 0x25, 0x63, 0xc6, 0x33, 0x1e, 0x1b, 0xce, 0x69, 0x3d, 0x33, 0xbf, 0xcc, 0x0f, 0x73,
+	0xb2, 0xfc, 0x83, 0x68, 0xfe, 0x99, 0x24, 0x39, 0xd7, 0x35, 0xc4, 0x29, 0x17, 0x10, 0x2f, 0x0e,
+	0xdf, 0xac, 0xe1, 0xe0, 0x5e, 0x1b, 0x53, 0xb0, 0xf4, 0x69, 0x5e, 0x82, 0x58, 0xb6, 0x85, 0x17,
+	0xa0, 0xd8, 0x35, 0xaf, 0xa2, 0x5b, 0xc8, 0x3f, 0x2e, 0x78, 0x5d, 0x2a, 0x3c, 0x42, 0xfd, 0x05,
+	0x3b, 0xaf, 0x21, 0xf0, 0xc6, 0xde, 0xe4, 0x46, 0x32, 0x5c, 0xaf, 0x46, 0xfd, 0x9f, 0xb4, 0x40,
+	0xad, 0x1e, 0xfd, 0xb1, 0x83, 0x76, 0x13, 0x96, 0xce, 0xf9, 0x6c, 0x86, 0xbf, 0x44, 0x83, 0xb3,
+	0x5a, 0x30, 0x95, 0xf3, 0xd2, 0xc4, 0x77, 0x93, 0xf7, 0x2f, 0x56, 0xa3, 0xce, 0x7a, 0x35, 0x1a,
+	0x7c, 0xe3, 0xf4, 0x57, 0xab, 0xd1, 0xbe, 0xca, 0x0b, 0x20, 0x8d, 0x40, 0x37, 0x4f, 0xf0, 0x13,
+	0xe4, 0xcf, 0x58, 0xaa, 0xb8, 0x08, 0x76, 0xc6, 0xde, 0x64, 0xef, 0xe8, 0x53, 0xb2, 0xa5, 0x81,
+	0xc4, 0x16, 0x9d, 0xbc, 0xe3, 0xb2, 0xfa, 0x0f, 0x0c, 0x8e, 0x3a, 0x2c, 0xfe, 0x19, 0xf9, 0xcf,
+	0x72, 0xa5, 0x40, 0x04, 0xdd, 0xb7, 0x4b, 0x80, 0x34, 0xfc, 0x3b, 0x83, 0xa2, 0x0e, 0x89, 0x3f,
+	0x40, 0x7d, 0xa9, 0xa0, 0x92, 0x41, 0x6f, 0xec, 0x4d, 0xfa, 0xc9, 0xbe, 0xab, 0xa1, 0x3f, 0xd5,
+	0x22, 0xb5, 0x77, 0xd1, 0x9f, 0x3b, 0x68, 0xf8, 0x35, 0x2f, 0xcf, 0x72, 0xf3, 0xc1, 0x87, 0xa8,
+	0xa7, 0x96, 0x95, 0xf5, 0x76, 0x98, 0xdc, 0x74, 0x2f, 0x7a, 0x8f, 0x96, 0x15, 0x68, 0x9f, 0x36,
+	0x81, 0x5a, 0xa0, 0x26, 0x14, 0x3f, 0x44, 0xbe, 0x54, 0x4c, 0xd5, 0xd2, 0x78, 0x34, 0x4c, 0xee,
+	0x35, 0x9f, 0x3a, 0x35, 0xea, 0xab, 0xd5, 0xe8, 0x9a, 0xa9, 0x20, 0x1b, 0x92, 0x8d, 0xa2, 0x8e,
+	0x81, 0x17, 0x08, 0x9f, 0x33, 0xa9, 0x1e, 0x09, 0x56, 0x4a, 0x9b, 0x29, 0x2f, 0xc0, 0x99, 0xf3,
+	0x31, 0xb1, 0x20, 0x72, 0x75, 0x74, 0x5a, 0x43, 0xf4, 0xe8, 0x90, 0xc5, 0x21, 0xd1, 0x2f, 0x92,
+	0x03, 0x57, 0x05, 0x7e, 0xf8, 0x2f, 0x1a, 0xbd, 0x26, 0x03, 0xfe, 0x08, 0xf9, 0x02, 0x98, 0xe4,
+	0xa5, 0x31, 0x6b, 0xd8, 0x36, 0x8c, 0x1a, 0x95, 0xba, 0x5b, 0x7c, 0x0b, 0xed, 0x16, 0x20, 0x25,
+	0xcb, 0x20, 0xe8, 0x9b, 0xc0, 0x77, 0x5d, 0xe0, 0xee, 0xf7, 0x56, 0xa6, 0xcd, 0x7d, 0xf4, 0x09,
+	0x1a, 0x50, 0x90, 0xbc, 0x16, 0x29, 0xfc, 0xf7, 0xd0, 0xfe, 0xde, 0x43, 0x68, 0x7a, 0xf7, 0x58,
+	0xa8, 0x5c, 0x4f, 0x06, 0xbe, 0x8d, 0x06, 0x50, 0x9e, 0x55, 0x3c, 0x2f, 0x95, 0xeb, 0xc5, 0x7b,
+	0xcd, 0xdc, 0xde, 0x77, 0x3a, 0xdd, 0x44, 0xe0, 0x5f, 0x90, 0x7f, 0x5a, 0xa7, 0x73, 0x50, 0x6e,
+	0x4c, 0x3f, 0xdf, 0x7a, 0x8a, 0xa6, 0x77, 0x13, 0x03, 0xb0, 0x73, 0x64, 0xcf, 0xd4, 0x41, 0xad,
+	0x37, 0x99, 0x5e, 0xa1, 0xee, 0x9b, 0xde, 0x64, 0xb9, 0xf5, 0x46, 0xff, 0xd5, 0x45, 0xe7, 0xa5,
+	0x84, 0xb4, 0x16, 0x60, 0x5c, 0x1c, 0xb4, 0x45, 0x7f, 0xeb, 0x74, 0xba, 0x89, 0xc0, 0x14, 0x0d,
+	0x59, 0x9a, 0x82, 0x94, 0x27, 0xb0, 0x34, 0x5e, 0xee, 0x1d, 0x7d, 0x78, 0xa5, 0xc1, 0x44, 0x4f,
+	0x8a, 0x6e, 0xe7, 0x14, 0x52, 0x01, 0xea, 0x04, 0x96, 0x53, 0x38, 0x07, 0xbd, 0x34, 0xc9, 0xfe,
+	0x7a, 0x35, 0x1a, 0x1e, 0x37, 0x6f, 0x69, 0x8b, 0xd1, 0x4c, 0xd9, 0x84, 0x07, 0xfe, 0xd6, 0xcc,
+	0x8d, 0x4c, 0x5b, 0x0c, 0x8e, 0x90, 0x6f, 0x4d, 0x0b, 0x76, 0xc7, 0xdd, 0xc9, 0xd0, 0x3a, 0x74,
+	0xdf, 0x28, 0xd4, 0xdd, 0xe8, 0x06, 0xcc, 0xf2, 0x73, 0xbd, 0xc6, 0x83, 0xff, 0xdd, 0x80, 0x07,
+	0x06, 0x60, 0xf1, 0xf6, 0x4c, 0x1d, 0x34, 0x3a, 0x41, 0x83, 0xa6, 0x41, 0xf8, 0x26, 0xea, 0xce,
+	0x61, 0xe9, 0x86, 0x62, 0xcf, 0xf9, 0xdb, 0xd5, 0x35, 0x6b, 0x1d, 0x8f, 0x51, 0xaf, 0x64, 0x05,
+	0xb8, 0x5d, 0xbc, 0xd1, 0x2c, 0xf0, 0x0f, 0xac, 0x00, 0x6a, 0x6e, 0xa2, 0xc7, 0x1a, 0x66, 0x13,
+	0xe8, 0xce, 0x56, 0x02, 0x66, 0xf9, 0xf3, 0xc0, 0x7b, 0xbd, 0xb3, 0x3f, 0x1a, 0x95, 0xba, 0x5b,
+	0x1d, 0x27, 0xeb, 0x99, 0x8e, 0xdb, 0x79, 0x3d, 0x6e, 0x6a, 0x54, 0xea, 0x6e, 0xa3, 0xe7, 0xc8,
+	0x6d, 0x3d, 0x2e, 0x11, 0x4a, 0x9b, 0x15, 0x97, 0x81, 0x37, 0xee, 0x4e, 0xf6, 0x8e, 0xbe, 0xd8,
+	0xda, 0x95, 0xcd, 0x7f, 0x89, 0x04, 0xbb, 0x8c, 0x68, 0x23, 0x49, 0x7a, 0x25, 0x43, 0x72, 0xfb,
+	0xe2, 0x32, 0xec, 0xbc, 0xb8, 0x0c, 0x3b, 0x2f, 0x2f, 0xc3, 0xce, 0xaf, 0xeb, 0xd0, 0xbb, 0x58,
+	0x87, 0xde, 0x8b, 0x75, 0xe8, 0xbd, 0x5c, 0x87, 0xde, 0x5f, 0xeb, 0xd0, 0xfb, 0xed, 0xef, 0xb0,
+	0xf3, 0xd8, 0xb7, 0xdc, 0x7f, 0x06, 0x00, 0xdb, 0x7b, 0xad, 0x8f, 0x49, 0x07, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
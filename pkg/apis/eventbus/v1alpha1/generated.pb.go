@@ -314,66 +314,65 @@ func init() {
 }
 
 var fileDescriptor_871e47633eb7aad4 = []byte{
-	// 931 bytes of a gzipped FileDescriptorProto
+	// 925 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x1b, 0xf6, 0x3a, 0x8e, 0x3f, 0x7b, 0xec, 0xe6, 0x8b, 0x86, 0x1e, 0xac, 0x48, 0xac, 0xab, 0x95,
-	0x90, 0x2a, 0xd1, 0xcc, 0x92, 0x0a, 0x41, 0xc5, 0xa5, 0x78, 0xd3, 0x20, 0x5a, 0xda, 0x14, 0xc6,
-	0xd0, 0x03, 0x20, 0xc2, 0x64, 0x33, 0x59, 0x6f, 0xe2, 0xdd, 0xd9, 0xce, 0xcc, 0x5a, 0x98, 0x13,
-	0xe2, 0x17, 0x70, 0xe4, 0x3f, 0xf0, 0x47, 0x72, 0xe0, 0xd0, 0x1b, 0x3d, 0x59, 0xcd, 0x56, 0xfc,
-	0x89, 0x9e, 0xd0, 0xcc, 0xce, 0x7a, 0xd7, 0x71, 0x29, 0x01, 0xe7, 0xe4, 0x9d, 0x77, 0xde, 0xf7,
-	0x79, 0xe6, 0x79, 0xe6, 0x7d, 0x77, 0x0d, 0x1e, 0x04, 0xa1, 0x1c, 0xa5, 0x87, 0xc8, 0x67, 0x91,
-	0x4b, 0x78, 0xc0, 0x12, 0xce, 0x4e, 0xf4, 0xc3, 0x36, 0x9d, 0xd0, 0x58, 0x0a, 0x37, 0x39, 0x0d,
-	0x5c, 0x92, 0x84, 0xc2, 0xd5, 0xeb, 0xc3, 0x54, 0xb8, 0x93, 0x1d, 0x32, 0x4e, 0x46, 0x64, 0xc7,
-	0x0d, 0x68, 0x4c, 0x39, 0x91, 0xf4, 0x08, 0x25, 0x9c, 0x49, 0x06, 0x3f, 0x2a, 0xb1, 0x50, 0x81,
-	0xa5, 0x1f, 0x0e, 0x72, 0x2c, 0x94, 0x9c, 0x06, 0x48, 0x61, 0xa1, 0x02, 0x0b, 0x15, 0x58, 0x5b,
-	0x77, 0x2f, 0x7d, 0x0e, 0x9f, 0x45, 0x11, 0x8b, 0x2f, 0x92, 0x6f, 0x6d, 0x57, 0x00, 0x02, 0x16,
-	0x30, 0x57, 0x87, 0x0f, 0xd3, 0x63, 0xbd, 0xd2, 0x0b, 0xfd, 0x64, 0xd2, 0x9d, 0xd3, 0x3b, 0x02,
-	0x85, 0x4c, 0x41, 0xba, 0x3e, 0xe3, 0xd4, 0x9d, 0x2c, 0xe9, 0xd9, 0x7a, 0xbf, 0xcc, 0x89, 0x88,
-	0x3f, 0x0a, 0x63, 0xca, 0xa7, 0xc5, 0x39, 0x5c, 0x4e, 0x05, 0x4b, 0xb9, 0x4f, 0xff, 0x55, 0x95,
-	0x70, 0x23, 0x2a, 0xc9, 0xeb, 0xb8, 0xdc, 0xbf, 0xab, 0xe2, 0x69, 0x2c, 0xc3, 0x68, 0x99, 0xe6,
-	0x83, 0x7f, 0x2a, 0x10, 0xfe, 0x88, 0x46, 0xe4, 0x62, 0x9d, 0xf3, 0x14, 0xb4, 0xbd, 0x54, 0xec,
-	0xb2, 0xf8, 0x38, 0x0c, 0xe0, 0x11, 0x68, 0xc4, 0x44, 0x8a, 0x9e, 0x75, 0xc3, 0xba, 0xd9, 0xb9,
-	0xfd, 0x09, 0xfa, 0xef, 0x17, 0x88, 0xf6, 0x07, 0x5f, 0x0e, 0x73, 0x54, 0xaf, 0x95, 0xcd, 0xfa,
-	0x0d, 0xb5, 0xc6, 0x1a, 0xdd, 0xf9, 0xbd, 0x0e, 0x5a, 0x7b, 0xaa, 0xc0, 0x4b, 0x05, 0xfc, 0x1e,
-	0xb4, 0x94, 0x07, 0x47, 0x44, 0x12, 0x43, 0xfb, 0x1e, 0xca, 0xa5, 0xa0, 0xaa, 0x94, 0x92, 0x4a,
-	0x65, 0xa3, 0xc9, 0x0e, 0x7a, 0x7c, 0x78, 0x42, 0x7d, 0xf9, 0x88, 0x4a, 0xe2, 0xc1, 0xb3, 0x59,
-	0xbf, 0x96, 0xcd, 0xfa, 0xa0, 0x8c, 0xe1, 0x39, 0x2a, 0x3c, 0x01, 0x0d, 0x91, 0x50, 0xbf, 0x57,
-	0xd7, 0xe8, 0x9f, 0xae, 0x22, 0xaa, 0x38, 0xf5, 0x30, 0xa1, 0xbe, 0xd7, 0x35, 0xac, 0x0d, 0xb5,
-	0xc2, 0x9a, 0x03, 0x72, 0xd0, 0x14, 0x92, 0xc8, 0x54, 0xf4, 0xd6, 0x34, 0xdb, 0x83, 0x2b, 0x61,
-	0xd3, 0x88, 0xde, 0x86, 0xe1, 0x6b, 0xe6, 0x6b, 0x6c, 0x98, 0x9c, 0x3f, 0x2c, 0xd0, 0x2d, 0x52,
-	0x1f, 0x86, 0x42, 0xc2, 0x6f, 0x97, 0x2c, 0x45, 0x97, 0xb3, 0x54, 0x55, 0x6b, 0x43, 0x37, 0x0d,
-	0x55, 0xab, 0x88, 0x54, 0xec, 0x0c, 0xc1, 0x7a, 0x28, 0x69, 0x24, 0x7a, 0xf5, 0x1b, 0x6b, 0x37,
-	0x3b, 0xb7, 0xef, 0x5d, 0x85, 0x42, 0xef, 0x9a, 0x21, 0x5c, 0xbf, 0xaf, 0xa0, 0x71, 0xce, 0xe0,
-	0x3c, 0x2d, 0x85, 0x29, 0x8f, 0x21, 0x59, 0x68, 0xcf, 0xdd, 0x55, 0xdb, 0x53, 0x11, 0x5f, 0xec,
-	0xcd, 0x17, 0x16, 0xd8, 0x58, 0xf4, 0x1d, 0x1e, 0xcc, 0xef, 0x34, 0xe7, 0xfd, 0xf0, 0xf2, 0xbc,
-	0xf9, 0xbb, 0x09, 0xbd, 0xf9, 0x02, 0x61, 0x04, 0x9a, 0xbe, 0x9e, 0x14, 0xd3, 0xa2, 0x7b, 0xab,
-	0x08, 0x9b, 0x0f, 0x73, 0x49, 0x97, 0xaf, 0xb1, 0x21, 0x71, 0xfe, 0xb4, 0xc0, 0xff, 0x8c, 0x7c,
-	0x18, 0x83, 0x66, 0x4c, 0x64, 0x38, 0xa1, 0x46, 0xdb, 0x4a, 0xfd, 0xba, 0xaf, 0x91, 0x86, 0x52,
-	0xbd, 0x5e, 0x82, 0xa9, 0x07, 0x14, 0x77, 0x1e, 0xc3, 0x86, 0x05, 0x9e, 0x80, 0x26, 0xfd, 0x81,
-	0xc9, 0xb0, 0x98, 0xc6, 0xab, 0x7a, 0xc5, 0x68, 0xae, 0x3d, 0x8d, 0x8c, 0x0d, 0x83, 0xf3, 0xd2,
-	0x02, 0xa0, 0x4c, 0x81, 0x6f, 0x83, 0xb5, 0x94, 0x8f, 0xb5, 0xce, 0xb6, 0xd7, 0x31, 0xde, 0xac,
-	0x7d, 0x85, 0x1f, 0x62, 0x15, 0x87, 0xef, 0x82, 0xb6, 0x3f, 0x4e, 0x85, 0xa4, 0xfc, 0xfe, 0x3d,
-	0x7d, 0xb8, 0xb6, 0x77, 0x2d, 0x9b, 0xf5, 0xdb, 0xbb, 0x45, 0x10, 0x97, 0xfb, 0xf0, 0x16, 0x68,
-	0x90, 0x54, 0x8e, 0xf4, 0x90, 0xb7, 0xbd, 0x9e, 0xea, 0xa1, 0x41, 0x2a, 0x47, 0xaf, 0x66, 0xfd,
-	0xae, 0xfa, 0x2d, 0x2c, 0xc0, 0x3a, 0x0b, 0x7e, 0x03, 0xba, 0xc4, 0xf7, 0xa9, 0x10, 0x43, 0xea,
-	0x73, 0x2a, 0x7b, 0x0d, 0x2d, 0xfd, 0x9d, 0xca, 0x4c, 0x22, 0xf5, 0xc9, 0x51, 0x13, 0x98, 0x67,
-	0x7c, 0x46, 0xa7, 0x43, 0x3a, 0xa6, 0xbe, 0x64, 0xdc, 0xdb, 0xcc, 0x14, 0x68, 0xa5, 0x1c, 0x2f,
-	0x80, 0x39, 0xbf, 0xd5, 0xc1, 0xc6, 0xa2, 0xf1, 0xf0, 0x16, 0x68, 0x71, 0x9a, 0x8c, 0x43, 0x9f,
-	0xe4, 0x2d, 0xbb, 0x5e, 0xce, 0x33, 0x36, 0x71, 0x3c, 0xcf, 0x98, 0x6b, 0xa9, 0x5f, 0x4a, 0xcb,
-	0x1d, 0xd0, 0x25, 0xb1, 0x0c, 0x07, 0xc7, 0xc7, 0x61, 0x1c, 0xca, 0xa9, 0x76, 0xa0, 0xe5, 0x5d,
-	0x37, 0xf8, 0xdd, 0x41, 0x65, 0x0f, 0x2f, 0x64, 0xc2, 0x9f, 0x2d, 0xd0, 0x49, 0x28, 0x17, 0xa1,
-	0x90, 0x34, 0xf6, 0xa9, 0x71, 0xe1, 0xf1, 0x2a, 0x0d, 0xf0, 0x79, 0x09, 0x37, 0xef, 0xba, 0xff,
-	0x67, 0xb3, 0x7e, 0xa7, 0xb2, 0x81, 0xab, 0xa4, 0xce, 0xaf, 0x75, 0xf0, 0xd6, 0x6b, 0xaa, 0xe0,
-	0xc7, 0x60, 0x53, 0x48, 0xc6, 0x49, 0x40, 0x77, 0xc7, 0x44, 0x88, 0x7d, 0x12, 0x51, 0xd3, 0x29,
-	0xd7, 0xb3, 0x59, 0x7f, 0x73, 0x78, 0x61, 0x0f, 0x2f, 0x65, 0xc3, 0x03, 0x00, 0xf2, 0x7b, 0x79,
-	0xc4, 0x8e, 0xa8, 0x31, 0xf3, 0xae, 0xfa, 0x26, 0x0d, 0xe6, 0xd1, 0x57, 0xb3, 0xfe, 0xf6, 0xf2,
-	0xdf, 0x8c, 0x52, 0x85, 0x7c, 0xc2, 0xc6, 0x69, 0x44, 0xcb, 0x02, 0x5c, 0x81, 0x84, 0xdf, 0x01,
-	0x30, 0xd1, 0xfb, 0xc3, 0xf0, 0x47, 0x6a, 0x3e, 0x2f, 0x6f, 0x7c, 0xaf, 0xa3, 0xe2, 0x2f, 0x09,
-	0xfa, 0x22, 0x55, 0x37, 0x21, 0xa7, 0xde, 0x86, 0x3a, 0xd0, 0x93, 0x39, 0x0a, 0xae, 0x20, 0x7a,
-	0xe8, 0xec, 0xdc, 0xae, 0x3d, 0x3b, 0xb7, 0x6b, 0xcf, 0xcf, 0xed, 0xda, 0x4f, 0x99, 0x6d, 0x9d,
-	0x65, 0xb6, 0xf5, 0x2c, 0xb3, 0xad, 0xe7, 0x99, 0x6d, 0xbd, 0xc8, 0x6c, 0xeb, 0x97, 0x97, 0x76,
-	0xed, 0xeb, 0x56, 0x61, 0xfe, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x58, 0x3c, 0xf7, 0x72, 0x2a,
-	0x0a, 0x00, 0x00,
+	0x14, 0xf6, 0xda, 0x8e, 0xb1, 0xc7, 0x6e, 0x88, 0x86, 0x1e, 0xac, 0x48, 0xd8, 0xd5, 0x4a, 0x48,
+	0x95, 0x68, 0x66, 0x49, 0x85, 0xa0, 0xe2, 0x52, 0xbc, 0x69, 0x10, 0x2d, 0x6d, 0x0a, 0x63, 0xe8,
+	0x01, 0x10, 0x61, 0xb2, 0x99, 0xac, 0x37, 0xf1, 0xee, 0x6c, 0x67, 0x66, 0x2d, 0xcc, 0x09, 0xf1,
+	0x0b, 0x38, 0xf2, 0x1f, 0xf8, 0x23, 0x39, 0x70, 0xe8, 0x8d, 0x9e, 0xac, 0x66, 0x2b, 0xfe, 0x44,
+	0x4f, 0x68, 0x66, 0x67, 0xbd, 0xeb, 0xb8, 0x90, 0x50, 0xe7, 0xe4, 0x79, 0x6f, 0xde, 0xfb, 0xbe,
+	0x79, 0xdf, 0xbc, 0x37, 0x5e, 0xf0, 0xc0, 0x0f, 0xe4, 0x28, 0x39, 0x40, 0x1e, 0x0b, 0x1d, 0xc2,
+	0x7d, 0x16, 0x73, 0x76, 0xac, 0x17, 0x5b, 0x74, 0x42, 0x23, 0x29, 0x9c, 0xf8, 0xc4, 0x77, 0x48,
+	0x1c, 0x08, 0x47, 0xdb, 0x07, 0x89, 0x70, 0x26, 0xdb, 0x64, 0x1c, 0x8f, 0xc8, 0xb6, 0xe3, 0xd3,
+	0x88, 0x72, 0x22, 0xe9, 0x21, 0x8a, 0x39, 0x93, 0x0c, 0x7e, 0x52, 0x60, 0xa1, 0x1c, 0x4b, 0x2f,
+	0xf6, 0x33, 0x2c, 0x14, 0x9f, 0xf8, 0x48, 0x61, 0xa1, 0x1c, 0x0b, 0xe5, 0x58, 0x9b, 0x77, 0x2f,
+	0x7d, 0x0e, 0x8f, 0x85, 0x21, 0x8b, 0xce, 0x93, 0x6f, 0x6e, 0x95, 0x00, 0x7c, 0xe6, 0x33, 0x47,
+	0xbb, 0x0f, 0x92, 0x23, 0x6d, 0x69, 0x43, 0xaf, 0x4c, 0xb8, 0x7d, 0x72, 0x47, 0xa0, 0x80, 0x29,
+	0x48, 0xc7, 0x63, 0x9c, 0x3a, 0x93, 0xa5, 0x7a, 0x36, 0x3f, 0x2c, 0x62, 0x42, 0xe2, 0x8d, 0x82,
+	0x88, 0xf2, 0x69, 0x7e, 0x0e, 0x87, 0x53, 0xc1, 0x12, 0xee, 0xd1, 0xff, 0x95, 0x25, 0x9c, 0x90,
+	0x4a, 0xf2, 0x3a, 0x2e, 0xe7, 0xdf, 0xb2, 0x78, 0x12, 0xc9, 0x20, 0x5c, 0xa6, 0xf9, 0xe8, 0xa2,
+	0x04, 0xe1, 0x8d, 0x68, 0x48, 0xce, 0xe7, 0xd9, 0x4f, 0x41, 0xcb, 0x4d, 0xc4, 0x0e, 0x8b, 0x8e,
+	0x02, 0x1f, 0x1e, 0x82, 0x7a, 0x44, 0xa4, 0xe8, 0x5a, 0x37, 0xac, 0x9b, 0xed, 0xdb, 0x9f, 0xa1,
+	0x37, 0xbf, 0x40, 0xb4, 0x37, 0xf8, 0x7a, 0x98, 0xa1, 0xba, 0xcd, 0x74, 0xd6, 0xaf, 0x2b, 0x1b,
+	0x6b, 0x74, 0xfb, 0xcf, 0x2a, 0x68, 0xee, 0xaa, 0x04, 0x37, 0x11, 0xf0, 0x47, 0xd0, 0x54, 0x1a,
+	0x1c, 0x12, 0x49, 0x0c, 0xed, 0x07, 0x28, 0x2b, 0x05, 0x95, 0x4b, 0x29, 0xa8, 0x54, 0x34, 0x9a,
+	0x6c, 0xa3, 0xc7, 0x07, 0xc7, 0xd4, 0x93, 0x8f, 0xa8, 0x24, 0x2e, 0x3c, 0x9d, 0xf5, 0x2b, 0xe9,
+	0xac, 0x0f, 0x0a, 0x1f, 0x9e, 0xa3, 0xc2, 0x63, 0x50, 0x17, 0x31, 0xf5, 0xba, 0x55, 0x8d, 0xfe,
+	0xf9, 0x2a, 0x45, 0xe5, 0xa7, 0x1e, 0xc6, 0xd4, 0x73, 0x3b, 0x86, 0xb5, 0xae, 0x2c, 0xac, 0x39,
+	0x20, 0x07, 0x0d, 0x21, 0x89, 0x4c, 0x44, 0xb7, 0xa6, 0xd9, 0x1e, 0x5c, 0x09, 0x9b, 0x46, 0x74,
+	0xd7, 0x0d, 0x5f, 0x23, 0xb3, 0xb1, 0x61, 0xb2, 0xff, 0xb2, 0x40, 0x27, 0x0f, 0x7d, 0x18, 0x08,
+	0x09, 0xbf, 0x5f, 0x92, 0x14, 0x5d, 0x4e, 0x52, 0x95, 0xad, 0x05, 0xdd, 0x30, 0x54, 0xcd, 0xdc,
+	0x53, 0x92, 0x33, 0x00, 0x6b, 0x81, 0xa4, 0xa1, 0xe8, 0x56, 0x6f, 0xd4, 0x6e, 0xb6, 0x6f, 0xdf,
+	0xbb, 0x8a, 0x0a, 0xdd, 0x6b, 0x86, 0x70, 0xed, 0xbe, 0x82, 0xc6, 0x19, 0x83, 0xfd, 0xb4, 0x28,
+	0x4c, 0x69, 0x0c, 0xc9, 0x42, 0x7b, 0xee, 0xac, 0xda, 0x9e, 0x8a, 0xf8, 0x7c, 0x6f, 0xbe, 0xb0,
+	0xc0, 0xfa, 0xa2, 0xee, 0x70, 0x7f, 0x7e, 0xa7, 0x19, 0xef, 0xc7, 0x97, 0xe7, 0xcd, 0xde, 0x26,
+	0xf4, 0xdf, 0x17, 0x08, 0x43, 0xd0, 0xf0, 0xf4, 0xa4, 0x98, 0x16, 0xdd, 0x5d, 0xa5, 0xb0, 0xf9,
+	0x30, 0x17, 0x74, 0x99, 0x8d, 0x0d, 0x89, 0xfd, 0xb7, 0x05, 0xde, 0x32, 0xe5, 0xc3, 0x08, 0x34,
+	0x22, 0x22, 0x83, 0x09, 0xed, 0x5a, 0xab, 0xf7, 0xeb, 0x9e, 0x46, 0x1a, 0x4a, 0xf5, 0xbc, 0xf8,
+	0x53, 0x17, 0x28, 0xee, 0xcc, 0x87, 0x0d, 0x0b, 0x3c, 0x06, 0x0d, 0xfa, 0x13, 0x93, 0x41, 0x3e,
+	0x8d, 0x57, 0xf5, 0xc4, 0x68, 0xae, 0x5d, 0x8d, 0x8c, 0x0d, 0x83, 0xfd, 0xd2, 0x02, 0xa0, 0x08,
+	0x81, 0xef, 0x82, 0x5a, 0xc2, 0xc7, 0xba, 0xce, 0x96, 0xdb, 0x36, 0xda, 0xd4, 0xbe, 0xc1, 0x0f,
+	0xb1, 0xf2, 0xc3, 0xf7, 0x41, 0xcb, 0x1b, 0x27, 0x42, 0x52, 0x7e, 0xff, 0x9e, 0x3e, 0x5c, 0xcb,
+	0xbd, 0x96, 0xce, 0xfa, 0xad, 0x9d, 0xdc, 0x89, 0x8b, 0x7d, 0x78, 0x0b, 0xd4, 0x49, 0x22, 0x47,
+	0x7a, 0xc8, 0x5b, 0x6e, 0x57, 0xf5, 0xd0, 0x20, 0x91, 0xa3, 0x57, 0xb3, 0x7e, 0x47, 0xfd, 0xe6,
+	0x12, 0x60, 0x1d, 0x05, 0xbf, 0x03, 0x1d, 0xe2, 0x79, 0x54, 0x88, 0x21, 0xf5, 0x38, 0x95, 0xdd,
+	0xba, 0x2e, 0xfd, 0xbd, 0xd2, 0x4c, 0x22, 0xf5, 0x97, 0xa3, 0x26, 0x30, 0x8b, 0xf8, 0x82, 0x4e,
+	0x87, 0x74, 0x4c, 0x3d, 0xc9, 0xb8, 0xbb, 0x91, 0x2a, 0xd0, 0x52, 0x3a, 0x5e, 0x00, 0xb3, 0xff,
+	0xa8, 0x82, 0xf5, 0x45, 0xe1, 0xe1, 0x2d, 0xd0, 0xe4, 0x34, 0x1e, 0x07, 0x1e, 0xc9, 0x5a, 0x76,
+	0xad, 0x98, 0x67, 0x6c, 0xfc, 0x78, 0x1e, 0x31, 0xaf, 0xa5, 0x7a, 0xa9, 0x5a, 0xee, 0x80, 0x0e,
+	0x89, 0x64, 0x30, 0x38, 0x3a, 0x0a, 0xa2, 0x40, 0x4e, 0xb5, 0x02, 0x4d, 0xf7, 0xba, 0xc1, 0xef,
+	0x0c, 0x4a, 0x7b, 0x78, 0x21, 0x12, 0xfe, 0x6a, 0x81, 0x76, 0x4c, 0xb9, 0x08, 0x84, 0xa4, 0x91,
+	0x47, 0x8d, 0x0a, 0x8f, 0x57, 0x69, 0x80, 0x2f, 0x0b, 0xb8, 0x79, 0xd7, 0xbd, 0x9d, 0xce, 0xfa,
+	0xed, 0xd2, 0x06, 0x2e, 0x93, 0xda, 0xbf, 0x57, 0xc1, 0x3b, 0xaf, 0xc9, 0x82, 0x9f, 0x82, 0x0d,
+	0x21, 0x19, 0x27, 0x3e, 0xdd, 0x19, 0x13, 0x21, 0xf6, 0x48, 0x48, 0x4d, 0xa7, 0x5c, 0x4f, 0x67,
+	0xfd, 0x8d, 0xe1, 0xb9, 0x3d, 0xbc, 0x14, 0x0d, 0xf7, 0x01, 0xc8, 0xee, 0xe5, 0x11, 0x3b, 0xa4,
+	0x46, 0xcc, 0xbb, 0xea, 0x3f, 0x69, 0x30, 0xf7, 0xbe, 0x9a, 0xf5, 0xb7, 0x96, 0x3f, 0x33, 0x8a,
+	0x2a, 0xe4, 0x13, 0x36, 0x4e, 0x42, 0x5a, 0x24, 0xe0, 0x12, 0x24, 0xfc, 0x01, 0x80, 0x89, 0xde,
+	0x1f, 0x06, 0x3f, 0xd3, 0x6e, 0xed, 0xe2, 0x77, 0x1d, 0xe5, 0x9f, 0x24, 0xe8, 0xab, 0x44, 0xdd,
+	0x84, 0x9c, 0xba, 0xeb, 0xea, 0x40, 0x4f, 0xe6, 0x28, 0xb8, 0x84, 0xe8, 0xa2, 0xd3, 0xb3, 0x5e,
+	0xe5, 0xd9, 0x59, 0xaf, 0xf2, 0xfc, 0xac, 0x57, 0xf9, 0x25, 0xed, 0x59, 0xa7, 0x69, 0xcf, 0x7a,
+	0x96, 0xf6, 0xac, 0xe7, 0x69, 0xcf, 0x7a, 0x91, 0xf6, 0xac, 0xdf, 0x5e, 0xf6, 0x2a, 0xdf, 0x36,
+	0x73, 0xf1, 0xff, 0x19, 0x00, 0x58, 0x3c, 0xf7, 0x72, 0x2a, 0x0a, 0x00, 0x00,
 }
 
 func (m *BusConfig) Marshal() (dAtA []byte, err error) {
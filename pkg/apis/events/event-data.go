@@ -95,6 +95,9 @@ type AzureEventsHubEventData struct {
 type CalendarEventData struct {
 	// EventTime is time at which event occurred
 	EventTime string `json:"eventTime"`
+	// ScheduledTime is the time of the occurrence of the schedule, which precedes the event time
+	// if the occurrence was missed and caught up
+	ScheduledTime string `json:"scheduledTime"`
 	// UserPayload if any
 	// +optional
 	UserPayload json.RawMessage `json:"userPayload,omitempty"`
@@ -147,6 +150,12 @@ type KafkaEventData struct {
 	Body interface{} `json:"body"`
 	// Timestamp of the message
 	Timestamp string `json:"timestamp"`
+	// Key refers to the message key
+	Key string `json:"key,omitempty"`
+	// Headers refers to the message headers
+	Headers map[string]string `json:"headers,omitempty"`
+	// Offset refers to the offset of the message in the partition
+	Offset int64 `json:"offset"`
 }
 
 // MinioEventData represents the event data generated by the Minio gateway.
@@ -204,6 +213,10 @@ type ResourceEventData struct {
 	Version string `json:"version"`
 	// Resource name.
 	Resource string `json:"resource"`
+	// OldBody is the resource body before the update, set for the UPDATE events.
+	OldBody *json.RawMessage `json:"oldBody,omitempty"`
+	// Diff is the JSON patch (RFC 6902) from the old body to the body, set for the UPDATE events.
+	Diff *json.RawMessage `json:"diff,omitempty"`
 }
 
 // WebhookEventData represents the event data generated by the Webhook gateway.
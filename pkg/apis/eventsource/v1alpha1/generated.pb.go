@@ -46,10 +46,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AMQPConsumeConfig) Reset()      { *m = AMQPConsumeConfig{} }
+func (*AMQPConsumeConfig) ProtoMessage() {}
+func (*AMQPConsumeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{0}
+}
+func (m *AMQPConsumeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AMQPConsumeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AMQPConsumeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMQPConsumeConfig.Merge(m, src)
+}
+func (m *AMQPConsumeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AMQPConsumeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMQPConsumeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMQPConsumeConfig proto.InternalMessageInfo
+
 func (m *AMQPEventSource) Reset()      { *m = AMQPEventSource{} }
 func (*AMQPEventSource) ProtoMessage() {}
 func (*AMQPEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{0}
+	return fileDescriptor_c9ac5d6cd016403b, []int{1}
 }
 func (m *AMQPEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -74,10 +102,94 @@ func (m *AMQPEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AMQPEventSource proto.InternalMessageInfo
 
+func (m *AMQPExchangeDeclareConfig) Reset()      { *m = AMQPExchangeDeclareConfig{} }
+func (*AMQPExchangeDeclareConfig) ProtoMessage() {}
+func (*AMQPExchangeDeclareConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{2}
+}
+func (m *AMQPExchangeDeclareConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AMQPExchangeDeclareConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AMQPExchangeDeclareConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMQPExchangeDeclareConfig.Merge(m, src)
+}
+func (m *AMQPExchangeDeclareConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AMQPExchangeDeclareConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMQPExchangeDeclareConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMQPExchangeDeclareConfig proto.InternalMessageInfo
+
+func (m *AMQPQoSConfig) Reset()      { *m = AMQPQoSConfig{} }
+func (*AMQPQoSConfig) ProtoMessage() {}
+func (*AMQPQoSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{3}
+}
+func (m *AMQPQoSConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AMQPQoSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AMQPQoSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMQPQoSConfig.Merge(m, src)
+}
+func (m *AMQPQoSConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AMQPQoSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMQPQoSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMQPQoSConfig proto.InternalMessageInfo
+
+func (m *AMQPQueueDeclareConfig) Reset()      { *m = AMQPQueueDeclareConfig{} }
+func (*AMQPQueueDeclareConfig) ProtoMessage() {}
+func (*AMQPQueueDeclareConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{4}
+}
+func (m *AMQPQueueDeclareConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AMQPQueueDeclareConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AMQPQueueDeclareConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMQPQueueDeclareConfig.Merge(m, src)
+}
+func (m *AMQPQueueDeclareConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AMQPQueueDeclareConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMQPQueueDeclareConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMQPQueueDeclareConfig proto.InternalMessageInfo
+
 func (m *AzureEventsHubEventSource) Reset()      { *m = AzureEventsHubEventSource{} }
 func (*AzureEventsHubEventSource) ProtoMessage() {}
 func (*AzureEventsHubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{1}
+	return fileDescriptor_c9ac5d6cd016403b, []int{5}
 }
 func (m *AzureEventsHubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -102,10 +214,38 @@ func (m *AzureEventsHubEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AzureEventsHubEventSource proto.InternalMessageInfo
 
+func (m *CalendarCatchUp) Reset()      { *m = CalendarCatchUp{} }
+func (*CalendarCatchUp) ProtoMessage() {}
+func (*CalendarCatchUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{6}
+}
+func (m *CalendarCatchUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarCatchUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CalendarCatchUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarCatchUp.Merge(m, src)
+}
+func (m *CalendarCatchUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarCatchUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarCatchUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarCatchUp proto.InternalMessageInfo
+
 func (m *CalendarEventSource) Reset()      { *m = CalendarEventSource{} }
 func (*CalendarEventSource) ProtoMessage() {}
 func (*CalendarEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{2}
+	return fileDescriptor_c9ac5d6cd016403b, []int{7}
 }
 func (m *CalendarEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,10 +270,38 @@ func (m *CalendarEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CalendarEventSource proto.InternalMessageInfo
 
+func (m *CalendarPersistence) Reset()      { *m = CalendarPersistence{} }
+func (*CalendarPersistence) ProtoMessage() {}
+func (*CalendarPersistence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{8}
+}
+func (m *CalendarPersistence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarPersistence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CalendarPersistence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarPersistence.Merge(m, src)
+}
+func (m *CalendarPersistence) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarPersistence) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarPersistence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarPersistence proto.InternalMessageInfo
+
 func (m *EmitterEventSource) Reset()      { *m = EmitterEventSource{} }
 func (*EmitterEventSource) ProtoMessage() {}
 func (*EmitterEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{3}
+	return fileDescriptor_c9ac5d6cd016403b, []int{9}
 }
 func (m *EmitterEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +329,7 @@ var xxx_messageInfo_EmitterEventSource proto.InternalMessageInfo
 func (m *EventSource) Reset()      { *m = EventSource{} }
 func (*EventSource) ProtoMessage() {}
 func (*EventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{4}
+	return fileDescriptor_c9ac5d6cd016403b, []int{10}
 }
 func (m *EventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +357,7 @@ var xxx_messageInfo_EventSource proto.InternalMessageInfo
 func (m *EventSourceList) Reset()      { *m = EventSourceList{} }
 func (*EventSourceList) ProtoMessage() {}
 func (*EventSourceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{5}
+	return fileDescriptor_c9ac5d6cd016403b, []int{11}
 }
 func (m *EventSourceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,7 +385,7 @@ var xxx_messageInfo_EventSourceList proto.InternalMessageInfo
 func (m *EventSourceSpec) Reset()      { *m = EventSourceSpec{} }
 func (*EventSourceSpec) ProtoMessage() {}
 func (*EventSourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{6}
+	return fileDescriptor_c9ac5d6cd016403b, []int{12}
 }
 func (m *EventSourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +413,7 @@ var xxx_messageInfo_EventSourceSpec proto.InternalMessageInfo
 func (m *EventSourceStatus) Reset()      { *m = EventSourceStatus{} }
 func (*EventSourceStatus) ProtoMessage() {}
 func (*EventSourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{7}
+	return fileDescriptor_c9ac5d6cd016403b, []int{13}
 }
 func (m *EventSourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +441,7 @@ var xxx_messageInfo_EventSourceStatus proto.InternalMessageInfo
 func (m *FileEventSource) Reset()      { *m = FileEventSource{} }
 func (*FileEventSource) ProtoMessage() {}
 func (*FileEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{8}
+	return fileDescriptor_c9ac5d6cd016403b, []int{14}
 }
 func (m *FileEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +469,7 @@ var xxx_messageInfo_FileEventSource proto.InternalMessageInfo
 func (m *GenericEventSource) Reset()      { *m = GenericEventSource{} }
 func (*GenericEventSource) ProtoMessage() {}
 func (*GenericEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{9}
+	return fileDescriptor_c9ac5d6cd016403b, []int{15}
 }
 func (m *GenericEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +497,7 @@ var xxx_messageInfo_GenericEventSource proto.InternalMessageInfo
 func (m *GithubEventSource) Reset()      { *m = GithubEventSource{} }
 func (*GithubEventSource) ProtoMessage() {}
 func (*GithubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{10}
+	return fileDescriptor_c9ac5d6cd016403b, []int{16}
 }
 func (m *GithubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +525,7 @@ var xxx_messageInfo_GithubEventSource proto.InternalMessageInfo
 func (m *GitlabEventSource) Reset()      { *m = GitlabEventSource{} }
 func (*GitlabEventSource) ProtoMessage() {}
 func (*GitlabEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{11}
+	return fileDescriptor_c9ac5d6cd016403b, []int{17}
 }
 func (m *GitlabEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +553,7 @@ var xxx_messageInfo_GitlabEventSource proto.InternalMessageInfo
 func (m *HDFSEventSource) Reset()      { *m = HDFSEventSource{} }
 func (*HDFSEventSource) ProtoMessage() {}
 func (*HDFSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{12}
+	return fileDescriptor_c9ac5d6cd016403b, []int{18}
 }
 func (m *HDFSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,10 +578,38 @@ func (m *HDFSEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HDFSEventSource proto.InternalMessageInfo
 
+func (m *KafkaConsumerGroup) Reset()      { *m = KafkaConsumerGroup{} }
+func (*KafkaConsumerGroup) ProtoMessage() {}
+func (*KafkaConsumerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{19}
+}
+func (m *KafkaConsumerGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaConsumerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaConsumerGroup.Merge(m, src)
+}
+func (m *KafkaConsumerGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaConsumerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaConsumerGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaConsumerGroup proto.InternalMessageInfo
+
 func (m *KafkaEventSource) Reset()      { *m = KafkaEventSource{} }
 func (*KafkaEventSource) ProtoMessage() {}
 func (*KafkaEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{13}
+	return fileDescriptor_c9ac5d6cd016403b, []int{20}
 }
 func (m *KafkaEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +637,7 @@ var xxx_messageInfo_KafkaEventSource proto.InternalMessageInfo
 func (m *MQTTEventSource) Reset()      { *m = MQTTEventSource{} }
 func (*MQTTEventSource) ProtoMessage() {}
 func (*MQTTEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{14}
+	return fileDescriptor_c9ac5d6cd016403b, []int{21}
 }
 func (m *MQTTEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +665,7 @@ var xxx_messageInfo_MQTTEventSource proto.InternalMessageInfo
 func (m *NATSEventsSource) Reset()      { *m = NATSEventsSource{} }
 func (*NATSEventsSource) ProtoMessage() {}
 func (*NATSEventsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{15}
+	return fileDescriptor_c9ac5d6cd016403b, []int{22}
 }
 func (m *NATSEventsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +693,7 @@ var xxx_messageInfo_NATSEventsSource proto.InternalMessageInfo
 func (m *NSQEventSource) Reset()      { *m = NSQEventSource{} }
 func (*NSQEventSource) ProtoMessage() {}
 func (*NSQEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{16}
+	return fileDescriptor_c9ac5d6cd016403b, []int{23}
 }
 func (m *NSQEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +721,7 @@ var xxx_messageInfo_NSQEventSource proto.InternalMessageInfo
 func (m *PubSubEventSource) Reset()      { *m = PubSubEventSource{} }
 func (*PubSubEventSource) ProtoMessage() {}
 func (*PubSubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{17}
+	return fileDescriptor_c9ac5d6cd016403b, []int{24}
 }
 func (m *PubSubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +749,7 @@ var xxx_messageInfo_PubSubEventSource proto.InternalMessageInfo
 func (m *RedisEventSource) Reset()      { *m = RedisEventSource{} }
 func (*RedisEventSource) ProtoMessage() {}
 func (*RedisEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{18}
+	return fileDescriptor_c9ac5d6cd016403b, []int{25}
 }
 func (m *RedisEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +777,7 @@ var xxx_messageInfo_RedisEventSource proto.InternalMessageInfo
 func (m *ResourceEventSource) Reset()      { *m = ResourceEventSource{} }
 func (*ResourceEventSource) ProtoMessage() {}
 func (*ResourceEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{19}
+	return fileDescriptor_c9ac5d6cd016403b, []int{26}
 }
 func (m *ResourceEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +805,7 @@ var xxx_messageInfo_ResourceEventSource proto.InternalMessageInfo
 func (m *ResourceFilter) Reset()      { *m = ResourceFilter{} }
 func (*ResourceFilter) ProtoMessage() {}
 func (*ResourceFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{20}
+	return fileDescriptor_c9ac5d6cd016403b, []int{27}
 }
 func (m *ResourceFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,10 +830,66 @@ func (m *ResourceFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceFilter proto.InternalMessageInfo
 
+func (m *ResourceJSONPathFilter) Reset()      { *m = ResourceJSONPathFilter{} }
+func (*ResourceJSONPathFilter) ProtoMessage() {}
+func (*ResourceJSONPathFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{28}
+}
+func (m *ResourceJSONPathFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceJSONPathFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceJSONPathFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceJSONPathFilter.Merge(m, src)
+}
+func (m *ResourceJSONPathFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceJSONPathFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceJSONPathFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceJSONPathFilter proto.InternalMessageInfo
+
+func (m *SASLConfig) Reset()      { *m = SASLConfig{} }
+func (*SASLConfig) ProtoMessage() {}
+func (*SASLConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{29}
+}
+func (m *SASLConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SASLConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SASLConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SASLConfig.Merge(m, src)
+}
+func (m *SASLConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SASLConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SASLConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SASLConfig proto.InternalMessageInfo
+
 func (m *SNSEventSource) Reset()      { *m = SNSEventSource{} }
 func (*SNSEventSource) ProtoMessage() {}
 func (*SNSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{21}
+	return fileDescriptor_c9ac5d6cd016403b, []int{30}
 }
 func (m *SNSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +917,7 @@ var xxx_messageInfo_SNSEventSource proto.InternalMessageInfo
 func (m *SQSEventSource) Reset()      { *m = SQSEventSource{} }
 func (*SQSEventSource) ProtoMessage() {}
 func (*SQSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{22}
+	return fileDescriptor_c9ac5d6cd016403b, []int{31}
 }
 func (m *SQSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +945,7 @@ var xxx_messageInfo_SQSEventSource proto.InternalMessageInfo
 func (m *Selector) Reset()      { *m = Selector{} }
 func (*Selector) ProtoMessage() {}
 func (*Selector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{23}
+	return fileDescriptor_c9ac5d6cd016403b, []int{32}
 }
 func (m *Selector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +973,7 @@ var xxx_messageInfo_Selector proto.InternalMessageInfo
 func (m *SlackEventSource) Reset()      { *m = SlackEventSource{} }
 func (*SlackEventSource) ProtoMessage() {}
 func (*SlackEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{24}
+	return fileDescriptor_c9ac5d6cd016403b, []int{33}
 }
 func (m *SlackEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -749,7 +1001,7 @@ var xxx_messageInfo_SlackEventSource proto.InternalMessageInfo
 func (m *StorageGridEventSource) Reset()      { *m = StorageGridEventSource{} }
 func (*StorageGridEventSource) ProtoMessage() {}
 func (*StorageGridEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{25}
+	return fileDescriptor_c9ac5d6cd016403b, []int{34}
 }
 func (m *StorageGridEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +1029,7 @@ var xxx_messageInfo_StorageGridEventSource proto.InternalMessageInfo
 func (m *StorageGridFilter) Reset()      { *m = StorageGridFilter{} }
 func (*StorageGridFilter) ProtoMessage() {}
 func (*StorageGridFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{26}
+	return fileDescriptor_c9ac5d6cd016403b, []int{35}
 }
 func (m *StorageGridFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +1057,7 @@ var xxx_messageInfo_StorageGridFilter proto.InternalMessageInfo
 func (m *StripeEventSource) Reset()      { *m = StripeEventSource{} }
 func (*StripeEventSource) ProtoMessage() {}
 func (*StripeEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{27}
+	return fileDescriptor_c9ac5d6cd016403b, []int{36}
 }
 func (m *StripeEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1085,7 @@ var xxx_messageInfo_StripeEventSource proto.InternalMessageInfo
 func (m *TLSConfig) Reset()      { *m = TLSConfig{} }
 func (*TLSConfig) ProtoMessage() {}
 func (*TLSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{28}
+	return fileDescriptor_c9ac5d6cd016403b, []int{37}
 }
 func (m *TLSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +1113,7 @@ var xxx_messageInfo_TLSConfig proto.InternalMessageInfo
 func (m *WatchPathConfig) Reset()      { *m = WatchPathConfig{} }
 func (*WatchPathConfig) ProtoMessage() {}
 func (*WatchPathConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{29}
+	return fileDescriptor_c9ac5d6cd016403b, []int{38}
 }
 func (m *WatchPathConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,10 +1138,66 @@ func (m *WatchPathConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WatchPathConfig proto.InternalMessageInfo
 
+func (m *WebhookAuth) Reset()      { *m = WebhookAuth{} }
+func (*WebhookAuth) ProtoMessage() {}
+func (*WebhookAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{39}
+}
+func (m *WebhookAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookAuth.Merge(m, src)
+}
+func (m *WebhookAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookAuth proto.InternalMessageInfo
+
+func (m *WebhookBasicAuth) Reset()      { *m = WebhookBasicAuth{} }
+func (*WebhookBasicAuth) ProtoMessage() {}
+func (*WebhookBasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{40}
+}
+func (m *WebhookBasicAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookBasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookBasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookBasicAuth.Merge(m, src)
+}
+func (m *WebhookBasicAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookBasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookBasicAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookBasicAuth proto.InternalMessageInfo
+
 func (m *WebhookContext) Reset()      { *m = WebhookContext{} }
 func (*WebhookContext) ProtoMessage() {}
 func (*WebhookContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{30}
+	return fileDescriptor_c9ac5d6cd016403b, []int{41}
 }
 func (m *WebhookContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,24 +1222,86 @@ func (m *WebhookContext) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WebhookContext proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPEventSource")
-	proto.RegisterType((*AzureEventsHubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AzureEventsHubEventSource")
-	proto.RegisterType((*CalendarEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarEventSource")
-	proto.RegisterType((*EmitterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EmitterEventSource")
-	proto.RegisterType((*EventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSource")
-	proto.RegisterType((*EventSourceList)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceList")
-	proto.RegisterType((*EventSourceSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec")
-	proto.RegisterMapType((map[string]AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.AmqpEntry")
-	proto.RegisterMapType((map[string]AzureEventsHubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.AzureEventsHubEntry")
-	proto.RegisterMapType((map[string]CalendarEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.CalendarEntry")
-	proto.RegisterMapType((map[string]EmitterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.EmitterEntry")
-	proto.RegisterMapType((map[string]FileEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.FileEntry")
-	proto.RegisterMapType((map[string]GenericEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GenericEntry")
-	proto.RegisterMapType((map[string]GithubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GithubEntry")
-	proto.RegisterMapType((map[string]GitlabEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GitlabEntry")
-	proto.RegisterMapType((map[string]HDFSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.HdfsEntry")
-	proto.RegisterMapType((map[string]KafkaEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.KafkaEntry")
+func (m *WebhookHMAC) Reset()      { *m = WebhookHMAC{} }
+func (*WebhookHMAC) ProtoMessage() {}
+func (*WebhookHMAC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{42}
+}
+func (m *WebhookHMAC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookHMAC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookHMAC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookHMAC.Merge(m, src)
+}
+func (m *WebhookHMAC) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookHMAC) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookHMAC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookHMAC proto.InternalMessageInfo
+
+func (m *WebhookRateLimit) Reset()      { *m = WebhookRateLimit{} }
+func (*WebhookRateLimit) ProtoMessage() {}
+func (*WebhookRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{43}
+}
+func (m *WebhookRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookRateLimit.Merge(m, src)
+}
+func (m *WebhookRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookRateLimit proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AMQPConsumeConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPConsumeConfig")
+	proto.RegisterType((*AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPEventSource")
+	proto.RegisterType((*AMQPExchangeDeclareConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPExchangeDeclareConfig")
+	proto.RegisterType((*AMQPQoSConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPQoSConfig")
+	proto.RegisterType((*AMQPQueueDeclareConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPQueueDeclareConfig")
+	proto.RegisterType((*AzureEventsHubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AzureEventsHubEventSource")
+	proto.RegisterType((*CalendarCatchUp)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarCatchUp")
+	proto.RegisterType((*CalendarEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarEventSource")
+	proto.RegisterType((*CalendarPersistence)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarPersistence")
+	proto.RegisterType((*EmitterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EmitterEventSource")
+	proto.RegisterType((*EventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSource")
+	proto.RegisterType((*EventSourceList)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceList")
+	proto.RegisterType((*EventSourceSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec")
+	proto.RegisterMapType((map[string]AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.AmqpEntry")
+	proto.RegisterMapType((map[string]AzureEventsHubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.AzureEventsHubEntry")
+	proto.RegisterMapType((map[string]CalendarEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.CalendarEntry")
+	proto.RegisterMapType((map[string]EmitterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.EmitterEntry")
+	proto.RegisterMapType((map[string]FileEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.FileEntry")
+	proto.RegisterMapType((map[string]GenericEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GenericEntry")
+	proto.RegisterMapType((map[string]GithubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GithubEntry")
+	proto.RegisterMapType((map[string]GitlabEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GitlabEntry")
+	proto.RegisterMapType((map[string]HDFSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.HdfsEntry")
+	proto.RegisterMapType((map[string]KafkaEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.KafkaEntry")
 	proto.RegisterMapType((map[string]common.S3Artifact)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.MinioEntry")
 	proto.RegisterMapType((map[string]MQTTEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.MqttEntry")
 	proto.RegisterMapType((map[string]NATSEventsSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.NatsEntry")
@@ -951,6 +1321,7 @@ func init() {
 	proto.RegisterType((*GithubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GithubEventSource")
 	proto.RegisterType((*GitlabEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GitlabEventSource")
 	proto.RegisterType((*HDFSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.HDFSEventSource")
+	proto.RegisterType((*KafkaConsumerGroup)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaConsumerGroup")
 	proto.RegisterType((*KafkaEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaEventSource")
 	proto.RegisterType((*MQTTEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.MQTTEventSource")
 	proto.RegisterType((*NATSEventsSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.NATSEventsSource")
@@ -959,6 +1330,8 @@ func init() {
 	proto.RegisterType((*RedisEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.RedisEventSource")
 	proto.RegisterType((*ResourceEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ResourceEventSource")
 	proto.RegisterType((*ResourceFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ResourceFilter")
+	proto.RegisterType((*ResourceJSONPathFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ResourceJSONPathFilter")
+	proto.RegisterType((*SASLConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SASLConfig")
 	proto.RegisterType((*SNSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SNSEventSource")
 	proto.RegisterType((*SQSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SQSEventSource")
 	proto.RegisterType((*Selector)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.Selector")
@@ -968,7 +1341,11 @@ func init() {
 	proto.RegisterType((*StripeEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StripeEventSource")
 	proto.RegisterType((*TLSConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.TLSConfig")
 	proto.RegisterType((*WatchPathConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WatchPathConfig")
+	proto.RegisterType((*WebhookAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookAuth")
+	proto.RegisterType((*WebhookBasicAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookBasicAuth")
 	proto.RegisterType((*WebhookContext)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookContext")
+	proto.RegisterType((*WebhookHMAC)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookHMAC")
+	proto.RegisterType((*WebhookRateLimit)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookRateLimit")
 }
 
 func init() {
@@ -976,252 +1353,389 @@ func init() {
 }
 
 var fileDescriptor_c9ac5d6cd016403b = []byte{
-	// 3920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5d, 0x6f, 0x1b, 0x49,
-	0x72, 0x1e, 0x92, 0xa2, 0xc8, 0xa6, 0x3e, 0xac, 0xf1, 0xda, 0x66, 0x84, 0x9c, 0x64, 0x70, 0x11,
-	0xc3, 0x9b, 0xdb, 0xa3, 0x62, 0xe7, 0x03, 0x9b, 0x5d, 0x64, 0x03, 0x52, 0x96, 0x6d, 0xad, 0x2c,
-	0x59, 0xaa, 0x91, 0xd7, 0xf7, 0x85, 0xbb, 0x34, 0x67, 0x9a, 0xe4, 0x2c, 0x87, 0x33, 0xa3, 0x99,
-	0xa6, 0x6c, 0x2d, 0x90, 0x4f, 0x20, 0xdf, 0x77, 0xc9, 0x25, 0xc0, 0x1d, 0x02, 0xe4, 0xed, 0x90,
-	0x97, 0x24, 0x7f, 0x21, 0x3f, 0x60, 0x1f, 0xef, 0x29, 0x38, 0x20, 0x88, 0xb0, 0x56, 0xde, 0x12,
-	0x20, 0x40, 0x1e, 0x92, 0x87, 0x7b, 0x0a, 0xfa, 0x63, 0x7a, 0x3e, 0x38, 0x92, 0x29, 0x4b, 0xb4,
-	0x5f, 0xee, 0x65, 0x57, 0xac, 0xaa, 0xae, 0xaa, 0xae, 0xaa, 0xae, 0xae, 0xee, 0xea, 0x31, 0xda,
-	0xee, 0xd9, 0xb4, 0x3f, 0xea, 0x34, 0x4d, 0x6f, 0xb8, 0x86, 0x83, 0x9e, 0xe7, 0x07, 0xde, 0x67,
-	0xfc, 0x8f, 0xaf, 0x91, 0x43, 0xe2, 0xd2, 0x70, 0xcd, 0x1f, 0xf4, 0xd6, 0xb0, 0x6f, 0x87, 0x6b,
-	0xe2, 0xb7, 0x37, 0x0a, 0x4c, 0xb2, 0x76, 0x78, 0x17, 0x3b, 0x7e, 0x1f, 0xdf, 0x5d, 0xeb, 0x11,
-	0x97, 0x04, 0x98, 0x12, 0xab, 0xe9, 0x07, 0x1e, 0xf5, 0xf4, 0xdf, 0x8a, 0xd9, 0x35, 0x23, 0x76,
-	0xfc, 0x8f, 0xef, 0x8a, 0xe1, 0x4d, 0x7f, 0xd0, 0x6b, 0x32, 0x76, 0xcd, 0x04, 0xbb, 0x66, 0xc4,
-	0x6e, 0xf9, 0xb7, 0x27, 0xd6, 0xc6, 0xf4, 0x86, 0x43, 0xcf, 0xcd, 0xca, 0x5f, 0xfe, 0x5a, 0x82,
-	0x41, 0xcf, 0xeb, 0x79, 0x6b, 0x1c, 0xdc, 0x19, 0x75, 0xf9, 0x2f, 0xfe, 0x83, 0xff, 0x25, 0xc9,
-	0x1b, 0x83, 0x0f, 0xc2, 0xa6, 0xed, 0x31, 0x96, 0x6b, 0xa6, 0x17, 0xb0, 0x89, 0x8d, 0xb1, 0xfc,
-	0xb5, 0x98, 0x66, 0x88, 0xcd, 0xbe, 0xed, 0x92, 0xe0, 0x28, 0xd6, 0x63, 0x48, 0x28, 0xce, 0x1b,
-	0xb5, 0x76, 0xda, 0xa8, 0x60, 0xe4, 0x52, 0x7b, 0x48, 0xc6, 0x06, 0xfc, 0xc6, 0xab, 0x06, 0x84,
-	0x66, 0x9f, 0x0c, 0x71, 0x76, 0x5c, 0xe3, 0x3f, 0x8b, 0x68, 0xb1, 0xb5, 0xbd, 0xb7, 0xbb, 0xc1,
-	0x0c, 0x64, 0x70, 0x7b, 0xea, 0x5f, 0x41, 0xc5, 0x51, 0xe0, 0xd4, 0xb5, 0x5b, 0xda, 0x9d, 0x6a,
-	0xbb, 0xf6, 0xc5, 0xf1, 0xea, 0x95, 0x93, 0xe3, 0xd5, 0xe2, 0x53, 0x78, 0x0c, 0x0c, 0xae, 0x7f,
-	0x80, 0xe6, 0xc8, 0x0b, 0xb3, 0x8f, 0xdd, 0x1e, 0xd9, 0xc1, 0x43, 0x52, 0x2f, 0x70, 0xba, 0x77,
-	0x24, 0xdd, 0xdc, 0x46, 0x02, 0x07, 0x29, 0xca, 0xe4, 0xc8, 0xfd, 0x23, 0x9f, 0xd4, 0x8b, 0xf9,
-	0x23, 0x19, 0x0e, 0x52, 0x94, 0xfa, 0x3d, 0x84, 0x02, 0x6f, 0x44, 0x6d, 0xb7, 0xb7, 0x45, 0x8e,
-	0xea, 0x25, 0x3e, 0x4e, 0x97, 0xe3, 0x10, 0x28, 0x0c, 0x24, 0xa8, 0xf4, 0xdf, 0x45, 0x4b, 0xa6,
-	0xe7, 0xba, 0xc4, 0xa4, 0xb6, 0xe7, 0xb6, 0xb1, 0x39, 0xf0, 0xba, 0xdd, 0xfa, 0xcc, 0x2d, 0xed,
-	0x4e, 0xed, 0xde, 0x07, 0xcd, 0x89, 0x03, 0x4d, 0x44, 0x4a, 0x53, 0x8e, 0x6f, 0x5f, 0x3f, 0x39,
-	0x5e, 0x5d, 0x5a, 0xcf, 0xb2, 0x85, 0x71, 0x49, 0xfa, 0xfb, 0xa8, 0xf2, 0x59, 0xe8, 0xb9, 0x6d,
-	0xcf, 0x3a, 0xaa, 0x97, 0x6f, 0x69, 0x77, 0x2a, 0xed, 0xab, 0x52, 0xe1, 0xca, 0x27, 0xc6, 0x93,
-	0x1d, 0x06, 0x07, 0x45, 0xa1, 0x9b, 0xa8, 0x48, 0x9d, 0xb0, 0x3e, 0xcb, 0xd5, 0x7b, 0xd4, 0xbc,
-	0xd0, 0x3a, 0x68, 0xee, 0x3f, 0x36, 0xd6, 0x3d, 0xb7, 0x6b, 0xf7, 0xda, 0xb3, 0xcc, 0x73, 0xfb,
-	0x8f, 0x0d, 0x60, 0xdc, 0x1b, 0xff, 0x53, 0x40, 0xbf, 0xd0, 0xfa, 0x7c, 0x14, 0x10, 0xee, 0xed,
-	0xf0, 0xd1, 0xa8, 0x93, 0x74, 0xfb, 0x2d, 0x54, 0xea, 0x1e, 0x58, 0xae, 0xf4, 0xfb, 0x9c, 0x54,
-	0xb6, 0xf4, 0x60, 0xef, 0xfe, 0x0e, 0x70, 0x8c, 0xee, 0xa3, 0x6b, 0x61, 0x1f, 0x07, 0xc4, 0x6a,
-	0x99, 0x26, 0x09, 0xc3, 0x2d, 0x72, 0xa4, 0x02, 0xa0, 0x76, 0xef, 0x97, 0x9a, 0x22, 0x04, 0x99,
-	0x5e, 0x4d, 0xb6, 0x1a, 0x9a, 0x87, 0x77, 0x9b, 0x06, 0x31, 0x03, 0x42, 0xb7, 0xc8, 0x91, 0x41,
-	0x1c, 0x62, 0x52, 0x2f, 0x68, 0xdf, 0x3c, 0x39, 0x5e, 0xbd, 0x66, 0x8c, 0x73, 0x81, 0x3c, 0xd6,
-	0xba, 0x85, 0x16, 0x33, 0x60, 0x1e, 0x34, 0x13, 0x4b, 0xbb, 0x76, 0x72, 0xbc, 0xba, 0x98, 0x91,
-	0x06, 0x59, 0x96, 0xfa, 0x7b, 0x68, 0xb6, 0x3f, 0xea, 0xf0, 0xb9, 0x88, 0xd0, 0x5a, 0x94, 0x93,
-	0x9f, 0x7d, 0x24, 0xc0, 0x10, 0xe1, 0xf5, 0x35, 0x54, 0x75, 0xf1, 0x90, 0x84, 0x3e, 0x36, 0x09,
-	0x0f, 0xa6, 0x6a, 0x7b, 0x49, 0x12, 0x57, 0x77, 0x22, 0x04, 0xc4, 0x34, 0x8d, 0x7f, 0x2a, 0xa0,
-	0x6b, 0xeb, 0xd8, 0x21, 0xae, 0x85, 0x83, 0xa4, 0xb5, 0xdf, 0x47, 0x15, 0xb6, 0x24, 0xad, 0x91,
-	0x43, 0xa4, 0xc5, 0x55, 0x78, 0x18, 0x12, 0x0e, 0x8a, 0x82, 0x51, 0xdb, 0x2e, 0x25, 0xc1, 0x21,
-	0x76, 0xe4, 0x7a, 0x53, 0xd4, 0x9b, 0x12, 0x0e, 0x8a, 0x42, 0xff, 0x10, 0x2d, 0x90, 0x17, 0xa6,
-	0x33, 0x0a, 0x6d, 0xcf, 0xbd, 0x8f, 0x29, 0x09, 0xeb, 0xc5, 0x5b, 0x45, 0xb6, 0x62, 0x4e, 0x8e,
-	0x57, 0x17, 0x36, 0x52, 0x18, 0xc8, 0x50, 0x32, 0x49, 0x2c, 0x5f, 0x7c, 0xee, 0xb9, 0x91, 0x31,
-	0x94, 0xa4, 0x7d, 0x09, 0x07, 0x45, 0xa1, 0x6f, 0xa3, 0xda, 0x28, 0x24, 0xc1, 0x2e, 0x3e, 0x72,
-	0x3c, 0x6c, 0x71, 0x83, 0xcc, 0xb5, 0xbf, 0x7a, 0x72, 0xbc, 0x5a, 0x7b, 0x1a, 0x83, 0x7f, 0x76,
-	0xbc, 0x5a, 0x27, 0xae, 0xe9, 0x59, 0xb6, 0xdb, 0x5b, 0x63, 0x11, 0xdf, 0x04, 0xfc, 0x7c, 0x9b,
-	0x84, 0x21, 0xee, 0x11, 0x48, 0x8e, 0x6f, 0x7c, 0x6f, 0x06, 0xe9, 0x1b, 0x43, 0x9b, 0x52, 0x92,
-	0xb2, 0xd5, 0x6d, 0x54, 0xee, 0x04, 0xde, 0x80, 0x04, 0xd2, 0x52, 0x0b, 0x52, 0xa3, 0x72, 0x9b,
-	0x43, 0x41, 0x62, 0x59, 0x96, 0x60, 0x39, 0xc3, 0x25, 0x0e, 0x0b, 0x94, 0x42, 0x3a, 0x4b, 0xac,
-	0x2b, 0x0c, 0x24, 0xa8, 0xf4, 0x5f, 0x47, 0x35, 0xf9, 0x8b, 0xfb, 0x5f, 0xa4, 0xa4, 0x6b, 0x72,
-	0x50, 0x6d, 0x3d, 0x46, 0x41, 0x92, 0x2e, 0x1d, 0x07, 0xa5, 0x57, 0xc7, 0x81, 0xfe, 0x04, 0x55,
-	0xd8, 0x4c, 0x19, 0x40, 0x26, 0xa1, 0x09, 0x43, 0x78, 0x8e, 0x99, 0xfe, 0xa9, 0x1c, 0x0a, 0x8a,
-	0x09, 0x63, 0xe8, 0xe3, 0x30, 0x7c, 0xee, 0x05, 0x16, 0xcf, 0x2f, 0xe7, 0x63, 0xb8, 0x2b, 0x87,
-	0x82, 0x62, 0x92, 0x9f, 0x2f, 0x67, 0xdf, 0x4a, 0xbe, 0xac, 0x4c, 0x9a, 0x2f, 0xab, 0x53, 0xcd,
-	0x97, 0xff, 0x56, 0x40, 0xb5, 0x64, 0x1c, 0xfe, 0x0e, 0xaa, 0xb0, 0x0d, 0xdb, 0xc2, 0x14, 0xf3,
-	0x48, 0xac, 0xdd, 0xfb, 0x95, 0x84, 0xc9, 0xd5, 0xbe, 0x1b, 0x4b, 0x63, 0xd4, 0xcc, 0x09, 0x4f,
-	0x3a, 0x9f, 0x11, 0x93, 0x6e, 0x13, 0x8a, 0xe3, 0x78, 0x8c, 0x61, 0xa0, 0xb8, 0xea, 0x2f, 0x50,
-	0x39, 0xa4, 0x98, 0x8e, 0x42, 0x99, 0x54, 0x77, 0x2f, 0x38, 0xb3, 0x84, 0xf6, 0x06, 0xe7, 0x1b,
-	0xaf, 0x1d, 0xf1, 0x1b, 0xa4, 0x3c, 0xdd, 0x47, 0xa5, 0xd0, 0x27, 0xa6, 0x4c, 0xaf, 0x3b, 0x97,
-	0x28, 0xd7, 0x27, 0x66, 0xbc, 0x9b, 0xb0, 0x5f, 0xc0, 0x25, 0x35, 0xbe, 0xd4, 0xd0, 0x62, 0x82,
-	0xee, 0xb1, 0x1d, 0x52, 0xfd, 0xdb, 0x63, 0x16, 0x6e, 0x4e, 0x66, 0x61, 0x36, 0x9a, 0xdb, 0x57,
-	0x05, 0x4d, 0x04, 0x49, 0x58, 0xd7, 0x43, 0x33, 0x36, 0x25, 0x43, 0x66, 0xdc, 0xe2, 0x9d, 0xda,
-	0xbd, 0x4f, 0x2e, 0x6f, 0x92, 0xed, 0x79, 0x29, 0x76, 0x66, 0x93, 0x09, 0x00, 0x21, 0xa7, 0xf1,
-	0x83, 0xbb, 0xa9, 0x29, 0xb2, 0xc9, 0xeb, 0xbf, 0x87, 0x66, 0x86, 0xb6, 0x6b, 0x7b, 0x75, 0x8d,
-	0x2b, 0xf1, 0x8d, 0xcb, 0xb5, 0x74, 0x73, 0x9b, 0xf1, 0xde, 0x70, 0x69, 0x70, 0x14, 0xeb, 0xc4,
-	0x61, 0x20, 0xc4, 0xea, 0x7f, 0xa1, 0xa1, 0x8a, 0x29, 0x37, 0x24, 0x69, 0x88, 0x6f, 0x5f, 0xb2,
-	0x0e, 0x6a, 0xbf, 0xe3, 0x6a, 0x28, 0x8f, 0x44, 0x60, 0x50, 0xf2, 0xf5, 0xcf, 0x51, 0xa9, 0x6b,
-	0x3b, 0x84, 0xef, 0x4f, 0xb5, 0x7b, 0x5f, 0xbf, 0x64, 0x3d, 0x1e, 0xd8, 0x0e, 0x11, 0x3a, 0xc4,
-	0xd5, 0x8c, 0xed, 0x10, 0xe0, 0x32, 0xb9, 0x21, 0x02, 0x22, 0x78, 0xd4, 0x4b, 0x53, 0x31, 0x04,
-	0x48, 0xf6, 0x19, 0x43, 0x44, 0x60, 0x50, 0xf2, 0xf5, 0x3f, 0xd1, 0xd0, 0xec, 0x73, 0xd2, 0xe9,
-	0x7b, 0xde, 0xa0, 0x3e, 0xc3, 0x75, 0xf9, 0xd6, 0x25, 0xeb, 0xf2, 0x4c, 0x70, 0x17, 0xaa, 0xa8,
-	0x02, 0x47, 0x42, 0x21, 0x12, 0xce, 0x3c, 0x82, 0x87, 0x07, 0x7e, 0xbd, 0x3c, 0x15, 0x8f, 0xb4,
-	0x86, 0x07, 0x7e, 0xc6, 0x23, 0xec, 0xf4, 0x01, 0x5c, 0x26, 0x5b, 0x1a, 0x03, 0xdc, 0x1d, 0xe0,
-	0xfa, 0xec, 0x54, 0x96, 0xc6, 0x16, 0xe3, 0x9d, 0x59, 0x1a, 0x1c, 0x06, 0x42, 0x2c, 0x9b, 0xfb,
-	0xf0, 0x80, 0xd2, 0x7a, 0x65, 0x2a, 0x73, 0xdf, 0x3e, 0xa0, 0x34, 0x33, 0xf7, 0xed, 0xbd, 0xfd,
-	0x7d, 0xe0, 0x32, 0x99, 0x6c, 0x17, 0x53, 0xb6, 0xa3, 0x4d, 0x43, 0xf6, 0x0e, 0xa6, 0x61, 0x46,
-	0xf6, 0x4e, 0x6b, 0xdf, 0x00, 0x2e, 0x53, 0x3f, 0x44, 0xc5, 0xd0, 0x0d, 0xeb, 0x88, 0x8b, 0x7e,
-	0x76, 0xc9, 0xa2, 0x0d, 0x57, 0x4a, 0x56, 0x27, 0x49, 0x63, 0xc7, 0x00, 0x26, 0x90, 0xcb, 0x3d,
-	0x08, 0xeb, 0xb5, 0xe9, 0xc8, 0x3d, 0x18, 0x93, 0xbb, 0xc7, 0xe4, 0x1e, 0x84, 0xfa, 0x1f, 0x69,
-	0xa8, 0xec, 0x8f, 0x3a, 0xc6, 0xa8, 0x53, 0x9f, 0xe3, 0xb2, 0xbf, 0x79, 0xc9, 0xb2, 0x77, 0x39,
-	0x73, 0x21, 0x5e, 0x6d, 0xb8, 0x02, 0x08, 0x52, 0x32, 0x57, 0x42, 0x48, 0xad, 0xcf, 0x4f, 0x45,
-	0x89, 0x87, 0x9c, 0x5b, 0x46, 0x09, 0x01, 0x04, 0x29, 0x39, 0x52, 0xc2, 0xc1, 0x9d, 0xfa, 0xc2,
-	0xb4, 0x94, 0x70, 0x70, 0x8e, 0x12, 0x0e, 0x16, 0x4a, 0x38, 0xb8, 0xc3, 0x42, 0xbf, 0x6f, 0x75,
-	0xc3, 0xfa, 0xe2, 0x54, 0x42, 0xff, 0x91, 0xd5, 0xcd, 0x86, 0xfe, 0xa3, 0xfb, 0x0f, 0x0c, 0xe0,
-	0x32, 0x59, 0xca, 0x09, 0x1d, 0x6c, 0x0e, 0xea, 0x57, 0xa7, 0x92, 0x72, 0x0c, 0xc6, 0x3b, 0x93,
-	0x72, 0x38, 0x0c, 0x84, 0x58, 0xfd, 0x47, 0x1a, 0xaa, 0x85, 0xd4, 0x0b, 0x70, 0x8f, 0x3c, 0x0c,
-	0x6c, 0xab, 0xbe, 0xc4, 0xd5, 0xf8, 0xee, 0x65, 0xab, 0x11, 0x4b, 0x10, 0xca, 0xa8, 0x03, 0x4e,
-	0x02, 0x03, 0x49, 0x45, 0xf4, 0x1f, 0x6b, 0x68, 0x01, 0xa7, 0xee, 0x0a, 0xea, 0x3a, 0xd7, 0xad,
-	0x73, 0xd9, 0x5b, 0x42, 0xfa, 0x42, 0x82, 0xab, 0x77, 0x43, 0xaa, 0xb7, 0x90, 0x46, 0x42, 0x46,
-	0x23, 0x1e, 0xbe, 0x21, 0x0d, 0x6c, 0x9f, 0xd4, 0xaf, 0x4d, 0x25, 0x7c, 0x0d, 0xce, 0x3c, 0x13,
-	0xbe, 0x02, 0x08, 0x52, 0x32, 0xdf, 0xba, 0x89, 0x38, 0xb4, 0xd6, 0xdf, 0x99, 0xca, 0xd6, 0x1d,
-	0x1d, 0x89, 0xd3, 0x5b, 0xb7, 0x84, 0x42, 0x24, 0x9c, 0xc5, 0x72, 0x40, 0x2c, 0x3b, 0xac, 0x5f,
-	0x9f, 0x4a, 0x2c, 0x03, 0xe3, 0x9d, 0x89, 0x65, 0x0e, 0x03, 0x21, 0x96, 0xa5, 0x73, 0x37, 0x3c,
-	0xa8, 0xdf, 0x98, 0x4a, 0x3a, 0xdf, 0x09, 0x0f, 0x32, 0xe9, 0x7c, 0xc7, 0xd8, 0x03, 0x26, 0x90,
-	0x3b, 0x80, 0xdf, 0x6b, 0xda, 0x66, 0xfd, 0xe6, 0x54, 0x1c, 0xf0, 0x50, 0x70, 0xcf, 0x38, 0x40,
-	0x42, 0x21, 0x12, 0xbe, 0x3c, 0x42, 0x28, 0x2e, 0xbf, 0xf5, 0xab, 0xa8, 0x38, 0x20, 0x47, 0xe2,
-	0xca, 0x02, 0xd8, 0x9f, 0xfa, 0x1e, 0x9a, 0x39, 0xc4, 0xce, 0x28, 0xba, 0x31, 0xfb, 0xe8, 0xdc,
-	0xa7, 0x6a, 0xe3, 0x57, 0x5b, 0x01, 0xb5, 0xbb, 0xd8, 0xa4, 0x20, 0x38, 0x7d, 0x58, 0xf8, 0x40,
-	0x5b, 0xfe, 0x6b, 0x0d, 0xcd, 0xa7, 0x4a, 0xee, 0x1c, 0xd1, 0xfd, 0xb4, 0x68, 0xb8, 0xa0, 0x81,
-	0x72, 0x6e, 0xb4, 0x92, 0x1a, 0xfd, 0xa9, 0x86, 0xaa, 0xaa, 0xf8, 0xce, 0xd1, 0xc6, 0x4a, 0x6b,
-	0x73, 0xd1, 0xd3, 0x26, 0x17, 0x95, 0xaf, 0x09, 0xb3, 0x4d, 0xaa, 0x0a, 0x9f, 0xbe, 0x6d, 0x94,
-	0xb8, 0x7c, 0x8d, 0xfe, 0x5c, 0x43, 0x73, 0xc9, 0x5a, 0x3c, 0x47, 0x21, 0x33, 0xad, 0xd0, 0xf6,
-	0x05, 0x15, 0x92, 0xd2, 0xd6, 0x3d, 0x97, 0x92, 0x17, 0x34, 0xeb, 0x27, 0x55, 0x92, 0x4f, 0xdf,
-	0x4f, 0x99, 0x46, 0x43, 0xc6, 0x2a, 0x28, 0xae, 0xcf, 0x73, 0x54, 0x21, 0x69, 0x55, 0x9e, 0x5c,
-	0x50, 0x15, 0x21, 0xeb, 0xf4, 0xe8, 0x55, 0xc5, 0xfa, 0xf4, 0xad, 0xc2, 0x0e, 0x01, 0xa7, 0x68,
-	0xf2, 0x67, 0x1a, 0xaa, 0xaa, 0xd2, 0x7d, 0xfa, 0x46, 0x61, 0x47, 0x02, 0xb1, 0xb9, 0x8e, 0xab,
-	0xf2, 0xc7, 0x1a, 0xaa, 0x44, 0xa5, 0xfc, 0xf4, 0x43, 0xd6, 0xd8, 0x31, 0x4e, 0x31, 0x09, 0xd7,
-	0xe3, 0xe0, 0x8d, 0xe9, 0xb1, 0x77, 0x9a, 0x1e, 0x7f, 0xa9, 0xa1, 0x5a, 0xa2, 0xcc, 0xcf, 0x51,
-	0xa5, 0x9b, 0x56, 0xe5, 0xa2, 0x57, 0x79, 0x52, 0xd8, 0xe9, 0xda, 0x24, 0xea, 0xfd, 0xe9, 0x6b,
-	0x23, 0x85, 0x9d, 0xa9, 0x4d, 0x54, 0xf8, 0xbf, 0x11, 0x6d, 0x98, 0xb0, 0xd3, 0x97, 0xb3, 0x3a,
-	0x04, 0x4c, 0x7f, 0x39, 0xb3, 0xc3, 0xc5, 0x19, 0x49, 0x2e, 0x3e, 0x11, 0x4c, 0x7f, 0x3d, 0x0b,
-	0x59, 0xf9, 0xba, 0xfc, 0x50, 0x43, 0x57, 0xb3, 0xc7, 0x82, 0x1c, 0x8d, 0x06, 0x69, 0x8d, 0x9e,
-	0x5e, 0x54, 0xa3, 0x84, 0xc4, 0x7c, 0xbd, 0xfe, 0x5e, 0x43, 0xd7, 0x72, 0x8e, 0x04, 0x39, 0xaa,
-	0xb9, 0x69, 0xd5, 0x2e, 0x7a, 0x6e, 0x3c, 0xb5, 0x31, 0x9a, 0x8d, 0xec, 0xc4, 0x99, 0x60, 0xfa,
-	0x91, 0x2d, 0x85, 0xe5, 0x6b, 0xf3, 0x7d, 0x0d, 0xcd, 0x25, 0xcf, 0x06, 0x39, 0xea, 0xf4, 0xd2,
-	0xea, 0xec, 0x5d, 0xb4, 0x30, 0x1e, 0x6b, 0xce, 0x65, 0xe3, 0x3b, 0x3e, 0x25, 0x4c, 0x3f, 0xbe,
-	0x85, 0xac, 0xd3, 0xf7, 0x89, 0xe8, 0xcc, 0x30, 0xfd, 0x7d, 0x62, 0xc7, 0xd8, 0x3b, 0xc3, 0x47,
-	0xc9, 0xe3, 0xc3, 0xf4, 0x7d, 0x14, 0x49, 0xcb, 0xd5, 0xa7, 0xe1, 0xa3, 0xa5, 0xb1, 0xa6, 0x90,
-	0xfe, 0x2d, 0x54, 0x35, 0x03, 0x82, 0x29, 0xb1, 0x5a, 0x54, 0xf6, 0x5d, 0x7e, 0x79, 0xb2, 0xbe,
-	0xcb, 0xbe, 0x3d, 0x24, 0x71, 0xe7, 0x73, 0x3d, 0x62, 0x02, 0x31, 0xbf, 0xc6, 0x1f, 0x16, 0xd0,
-	0x62, 0xa6, 0x42, 0xd7, 0xd7, 0x50, 0x95, 0xeb, 0xce, 0x9f, 0x81, 0x68, 0xe9, 0xf6, 0xe9, 0x46,
-	0x84, 0x80, 0x98, 0x46, 0xff, 0x1b, 0x0d, 0x2d, 0x3e, 0xc7, 0xd4, 0xec, 0xef, 0x62, 0xda, 0x17,
-	0xcd, 0xba, 0x4b, 0xca, 0xd7, 0xcf, 0xd2, 0x5c, 0xdb, 0x37, 0xa5, 0x1e, 0x8b, 0x19, 0x04, 0x64,
-	0xe5, 0xeb, 0xef, 0xa1, 0x59, 0xdf, 0x73, 0x1c, 0xdb, 0xed, 0xf1, 0xae, 0x59, 0x25, 0x3e, 0x19,
-	0xee, 0x0a, 0x30, 0x44, 0xf8, 0xc6, 0x6f, 0x22, 0x7d, 0xdc, 0x2d, 0xfa, 0xbb, 0x91, 0xe3, 0x85,
-	0x05, 0xd4, 0xa9, 0xfa, 0x53, 0x06, 0x94, 0x4e, 0x6b, 0xfc, 0x7b, 0x19, 0x2d, 0x8d, 0xed, 0xb6,
-	0xfa, 0x32, 0x2a, 0xd8, 0x16, 0x1f, 0x57, 0x6c, 0x23, 0x39, 0xae, 0xb0, 0x69, 0x41, 0xc1, 0xb6,
-	0x74, 0x1a, 0xb7, 0x12, 0xa6, 0x71, 0x80, 0x68, 0xd7, 0x72, 0x1b, 0x07, 0xef, 0xa2, 0x19, 0xef,
-	0xb9, 0x4b, 0x02, 0xd9, 0x42, 0x57, 0x93, 0x79, 0xc2, 0x80, 0x20, 0x70, 0xfc, 0x1d, 0x0f, 0xf1,
-	0xbd, 0xd0, 0xa6, 0x5e, 0x30, 0xfe, 0x8e, 0x47, 0x61, 0x20, 0x41, 0xa5, 0x37, 0x50, 0x59, 0x68,
-	0xc5, 0x1b, 0x23, 0xd5, 0x36, 0x3a, 0x39, 0x5e, 0x2d, 0x8b, 0x44, 0x0d, 0x12, 0xa3, 0x3f, 0x41,
-	0x15, 0xec, 0xdb, 0xfb, 0xde, 0x80, 0xb8, 0xaf, 0xd1, 0x0c, 0x6f, 0xed, 0x6e, 0xf2, 0xa1, 0xa0,
-	0x98, 0xe8, 0xdf, 0x41, 0xf3, 0x72, 0x62, 0x62, 0x8c, 0x6c, 0x84, 0x4f, 0xc8, 0x75, 0xe9, 0xe4,
-	0x78, 0x75, 0xfe, 0x59, 0x72, 0x3c, 0xa4, 0xd9, 0x89, 0x07, 0x1d, 0x21, 0x31, 0x47, 0x01, 0xc9,
-	0x76, 0xbb, 0x37, 0x25, 0x1c, 0x14, 0x85, 0x7e, 0x1b, 0x95, 0xb1, 0x49, 0xed, 0x43, 0xc2, 0x1b,
-	0xde, 0x95, 0xf8, 0x2a, 0xaa, 0xc5, 0xa1, 0x20, 0xb1, 0xfc, 0x31, 0x03, 0x73, 0x92, 0x5c, 0x58,
-	0x28, 0xf3, 0x98, 0x21, 0x46, 0x41, 0x92, 0x4e, 0xff, 0x08, 0xcd, 0x8b, 0x00, 0x69, 0xe3, 0x90,
-	0x3c, 0x85, 0xc7, 0xf5, 0x1a, 0x1f, 0x78, 0x5d, 0x0e, 0x9c, 0x7f, 0x98, 0x44, 0x42, 0x9a, 0x56,
-	0x6f, 0xa1, 0x45, 0x01, 0x78, 0xea, 0x3b, 0x1e, 0xb6, 0xd8, 0xf0, 0x39, 0x3e, 0x5c, 0x2d, 0xa4,
-	0x87, 0x69, 0x34, 0x64, 0xe9, 0xd3, 0x8f, 0x29, 0xe6, 0x27, 0x78, 0x4c, 0xf1, 0x09, 0xd2, 0x2d,
-	0xe2, 0x10, 0x4a, 0x1e, 0x79, 0xde, 0xe0, 0x89, 0xfb, 0xc0, 0x76, 0xed, 0xb0, 0x5f, 0x5f, 0xe0,
-	0xb6, 0x59, 0x96, 0x23, 0xf5, 0xfb, 0x63, 0x14, 0x90, 0x33, 0xaa, 0xf1, 0xa3, 0x12, 0x5f, 0x5f,
-	0xe9, 0xfa, 0x31, 0xb9, 0x86, 0xb4, 0x37, 0xb7, 0x86, 0xd6, 0x50, 0x95, 0xb1, 0x25, 0x26, 0xdd,
-	0xbc, 0xcf, 0x5d, 0x9d, 0x30, 0xc4, 0x6e, 0x84, 0x80, 0x98, 0x26, 0xb1, 0x36, 0x8a, 0xa7, 0xae,
-	0x8d, 0xaf, 0xa3, 0x1a, 0xe6, 0x4f, 0x9d, 0xc4, 0xf2, 0x28, 0x9d, 0x27, 0x90, 0x17, 0x59, 0xdc,
-	0xb4, 0xe2, 0xd1, 0x90, 0x64, 0xa5, 0x1b, 0xe8, 0x3a, 0x71, 0x71, 0xc7, 0x21, 0x86, 0xf1, 0xf8,
-	0x53, 0x12, 0xd8, 0x5d, 0xdb, 0xc4, 0xd4, 0xf6, 0x5c, 0xfe, 0xc0, 0xa5, 0xd2, 0xfe, 0x8a, 0x54,
-	0xfd, 0xfa, 0x46, 0x1e, 0x11, 0xe4, 0x8f, 0x95, 0xc1, 0xe8, 0x60, 0x15, 0x8c, 0xe5, 0xb1, 0x60,
-	0x8c, 0x91, 0x90, 0xa6, 0x3d, 0x25, 0x30, 0x2a, 0xaf, 0x15, 0x18, 0x7f, 0x35, 0x8b, 0x16, 0x33,
-	0xc5, 0x7c, 0xee, 0x36, 0xa4, 0xbd, 0xe5, 0x6d, 0xe8, 0x16, 0x2a, 0x51, 0xb6, 0xda, 0x0b, 0xe9,
-	0x77, 0x7b, 0x7c, 0x99, 0x73, 0x0c, 0x33, 0xa9, 0xd9, 0x27, 0xe6, 0x20, 0x7a, 0x2a, 0x26, 0x53,
-	0xb4, 0x32, 0xe9, 0x7a, 0x12, 0x09, 0x69, 0x5a, 0xfd, 0xab, 0xa8, 0x8a, 0x2d, 0x2b, 0x20, 0x61,
-	0x48, 0x42, 0xde, 0x26, 0xaf, 0xb6, 0xe7, 0x59, 0x3c, 0xb6, 0x22, 0x20, 0xc4, 0x78, 0x96, 0xd6,
-	0xfa, 0x56, 0x37, 0x7c, 0x1a, 0x92, 0x40, 0xbe, 0x8e, 0x53, 0x69, 0x8d, 0x99, 0x92, 0xc1, 0x41,
-	0x51, 0xe8, 0x16, 0x5a, 0x1c, 0x04, 0x9d, 0xf5, 0x75, 0x6c, 0xf6, 0x89, 0x4c, 0xb3, 0xe5, 0x73,
-	0xbf, 0xee, 0xdb, 0x4a, 0x73, 0x80, 0x2c, 0x4b, 0x29, 0x65, 0x8b, 0x1c, 0x51, 0xdc, 0x79, 0x9d,
-	0x64, 0x1e, 0x49, 0x49, 0x72, 0x80, 0x2c, 0x4b, 0x96, 0x7a, 0x07, 0x41, 0x27, 0x7a, 0xa7, 0xc5,
-	0x43, 0x2e, 0x91, 0x7a, 0xb7, 0x62, 0x14, 0x24, 0xe9, 0x98, 0xc1, 0x06, 0x41, 0x07, 0x08, 0x76,
-	0x86, 0x72, 0xc1, 0x2b, 0x83, 0x6d, 0x49, 0x38, 0x28, 0x0a, 0xdd, 0x47, 0x3a, 0x9b, 0x1d, 0xf7,
-	0xbb, 0xf8, 0xef, 0x36, 0xf6, 0x79, 0x9a, 0xaf, 0xdd, 0xbb, 0x93, 0x37, 0x1b, 0x45, 0x94, 0x9c,
-	0xd0, 0x0d, 0xb6, 0x08, 0xb6, 0xc6, 0xf8, 0x40, 0x0e, 0x6f, 0xfd, 0x1b, 0xe8, 0xe6, 0x20, 0xe8,
-	0x18, 0x24, 0x38, 0xb4, 0x4d, 0xb2, 0x1b, 0xd8, 0xae, 0x69, 0xfb, 0x58, 0x3c, 0x95, 0x13, 0x9b,
-	0xc4, 0xaa, 0x54, 0xf7, 0xe6, 0x56, 0x3e, 0x19, 0x9c, 0x36, 0x3e, 0x9d, 0xf5, 0xe7, 0x26, 0x78,
-	0x4a, 0xf9, 0x5f, 0x05, 0x74, 0x35, 0x7b, 0x6f, 0xf7, 0xaa, 0xc7, 0xca, 0x2c, 0xa3, 0xe2, 0x80,
-	0xda, 0x3c, 0x2d, 0x15, 0x32, 0x19, 0x35, 0x42, 0x40, 0x4c, 0xc3, 0xca, 0x18, 0xea, 0xf9, 0xb6,
-	0x99, 0x2d, 0x63, 0xf6, 0x19, 0x10, 0x04, 0x2e, 0xff, 0xa9, 0x5c, 0xe9, 0x8d, 0x3d, 0x95, 0x93,
-	0x8f, 0xdf, 0x66, 0xa6, 0xfa, 0xf8, 0xed, 0x87, 0x45, 0xb4, 0x98, 0xb9, 0x9a, 0x7c, 0x95, 0xb1,
-	0x95, 0xed, 0x0a, 0x67, 0xd8, 0xee, 0x7d, 0x54, 0x31, 0x1d, 0x9b, 0xb8, 0x74, 0xd3, 0x92, 0x36,
-	0x8e, 0x1f, 0x08, 0x49, 0x38, 0x28, 0x8a, 0xb7, 0x6d, 0xe9, 0xe4, 0xa3, 0xc4, 0x99, 0x49, 0x1f,
-	0x25, 0x96, 0xa7, 0xea, 0x97, 0xff, 0x2e, 0xa0, 0xab, 0xd9, 0x8b, 0xda, 0x57, 0x39, 0xe6, 0x3d,
-	0x34, 0x1b, 0x8e, 0xf8, 0x7b, 0x43, 0xe9, 0x1a, 0x75, 0x52, 0x31, 0x04, 0x18, 0x22, 0x7c, 0xbe,
-	0xc1, 0x8b, 0x6f, 0xc5, 0xe0, 0xa5, 0x49, 0x0d, 0x3e, 0xdd, 0x85, 0xf0, 0x8f, 0x45, 0xb4, 0x90,
-	0x3e, 0xdf, 0xb3, 0x64, 0xdf, 0xf7, 0x42, 0x2a, 0xb7, 0x40, 0x69, 0x76, 0x95, 0xec, 0x1f, 0xc5,
-	0x28, 0x48, 0xd2, 0x4d, 0xb6, 0x3e, 0xde, 0x43, 0xb3, 0xf2, 0xa1, 0xb1, 0x5c, 0x1e, 0xca, 0x57,
-	0xf2, 0x31, 0x32, 0x44, 0xf8, 0x9f, 0x2f, 0x8e, 0x31, 0x5f, 0xfd, 0x6b, 0x11, 0x2d, 0x8d, 0x5d,
-	0x94, 0xa7, 0xcb, 0x6a, 0x6d, 0x82, 0xb2, 0xfa, 0x63, 0xb4, 0xc0, 0x9d, 0xa1, 0x90, 0xd2, 0x63,
-	0xea, 0x5d, 0xc2, 0x7e, 0x0a, 0x0b, 0x19, 0xea, 0xc9, 0x36, 0x91, 0x16, 0x5a, 0x34, 0x03, 0x62,
-	0x11, 0x97, 0xda, 0xd8, 0x09, 0x1f, 0xd8, 0x4e, 0xf4, 0x90, 0x5c, 0x95, 0x7e, 0xeb, 0x69, 0x34,
-	0x64, 0xe9, 0xf5, 0x4f, 0xd1, 0x0d, 0x51, 0x44, 0x3f, 0xf3, 0x82, 0x41, 0xd7, 0xf1, 0x9e, 0x6f,
-	0x72, 0x34, 0x8d, 0xfc, 0xb1, 0x22, 0x39, 0xdd, 0xd8, 0xc8, 0xa5, 0x82, 0x53, 0x46, 0xeb, 0x1d,
-	0xb4, 0x2c, 0x0a, 0x62, 0x63, 0xd4, 0x09, 0xcd, 0xc0, 0xf6, 0x99, 0xdb, 0x55, 0x39, 0x2d, 0xbe,
-	0x66, 0x69, 0x48, 0xde, 0xcb, 0xf7, 0x4f, 0xa5, 0x84, 0x33, 0xb8, 0xa4, 0xa2, 0x67, 0xf6, 0x55,
-	0xd1, 0xd3, 0xf8, 0xbf, 0x02, 0xba, 0x9a, 0xbd, 0xee, 0x7b, 0xdd, 0x65, 0x98, 0x7c, 0x39, 0x5f,
-	0xb8, 0x8c, 0x97, 0xf3, 0xa9, 0x4a, 0xa6, 0x38, 0xc1, 0xf9, 0x75, 0x19, 0x15, 0xac, 0x0e, 0xf7,
-	0xf6, 0x4c, 0x7c, 0x7b, 0x73, 0xbf, 0x0d, 0x05, 0xab, 0xa3, 0xdf, 0x41, 0x15, 0xb9, 0xbe, 0xa3,
-	0x0b, 0x0f, 0x2e, 0x56, 0x2e, 0xfe, 0x10, 0x14, 0xf6, 0xcd, 0xac, 0xa8, 0xef, 0x17, 0xd1, 0xb5,
-	0x9c, 0x8e, 0x76, 0x7a, 0xce, 0xda, 0x04, 0x73, 0x3e, 0x40, 0xe5, 0xae, 0xed, 0x50, 0x12, 0x5c,
-	0xd2, 0xa5, 0x54, 0xa4, 0xd4, 0x03, 0xce, 0x54, 0x9c, 0x7c, 0xc5, 0xdf, 0x20, 0x05, 0xe9, 0xdf,
-	0xd3, 0xd0, 0x3b, 0xbd, 0xc0, 0x1b, 0xf9, 0x9f, 0x92, 0x20, 0x64, 0x75, 0x5e, 0xf4, 0xda, 0x57,
-	0xec, 0x67, 0x1f, 0x4e, 0x76, 0xc5, 0xf9, 0x30, 0x87, 0x43, 0xfb, 0x17, 0xe5, 0x5c, 0xdf, 0xc9,
-	0xc3, 0x42, 0xae, 0x54, 0x7d, 0x1d, 0x21, 0x75, 0xa1, 0x19, 0x1d, 0xa5, 0xde, 0x3d, 0x39, 0x5e,
-	0x45, 0xea, 0xc6, 0x33, 0xfc, 0xd9, 0xf1, 0xea, 0x52, 0xca, 0xda, 0xfc, 0x14, 0x97, 0x18, 0xd6,
-	0xf8, 0xe7, 0x22, 0x5a, 0x48, 0x4f, 0x5d, 0xbf, 0x8d, 0xca, 0x7e, 0x40, 0xba, 0xf6, 0x8b, 0xec,
-	0xe7, 0x31, 0xbb, 0x1c, 0x0a, 0x12, 0xab, 0x7b, 0xa8, 0xec, 0xe0, 0x0e, 0x8b, 0x2b, 0xf1, 0xec,
-	0xfb, 0xe1, 0x45, 0x7b, 0x13, 0xd1, 0xba, 0x50, 0x02, 0x1f, 0x73, 0xf6, 0x20, 0xc5, 0x30, 0x81,
-	0x5d, 0x9b, 0x38, 0x56, 0x28, 0xdf, 0x77, 0x5f, 0xbe, 0xc0, 0x07, 0x9c, 0x3d, 0x48, 0x31, 0x89,
-	0x7b, 0xec, 0xf6, 0x91, 0xdc, 0x08, 0x2f, 0x72, 0x8f, 0xdd, 0x3e, 0x82, 0x98, 0x9f, 0x7e, 0x0f,
-	0x21, 0xdc, 0xa5, 0x24, 0x30, 0x28, 0x0e, 0xa8, 0x4c, 0xb0, 0xea, 0xee, 0xb2, 0xa5, 0x30, 0x90,
-	0xa0, 0x6a, 0x7c, 0x59, 0x44, 0x0b, 0xe9, 0x5e, 0xf6, 0x5b, 0xba, 0x59, 0x7a, 0x1f, 0x55, 0xf8,
-	0xae, 0xd3, 0x0a, 0xdc, 0xec, 0x07, 0x64, 0xfb, 0x12, 0x0e, 0x8a, 0x42, 0x07, 0x54, 0xc5, 0xaf,
-	0xf7, 0xc1, 0x9d, 0xb8, 0x1a, 0x50, 0x9f, 0xda, 0xc5, 0x6c, 0x18, 0xcf, 0x30, 0x22, 0x3f, 0xdf,
-	0x25, 0x14, 0xe7, 0xa9, 0xc0, 0x10, 0xb3, 0x39, 0xf7, 0xd7, 0x78, 0x6c, 0xa9, 0x04, 0xa4, 0xc7,
-	0xce, 0x82, 0xe5, 0xf4, 0x52, 0x01, 0x0e, 0x05, 0x89, 0x65, 0x45, 0x58, 0xe0, 0x39, 0xa4, 0x05,
-	0x3b, 0x7c, 0x6f, 0x4a, 0x14, 0x61, 0x20, 0xc0, 0x10, 0xe1, 0x1b, 0x7f, 0x5b, 0x42, 0x0b, 0xe9,
-	0x67, 0x02, 0x69, 0xf3, 0x69, 0x53, 0x30, 0x5f, 0xe1, 0x72, 0xcc, 0x17, 0x5b, 0xa3, 0x78, 0xa6,
-	0x35, 0xde, 0x45, 0x33, 0x07, 0x23, 0x32, 0x8a, 0xea, 0x13, 0x55, 0xce, 0xec, 0x31, 0x20, 0x08,
-	0x1c, 0x2b, 0x67, 0x9e, 0x63, 0x9b, 0xb2, 0x85, 0x64, 0x10, 0xd3, 0x73, 0x2d, 0x51, 0x97, 0x17,
-	0x93, 0x37, 0x59, 0x29, 0x34, 0x64, 0xe9, 0xd3, 0xee, 0x2c, 0x4f, 0xe0, 0xce, 0xc9, 0xdd, 0x74,
-	0xce, 0xcf, 0xcb, 0x3e, 0x46, 0x0b, 0x7c, 0x56, 0x2d, 0xd3, 0xf4, 0x46, 0xfc, 0xa8, 0x5a, 0x4d,
-	0x17, 0x80, 0x7b, 0x29, 0x2c, 0x64, 0xa8, 0x1b, 0xbf, 0x8f, 0x2a, 0x91, 0xfd, 0xd9, 0xd9, 0x4c,
-	0x35, 0xfc, 0xe2, 0xb3, 0x19, 0x73, 0x05, 0xef, 0xfe, 0xad, 0xa1, 0xaa, 0xe7, 0x93, 0x00, 0xe7,
-	0xdd, 0x50, 0x3c, 0x89, 0x10, 0x10, 0xd3, 0xc4, 0x5d, 0xa3, 0xe2, 0x19, 0x5d, 0xa3, 0x97, 0x05,
-	0x74, 0x35, 0xdb, 0xfe, 0xd7, 0xbf, 0x83, 0xe6, 0x43, 0xbb, 0xe7, 0xda, 0x6e, 0x4f, 0xde, 0x83,
-	0x69, 0xe7, 0x6e, 0x6a, 0x18, 0xc9, 0xf1, 0x90, 0x66, 0xa7, 0x3f, 0x60, 0x65, 0xef, 0x80, 0xb8,
-	0xe7, 0x8b, 0xcf, 0xaa, 0xa8, 0x8c, 0x07, 0xc4, 0x05, 0x31, 0x3c, 0x99, 0x22, 0x8b, 0x6f, 0xf4,
-	0xf2, 0xfd, 0x5c, 0x9f, 0x74, 0x36, 0x7e, 0x5c, 0x42, 0x37, 0xf2, 0x1f, 0x34, 0xbc, 0xa5, 0x24,
-	0x1f, 0x77, 0x03, 0x0a, 0xa7, 0x76, 0x03, 0xa8, 0x2a, 0xc3, 0x8a, 0x97, 0xf4, 0x40, 0x41, 0x19,
-	0xe0, 0x8c, 0x4a, 0x2c, 0xb9, 0xfd, 0x94, 0x5e, 0xb9, 0xfd, 0xdc, 0x46, 0xe5, 0xce, 0xc8, 0x1c,
-	0x10, 0x2a, 0x73, 0x7a, 0xfc, 0xbd, 0x2f, 0x87, 0x82, 0xc4, 0x4e, 0x9c, 0xcd, 0x59, 0x3e, 0x1e,
-	0xd1, 0xbe, 0xe8, 0x7f, 0xcc, 0x9e, 0x3f, 0x1f, 0x47, 0x63, 0x21, 0x66, 0xc3, 0x5b, 0x72, 0xbe,
-	0xfd, 0x14, 0x1e, 0xcb, 0xab, 0xde, 0xb8, 0x25, 0xc7, 0xa1, 0x20, 0xb1, 0x0d, 0x13, 0x2d, 0x8d,
-	0x99, 0x68, 0xe2, 0x8a, 0xed, 0x36, 0x2a, 0x87, 0xa3, 0x2e, 0xa3, 0x2b, 0xa4, 0xe9, 0x0c, 0x0e,
-	0x05, 0x89, 0x6d, 0xfc, 0x6f, 0x81, 0x49, 0xc9, 0xbc, 0x14, 0x79, 0x4b, 0x41, 0xf8, 0x11, 0x9a,
-	0x17, 0x35, 0xd3, 0xb3, 0x44, 0x0f, 0xba, 0x92, 0x68, 0x36, 0x24, 0x91, 0x90, 0xa6, 0xd5, 0x37,
-	0xb9, 0x55, 0xcf, 0x5d, 0x75, 0xf0, 0x90, 0x6b, 0xed, 0x6e, 0xb2, 0xa4, 0x2a, 0x19, 0x9c, 0xff,
-	0x0b, 0xed, 0xbb, 0xa8, 0xc6, 0x67, 0x2d, 0x7c, 0x24, 0xcf, 0x5e, 0xbc, 0x01, 0xb6, 0x11, 0x83,
-	0x21, 0x49, 0xd3, 0xf8, 0x17, 0x0d, 0x55, 0xd5, 0xc1, 0x89, 0x7f, 0x7e, 0x8e, 0xd7, 0x49, 0x40,
-	0x77, 0x31, 0xed, 0x4b, 0xcf, 0xc6, 0x9f, 0x9f, 0xb7, 0x22, 0x0c, 0x24, 0xa8, 0xd8, 0x46, 0x23,
-	0xee, 0x3a, 0xd5, 0xb8, 0xcc, 0x4d, 0xc3, 0x7a, 0x0a, 0x0b, 0x19, 0x6a, 0x6e, 0x6d, 0x0e, 0xd9,
-	0x22, 0x47, 0x7c, 0x78, 0xb6, 0xb5, 0x93, 0x44, 0x42, 0x9a, 0xb6, 0xf1, 0x77, 0x1a, 0xca, 0xb6,
-	0x97, 0x98, 0xd9, 0x2c, 0x3b, 0xe0, 0x66, 0x3d, 0xca, 0x9e, 0xeb, 0xee, 0x47, 0x08, 0x88, 0x69,
-	0xf4, 0x5b, 0xa8, 0xe4, 0xc7, 0x7a, 0xab, 0xf6, 0x13, 0x97, 0xc7, 0x31, 0xcc, 0x2e, 0xec, 0xff,
-	0x40, 0x7a, 0xe4, 0x85, 0x2f, 0x15, 0x54, 0x76, 0xd9, 0x55, 0x18, 0x48, 0x50, 0x35, 0xfe, 0xa1,
-	0x80, 0x16, 0xd2, 0xe1, 0xc6, 0x72, 0x08, 0x71, 0x2d, 0xdf, 0xb3, 0x5d, 0x9a, 0xfd, 0x17, 0x13,
-	0x36, 0x24, 0x1c, 0x14, 0x05, 0x5b, 0x3a, 0x43, 0x42, 0xfb, 0x9e, 0x95, 0x5d, 0x3a, 0xdb, 0x1c,
-	0x0a, 0x12, 0xcb, 0xd5, 0xf7, 0x02, 0x2a, 0xd5, 0x8a, 0xd5, 0xf7, 0x02, 0x0a, 0x1c, 0x13, 0xdd,
-	0xad, 0x96, 0x4e, 0xb9, 0x5b, 0xfd, 0x18, 0x2d, 0x84, 0x24, 0x38, 0x24, 0x81, 0xf2, 0xe0, 0x4c,
-	0xda, 0x83, 0x46, 0x0a, 0x0b, 0x19, 0x6a, 0xe6, 0x41, 0x01, 0x89, 0x3c, 0x98, 0xe9, 0x77, 0x1a,
-	0x49, 0x24, 0xa4, 0x69, 0xdb, 0xcd, 0x2f, 0x5e, 0xae, 0x5c, 0xf9, 0xc9, 0xcb, 0x95, 0x2b, 0x3f,
-	0x7d, 0xb9, 0x72, 0xe5, 0x0f, 0x4e, 0x56, 0xb4, 0x2f, 0x4e, 0x56, 0xb4, 0x9f, 0x9c, 0xac, 0x68,
-	0x3f, 0x3d, 0x59, 0xd1, 0xbe, 0x3c, 0x59, 0xd1, 0x7e, 0xf0, 0x1f, 0x2b, 0x57, 0xbe, 0x59, 0x89,
-	0x56, 0xf0, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x26, 0xc9, 0xda, 0xc2, 0xb0, 0x47, 0x00, 0x00,
+	// 5147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5d, 0x8f, 0x1c, 0xc7,
+	0x71, 0x9a, 0xdd, 0xbd, 0x8f, 0xed, 0xfb, 0x1e, 0x4a, 0xd4, 0x88, 0x88, 0x48, 0x62, 0x05, 0x0b,
+	0x52, 0x2c, 0xdf, 0x45, 0xcc, 0x97, 0x22, 0x23, 0x4a, 0x76, 0x8f, 0xc7, 0xe3, 0xe9, 0x3e, 0x78,
+	0x57, 0x7b, 0x14, 0xfd, 0x05, 0x3b, 0xbd, 0xb3, 0x7d, 0xbb, 0xa3, 0x9d, 0x9d, 0xd9, 0x9b, 0x99,
+	0x25, 0x79, 0x42, 0x3e, 0x9c, 0x00, 0xb6, 0x93, 0xd8, 0x71, 0x94, 0x00, 0x4e, 0x02, 0x18, 0xc8,
+	0x83, 0xf3, 0xe4, 0x97, 0x3c, 0xe5, 0x31, 0x3f, 0x40, 0xc8, 0x93, 0x80, 0x00, 0x81, 0x81, 0x20,
+	0x84, 0x75, 0x79, 0x4e, 0x80, 0x00, 0x41, 0x80, 0x18, 0x08, 0x10, 0x54, 0x77, 0x4f, 0x4f, 0xf7,
+	0xec, 0x1e, 0xb9, 0xc7, 0xdb, 0x25, 0x5f, 0xfc, 0x74, 0xb7, 0x55, 0xd5, 0x55, 0x35, 0xd5, 0x55,
+	0xd5, 0x5d, 0xdd, 0x35, 0x43, 0x76, 0x5b, 0x5e, 0xd2, 0xee, 0x37, 0x56, 0xdd, 0xb0, 0xbb, 0x46,
+	0xa3, 0x56, 0xd8, 0x8b, 0xc2, 0x0f, 0xf9, 0x3f, 0x5f, 0x60, 0xf7, 0x59, 0x90, 0xc4, 0x6b, 0xbd,
+	0x4e, 0x6b, 0x8d, 0xf6, 0xbc, 0x78, 0x4d, 0xfc, 0x0e, 0xfb, 0x91, 0xcb, 0xd6, 0xee, 0xbf, 0x4d,
+	0xfd, 0x5e, 0x9b, 0xbe, 0xbd, 0xd6, 0x62, 0x01, 0x8b, 0x68, 0xc2, 0x9a, 0xab, 0xbd, 0x28, 0x4c,
+	0x42, 0xfb, 0x37, 0x33, 0x76, 0xab, 0x29, 0x3b, 0xfe, 0xcf, 0x37, 0xc4, 0xf0, 0xd5, 0x5e, 0xa7,
+	0xb5, 0x8a, 0xec, 0x56, 0x35, 0x76, 0xab, 0x29, 0xbb, 0x2b, 0xbf, 0x35, 0xb2, 0x36, 0x6e, 0xd8,
+	0xed, 0x86, 0x41, 0x5e, 0xfe, 0x95, 0x2f, 0x68, 0x0c, 0x5a, 0x61, 0x2b, 0x5c, 0xe3, 0xe0, 0x46,
+	0xff, 0x88, 0xff, 0xe2, 0x3f, 0xf8, 0x7f, 0x92, 0xbc, 0xd2, 0x79, 0x27, 0x5e, 0xf5, 0x42, 0x64,
+	0xb9, 0xe6, 0x86, 0x11, 0x3e, 0xd8, 0x00, 0xcb, 0x5f, 0xc9, 0x68, 0xba, 0xd4, 0x6d, 0x7b, 0x01,
+	0x8b, 0x4e, 0x32, 0x3d, 0xba, 0x2c, 0xa1, 0xc3, 0x46, 0xad, 0x9d, 0x35, 0x2a, 0xea, 0x07, 0x89,
+	0xd7, 0x65, 0x03, 0x03, 0x7e, 0xed, 0x49, 0x03, 0x62, 0xb7, 0xcd, 0xba, 0x34, 0x3f, 0xae, 0xf2,
+	0xbf, 0x16, 0x59, 0xa9, 0xee, 0x1e, 0xec, 0xaf, 0x87, 0x41, 0xdc, 0xef, 0xb2, 0xf5, 0x30, 0x38,
+	0xf2, 0x5a, 0xf6, 0xaf, 0x92, 0x39, 0x57, 0x00, 0xa2, 0x43, 0xda, 0x72, 0xac, 0xeb, 0xd6, 0x1b,
+	0xe5, 0xda, 0xa5, 0x4f, 0x1e, 0x5d, 0x7b, 0xe1, 0xf4, 0xd1, 0xb5, 0xb9, 0xf5, 0x0c, 0x05, 0x3a,
+	0x9d, 0xfd, 0x26, 0x99, 0xa1, 0xfd, 0x24, 0xac, 0xba, 0x1d, 0xa7, 0x70, 0xdd, 0x7a, 0x63, 0xb6,
+	0xb6, 0x24, 0x87, 0xcc, 0x54, 0x05, 0x18, 0x52, 0xbc, 0xbd, 0x46, 0xca, 0xec, 0xa1, 0xeb, 0xf7,
+	0x63, 0xef, 0x3e, 0x73, 0x8a, 0x9c, 0x78, 0x45, 0x12, 0x97, 0x37, 0x52, 0x04, 0x64, 0x34, 0xc8,
+	0x3b, 0x08, 0x77, 0x42, 0x97, 0xfa, 0x4e, 0xc9, 0xe4, 0xbd, 0x27, 0xc0, 0x90, 0xe2, 0xed, 0xd7,
+	0xc9, 0x74, 0x10, 0xde, 0xa3, 0x5e, 0xe2, 0x4c, 0x71, 0xca, 0x45, 0x49, 0x39, 0xbd, 0xc7, 0xa1,
+	0x20, 0xb1, 0x95, 0xff, 0x9e, 0x21, 0x4b, 0xf8, 0xec, 0x1b, 0xe8, 0x1c, 0x75, 0xee, 0x4b, 0xf6,
+	0xab, 0xa4, 0xd8, 0x8f, 0x7c, 0xf9, 0xc4, 0x73, 0x72, 0x60, 0xf1, 0x2e, 0xec, 0x00, 0xc2, 0xed,
+	0x77, 0xc8, 0x3c, 0x7b, 0xe8, 0xb6, 0x69, 0xd0, 0x62, 0x7b, 0xb4, 0xcb, 0xf8, 0x63, 0x96, 0x6b,
+	0x2f, 0x4a, 0xba, 0xf9, 0x0d, 0x0d, 0x07, 0x06, 0xa5, 0x3e, 0xf2, 0xf0, 0xa4, 0x27, 0x9e, 0x79,
+	0xc8, 0x48, 0xc4, 0x81, 0x41, 0x69, 0xdf, 0x20, 0x24, 0x0a, 0xfb, 0x89, 0x17, 0xb4, 0xb6, 0xd9,
+	0x09, 0x7f, 0xf8, 0x72, 0xcd, 0x96, 0xe3, 0x08, 0x28, 0x0c, 0x68, 0x54, 0xf6, 0xef, 0x91, 0x15,
+	0x37, 0x0c, 0x02, 0xe6, 0x26, 0x5e, 0x18, 0xd4, 0xa8, 0xdb, 0x09, 0x8f, 0x8e, 0xb8, 0x35, 0xe6,
+	0x6e, 0xbc, 0xb3, 0x3a, 0x72, 0x90, 0x89, 0x28, 0x59, 0x95, 0xe3, 0x6b, 0x2f, 0x9d, 0x3e, 0xba,
+	0xb6, 0xb2, 0x9e, 0x67, 0x0b, 0x83, 0x92, 0xec, 0xb7, 0xc8, 0xec, 0x87, 0x71, 0x18, 0xd4, 0xc2,
+	0xe6, 0x89, 0x33, 0xcd, 0xe7, 0x60, 0x59, 0x2a, 0x3c, 0xfb, 0x7e, 0xfd, 0xce, 0x1e, 0xc2, 0x41,
+	0x51, 0xd8, 0x2e, 0x29, 0x26, 0x7e, 0xec, 0xcc, 0x70, 0xf5, 0x6e, 0xaf, 0x5e, 0x28, 0x07, 0xac,
+	0x1e, 0xee, 0xd4, 0x85, 0x13, 0xd7, 0x66, 0x70, 0xe6, 0x0e, 0x77, 0xea, 0x80, 0xdc, 0xed, 0xbf,
+	0xb6, 0xc8, 0x52, 0x6a, 0xd6, 0x9b, 0xcc, 0xf5, 0x69, 0xc4, 0x9c, 0x59, 0x2e, 0xf1, 0x4b, 0x17,
+	0x94, 0xc8, 0x5d, 0xc8, 0xe4, 0x2c, 0x35, 0xb8, 0x74, 0xfa, 0xe8, 0xda, 0x52, 0x0e, 0x05, 0x79,
+	0x2d, 0xec, 0xef, 0x5a, 0x64, 0xfe, 0xb8, 0xcf, 0xfa, 0x4a, 0xad, 0x32, 0x57, 0xeb, 0xee, 0x18,
+	0xd4, 0x3a, 0xd0, 0xd8, 0x4a, 0x9d, 0x96, 0xd1, 0xdb, 0x74, 0x38, 0x18, 0xc2, 0xed, 0x16, 0x29,
+	0x1e, 0x87, 0xb1, 0x43, 0xb8, 0x0e, 0x3b, 0xe3, 0xd0, 0x21, 0x34, 0x26, 0xe4, 0x20, 0xac, 0x03,
+	0x4a, 0xb0, 0x1f, 0x90, 0x19, 0x99, 0x3b, 0x9c, 0x39, 0x2e, 0x6c, 0x7f, 0x0c, 0xc2, 0x8c, 0x34,
+	0x56, 0x9b, 0xc3, 0xf4, 0x20, 0x41, 0x90, 0x4a, 0xab, 0x7c, 0x6a, 0x91, 0x57, 0xce, 0x9c, 0x33,
+	0xcc, 0x33, 0xcd, 0x7e, 0x44, 0x1b, 0x3e, 0x73, 0x2c, 0x33, 0xcf, 0xdc, 0x14, 0x60, 0x48, 0xf1,
+	0x18, 0x98, 0x98, 0xce, 0x6e, 0x32, 0x9f, 0x25, 0x4c, 0x66, 0x3c, 0x15, 0x98, 0x55, 0x85, 0x01,
+	0x8d, 0x0a, 0x23, 0xc3, 0x0b, 0x12, 0x16, 0x05, 0xd4, 0x77, 0x8a, 0x66, 0x64, 0x6c, 0x49, 0x38,
+	0x28, 0x0a, 0x2d, 0x93, 0x95, 0x1e, 0x9b, 0xc9, 0xfe, 0xde, 0x22, 0x0b, 0x86, 0xad, 0xed, 0x2f,
+	0x92, 0x85, 0x5e, 0xc4, 0x8e, 0x58, 0xe2, 0xb6, 0xd7, 0xc3, 0x7e, 0x90, 0xf0, 0x87, 0x99, 0xaa,
+	0xbd, 0x24, 0x19, 0x2c, 0xec, 0xeb, 0x48, 0x30, 0x69, 0x31, 0x57, 0xa5, 0x80, 0xba, 0xf7, 0x91,
+	0x78, 0xb4, 0xa9, 0x2c, 0x57, 0xed, 0x6b, 0x38, 0x30, 0x28, 0x51, 0xe1, 0x96, 0x1f, 0x36, 0xd4,
+	0xc3, 0x29, 0x85, 0x37, 0x39, 0x14, 0x24, 0xb6, 0xf2, 0x77, 0x05, 0x72, 0x79, 0xb8, 0x83, 0xda,
+	0xd7, 0x49, 0x29, 0xc0, 0xd4, 0x2a, 0x52, 0xf0, 0xbc, 0x64, 0x50, 0xe2, 0x29, 0x95, 0x63, 0xf4,
+	0x29, 0x2a, 0x9c, 0x6b, 0x8a, 0x8a, 0x23, 0x4d, 0x91, 0xb1, 0x34, 0x95, 0x46, 0x58, 0x9a, 0x46,
+	0x5c, 0x6f, 0x90, 0x31, 0x8d, 0x5a, 0xfd, 0x2e, 0xfa, 0x2e, 0x4f, 0x8b, 0xe5, 0x8c, 0x71, 0x35,
+	0x45, 0x40, 0x46, 0x53, 0xf9, 0xaf, 0x02, 0x79, 0xa5, 0xfa, 0x51, 0x3f, 0x62, 0x7c, 0x85, 0x8a,
+	0x6f, 0xf7, 0x1b, 0xfa, 0x52, 0x75, 0x9d, 0x94, 0x8e, 0x8e, 0x9b, 0x41, 0xde, 0x50, 0xb7, 0x0e,
+	0x6e, 0xee, 0x01, 0xc7, 0xd8, 0x3d, 0x72, 0x29, 0x6e, 0xd3, 0x88, 0x35, 0xab, 0xae, 0xcb, 0xe2,
+	0x78, 0x9b, 0x9d, 0xa8, 0x45, 0x6b, 0xee, 0xc6, 0xe7, 0x56, 0xc5, 0x96, 0x01, 0x23, 0x6a, 0x15,
+	0x77, 0x2f, 0xab, 0xf7, 0xdf, 0x5e, 0xad, 0x33, 0x37, 0x62, 0xc9, 0x36, 0x3b, 0xa9, 0x33, 0x9f,
+	0xb9, 0x49, 0x18, 0xd5, 0x5e, 0x3e, 0x7d, 0x74, 0xed, 0x52, 0x7d, 0x90, 0x0b, 0x0c, 0x63, 0x6d,
+	0x37, 0xc9, 0x52, 0x0e, 0xec, 0x14, 0xcf, 0x23, 0x8d, 0x67, 0xcc, 0x9c, 0x34, 0xc8, 0xb3, 0x44,
+	0x07, 0x68, 0xf7, 0x1b, 0xfc, 0x59, 0xc4, 0x72, 0xa8, 0x1c, 0xe0, 0xb6, 0x00, 0x43, 0x8a, 0x47,
+	0x9b, 0xa3, 0xcf, 0xc4, 0x3d, 0xea, 0x32, 0x67, 0xca, 0xb4, 0xf9, 0x5e, 0x8a, 0x80, 0x8c, 0xa6,
+	0xf2, 0x21, 0x59, 0x5a, 0xa7, 0x3e, 0x0b, 0x9a, 0x34, 0x5a, 0xa7, 0x89, 0xdb, 0xbe, 0xdb, 0xc3,
+	0xf9, 0xed, 0x85, 0xbe, 0xe7, 0x9e, 0x48, 0x53, 0xab, 0xf9, 0xdd, 0xe7, 0x50, 0x90, 0x58, 0x94,
+	0xd5, 0xa5, 0x0f, 0xc5, 0x5c, 0xc9, 0x98, 0x51, 0xb2, 0x76, 0x53, 0x04, 0x64, 0x34, 0x95, 0x1f,
+	0x97, 0xc8, 0xa5, 0x54, 0x98, 0x3e, 0xb3, 0x6f, 0x91, 0x59, 0xdc, 0xae, 0x35, 0xfb, 0x7e, 0x1a,
+	0x06, 0x2a, 0x49, 0xd4, 0x25, 0x1c, 0x14, 0x85, 0x4a, 0x29, 0xf7, 0xa9, 0xef, 0x14, 0x4c, 0xea,
+	0x2d, 0x09, 0x07, 0x45, 0x61, 0xbf, 0x4b, 0x16, 0xa5, 0xe7, 0x86, 0xc1, 0x4d, 0x9a, 0xb0, 0xd8,
+	0x29, 0x5e, 0x2f, 0xe2, 0x8e, 0xe2, 0xf4, 0xd1, 0xb5, 0xc5, 0x0d, 0x03, 0x03, 0x39, 0x4a, 0x94,
+	0x84, 0x7b, 0xc9, 0x8f, 0xc2, 0x20, 0x35, 0xbc, 0x92, 0x74, 0x28, 0xe1, 0xa0, 0x28, 0xec, 0x5d,
+	0x32, 0xd7, 0x8f, 0x59, 0xb4, 0x4f, 0x4f, 0xfc, 0x90, 0x36, 0xb9, 0xf1, 0xe7, 0x6b, 0x9f, 0xc7,
+	0x0d, 0xe4, 0xdd, 0x0c, 0xfc, 0xb3, 0x47, 0xd7, 0x1c, 0x16, 0xb8, 0x61, 0xd3, 0x0b, 0x5a, 0x6b,
+	0xb8, 0x23, 0x58, 0x05, 0xfa, 0x60, 0x97, 0xc5, 0x31, 0x6d, 0x31, 0xd0, 0xc7, 0xdb, 0xdf, 0xb2,
+	0xc8, 0x5c, 0x8f, 0x45, 0xb1, 0x17, 0x27, 0x2c, 0x70, 0x19, 0x0f, 0xa0, 0xb9, 0x1b, 0x70, 0xc1,
+	0x45, 0x23, 0x35, 0xff, 0x7e, 0xc6, 0xb9, 0xb6, 0x84, 0x3a, 0x6a, 0x00, 0xd0, 0xe5, 0xda, 0x7d,
+	0x32, 0xe3, 0x0a, 0xc7, 0x90, 0x3b, 0x96, 0xbd, 0x31, 0xa9, 0x20, 0xdd, 0x4d, 0xae, 0x5a, 0xe2,
+	0x07, 0xa4, 0xb2, 0x2a, 0xb7, 0x32, 0x57, 0xd1, 0x54, 0x43, 0x9f, 0x73, 0x79, 0xde, 0xdc, 0xa5,
+	0x3d, 0xe9, 0x2b, 0xca, 0xe7, 0xd6, 0x53, 0x04, 0x64, 0x34, 0x95, 0xef, 0x4d, 0x11, 0x7b, 0xa3,
+	0xeb, 0x25, 0x09, 0x33, 0x5c, 0xee, 0x75, 0x32, 0xdd, 0x88, 0xc2, 0x0e, 0x8b, 0xf2, 0x3e, 0x5e,
+	0xe3, 0x50, 0x90, 0x58, 0x4c, 0xa8, 0xb8, 0x6a, 0x06, 0xcc, 0xc7, 0xd8, 0x2e, 0x98, 0x9b, 0xd1,
+	0x75, 0x85, 0x01, 0x8d, 0x8a, 0x57, 0x13, 0xe2, 0x17, 0x0f, 0xd9, 0x62, 0xae, 0x9a, 0xc8, 0x50,
+	0xa0, 0xd3, 0x99, 0xa1, 0x5b, 0x7a, 0x72, 0xe8, 0xda, 0x77, 0xc8, 0x2c, 0x3a, 0x0c, 0x02, 0x9c,
+	0xa9, 0xf3, 0x64, 0x9d, 0x79, 0xf4, 0xe0, 0xbb, 0x72, 0x28, 0x28, 0x26, 0xc8, 0xb0, 0x47, 0xe3,
+	0xf8, 0x41, 0x18, 0x35, 0x9d, 0xe9, 0x73, 0x33, 0xdc, 0x97, 0x43, 0x41, 0x31, 0x19, 0xbe, 0x2d,
+	0x9f, 0x79, 0x2e, 0xdb, 0xf2, 0xd9, 0x51, 0xb7, 0xe5, 0xe5, 0x49, 0x6e, 0xcb, 0x2b, 0xff, 0x5a,
+	0x20, 0x73, 0xba, 0x1f, 0xfe, 0x0e, 0x99, 0xc5, 0x9a, 0xb8, 0x49, 0x13, 0xca, 0x3d, 0x71, 0xee,
+	0xc6, 0x2f, 0x69, 0x26, 0x57, 0xa5, 0x6d, 0x26, 0x0d, 0xa9, 0x71, 0x12, 0xee, 0x34, 0x3e, 0x64,
+	0x6e, 0xb2, 0xcb, 0x12, 0x9a, 0xf9, 0x63, 0x06, 0x03, 0xc5, 0xd5, 0x7e, 0x48, 0xa6, 0xe3, 0x84,
+	0x26, 0xfd, 0xd8, 0x29, 0x8c, 0x65, 0xdb, 0xa9, 0x69, 0x5f, 0xe7, 0x7c, 0xb3, 0xd8, 0x11, 0xbf,
+	0x41, 0xca, 0xb3, 0x7b, 0xa4, 0x14, 0xf7, 0x98, 0xeb, 0x14, 0xc7, 0x92, 0x36, 0x74, 0xb9, 0x3d,
+	0xe6, 0x66, 0x1b, 0x00, 0xfc, 0x05, 0x5c, 0x52, 0xe5, 0xa7, 0x16, 0x59, 0xd2, 0xe8, 0x76, 0xbc,
+	0x38, 0xb1, 0xbf, 0x36, 0x60, 0xe1, 0xd5, 0xd1, 0x2c, 0x8c, 0xa3, 0xb9, 0x7d, 0x95, 0xd3, 0xa4,
+	0x10, 0xcd, 0xba, 0x21, 0x99, 0xf2, 0x12, 0xd6, 0x45, 0xe3, 0x16, 0xdf, 0x98, 0xbb, 0xf1, 0xfe,
+	0xf8, 0x1e, 0xb2, 0xb6, 0x20, 0xc5, 0x4e, 0x6d, 0xa1, 0x00, 0x10, 0x72, 0x2a, 0x1f, 0xbf, 0x6d,
+	0x3c, 0x22, 0x3e, 0xbc, 0xfd, 0xfb, 0x64, 0xaa, 0xeb, 0x05, 0x5e, 0xe8, 0x58, 0x5c, 0x89, 0x2f,
+	0x8f, 0xd7, 0xd2, 0xab, 0xbb, 0xc8, 0x7b, 0x23, 0x48, 0xa2, 0x93, 0x4c, 0x27, 0x0e, 0x03, 0x21,
+	0xd6, 0xfe, 0x53, 0x8b, 0xcc, 0xba, 0x32, 0x59, 0x4b, 0x43, 0x7c, 0x6d, 0xcc, 0x3a, 0xa8, 0x6d,
+	0x03, 0x57, 0x43, 0xcd, 0x48, 0x0a, 0x06, 0x25, 0xdf, 0xfe, 0x88, 0x94, 0x8e, 0x3c, 0x9f, 0xf1,
+	0x65, 0xfe, 0xe2, 0xc5, 0x6e, 0x5e, 0x8f, 0x5b, 0x9e, 0xcf, 0x84, 0x0e, 0xd9, 0x06, 0xd4, 0xf3,
+	0x19, 0x70, 0x99, 0xdc, 0x10, 0x11, 0x13, 0x3c, 0x9c, 0xd2, 0x44, 0x0c, 0x01, 0x92, 0x7d, 0xce,
+	0x10, 0x29, 0x18, 0x94, 0x7c, 0xfb, 0xdb, 0x16, 0x99, 0x79, 0xc0, 0x1a, 0xed, 0x30, 0xec, 0x38,
+	0x53, 0x5c, 0x97, 0xaf, 0x8e, 0x59, 0x97, 0x7b, 0x82, 0xbb, 0x50, 0x45, 0xed, 0x49, 0x25, 0x14,
+	0x52, 0xe1, 0x38, 0x23, 0xb4, 0x7b, 0xdc, 0x73, 0xa6, 0x27, 0x32, 0x23, 0xd5, 0xee, 0x71, 0x2f,
+	0x37, 0x23, 0x58, 0x69, 0x01, 0x97, 0x89, 0xa1, 0xd1, 0xa1, 0x47, 0x1d, 0xea, 0xcc, 0x4c, 0x24,
+	0x34, 0xb6, 0x91, 0x77, 0x2e, 0x34, 0x38, 0x0c, 0x84, 0x58, 0x7c, 0xf6, 0xee, 0x71, 0x92, 0x38,
+	0xb3, 0x13, 0x79, 0xf6, 0xdd, 0xe3, 0x24, 0xc9, 0x3d, 0xfb, 0xee, 0xc1, 0xe1, 0x21, 0x70, 0x99,
+	0x28, 0x3b, 0xa0, 0x09, 0xae, 0x68, 0x93, 0x90, 0xbd, 0x47, 0x93, 0x38, 0x27, 0x7b, 0xaf, 0x7a,
+	0x58, 0x07, 0x2e, 0xd3, 0xbe, 0x4f, 0x8a, 0x71, 0x80, 0xc7, 0x2a, 0x28, 0xfa, 0xde, 0x98, 0x45,
+	0xd7, 0x03, 0x29, 0x59, 0x1d, 0x58, 0xd6, 0xf7, 0xea, 0x80, 0x02, 0xb9, 0xdc, 0xe3, 0xd8, 0x99,
+	0x9b, 0x8c, 0xdc, 0xe3, 0x01, 0xb9, 0x07, 0x28, 0xf7, 0x38, 0xb6, 0xff, 0xc8, 0x22, 0xd3, 0xbd,
+	0x7e, 0xa3, 0xde, 0x6f, 0x38, 0xf3, 0x5c, 0xf6, 0x57, 0xc6, 0x2c, 0x7b, 0x9f, 0x33, 0x17, 0xe2,
+	0xb3, 0x82, 0x8c, 0x03, 0x41, 0x4a, 0xe6, 0x4a, 0x08, 0xa9, 0xce, 0xc2, 0x44, 0x94, 0xd8, 0xe4,
+	0xdc, 0x72, 0x4a, 0x08, 0x20, 0x48, 0xc9, 0xa9, 0x12, 0x3e, 0x6d, 0x38, 0x8b, 0x93, 0x52, 0xc2,
+	0xa7, 0x43, 0x94, 0xf0, 0xa9, 0x50, 0xc2, 0xa7, 0x0d, 0x74, 0xfd, 0x76, 0xf3, 0x28, 0x76, 0x96,
+	0x26, 0xe2, 0xfa, 0xb7, 0x9b, 0x47, 0x79, 0xd7, 0xbf, 0x7d, 0xf3, 0x56, 0x1d, 0xb8, 0x4c, 0x4c,
+	0x39, 0xb1, 0x4f, 0xdd, 0x8e, 0xb3, 0x3c, 0x91, 0x94, 0x53, 0x47, 0xde, 0xb9, 0x94, 0xc3, 0x61,
+	0x20, 0xc4, 0xda, 0x7f, 0x65, 0x91, 0xb9, 0x38, 0x09, 0x23, 0xda, 0x62, 0x9b, 0x91, 0xd7, 0x74,
+	0x56, 0xb8, 0x1a, 0xdf, 0x18, 0xb7, 0x1a, 0x99, 0x04, 0xa1, 0x8c, 0x2a, 0x70, 0x34, 0x0c, 0xe8,
+	0x8a, 0xd8, 0x3f, 0xb2, 0xc8, 0x22, 0x35, 0x8e, 0x77, 0x1c, 0x9b, 0xeb, 0xd6, 0x18, 0xf7, 0x92,
+	0x60, 0x9e, 0x21, 0x71, 0xf5, 0x2e, 0x4b, 0xf5, 0x16, 0x4d, 0x24, 0xe4, 0x34, 0xe2, 0xee, 0x1b,
+	0x27, 0x91, 0xd7, 0x63, 0xce, 0xa5, 0x89, 0xb8, 0x6f, 0x9d, 0x33, 0xcf, 0xb9, 0xaf, 0x00, 0x82,
+	0x94, 0xcc, 0x97, 0x6e, 0x26, 0x8a, 0x56, 0xe7, 0xc5, 0x89, 0x2c, 0xdd, 0x69, 0x49, 0x6c, 0x2e,
+	0xdd, 0x12, 0x0a, 0xa9, 0x70, 0xf4, 0xe5, 0x88, 0x35, 0xbd, 0xd8, 0x79, 0x69, 0x22, 0xbe, 0x0c,
+	0xc8, 0x3b, 0xe7, 0xcb, 0x1c, 0x06, 0x42, 0x2c, 0xa6, 0xf3, 0x20, 0x3e, 0x76, 0x2e, 0x4f, 0x24,
+	0x9d, 0xef, 0xc5, 0xc7, 0xb9, 0x74, 0xbe, 0x57, 0x3f, 0x00, 0x14, 0xc8, 0x27, 0x80, 0x5f, 0x1d,
+	0x7a, 0xae, 0xf3, 0xf2, 0x44, 0x26, 0x60, 0x53, 0x70, 0xcf, 0x4d, 0x80, 0x84, 0x42, 0x2a, 0xfc,
+	0x4a, 0x9f, 0x90, 0x6c, 0xfb, 0x6d, 0x2f, 0x93, 0x62, 0x87, 0xc9, 0x63, 0x39, 0xc0, 0x7f, 0xed,
+	0x03, 0x32, 0x75, 0x9f, 0xfa, 0xfd, 0xf4, 0x90, 0xf3, 0x8b, 0xe7, 0xae, 0xaa, 0xeb, 0xbf, 0x5c,
+	0x8d, 0x12, 0xef, 0x88, 0xba, 0x09, 0x08, 0x4e, 0xef, 0x16, 0xde, 0xb1, 0xae, 0xfc, 0xb9, 0x45,
+	0x16, 0x8c, 0x2d, 0xf7, 0x10, 0xd1, 0x6d, 0x53, 0xf4, 0xb8, 0x4e, 0xa6, 0x34, 0x43, 0xe9, 0x1a,
+	0x7d, 0xc7, 0x22, 0x65, 0xb5, 0xf9, 0x1e, 0xa2, 0x4d, 0xd3, 0xd4, 0xe6, 0xa2, 0xd5, 0x26, 0x17,
+	0x35, 0x5c, 0x13, 0xb4, 0x8d, 0xb1, 0x0b, 0x9f, 0xbc, 0x6d, 0x94, 0xb8, 0xe1, 0x1a, 0xfd, 0x89,
+	0x45, 0xe6, 0xf5, 0xbd, 0xf8, 0x10, 0x85, 0x5c, 0x53, 0xa1, 0xdd, 0x0b, 0x2a, 0x24, 0xa5, 0xad,
+	0x87, 0x41, 0xc2, 0x1e, 0x26, 0xf9, 0x79, 0x52, 0x5b, 0xf2, 0xc9, 0xcf, 0x53, 0xee, 0x3e, 0x3b,
+	0x67, 0x15, 0x92, 0xed, 0xcf, 0x87, 0xa8, 0xc2, 0x4c, 0x55, 0xee, 0x5c, 0x50, 0x15, 0x21, 0xeb,
+	0x6c, 0xef, 0x55, 0x9b, 0xf5, 0xc9, 0x5b, 0x05, 0x8b, 0x80, 0x33, 0x34, 0xf9, 0x63, 0x8b, 0x94,
+	0xd5, 0xd6, 0x7d, 0xf2, 0x46, 0xc1, 0x92, 0x40, 0x2c, 0xae, 0x83, 0xaa, 0x7c, 0xcb, 0x22, 0xb3,
+	0xf5, 0xe0, 0x4c, 0x4d, 0xc6, 0xec, 0xb2, 0xf5, 0xbd, 0xfa, 0x19, 0x26, 0xe1, 0x7a, 0x1c, 0x3f,
+	0x33, 0x3d, 0x0e, 0xce, 0xd2, 0xe3, 0xbb, 0x16, 0x99, 0xd3, 0xb6, 0xf9, 0x43, 0x54, 0x39, 0x32,
+	0x55, 0xb9, 0xe8, 0x51, 0x9e, 0x14, 0x76, 0xb6, 0x36, 0xda, 0x7e, 0x7f, 0xf2, 0xda, 0x48, 0x61,
+	0x8f, 0xd5, 0xc6, 0xa7, 0xcf, 0x50, 0x1b, 0x14, 0x76, 0x76, 0x38, 0xab, 0x22, 0x60, 0xf2, 0xe1,
+	0x8c, 0xc5, 0xc5, 0x63, 0x92, 0x5c, 0x56, 0x11, 0x4c, 0x3e, 0x9e, 0x85, 0xac, 0xe1, 0xba, 0xfc,
+	0xc0, 0x22, 0xcb, 0xf9, 0xb2, 0x60, 0x88, 0x46, 0x1d, 0x53, 0xa3, 0x8b, 0xf6, 0x7d, 0xe8, 0x12,
+	0x87, 0xeb, 0xf5, 0x43, 0x8b, 0x5c, 0x1a, 0x52, 0x12, 0x0c, 0x51, 0x2d, 0x30, 0x55, 0xbb, 0x70,
+	0xa7, 0xcc, 0x59, 0x77, 0xd9, 0x79, 0xcf, 0xd6, 0x6a, 0x82, 0xc9, 0x7b, 0xb6, 0x14, 0x36, 0x5c,
+	0x9b, 0x3f, 0xb3, 0xc8, 0xbc, 0x5e, 0x1b, 0x0c, 0x51, 0xa7, 0x65, 0xaa, 0x73, 0x70, 0xd1, 0x8d,
+	0xf1, 0xc0, 0xe5, 0x5c, 0xde, 0xbf, 0xb3, 0x2a, 0x61, 0xf2, 0xfe, 0x2d, 0x64, 0x9d, 0xbd, 0x4e,
+	0xa4, 0x35, 0xc3, 0xe4, 0xd7, 0x89, 0xbd, 0xfa, 0xc1, 0x63, 0xe6, 0x48, 0x2f, 0x1f, 0x26, 0x3f,
+	0x47, 0xa9, 0xb4, 0xa1, 0xfa, 0x54, 0x7a, 0x64, 0x65, 0xe0, 0x52, 0xc8, 0xfe, 0x2a, 0x29, 0xbb,
+	0x11, 0xc3, 0xce, 0xcb, 0x6a, 0x22, 0xef, 0x5d, 0x7e, 0x71, 0xb4, 0x7b, 0x17, 0xbc, 0x5a, 0xd7,
+	0x2e, 0x75, 0x53, 0x26, 0x90, 0xf1, 0xab, 0xfc, 0x61, 0x81, 0x2c, 0xe5, 0x76, 0xe8, 0xbc, 0x8d,
+	0x05, 0x7f, 0xf2, 0x6e, 0xc3, 0xdc, 0xcd, 0xf0, 0x46, 0x8a, 0x80, 0x8c, 0xc6, 0xfe, 0x0b, 0x8b,
+	0x2c, 0x3d, 0xc0, 0xdb, 0xe6, 0x7d, 0x9a, 0xb4, 0xc5, 0x65, 0xdd, 0x98, 0xf2, 0xf5, 0x3d, 0x93,
+	0x6b, 0xed, 0x65, 0xa9, 0xc7, 0x52, 0x0e, 0x01, 0x79, 0xf9, 0xd8, 0xe9, 0xd1, 0x0b, 0x7d, 0xdf,
+	0x0b, 0x5a, 0xb2, 0x79, 0x47, 0x55, 0x86, 0xfb, 0x02, 0x0c, 0x29, 0xbe, 0xf2, 0x1b, 0xc4, 0x1e,
+	0x9c, 0x16, 0xfb, 0xb5, 0x74, 0xe2, 0x85, 0x05, 0x54, 0x55, 0xfd, 0x01, 0x02, 0xe5, 0xa4, 0x55,
+	0xfe, 0x6d, 0x9a, 0xac, 0x0c, 0xac, 0xb6, 0xf6, 0x15, 0x52, 0xf0, 0x9a, 0x7c, 0x5c, 0xb1, 0x46,
+	0xe4, 0xb8, 0xc2, 0x56, 0x13, 0x0a, 0x5e, 0xd3, 0x4e, 0xb2, 0xab, 0x84, 0x49, 0x14, 0x10, 0xa2,
+	0x07, 0x60, 0xe0, 0xe2, 0xe0, 0x35, 0x32, 0x15, 0x3e, 0x08, 0x58, 0xe4, 0x14, 0xcd, 0x87, 0xb9,
+	0x83, 0x40, 0x10, 0x38, 0xde, 0x2e, 0xca, 0x7a, 0x61, 0xec, 0x25, 0x61, 0x34, 0xd8, 0x2e, 0xaa,
+	0x30, 0xa0, 0x51, 0xd9, 0x15, 0x32, 0x2d, 0xb4, 0xe2, 0x17, 0x23, 0xe5, 0x1a, 0xc1, 0x33, 0x18,
+	0xd9, 0xaf, 0x22, 0x31, 0x78, 0x19, 0x4e, 0x7b, 0xde, 0x61, 0xd8, 0x61, 0xc1, 0x53, 0x5c, 0x86,
+	0x57, 0xf7, 0xb7, 0xf8, 0x50, 0x50, 0x4c, 0xec, 0xaf, 0x93, 0x05, 0xf9, 0x60, 0x62, 0x8c, 0x33,
+	0x73, 0x1e, 0xae, 0x2b, 0xd8, 0xc5, 0x76, 0x4f, 0x1f, 0x0f, 0x26, 0x3b, 0xd1, 0x17, 0x13, 0x33,
+	0xb7, 0x1f, 0xb1, 0xfc, 0x6d, 0xf7, 0x96, 0x84, 0x83, 0xa2, 0xc0, 0x06, 0x08, 0xea, 0x26, 0xd8,
+	0xf2, 0x55, 0x36, 0x9b, 0xb8, 0xaa, 0x1c, 0x0a, 0x12, 0x2b, 0x5b, 0xa3, 0x93, 0x34, 0xb0, 0xc8,
+	0x40, 0x6b, 0x74, 0x8a, 0x02, 0x9d, 0x0e, 0xfb, 0xf1, 0x84, 0x83, 0xd4, 0x68, 0xcc, 0xee, 0xc2,
+	0x0e, 0xef, 0x79, 0x2c, 0x67, 0xfd, 0x78, 0x9b, 0x3a, 0x12, 0x4c, 0x5a, 0xbb, 0x4a, 0x96, 0x04,
+	0xe0, 0x6e, 0x0f, 0x5b, 0x61, 0x70, 0xf8, 0x3c, 0x1f, 0xae, 0x02, 0x69, 0xd3, 0x44, 0x43, 0x9e,
+	0xde, 0x6c, 0xa6, 0x58, 0x18, 0xa1, 0x99, 0xe2, 0x7d, 0x62, 0x37, 0x79, 0x3f, 0xdc, 0xed, 0x30,
+	0xec, 0xdc, 0x09, 0x6e, 0x79, 0x81, 0x17, 0xb7, 0x9d, 0x45, 0x6e, 0x9b, 0x2b, 0x72, 0xa4, 0x7d,
+	0x73, 0x80, 0x02, 0x86, 0x8c, 0xaa, 0xfc, 0xd3, 0x14, 0x59, 0x19, 0xd8, 0x3f, 0xea, 0x31, 0x64,
+	0x3d, 0xbb, 0x18, 0x5a, 0x23, 0x65, 0x64, 0xcb, 0xdc, 0x64, 0xeb, 0xa6, 0x53, 0x36, 0x0d, 0xb1,
+	0x9f, 0x22, 0x20, 0xa3, 0xd1, 0x62, 0xa3, 0x78, 0x66, 0x6c, 0x7c, 0x89, 0xcc, 0x51, 0xde, 0x9d,
+	0x26, 0xc2, 0xa3, 0x74, 0x1e, 0x47, 0xe6, 0xdd, 0x46, 0xd5, 0x6c, 0x34, 0xe8, 0xac, 0xec, 0x3a,
+	0x79, 0x89, 0x05, 0xd8, 0xca, 0x58, 0xaf, 0xef, 0x7c, 0xc0, 0x22, 0xef, 0xc8, 0x73, 0x69, 0xe2,
+	0x85, 0x81, 0x6c, 0x35, 0x7c, 0x55, 0xaa, 0xfe, 0xd2, 0xc6, 0x30, 0x22, 0x18, 0x3e, 0x56, 0x3a,
+	0xa3, 0x4f, 0x95, 0x33, 0x4e, 0x0f, 0x38, 0xa3, 0x4f, 0x0d, 0x67, 0xcc, 0x7e, 0x9e, 0xe1, 0x18,
+	0xb3, 0x4f, 0xe3, 0x18, 0x68, 0xb7, 0x98, 0x1b, 0x44, 0xd8, 0x8d, 0x9c, 0xdb, 0x6e, 0xf5, 0x6c,
+	0x34, 0xe8, 0xac, 0xec, 0x55, 0x42, 0xd4, 0x14, 0x8a, 0xeb, 0xaf, 0x72, 0x6d, 0x11, 0x33, 0xa0,
+	0x9a, 0xe3, 0x18, 0x34, 0x0a, 0xfb, 0x0d, 0x32, 0xdb, 0x8a, 0xc2, 0x7e, 0x0f, 0xa9, 0xe7, 0x39,
+	0x35, 0x4f, 0x5b, 0x9b, 0x12, 0x06, 0x0a, 0x5b, 0xf9, 0xfe, 0x0c, 0x59, 0xca, 0x15, 0x20, 0x43,
+	0x97, 0x4e, 0xeb, 0x39, 0x2f, 0x9d, 0xd7, 0x49, 0x29, 0xc1, 0x0c, 0x55, 0x30, 0xdb, 0x43, 0x79,
+	0x6a, 0xe2, 0x18, 0x74, 0x03, 0xb7, 0xcd, 0xdc, 0x4e, 0xda, 0x25, 0xe8, 0x14, 0x4d, 0x37, 0x58,
+	0xd7, 0x91, 0x60, 0xd2, 0xda, 0x9f, 0x27, 0x65, 0xda, 0x6c, 0x46, 0x2c, 0x8e, 0x59, 0xcc, 0xaf,
+	0xf6, 0xcb, 0xb5, 0x05, 0xde, 0xc8, 0x9a, 0x02, 0x21, 0xc3, 0x63, 0x2a, 0xc6, 0xab, 0x20, 0x6c,
+	0xb1, 0x92, 0x4d, 0x98, 0x2a, 0x15, 0xa3, 0x29, 0x11, 0x0e, 0x8a, 0x02, 0x9b, 0x48, 0x3b, 0x51,
+	0x63, 0x7d, 0x9d, 0xba, 0x6d, 0x26, 0x97, 0x86, 0xe9, 0x73, 0x37, 0x91, 0x6e, 0x9b, 0x1c, 0x20,
+	0xcf, 0x52, 0x4a, 0xd9, 0x66, 0x27, 0x09, 0x6d, 0x3c, 0xcd, 0x02, 0x94, 0x4a, 0xd1, 0x39, 0x40,
+	0x9e, 0x25, 0x2e, 0x17, 0x9d, 0xa8, 0x91, 0xf6, 0x96, 0x39, 0xb3, 0xe6, 0x72, 0xb1, 0x9d, 0xa1,
+	0x40, 0xa7, 0x43, 0x83, 0x75, 0xa2, 0x06, 0x30, 0xea, 0x77, 0x9d, 0xb2, 0x69, 0xb0, 0x6d, 0x09,
+	0x07, 0x45, 0x61, 0xf7, 0x88, 0x8d, 0x4f, 0xc7, 0xe7, 0x5d, 0x35, 0xfd, 0xc9, 0x68, 0x7a, 0x63,
+	0xd8, 0xd3, 0x28, 0x22, 0xfd, 0x81, 0x2e, 0x63, 0xe0, 0x6e, 0x0f, 0xf0, 0x81, 0x21, 0xbc, 0xed,
+	0x2f, 0x93, 0x97, 0x3b, 0x51, 0xa3, 0xce, 0xa2, 0xfb, 0x9e, 0xcb, 0xf6, 0x23, 0x2f, 0x70, 0xbd,
+	0x1e, 0x15, 0xed, 0x7d, 0x62, 0x61, 0xbb, 0x26, 0xd5, 0x7d, 0x79, 0x7b, 0x38, 0x19, 0x9c, 0x35,
+	0xde, 0x5c, 0xa9, 0xe6, 0x47, 0xe8, 0xd8, 0xfd, 0xbe, 0x45, 0x6c, 0x7e, 0xd6, 0x98, 0xbe, 0x97,
+	0xc4, 0x83, 0x16, 0xf9, 0xf0, 0x98, 0xdd, 0xcb, 0x9a, 0xc9, 0x15, 0x9f, 0xcd, 0x14, 0x01, 0x19,
+	0x8d, 0xbd, 0x49, 0x56, 0x22, 0xd6, 0xa0, 0x3e, 0x0d, 0x70, 0xd3, 0x1e, 0xd1, 0x84, 0xb5, 0xd2,
+	0x0e, 0xc7, 0x57, 0xe4, 0xc0, 0x15, 0xc8, 0x13, 0xc0, 0xe0, 0x98, 0xca, 0xdf, 0x4e, 0x93, 0xe5,
+	0xfc, 0xe1, 0xe7, 0x93, 0x5e, 0x2c, 0xc2, 0x65, 0x89, 0x46, 0x89, 0xc7, 0x73, 0x7b, 0x21, 0xb7,
+	0x2c, 0xa5, 0x08, 0xc8, 0x68, 0x70, 0x2f, 0x98, 0x84, 0x3d, 0xcf, 0xcd, 0xef, 0x05, 0x0f, 0x11,
+	0x08, 0x02, 0x37, 0xbc, 0xdf, 0xb0, 0xf4, 0xcc, 0xfa, 0x0d, 0x65, 0x07, 0xe1, 0xd4, 0x44, 0x5f,
+	0xec, 0x39, 0xdf, 0xbb, 0x46, 0x9f, 0x23, 0x33, 0xa2, 0x93, 0x35, 0xe6, 0x1d, 0x30, 0x65, 0xb1,
+	0x4b, 0x10, 0x4d, 0xae, 0x31, 0xa4, 0x38, 0x6c, 0x5c, 0x5a, 0x70, 0x75, 0x77, 0x72, 0x66, 0xc7,
+	0x52, 0x38, 0x0e, 0xfa, 0xa9, 0xd8, 0xc8, 0x1a, 0x20, 0x30, 0x45, 0x63, 0x9e, 0xf6, 0x02, 0x2f,
+	0xf1, 0xa8, 0x7f, 0xe7, 0xe8, 0x28, 0x66, 0x89, 0x53, 0x36, 0xf3, 0xf4, 0x96, 0x8e, 0x04, 0x93,
+	0xd6, 0x6e, 0x91, 0x52, 0x4c, 0x63, 0x5f, 0x66, 0x83, 0xad, 0x8b, 0x9e, 0x95, 0x54, 0xeb, 0x3b,
+	0x72, 0x16, 0x66, 0x79, 0xaf, 0x61, 0xb5, 0xbe, 0x03, 0x5c, 0x00, 0x96, 0x6a, 0xf7, 0x59, 0x14,
+	0xa3, 0xff, 0xce, 0x99, 0x4d, 0xf9, 0x1f, 0x08, 0x30, 0xa4, 0xf8, 0xca, 0x0f, 0x8a, 0x64, 0x29,
+	0x77, 0x24, 0xff, 0xa4, 0xf8, 0x50, 0xee, 0x5e, 0x78, 0x8c, 0xbb, 0xbf, 0x45, 0x66, 0x5d, 0xdf,
+	0x63, 0x41, 0xb2, 0xd5, 0x94, 0x61, 0x91, 0x35, 0xc6, 0x49, 0x38, 0x28, 0x8a, 0xe7, 0x1d, 0x1c,
+	0xba, 0xdf, 0x4e, 0x8d, 0xda, 0x8c, 0x3b, 0x3d, 0xd1, 0x66, 0xdc, 0xff, 0x2c, 0x90, 0xe5, 0xfc,
+	0x05, 0xc5, 0x93, 0x26, 0xe6, 0x4d, 0x32, 0x13, 0xf7, 0x79, 0x9f, 0xad, 0x53, 0x30, 0xa7, 0xbd,
+	0x2e, 0xc0, 0x90, 0xe2, 0x87, 0x1b, 0xbc, 0xf8, 0x5c, 0x0c, 0x5e, 0x1a, 0xd5, 0xe0, 0x13, 0xcd,
+	0x5d, 0x95, 0x1f, 0x17, 0xc9, 0xa2, 0x79, 0xae, 0x85, 0x1b, 0x86, 0x76, 0x18, 0x27, 0x72, 0x1b,
+	0x95, 0x7f, 0xf5, 0xf6, 0x76, 0x86, 0x02, 0x9d, 0x6e, 0xb4, 0xf8, 0x78, 0x93, 0xcc, 0xc8, 0x06,
+	0x7b, 0xa7, 0x68, 0xce, 0x95, 0x6c, 0xc2, 0x87, 0x14, 0xff, 0xf3, 0xe0, 0x18, 0x98, 0xab, 0x7f,
+	0x29, 0x92, 0x95, 0x81, 0x0b, 0x22, 0xb3, 0x9c, 0xb4, 0x46, 0x28, 0x27, 0xdf, 0x23, 0x8b, 0x7c,
+	0x32, 0x14, 0x52, 0xce, 0x98, 0xea, 0xc7, 0x39, 0x34, 0xb0, 0x90, 0xa3, 0x1e, 0x6d, 0xdd, 0xaf,
+	0x92, 0x25, 0x37, 0x62, 0x4d, 0x16, 0xe0, 0x42, 0x10, 0xe3, 0xc9, 0xa0, 0x3c, 0x08, 0x52, 0xe5,
+	0xc3, 0xba, 0x89, 0x86, 0x3c, 0xbd, 0xfd, 0x01, 0xb9, 0x2c, 0x8a, 0xc7, 0x7b, 0x61, 0xd4, 0x39,
+	0xf2, 0xc3, 0x07, 0x5b, 0x1c, 0x9d, 0xa4, 0xf3, 0x71, 0x55, 0x72, 0xba, 0xbc, 0x31, 0x94, 0x0a,
+	0xce, 0x18, 0x6d, 0x37, 0xc8, 0x15, 0x51, 0x08, 0xd6, 0xfb, 0x8d, 0xd8, 0x8d, 0xbc, 0x1e, 0x4e,
+	0xbb, 0x2a, 0x23, 0xc5, 0x02, 0x5e, 0x91, 0xbc, 0xaf, 0xdc, 0x3c, 0x93, 0x12, 0x1e, 0xc3, 0xc5,
+	0xf0, 0x9e, 0x99, 0x27, 0x79, 0x4f, 0xe5, 0x7f, 0x0a, 0x64, 0x39, 0x7f, 0xcc, 0xfd, 0xb4, 0x61,
+	0xa8, 0xbf, 0x31, 0x52, 0x18, 0xc7, 0x1b, 0x23, 0xc6, 0x6e, 0xb8, 0x38, 0xc2, 0xb9, 0xcd, 0x15,
+	0x52, 0x68, 0x36, 0xf8, 0x6c, 0x4f, 0x65, 0xa7, 0x96, 0x37, 0x6b, 0x50, 0x68, 0x36, 0xb0, 0xc8,
+	0x95, 0xf1, 0x9d, 0x1e, 0xf4, 0x71, 0xb1, 0x32, 0xf8, 0x63, 0x50, 0xd8, 0x67, 0x13, 0x51, 0x9f,
+	0x96, 0xc8, 0xa5, 0x21, 0x9d, 0x1c, 0xe6, 0x33, 0x5b, 0x23, 0x3c, 0xf3, 0x31, 0x99, 0x3e, 0xf2,
+	0x7c, 0x6c, 0x0e, 0x1b, 0xcf, 0x61, 0x6c, 0xaa, 0xd4, 0x2d, 0xce, 0x54, 0x9c, 0xf8, 0x88, 0xff,
+	0x41, 0x0a, 0xb2, 0xbf, 0x67, 0x91, 0x17, 0x79, 0xe9, 0x90, 0x6e, 0x6e, 0xe4, 0x10, 0xb9, 0x9e,
+	0xbd, 0x3b, 0xda, 0xd1, 0xfe, 0xe6, 0x10, 0x0e, 0xb5, 0x5f, 0x90, 0xcf, 0xfa, 0xe2, 0x30, 0x2c,
+	0x0c, 0x95, 0x6a, 0xaf, 0x13, 0xa2, 0x0e, 0xf2, 0xd3, 0x72, 0xfc, 0x35, 0x3c, 0xee, 0x50, 0x27,
+	0xfd, 0xf1, 0xcf, 0x78, 0xf9, 0xa2, 0x59, 0x1b, 0xa1, 0xa0, 0x0d, 0xc3, 0x33, 0x13, 0x65, 0xd3,
+	0xd4, 0x41, 0xf8, 0x99, 0x89, 0x32, 0x7a, 0x0c, 0x1a, 0x85, 0xfd, 0xb1, 0x45, 0x56, 0xd4, 0xcf,
+	0xd4, 0x93, 0x65, 0x57, 0xfb, 0xe6, 0x45, 0x37, 0x9a, 0x69, 0x60, 0xa8, 0xd2, 0x6b, 0x2f, 0x2f,
+	0x09, 0x06, 0x85, 0x57, 0xfe, 0xa3, 0x44, 0x16, 0xcd, 0xd9, 0xe3, 0x6f, 0x6f, 0x46, 0xec, 0xc8,
+	0x7b, 0x38, 0xf0, 0xf6, 0x26, 0x87, 0x82, 0xc4, 0xda, 0x21, 0x99, 0xf6, 0x69, 0x03, 0x43, 0xa3,
+	0x30, 0xde, 0x27, 0x50, 0x02, 0x77, 0x38, 0x7b, 0x90, 0x62, 0x50, 0xe0, 0x91, 0xc7, 0xfc, 0x66,
+	0xec, 0x14, 0x27, 0x24, 0xf0, 0x16, 0x67, 0x0f, 0x52, 0x8c, 0x76, 0x05, 0x55, 0x3b, 0x71, 0x4a,
+	0x17, 0xbe, 0x82, 0xaa, 0x9d, 0x40, 0xc6, 0x8f, 0xbf, 0x69, 0x7d, 0x94, 0xb0, 0xa8, 0x9e, 0xd0,
+	0x28, 0x7d, 0x11, 0x3a, 0x7b, 0xd3, 0x5a, 0x61, 0x40, 0xa3, 0xc2, 0xae, 0xc2, 0x32, 0xa6, 0x61,
+	0x3c, 0xb5, 0x8a, 0xa5, 0xe3, 0xdc, 0x1d, 0x53, 0xec, 0x62, 0xa2, 0x47, 0xbe, 0x32, 0x86, 0x95,
+	0xf2, 0x29, 0x3c, 0x86, 0x4c, 0xb4, 0xfd, 0xeb, 0x64, 0x41, 0x7c, 0x0b, 0xa0, 0x29, 0x4c, 0x26,
+	0x6b, 0x43, 0x51, 0x9a, 0xe9, 0x08, 0x30, 0xe9, 0x2a, 0x5f, 0x27, 0x97, 0x87, 0x0b, 0xc4, 0xe3,
+	0xb7, 0x1e, 0x4d, 0xda, 0xf9, 0xb7, 0xb3, 0x91, 0x02, 0x38, 0x06, 0x0f, 0x96, 0xf9, 0xf5, 0x93,
+	0x70, 0x38, 0x79, 0xb0, 0xcc, 0xef, 0xa5, 0x62, 0x90, 0x98, 0xca, 0x3f, 0x63, 0x3b, 0x83, 0xaa,
+	0xb9, 0xf8, 0x1b, 0xc6, 0x0c, 0x35, 0xf0, 0xe2, 0x6e, 0x3e, 0x33, 0xee, 0xa6, 0x08, 0xc8, 0x68,
+	0xec, 0x75, 0x52, 0xea, 0xc7, 0x2c, 0x3a, 0xdf, 0x5a, 0xc4, 0x2b, 0x3b, 0x7e, 0x26, 0xc7, 0x07,
+	0x1b, 0x8b, 0x5a, 0x71, 0x0c, 0x8b, 0x5a, 0xe5, 0x47, 0x25, 0xb2, 0x68, 0xb6, 0x1f, 0x3d, 0xa7,
+	0xcb, 0x00, 0x7c, 0xa1, 0x19, 0x37, 0x4c, 0xd5, 0x28, 0xc8, 0xbf, 0x3a, 0x7d, 0x28, 0xe1, 0xa0,
+	0x28, 0x6c, 0x20, 0x65, 0xfa, 0x74, 0xaf, 0xb5, 0x8b, 0x93, 0xd1, 0x74, 0x2c, 0x64, 0x6c, 0x90,
+	0x67, 0x9c, 0x92, 0x3b, 0xa5, 0x73, 0xf3, 0x54, 0x60, 0xc8, 0xd8, 0x9c, 0xfb, 0x9d, 0x77, 0x4c,
+	0x91, 0x11, 0x6b, 0x61, 0xe5, 0x3e, 0x6d, 0xa6, 0x48, 0xe0, 0x50, 0x90, 0x58, 0xac, 0x1f, 0xa2,
+	0xd0, 0x67, 0x55, 0xd8, 0x73, 0x66, 0xcc, 0xfa, 0x01, 0x04, 0x18, 0x52, 0xbc, 0xfd, 0xdb, 0x64,
+	0x39, 0xf6, 0x5a, 0x81, 0x17, 0xb4, 0xd6, 0x59, 0x94, 0xe0, 0x7e, 0x29, 0xe6, 0xef, 0xfc, 0x94,
+	0x6b, 0x2f, 0x9e, 0x3e, 0xba, 0xb6, 0x5c, 0xcf, 0xe1, 0x60, 0x80, 0xba, 0xf2, 0x97, 0xe8, 0x24,
+	0x46, 0x6f, 0x98, 0x39, 0x01, 0xd6, 0x04, 0x26, 0xa0, 0x30, 0x9e, 0x09, 0xc8, 0xec, 0x59, 0x7c,
+	0xac, 0x3d, 0x5f, 0x23, 0x53, 0xfc, 0xdb, 0x2b, 0x4e, 0xc9, 0xdc, 0xcb, 0xf3, 0xaf, 0x62, 0x80,
+	0xc0, 0xe1, 0x5e, 0xfe, 0x01, 0xf5, 0x12, 0x4c, 0xc1, 0x75, 0xe6, 0x86, 0x41, 0x53, 0x14, 0xa5,
+	0x45, 0xfd, 0x2a, 0xc0, 0x40, 0x43, 0x9e, 0xde, 0x74, 0x88, 0xe9, 0x11, 0x1c, 0xe2, 0x1c, 0x13,
+	0x7d, 0xbe, 0x77, 0x8a, 0xdf, 0x23, 0x8b, 0xfc, 0xa9, 0xaa, 0xae, 0x1b, 0xf6, 0xf9, 0x39, 0x4d,
+	0xd9, 0xac, 0x7e, 0x0e, 0x0c, 0x2c, 0xe4, 0xa8, 0x2b, 0x7f, 0x40, 0x66, 0x53, 0xfb, 0xdb, 0xaf,
+	0x6a, 0x5d, 0x1e, 0xd9, 0xc1, 0x04, 0x4e, 0x05, 0xc2, 0xf1, 0xa1, 0xc3, 0x1e, 0x8b, 0xe8, 0xb0,
+	0x13, 0xd5, 0x3b, 0x29, 0x02, 0x32, 0x9a, 0xac, 0x55, 0xa0, 0xf8, 0x98, 0x56, 0x81, 0xcf, 0x0a,
+	0x64, 0x39, 0xdf, 0xf3, 0x85, 0x37, 0xd9, 0xd2, 0x7d, 0xe5, 0x45, 0x82, 0x75, 0xee, 0x9b, 0xec,
+	0xba, 0x3e, 0x1e, 0x4c, 0x76, 0xf6, 0x2d, 0xac, 0xf9, 0x3a, 0x4c, 0x3c, 0xc6, 0xc8, 0x7c, 0xcb,
+	0xa2, 0x2c, 0xc4, 0xab, 0x31, 0x31, 0x5c, 0x4f, 0xb2, 0xc5, 0x67, 0x7a, 0xe3, 0x7a, 0xae, 0xf7,
+	0xf8, 0x71, 0x79, 0xb8, 0x3c, 0xbc, 0x8b, 0xed, 0x39, 0x2d, 0x13, 0xd9, 0x15, 0x70, 0xe1, 0xcc,
+	0x2b, 0xe0, 0x44, 0xd5, 0x20, 0xc5, 0x31, 0x75, 0xa5, 0x29, 0x03, 0x3c, 0xa6, 0x0c, 0xd1, 0x17,
+	0xb0, 0xd2, 0x13, 0x17, 0x30, 0xfc, 0xc8, 0x43, 0xdf, 0xed, 0xb0, 0xc4, 0x99, 0x32, 0xf3, 0x52,
+	0x8d, 0x43, 0x41, 0x62, 0x47, 0x5e, 0x0f, 0x30, 0x1f, 0xf7, 0x93, 0xb6, 0xb8, 0xbc, 0x9d, 0x39,
+	0x7f, 0x3e, 0x4e, 0xc7, 0x42, 0xc6, 0x06, 0x65, 0xd3, 0x9e, 0x87, 0x97, 0xd2, 0xb3, 0xa6, 0xec,
+	0x2a, 0x87, 0x82, 0xc4, 0x56, 0x5c, 0xb2, 0x32, 0x60, 0xa2, 0x91, 0xf7, 0xfa, 0xaf, 0x93, 0xe9,
+	0xb8, 0x7f, 0x84, 0x74, 0x05, 0x93, 0xae, 0xce, 0xa1, 0x20, 0xb1, 0x95, 0x6f, 0x97, 0xc8, 0xca,
+	0x40, 0x7b, 0xe0, 0x73, 0x72, 0x42, 0xbc, 0xad, 0xe5, 0xbb, 0xed, 0x7b, 0x5a, 0xe3, 0xd1, 0xac,
+	0x76, 0x5b, 0xab, 0x23, 0xc1, 0xa4, 0xb5, 0xb7, 0xb8, 0x55, 0xcf, 0xbd, 0x6f, 0xe1, 0x2e, 0x57,
+	0xdd, 0xdf, 0xc2, 0xa4, 0x2a, 0x19, 0x9c, 0xff, 0xb3, 0x1c, 0x6f, 0x93, 0x39, 0xfe, 0xd4, 0x62,
+	0x8e, 0x64, 0x5d, 0xc9, 0x6f, 0xef, 0x37, 0x32, 0x30, 0xe8, 0x34, 0x83, 0xad, 0x41, 0xd3, 0xe3,
+	0x6d, 0x0d, 0x5a, 0x23, 0xe5, 0x24, 0xf4, 0x59, 0x44, 0x03, 0x97, 0x71, 0xc7, 0x2d, 0x66, 0xcf,
+	0x70, 0x98, 0x22, 0x20, 0xa3, 0xa9, 0xfc, 0xa3, 0x45, 0xca, 0xea, 0x18, 0x83, 0x7f, 0x04, 0x85,
+	0xe2, 0x4e, 0x65, 0x3f, 0xdb, 0xe1, 0x67, 0x1f, 0x41, 0xa9, 0xa6, 0x18, 0xd0, 0xa8, 0x70, 0xe5,
+	0x13, 0x37, 0x0f, 0x6a, 0x5c, 0xee, 0xdc, 0x6f, 0xdd, 0xc0, 0x42, 0x8e, 0x9a, 0x4f, 0x3f, 0x87,
+	0x6c, 0xb3, 0x13, 0x3e, 0x3c, 0x7f, 0x59, 0xaf, 0x23, 0xc1, 0xa4, 0xad, 0xfc, 0x8d, 0x45, 0xf2,
+	0x0d, 0x03, 0x68, 0x83, 0xa6, 0x17, 0x71, 0x8b, 0x9d, 0xe4, 0x6b, 0x89, 0x9b, 0x29, 0x02, 0x32,
+	0x1a, 0x55, 0xd1, 0x14, 0xce, 0xac, 0x68, 0x6e, 0x10, 0x82, 0x7f, 0x81, 0xb5, 0xd8, 0xc3, 0x9e,
+	0x53, 0x34, 0xed, 0xb2, 0xaf, 0x30, 0xa0, 0x51, 0x55, 0xfe, 0xaf, 0x40, 0xe6, 0xe4, 0x5c, 0x61,
+	0x3e, 0xc0, 0x96, 0x90, 0x06, 0xa3, 0x11, 0x8b, 0x44, 0x56, 0xb1, 0xce, 0xdd, 0x12, 0x52, 0xcb,
+	0x46, 0x83, 0xce, 0xca, 0x6e, 0x93, 0x52, 0xbb, 0x4b, 0x5d, 0xb9, 0x88, 0xbe, 0x3f, 0x9e, 0x98,
+	0xbd, 0xbd, 0x5b, 0x5d, 0x17, 0x05, 0x13, 0xfe, 0x07, 0x5c, 0x82, 0xdd, 0x23, 0x53, 0x0d, 0x1a,
+	0x7b, 0xe9, 0x97, 0x3e, 0xee, 0x8c, 0x47, 0x54, 0x0d, 0x59, 0xa2, 0x8d, 0xc4, 0xca, 0xce, 0x7f,
+	0x82, 0x10, 0x84, 0x5f, 0x6c, 0x93, 0xfe, 0x52, 0xe5, 0xce, 0x51, 0x32, 0xbf, 0x2e, 0xb9, 0xae,
+	0xe1, 0xc0, 0xa0, 0xac, 0xfc, 0x83, 0x45, 0x96, 0xf3, 0x02, 0x8c, 0x2f, 0xe9, 0x58, 0xe3, 0xfe,
+	0x92, 0xce, 0x38, 0xce, 0x45, 0x2b, 0x3f, 0x9c, 0x26, 0x8b, 0x66, 0xda, 0xc4, 0xb5, 0x90, 0x05,
+	0xcd, 0x5e, 0xe8, 0xc9, 0xaf, 0xdd, 0x69, 0x6b, 0xe1, 0x86, 0x84, 0x83, 0xa2, 0xc0, 0x25, 0xa0,
+	0xcb, 0x92, 0x76, 0xd8, 0xcc, 0x2f, 0x01, 0xbb, 0x1c, 0x0a, 0x12, 0xcb, 0xbd, 0x3e, 0x8c, 0x12,
+	0xa7, 0x98, 0xf3, 0xfa, 0x30, 0x4a, 0x80, 0x63, 0xd2, 0x0b, 0xb2, 0xd2, 0x19, 0x17, 0x64, 0xef,
+	0x91, 0xc5, 0x98, 0x45, 0xf7, 0x59, 0xa4, 0x02, 0x7f, 0xca, 0x0c, 0xfc, 0xba, 0x81, 0x85, 0x1c,
+	0x35, 0x06, 0xbe, 0x80, 0xa4, 0x81, 0x9f, 0x6b, 0xd6, 0xaa, 0xeb, 0x48, 0x30, 0x69, 0xd1, 0xe7,
+	0x71, 0x69, 0x75, 0x66, 0xc6, 0xe9, 0xf3, 0xdc, 0x07, 0xb9, 0xcf, 0xe3, 0x7f, 0xc0, 0x25, 0xe0,
+	0xc5, 0xba, 0xb0, 0x58, 0x5a, 0xe7, 0xf1, 0x55, 0x4c, 0x18, 0x33, 0x86, 0x14, 0x87, 0xd6, 0xe8,
+	0xd2, 0x87, 0xf2, 0x9b, 0x5e, 0xfc, 0xe3, 0x82, 0x65, 0x9e, 0x7e, 0x95, 0x35, 0x76, 0x0d, 0x2c,
+	0xe4, 0xa8, 0xf1, 0x5c, 0x3e, 0x62, 0xb4, 0x89, 0xd5, 0x4d, 0xd8, 0x4f, 0xf8, 0xad, 0x76, 0x31,
+	0x3b, 0x97, 0x87, 0x0c, 0x05, 0x3a, 0x1d, 0xc6, 0xc7, 0x83, 0xc8, 0x4b, 0x58, 0x3a, 0x6e, 0x8e,
+	0x8f, 0x53, 0xf1, 0x71, 0x4f, 0xc3, 0x81, 0x41, 0x89, 0x02, 0xbd, 0xa6, 0xaf, 0x06, 0xce, 0x9b,
+	0x02, 0xb7, 0x32, 0x14, 0xe8, 0x74, 0xf6, 0xef, 0x92, 0x72, 0x44, 0x13, 0xb6, 0xe3, 0x75, 0xbd,
+	0xc4, 0x59, 0x18, 0x67, 0x1a, 0x80, 0x94, 0xad, 0xd8, 0x44, 0xa9, 0x9f, 0x90, 0x09, 0xac, 0x7c,
+	0x27, 0x4b, 0xaa, 0x98, 0x96, 0x70, 0xf9, 0x8f, 0x9f, 0xa2, 0x32, 0xe1, 0xcb, 0xbf, 0x00, 0x83,
+	0x64, 0x80, 0x71, 0xd3, 0x66, 0xb4, 0xc9, 0xa2, 0x7c, 0xdc, 0xdc, 0xe6, 0x50, 0x90, 0x58, 0x5c,
+	0x5e, 0xa8, 0xdf, 0x0a, 0x23, 0x2f, 0x69, 0x77, 0xf3, 0x17, 0x17, 0xd5, 0x14, 0x01, 0x19, 0x8d,
+	0xb6, 0x77, 0x2b, 0x3d, 0x76, 0xef, 0xc6, 0xc3, 0x5c, 0x7c, 0x30, 0x2e, 0xdf, 0x4b, 0xb6, 0x21,
+	0xe1, 0xa0, 0x28, 0x2a, 0xdf, 0xcc, 0xd2, 0x9b, 0xb2, 0x94, 0xe8, 0xf4, 0x39, 0xee, 0xb3, 0x38,
+	0x89, 0xf7, 0x59, 0x24, 0xaa, 0x64, 0xf9, 0x81, 0x4c, 0xad, 0xd3, 0x27, 0x47, 0x00, 0x83, 0x63,
+	0xb0, 0x64, 0x6c, 0xf4, 0xa3, 0x38, 0x91, 0x5f, 0xfb, 0x53, 0x25, 0x63, 0x0d, 0x81, 0x20, 0x70,
+	0xb5, 0xd5, 0x4f, 0x3e, 0xbb, 0xfa, 0xc2, 0xa7, 0x9f, 0x5d, 0x7d, 0xe1, 0x27, 0x9f, 0x5d, 0x7d,
+	0xe1, 0x9b, 0xa7, 0x57, 0xad, 0x4f, 0x4e, 0xaf, 0x5a, 0x9f, 0x9e, 0x5e, 0xb5, 0x7e, 0x72, 0x7a,
+	0xd5, 0xfa, 0xe9, 0xe9, 0x55, 0xeb, 0xe3, 0x7f, 0xbf, 0xfa, 0xc2, 0x57, 0x66, 0xd3, 0x79, 0xfe,
+	0xff, 0x01, 0x00, 0x0f, 0x75, 0xe7, 0x9c, 0x54, 0x5b, 0x00, 0x00,
+}
+
+func (m *AMQPConsumeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AMQPConsumeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AMQPConsumeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.NoWait {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.NoLocal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.Exclusive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i--
+	if m.AutoAck {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.ConsumerTag)
+	copy(dAtA[i:], m.ConsumerTag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConsumerTag)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *AMQPEventSource) Marshal() (dAtA []byte, err error) {
@@ -1244,6 +1758,54 @@ func (m *AMQPEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Consume != nil {
+		{
+			size, err := m.Consume.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.QoS != nil {
+		{
+			size, err := m.QoS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.QueueDeclare != nil {
+		{
+			size, err := m.QueueDeclare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExchangeDeclare != nil {
+		{
+			size, err := m.ExchangeDeclare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -1299,7 +1861,7 @@ func (m *AMQPEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AzureEventsHubEventSource) Marshal() (dAtA []byte, err error) {
+func (m *AMQPExchangeDeclareConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1309,59 +1871,52 @@ func (m *AzureEventsHubEventSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AzureEventsHubEventSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *AMQPExchangeDeclareConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AzureEventsHubEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AMQPExchangeDeclareConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Namespace)
-	copy(dAtA[i:], m.Namespace)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i--
-	dAtA[i] = 0x2a
-	i -= len(m.HubName)
-	copy(dAtA[i:], m.HubName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HubName)))
+	if m.NoWait {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
-	dAtA[i] = 0x22
-	if m.SharedAccessKey != nil {
-		{
-			size, err := m.SharedAccessKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	dAtA[i] = 0x20
+	i--
+	if m.Internal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if m.SharedAccessKeyName != nil {
-		{
-			size, err := m.SharedAccessKeyName.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x18
+	i--
+	if m.AutoDelete {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i -= len(m.FQDN)
-	copy(dAtA[i:], m.FQDN)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FQDN)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x10
+	i--
+	if m.Durable {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *CalendarEventSource) Marshal() (dAtA []byte, err error) {
+func (m *AMQPQoSConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1371,51 +1926,34 @@ func (m *CalendarEventSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CalendarEventSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *AMQPQoSConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CalendarEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AMQPQoSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UserPayload != nil {
-		i -= len(m.UserPayload)
-		copy(dAtA[i:], m.UserPayload)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.UserPayload)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	i -= len(m.Timezone)
-	copy(dAtA[i:], m.Timezone)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
 	i--
-	dAtA[i] = 0x22
-	if len(m.ExclusionDates) > 0 {
-		for iNdEx := len(m.ExclusionDates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExclusionDates[iNdEx])
-			copy(dAtA[i:], m.ExclusionDates[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExclusionDates[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Global {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i -= len(m.Interval)
-	copy(dAtA[i:], m.Interval)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Schedule)
-	copy(dAtA[i:], m.Schedule)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PrefetchSize))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PrefetchCount))
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *EmitterEventSource) Marshal() (dAtA []byte, err error) {
+func (m *AMQPQueueDeclareConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1425,38 +1963,302 @@ func (m *EmitterEventSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmitterEventSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *AMQPQueueDeclareConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmitterEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AMQPQueueDeclareConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TLS != nil {
-		{
-			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
+	i -= len(m.Arguments)
+	copy(dAtA[i:], m.Arguments)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Arguments)))
 	i--
-	if m.JSONBody {
+	dAtA[i] = 0x32
+	i--
+	if m.NoWait {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x40
-	if m.ConnectionBackoff != nil {
-		{
+	dAtA[i] = 0x28
+	i--
+	if m.Exclusive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.AutoDelete {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i--
+	if m.Durable {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureEventsHubEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureEventsHubEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureEventsHubEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.HubName)
+	copy(dAtA[i:], m.HubName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HubName)))
+	i--
+	dAtA[i] = 0x22
+	if m.SharedAccessKey != nil {
+		{
+			size, err := m.SharedAccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SharedAccessKeyName != nil {
+		{
+			size, err := m.SharedAccessKeyName.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.FQDN)
+	copy(dAtA[i:], m.FQDN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FQDN)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarCatchUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarCatchUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarCatchUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxEvents))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CatchUp != nil {
+		{
+			size, err := m.CatchUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Persistence != nil {
+		{
+			size, err := m.Persistence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UserPayload != nil {
+		i -= len(m.UserPayload)
+		copy(dAtA[i:], m.UserPayload)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.UserPayload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ExclusionDates) > 0 {
+		for iNdEx := len(m.ExclusionDates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExclusionDates[iNdEx])
+			copy(dAtA[i:], m.ExclusionDates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExclusionDates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarPersistence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarPersistence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarPersistence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ConfigMap)
+	copy(dAtA[i:], m.ConfigMap)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMap)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmitterEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmitterEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmitterEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i--
+	if m.JSONBody {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	if m.ConnectionBackoff != nil {
+		{
 			size, err := m.ConnectionBackoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -2572,11 +3374,41 @@ func (m *GitlabEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.ProjectID)
-	copy(dAtA[i:], m.ProjectID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectID)))
-	i--
-	dAtA[i] = 0x4a
+	if len(m.GroupIDs) > 0 {
+		for iNdEx := len(m.GroupIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupIDs[iNdEx])
+			copy(dAtA[i:], m.GroupIDs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ProjectIDs) > 0 {
+		for iNdEx := len(m.ProjectIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProjectIDs[iNdEx])
+			copy(dAtA[i:], m.ProjectIDs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SecretToken != nil {
+		{
+			size, err := m.SecretToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.ProjectID)
+	copy(dAtA[i:], m.ProjectID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectID)))
+	i--
+	dAtA[i] = 0x4a
 	i--
 	if m.DeleteHookOnFinish {
 		dAtA[i] = 1
@@ -2747,6 +3579,39 @@ func (m *HDFSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaConsumerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.RebalanceStrategy)
+	copy(dAtA[i:], m.RebalanceStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RebalanceStrategy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.GroupName)
+	copy(dAtA[i:], m.GroupName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KafkaEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2767,6 +3632,57 @@ func (m *KafkaEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x5a
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.InitialOffset)
+	copy(dAtA[i:], m.InitialOffset)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.InitialOffset)))
+	i--
+	dAtA[i] = 0x4a
+	if m.ConsumerGroup != nil {
+		{
+			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i--
+	if m.JSONBody {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -3170,6 +4086,29 @@ func (m *ResourceEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NamespaceSelector) > 0 {
+		for iNdEx := len(m.NamespaceSelector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceSelector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.EventTypes) > 0 {
 		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EventTypes[iNdEx])
@@ -3229,6 +4168,29 @@ func (m *ResourceFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.JSONPaths) > 0 {
+		for iNdEx := len(m.JSONPaths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JSONPaths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	i--
 	if m.AfterStart {
 		dAtA[i] = 1
@@ -3283,6 +4245,95 @@ func (m *ResourceFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceJSONPathFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceJSONPathFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceJSONPathFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SASLConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SASLConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SASLConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Mechanism)
+	copy(dAtA[i:], m.Mechanism)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mechanism)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SNSEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3303,6 +4354,15 @@ func (m *SNSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SigningCertHosts) > 0 {
+		for iNdEx := len(m.SigningCertHosts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigningCertHosts[iNdEx])
+			copy(dAtA[i:], m.SigningCertHosts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SigningCertHosts[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	i -= len(m.RoleARN)
 	copy(dAtA[i:], m.RoleARN)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
@@ -3688,6 +4748,21 @@ func (m *StripeEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Tolerance))
+	i--
+	dAtA[i] = 0x38
+	if m.WebhookSecret != nil {
+		{
+			size, err := m.WebhookSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.EventFilter) > 0 {
 		for iNdEx := len(m.EventFilter) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EventFilter[iNdEx])
@@ -3813,7 +4888,7 @@ func (m *WatchPathConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WebhookContext) Marshal() (dAtA []byte, err error) {
+func (m *WebhookAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3823,60 +4898,315 @@ func (m *WebhookContext) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebhookContext) MarshalTo(dAtA []byte) (int, error) {
+func (m *WebhookAuth) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WebhookContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WebhookAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.ServerKeyPath)
-	copy(dAtA[i:], m.ServerKeyPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerKeyPath)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.ServerCertPath)
-	copy(dAtA[i:], m.ServerCertPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerCertPath)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i -= len(m.ClientCAPath)
+	copy(dAtA[i:], m.ClientCAPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClientCAPath)))
 	i--
 	dAtA[i] = 0x22
-	i -= len(m.Port)
-	copy(dAtA[i:], m.Port)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Port)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Method)
-	copy(dAtA[i:], m.Method)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Endpoint)
-	copy(dAtA[i:], m.Endpoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.Basic != nil {
+		{
+			size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+	if m.HMAC != nil {
+		{
+			size, err := m.HMAC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BearerToken != nil {
+		{
+			size, err := m.BearerToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookBasicAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookBasicAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookBasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Username != nil {
+		{
+			size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.IdleTimeout))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintGenerated(dAtA, i, uint64(m.WriteTimeout))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadTimeout))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxPayloadSize))
+	i--
+	dAtA[i] = 0x48
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Methods[iNdEx])
+			copy(dAtA[i:], m.Methods[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Methods[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.ServerKeyPath)
+	copy(dAtA[i:], m.ServerKeyPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerKeyPath)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ServerCertPath)
+	copy(dAtA[i:], m.ServerCertPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerCertPath)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Port)
+	copy(dAtA[i:], m.Port)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Port)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookHMAC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookHMAC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookHMAC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Encoding)
+	copy(dAtA[i:], m.Encoding)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Encoding)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Prefix)
+	copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Algorithm)
+	copy(dAtA[i:], m.Algorithm)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Algorithm)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x12
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestsPerSecond))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AMQPConsumeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerTag)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	n += 2
+	n += 2
+	return n
+}
+
 func (m *AMQPEventSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3900,25 +5230,83 @@ func (m *AMQPEventSource) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ExchangeDeclare != nil {
+		l = m.ExchangeDeclare.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.QueueDeclare != nil {
+		l = m.QueueDeclare.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.QoS != nil {
+		l = m.QoS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Consume != nil {
+		l = m.Consume.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *AzureEventsHubEventSource) Size() (n int) {
+func (m *AMQPExchangeDeclareConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FQDN)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SharedAccessKeyName != nil {
-		l = m.SharedAccessKeyName.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SharedAccessKey != nil {
-		l = m.SharedAccessKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	n += 2
+	n += 2
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *AMQPQoSConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.PrefetchCount))
+	n += 1 + sovGenerated(uint64(m.PrefetchSize))
+	n += 2
+	return n
+}
+
+func (m *AMQPQueueDeclareConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	n += 2
+	n += 2
+	l = len(m.Arguments)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureEventsHubEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FQDN)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SharedAccessKeyName != nil {
+		l = m.SharedAccessKeyName.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SharedAccessKey != nil {
+		l = m.SharedAccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	l = len(m.HubName)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Namespace)
@@ -3926,6 +5314,18 @@ func (m *AzureEventsHubEventSource) Size() (n int) {
 	return n
 }
 
+func (m *CalendarCatchUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxEvents))
+	return n
+}
+
 func (m *CalendarEventSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3948,6 +5348,25 @@ func (m *CalendarEventSource) Size() (n int) {
 		l = len(m.UserPayload)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Persistence != nil {
+		l = m.Persistence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CatchUp != nil {
+		l = m.CatchUp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CalendarPersistence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMap)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4338,6 +5757,22 @@ func (m *GitlabEventSource) Size() (n int) {
 	n += 2
 	l = len(m.ProjectID)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretToken != nil {
+		l = m.SecretToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ProjectIDs) > 0 {
+		for _, s := range m.ProjectIDs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.GroupIDs) > 0 {
+		for _, s := range m.GroupIDs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4384,6 +5819,19 @@ func (m *HDFSEventSource) Size() (n int) {
 	return n
 }
 
+func (m *KafkaConsumerGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RebalanceStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *KafkaEventSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4404,6 +5852,25 @@ func (m *KafkaEventSource) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ConsumerGroup != nil {
+		l = m.ConsumerGroup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.InitialOffset)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4545,6 +6012,18 @@ func (m *ResourceEventSource) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.NamespaceSelector) > 0 {
+		for _, e := range m.NamespaceSelector {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4571,6 +6050,54 @@ func (m *ResourceFilter) Size() (n int) {
 	l = m.CreatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if len(m.JSONPaths) > 0 {
+		for _, e := range m.JSONPaths {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceJSONPathFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SASLConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mechanism)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4600,6 +6127,12 @@ func (m *SNSEventSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.RoleARN)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SigningCertHosts) > 0 {
+		for _, s := range m.SigningCertHosts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4741,6 +6274,11 @@ func (m *StripeEventSource) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.WebhookSecret != nil {
+		l = m.WebhookSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Tolerance))
 	return n
 }
 
@@ -4774,6 +6312,46 @@ func (m *WatchPathConfig) Size() (n int) {
 	return n
 }
 
+func (m *WebhookAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BearerToken != nil {
+		l = m.BearerToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HMAC != nil {
+		l = m.HMAC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Basic != nil {
+		l = m.Basic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ClientCAPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebhookBasicAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Username != nil {
+		l = m.Username.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *WebhookContext) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4792,6 +6370,56 @@ func (m *WebhookContext) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.ServerKeyPath)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.MaxPayloadSize))
+	n += 1 + sovGenerated(uint64(m.ReadTimeout))
+	n += 1 + sovGenerated(uint64(m.WriteTimeout))
+	n += 1 + sovGenerated(uint64(m.IdleTimeout))
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookHMAC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Algorithm)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Encoding)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebhookRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.RequestsPerSecond))
+	n += 1 + sovGenerated(uint64(m.Burst))
 	return n
 }
 
@@ -4801,6 +6429,20 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *AMQPConsumeConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AMQPConsumeConfig{`,
+		`ConsumerTag:` + fmt.Sprintf("%v", this.ConsumerTag) + `,`,
+		`AutoAck:` + fmt.Sprintf("%v", this.AutoAck) + `,`,
+		`Exclusive:` + fmt.Sprintf("%v", this.Exclusive) + `,`,
+		`NoLocal:` + fmt.Sprintf("%v", this.NoLocal) + `,`,
+		`NoWait:` + fmt.Sprintf("%v", this.NoWait) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AMQPEventSource) String() string {
 	if this == nil {
 		return "nil"
@@ -4813,6 +6455,50 @@ func (this *AMQPEventSource) String() string {
 		`ConnectionBackoff:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionBackoff), "Backoff", "common.Backoff", 1) + `,`,
 		`JSONBody:` + fmt.Sprintf("%v", this.JSONBody) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`ExchangeDeclare:` + strings.Replace(this.ExchangeDeclare.String(), "AMQPExchangeDeclareConfig", "AMQPExchangeDeclareConfig", 1) + `,`,
+		`QueueDeclare:` + strings.Replace(this.QueueDeclare.String(), "AMQPQueueDeclareConfig", "AMQPQueueDeclareConfig", 1) + `,`,
+		`QoS:` + strings.Replace(this.QoS.String(), "AMQPQoSConfig", "AMQPQoSConfig", 1) + `,`,
+		`Consume:` + strings.Replace(this.Consume.String(), "AMQPConsumeConfig", "AMQPConsumeConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AMQPExchangeDeclareConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AMQPExchangeDeclareConfig{`,
+		`Durable:` + fmt.Sprintf("%v", this.Durable) + `,`,
+		`AutoDelete:` + fmt.Sprintf("%v", this.AutoDelete) + `,`,
+		`Internal:` + fmt.Sprintf("%v", this.Internal) + `,`,
+		`NoWait:` + fmt.Sprintf("%v", this.NoWait) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AMQPQoSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AMQPQoSConfig{`,
+		`PrefetchCount:` + fmt.Sprintf("%v", this.PrefetchCount) + `,`,
+		`PrefetchSize:` + fmt.Sprintf("%v", this.PrefetchSize) + `,`,
+		`Global:` + fmt.Sprintf("%v", this.Global) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AMQPQueueDeclareConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AMQPQueueDeclareConfig{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Durable:` + fmt.Sprintf("%v", this.Durable) + `,`,
+		`AutoDelete:` + fmt.Sprintf("%v", this.AutoDelete) + `,`,
+		`Exclusive:` + fmt.Sprintf("%v", this.Exclusive) + `,`,
+		`NoWait:` + fmt.Sprintf("%v", this.NoWait) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4831,6 +6517,17 @@ func (this *AzureEventsHubEventSource) String() string {
 	}, "")
 	return s
 }
+func (this *CalendarCatchUp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CalendarCatchUp{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`MaxEvents:` + fmt.Sprintf("%v", this.MaxEvents) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CalendarEventSource) String() string {
 	if this == nil {
 		return "nil"
@@ -4841,6 +6538,18 @@ func (this *CalendarEventSource) String() string {
 		`ExclusionDates:` + fmt.Sprintf("%v", this.ExclusionDates) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
 		`UserPayload:` + valueToStringGenerated(this.UserPayload) + `,`,
+		`Persistence:` + strings.Replace(this.Persistence.String(), "CalendarPersistence", "CalendarPersistence", 1) + `,`,
+		`CatchUp:` + strings.Replace(this.CatchUp.String(), "CalendarCatchUp", "CalendarCatchUp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CalendarPersistence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CalendarPersistence{`,
+		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5220,6 +6929,9 @@ func (this *GitlabEventSource) String() string {
 		`GitlabBaseURL:` + fmt.Sprintf("%v", this.GitlabBaseURL) + `,`,
 		`DeleteHookOnFinish:` + fmt.Sprintf("%v", this.DeleteHookOnFinish) + `,`,
 		`ProjectID:` + fmt.Sprintf("%v", this.ProjectID) + `,`,
+		`SecretToken:` + strings.Replace(fmt.Sprintf("%v", this.SecretToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ProjectIDs:` + fmt.Sprintf("%v", this.ProjectIDs) + `,`,
+		`GroupIDs:` + fmt.Sprintf("%v", this.GroupIDs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5245,6 +6957,17 @@ func (this *HDFSEventSource) String() string {
 	}, "")
 	return s
 }
+func (this *KafkaConsumerGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaConsumerGroup{`,
+		`GroupName:` + fmt.Sprintf("%v", this.GroupName) + `,`,
+		`RebalanceStrategy:` + fmt.Sprintf("%v", this.RebalanceStrategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KafkaEventSource) String() string {
 	if this == nil {
 		return "nil"
@@ -5255,6 +6978,12 @@ func (this *KafkaEventSource) String() string {
 		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
 		`ConnectionBackoff:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionBackoff), "Backoff", "common.Backoff", 1) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`JSONBody:` + fmt.Sprintf("%v", this.JSONBody) + `,`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`ConsumerGroup:` + strings.Replace(this.ConsumerGroup.String(), "KafkaConsumerGroup", "KafkaConsumerGroup", 1) + `,`,
+		`InitialOffset:` + fmt.Sprintf("%v", this.InitialOffset) + `,`,
+		`SASL:` + strings.Replace(this.SASL.String(), "SASLConfig", "SASLConfig", 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5338,11 +7067,18 @@ func (this *ResourceEventSource) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForNamespaceSelector := "[]Selector{"
+	for _, f := range this.NamespaceSelector {
+		repeatedStringForNamespaceSelector += strings.Replace(strings.Replace(f.String(), "Selector", "Selector", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNamespaceSelector += "}"
 	s := strings.Join([]string{`&ResourceEventSource{`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "ResourceFilter", "ResourceFilter", 1) + `,`,
 		`GroupVersionResource:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GroupVersionResource), "GroupVersionResource", "v11.GroupVersionResource", 1), `&`, ``, 1) + `,`,
 		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`NamespaceSelector:` + repeatedStringForNamespaceSelector + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5361,12 +7097,42 @@ func (this *ResourceFilter) String() string {
 		repeatedStringForFields += strings.Replace(strings.Replace(f.String(), "Selector", "Selector", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForFields += "}"
+	repeatedStringForJSONPaths := "[]ResourceJSONPathFilter{"
+	for _, f := range this.JSONPaths {
+		repeatedStringForJSONPaths += strings.Replace(strings.Replace(f.String(), "ResourceJSONPathFilter", "ResourceJSONPathFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForJSONPaths += "}"
 	s := strings.Join([]string{`&ResourceFilter{`,
 		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
 		`Labels:` + repeatedStringForLabels + `,`,
 		`Fields:` + repeatedStringForFields + `,`,
 		`CreatedBy:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedBy), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
 		`AfterStart:` + fmt.Sprintf("%v", this.AfterStart) + `,`,
+		`JSONPaths:` + repeatedStringForJSONPaths + `,`,
+		`ChangedFields:` + fmt.Sprintf("%v", this.ChangedFields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceJSONPathFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceJSONPathFilter{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SASLConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SASLConfig{`,
+		`Mechanism:` + fmt.Sprintf("%v", this.Mechanism) + `,`,
+		`User:` + strings.Replace(fmt.Sprintf("%v", this.User), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5383,6 +7149,7 @@ func (this *SNSEventSource) String() string {
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
 		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`SigningCertHosts:` + fmt.Sprintf("%v", this.SigningCertHosts) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5468,6 +7235,8 @@ func (this *StripeEventSource) String() string {
 		`APIKey:` + strings.Replace(fmt.Sprintf("%v", this.APIKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`EventFilter:` + fmt.Sprintf("%v", this.EventFilter) + `,`,
+		`WebhookSecret:` + strings.Replace(fmt.Sprintf("%v", this.WebhookSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Tolerance:` + fmt.Sprintf("%v", this.Tolerance) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5496,6 +7265,30 @@ func (this *WatchPathConfig) String() string {
 	}, "")
 	return s
 }
+func (this *WebhookAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookAuth{`,
+		`BearerToken:` + strings.Replace(fmt.Sprintf("%v", this.BearerToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`HMAC:` + strings.Replace(this.HMAC.String(), "WebhookHMAC", "WebhookHMAC", 1) + `,`,
+		`Basic:` + strings.Replace(this.Basic.String(), "WebhookBasicAuth", "WebhookBasicAuth", 1) + `,`,
+		`ClientCAPath:` + fmt.Sprintf("%v", this.ClientCAPath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookBasicAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookBasicAuth{`,
+		`Username:` + strings.Replace(fmt.Sprintf("%v", this.Username), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WebhookContext) String() string {
 	if this == nil {
 		return "nil"
@@ -5507,6 +7300,38 @@ func (this *WebhookContext) String() string {
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`ServerCertPath:` + fmt.Sprintf("%v", this.ServerCertPath) + `,`,
 		`ServerKeyPath:` + fmt.Sprintf("%v", this.ServerKeyPath) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "WebhookAuth", "WebhookAuth", 1) + `,`,
+		`Methods:` + fmt.Sprintf("%v", this.Methods) + `,`,
+		`MaxPayloadSize:` + fmt.Sprintf("%v", this.MaxPayloadSize) + `,`,
+		`ReadTimeout:` + fmt.Sprintf("%v", this.ReadTimeout) + `,`,
+		`WriteTimeout:` + fmt.Sprintf("%v", this.WriteTimeout) + `,`,
+		`IdleTimeout:` + fmt.Sprintf("%v", this.IdleTimeout) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "WebhookRateLimit", "WebhookRateLimit", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookHMAC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookHMAC{`,
+		`Secret:` + strings.Replace(fmt.Sprintf("%v", this.Secret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Encoding:` + fmt.Sprintf("%v", this.Encoding) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookRateLimit{`,
+		`RequestsPerSecond:` + fmt.Sprintf("%v", this.RequestsPerSecond) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5519,7 +7344,7 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
+func (m *AMQPConsumeConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5542,15 +7367,15 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AMQPEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: AMQPConsumeConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMQPEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AMQPConsumeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerTag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5578,13 +7403,13 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ConsumerTag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoAck", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5594,29 +7419,17 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExchangeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AutoAck = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusive", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5626,29 +7439,17 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExchangeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Exclusive = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoLocal", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5658,63 +7459,15 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RoutingKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.NoLocal = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5731,43 +7484,7 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.NoWait = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5792,7 +7509,7 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
+func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5815,15 +7532,15 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AzureEventsHubEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: AMQPEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AzureEventsHubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AMQPEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FQDN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5851,13 +7568,13 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FQDN = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKeyName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5867,33 +7584,29 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SharedAccessKeyName == nil {
-				m.SharedAccessKeyName = &v1.SecretKeySelector{}
-			}
-			if err := m.SharedAccessKeyName.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExchangeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5903,31 +7616,27 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SharedAccessKey == nil {
-				m.SharedAccessKey = &v1.SecretKeySelector{}
-			}
-			if err := m.SharedAccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExchangeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5955,13 +7664,13 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HubName = string(dAtA[iNdEx:postIndex])
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5971,82 +7680,53 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CalendarEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CalendarEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.JSONBody = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6056,29 +7736,33 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeDeclare", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6088,29 +7772,33 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = string(dAtA[iNdEx:postIndex])
+			if m.ExchangeDeclare == nil {
+				m.ExchangeDeclare = &AMQPExchangeDeclareConfig{}
+			}
+			if err := m.ExchangeDeclare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionDates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueDeclare", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6120,29 +7808,33 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExclusionDates = append(m.ExclusionDates, string(dAtA[iNdEx:postIndex]))
+			if m.QueueDeclare == nil {
+				m.QueueDeclare = &AMQPQueueDeclareConfig{}
+			}
+			if err := m.QueueDeclare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QoS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6152,29 +7844,33 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			if m.QoS == nil {
+				m.QoS = &AMQPQoSConfig{}
+			}
+			if err := m.QoS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consume", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6184,24 +7880,26 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserPayload = append(m.UserPayload[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserPayload == nil {
-				m.UserPayload = []byte{}
+			if m.Consume == nil {
+				m.Consume = &AMQPConsumeConfig{}
+			}
+			if err := m.Consume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6228,7 +7926,7 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
+func (m *AMQPExchangeDeclareConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6251,17 +7949,17 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmitterEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: AMQPExchangeDeclareConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmitterEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AMQPExchangeDeclareConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6271,29 +7969,17 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Broker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Durable = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDelete", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6303,29 +7989,17 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AutoDelete = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6335,29 +8009,17 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Internal = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6367,65 +8029,70 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.NoWait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AMQPQoSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
-			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPQoSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPQoSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefetchCount", wireType)
 			}
-			var msglen int
+			m.PrefetchCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6435,33 +8102,16 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrefetchCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefetchSize", wireType)
 			}
-			var msglen int
+			m.PrefetchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6471,31 +8121,14 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrefetchSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6512,43 +8145,7 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Global = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6573,7 +8170,7 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSource) Unmarshal(dAtA []byte) error {
+func (m *AMQPQueueDeclareConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6596,17 +8193,17 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: AMQPQueueDeclareConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AMQPQueueDeclareConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6616,30 +8213,29 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6649,30 +8245,77 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Durable = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDelete", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AutoDelete = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusive", wireType)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+			m.Exclusive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoWait = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6682,24 +8325,23 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Arguments = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6725,7 +8367,7 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSourceList) Unmarshal(dAtA []byte) error {
+func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6748,17 +8390,17 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureEventsHubEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureEventsHubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FQDN", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6768,28 +8410,63 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FQDN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKeyName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SharedAccessKeyName == nil {
+				m.SharedAccessKeyName = &v1.SecretKeySelector{}
+			}
+			if err := m.SharedAccessKeyName.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6816,11 +8493,77 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, EventSource{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SharedAccessKey == nil {
+				m.SharedAccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SharedAccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6845,7 +8588,7 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
+func (m *CalendarCatchUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6868,17 +8611,17 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalendarCatchUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalendarCatchUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6888,126 +8631,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Minio == nil {
-				m.Minio = make(map[string]common.S3Artifact)
-			}
-			var mapkey string
-			mapvalue := &common.S3Artifact{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &common.S3Artifact{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Minio[mapkey] = *mapvalue
+			m.Policy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvents", wireType)
 			}
-			var msglen int
+			m.MaxEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7017,126 +8663,69 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxEvents |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Calendar == nil {
-				m.Calendar = make(map[string]CalendarEventSource)
-			}
-			var mapkey string
-			mapvalue := &CalendarEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &CalendarEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.Calendar[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalendarEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalendarEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7146,126 +8735,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = make(map[string]FileEventSource)
-			}
-			var mapkey string
-			mapvalue := &FileEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &FileEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.File[mapkey] = *mapvalue
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7275,126 +8767,61 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = make(map[string]ResourceEventSource)
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionDates", wireType)
 			}
-			var mapkey string
-			mapvalue := &ResourceEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ResourceEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Resource[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionDates = append(m.ExclusionDates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7404,124 +8831,61 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = make(map[string]WebhookContext)
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPayload", wireType)
 			}
-			var mapkey string
-			mapvalue := &WebhookContext{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &WebhookContext{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Webhook[mapkey] = *mapvalue
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserPayload = append(m.UserPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserPayload == nil {
+				m.UserPayload = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7548,109 +8912,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AMQP == nil {
-				m.AMQP = make(map[string]AMQPEventSource)
+			if m.Persistence == nil {
+				m.Persistence = &CalendarPersistence{}
 			}
-			var mapkey string
-			mapvalue := &AMQPEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &AMQPEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AMQP[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7677,111 +8948,71 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kafka == nil {
-				m.Kafka = make(map[string]KafkaEventSource)
+			if m.CatchUp == nil {
+				m.CatchUp = &CalendarCatchUp{}
 			}
-			var mapkey string
-			mapvalue := &KafkaEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &KafkaEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.CatchUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Kafka[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalendarPersistence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalendarPersistence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalendarPersistence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7791,126 +9022,114 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MQTT == nil {
-				m.MQTT = make(map[string]MQTTEventSource)
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &MQTTEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmitterEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmitterEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &MQTTEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MQTT[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7920,80 +9139,3374 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = make(map[string]NATSEventsSource)
+			m.ChannelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelName", wireType)
 			}
-			var mapkey string
-			mapvalue := &NATSEventsSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, EventSource{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Minio == nil {
+				m.Minio = make(map[string]common.S3Artifact)
+			}
+			var mapkey string
+			mapvalue := &common.S3Artifact{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &common.S3Artifact{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Minio[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Calendar == nil {
+				m.Calendar = make(map[string]CalendarEventSource)
+			}
+			var mapkey string
+			mapvalue := &CalendarEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CalendarEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Calendar[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = make(map[string]FileEventSource)
+			}
+			var mapkey string
+			mapvalue := &FileEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FileEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.File[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = make(map[string]ResourceEventSource)
+			}
+			var mapkey string
+			mapvalue := &ResourceEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ResourceEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Resource[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = make(map[string]WebhookContext)
+			}
+			var mapkey string
+			mapvalue := &WebhookContext{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &WebhookContext{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Webhook[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AMQP == nil {
+				m.AMQP = make(map[string]AMQPEventSource)
+			}
+			var mapkey string
+			mapvalue := &AMQPEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AMQPEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AMQP[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = make(map[string]KafkaEventSource)
+			}
+			var mapkey string
+			mapvalue := &KafkaEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &KafkaEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Kafka[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MQTT == nil {
+				m.MQTT = make(map[string]MQTTEventSource)
+			}
+			var mapkey string
+			mapvalue := &MQTTEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MQTTEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MQTT[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NATS == nil {
+				m.NATS = make(map[string]NATSEventsSource)
+			}
+			var mapkey string
+			mapvalue := &NATSEventsSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NATSEventsSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NATS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SNS == nil {
+				m.SNS = make(map[string]SNSEventSource)
+			}
+			var mapkey string
+			mapvalue := &SNSEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SNSEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SNS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQS == nil {
+				m.SQS = make(map[string]SQSEventSource)
+			}
+			var mapkey string
+			mapvalue := &SQSEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SQSEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SQS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubSub == nil {
+				m.PubSub = make(map[string]PubSubEventSource)
+			}
+			var mapkey string
+			mapvalue := &PubSubEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PubSubEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PubSub[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Github == nil {
+				m.Github = make(map[string]GithubEventSource)
+			}
+			var mapkey string
+			mapvalue := &GithubEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GithubEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Github[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gitlab == nil {
+				m.Gitlab = make(map[string]GitlabEventSource)
+			}
+			var mapkey string
+			mapvalue := &GitlabEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GitlabEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Gitlab[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HDFS == nil {
+				m.HDFS = make(map[string]HDFSEventSource)
+			}
+			var mapkey string
+			mapvalue := &HDFSEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HDFSEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HDFS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slack == nil {
+				m.Slack = make(map[string]SlackEventSource)
+			}
+			var mapkey string
+			mapvalue := &SlackEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SlackEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Slack[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGrid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageGrid == nil {
+				m.StorageGrid = make(map[string]StorageGridEventSource)
+			}
+			var mapkey string
+			mapvalue := &StorageGridEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StorageGridEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StorageGrid[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureEventsHub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AzureEventsHub == nil {
+				m.AzureEventsHub = make(map[string]AzureEventsHubEventSource)
+			}
+			var mapkey string
+			mapvalue := &AzureEventsHubEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AzureEventsHubEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AzureEventsHub[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stripe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stripe == nil {
+				m.Stripe = make(map[string]StripeEventSource)
+			}
+			var mapkey string
+			mapvalue := &StripeEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StripeEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stripe[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Emitter == nil {
+				m.Emitter = make(map[string]EmitterEventSource)
+			}
+			var mapkey string
+			mapvalue := &EmitterEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &EmitterEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Emitter[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Redis == nil {
+				m.Redis = make(map[string]RedisEventSource)
+			}
+			var mapkey string
+			mapvalue := &RedisEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RedisEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Redis[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NSQ", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NSQ == nil {
+				m.NSQ = make(map[string]NSQEventSource)
+			}
+			var mapkey string
+			mapvalue := &NSQEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
 							return io.ErrUnexpectedEOF
 						}
 						b := dAtA[iNdEx]
@@ -8013,7 +12526,136 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &NATSEventsSource{}
+					mapvalue = &NSQEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NSQ[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generic == nil {
+				m.Generic = make(map[string]GenericEventSource)
+			}
+			var mapkey string
+			mapvalue := &GenericEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GenericEventSource{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -8033,11 +12675,392 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NATS[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 10:
+			m.Generic[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Polling = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenericEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenericEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GithubEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GithubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8064,111 +13087,18 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SNS == nil {
-				m.SNS = make(map[string]SNSEventSource)
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
 			}
-			var mapkey string
-			mapvalue := &SNSEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SNSEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SNS[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SQS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8178,124 +13108,91 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SQS == nil {
-				m.SQS = make(map[string]SQSEventSource)
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
 			}
-			var mapkey string
-			mapvalue := &SQSEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SQSEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SQS[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8322,109 +13219,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubSub == nil {
-				m.PubSub = make(map[string]PubSubEventSource)
+			if m.APIToken == nil {
+				m.APIToken = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &PubSubEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PubSubEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.APIToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PubSub[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8451,111 +13255,58 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = make(map[string]GithubEventSource)
+			if m.WebhookSecret == nil {
+				m.WebhookSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &GithubEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &GithubEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Github[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 14:
+			m.Insecure = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8565,126 +13316,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitlab == nil {
-				m.Gitlab = make(map[string]GitlabEventSource)
-			}
-			var mapkey string
-			mapvalue := &GitlabEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &GitlabEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Gitlab[mapkey] = *mapvalue
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubBaseURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8694,126 +13348,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HDFS == nil {
-				m.HDFS = make(map[string]HDFSEventSource)
-			}
-			var mapkey string
-			mapvalue := &HDFSEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &HDFSEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.HDFS[mapkey] = *mapvalue
+			m.GithubBaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubUploadURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8823,126 +13380,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Slack == nil {
-				m.Slack = make(map[string]SlackEventSource)
-			}
-			var mapkey string
-			mapvalue := &SlackEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SlackEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Slack[mapkey] = *mapvalue
+			m.GithubUploadURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageGrid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8952,124 +13412,100 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageGrid == nil {
-				m.StorageGrid = make(map[string]StorageGridEventSource)
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
 			}
-			var mapkey string
-			mapvalue := &StorageGridEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StorageGridEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.StorageGrid[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 18:
+			m.DeleteHookOnFinish = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitlabEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitlabEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureEventsHub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9096,111 +13532,18 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureEventsHub == nil {
-				m.AzureEventsHub = make(map[string]AzureEventsHubEventSource)
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
 			}
-			var mapkey string
-			mapvalue := &AzureEventsHubEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &AzureEventsHubEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AzureEventsHub[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 19:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stripe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9210,124 +13553,27 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stripe == nil {
-				m.Stripe = make(map[string]StripeEventSource)
-			}
-			var mapkey string
-			mapvalue := &StripeEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StripeEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					break
 				}
 			}
-			m.Stripe[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 20:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9354,111 +13600,38 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Emitter == nil {
-				m.Emitter = make(map[string]EmitterEventSource)
+			if m.AccessToken == nil {
+				m.AccessToken = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &EmitterEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.AccessToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSSLVerification", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &EmitterEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Emitter[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 21:
+			m.EnableSSLVerification = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitlabBaseURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9468,124 +13641,79 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = make(map[string]RedisEventSource)
+			m.GitlabBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
 			}
-			var mapkey string
-			mapvalue := &RedisEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &RedisEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Redis[mapkey] = *mapvalue
+			m.DeleteHookOnFinish = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NSQ", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9612,111 +13740,18 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NSQ == nil {
-				m.NSQ = make(map[string]NSQEventSource)
+			if m.SecretToken == nil {
+				m.SecretToken = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &NSQEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NSQEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SecretToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NSQ[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 23:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9726,120 +13761,55 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Generic == nil {
-				m.Generic = make(map[string]GenericEventSource)
+			m.ProjectIDs = append(m.ProjectIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIDs", wireType)
 			}
-			var mapkey string
-			mapvalue := &GenericEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &GenericEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Generic[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupIDs = append(m.GroupIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9865,7 +13835,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
+func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9888,15 +13858,212 @@ func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9913,76 +14080,58 @@ func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FileEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10010,11 +14159,11 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10041,15 +14190,18 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polling", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10059,12 +14211,56 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Polling = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10089,7 +14285,7 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
+func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10112,15 +14308,15 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenericEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenericEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10148,7 +14344,39 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10174,7 +14402,7 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
+func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10197,70 +14425,15 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GithubEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GithubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10288,11 +14461,11 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10320,11 +14493,11 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repository = string(dAtA[iNdEx:postIndex])
+			m.Partition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10352,11 +14525,11 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10383,16 +14556,16 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.APIToken == nil {
-				m.APIToken = &v1.SecretKeySelector{}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			if err := m.APIToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10419,36 +14592,16 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WebhookSecret == nil {
-				m.WebhookSecret = &v1.SecretKeySelector{}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
 			}
-			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 9:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10465,10 +14618,10 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 10:
+			m.JSONBody = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10496,13 +14649,13 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10512,27 +14665,31 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubBaseURL = string(dAtA[iNdEx:postIndex])
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &KafkaConsumerGroup{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubUploadURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialOffset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10560,13 +14717,13 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubUploadURL = string(dAtA[iNdEx:postIndex])
+			m.InitialOffset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10576,29 +14733,33 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.SASL == nil {
+				m.SASL = &SASLConfig{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10608,12 +14769,24 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteHookOnFinish = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10638,7 +14811,7 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
+func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10661,17 +14834,17 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitlabEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MQTTEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitlabEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MQTTEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10681,31 +14854,27 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10733,13 +14902,13 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10749,53 +14918,29 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessToken == nil {
-				m.AccessToken = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableSSLVerification", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableSSLVerification = bool(v != 0)
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitlabBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10805,27 +14950,31 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitlabBaseURL = string(dAtA[iNdEx:postIndex])
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10842,12 +14991,12 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DeleteHookOnFinish = bool(v != 0)
-		case 9:
+			m.JSONBody = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10857,23 +15006,27 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10899,7 +15052,7 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
+func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10922,48 +15075,15 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: NATSEventsSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: NATSEventsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10991,11 +15111,11 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11023,13 +15143,13 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CheckInterval = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11039,29 +15159,33 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11071,27 +15195,15 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.JSONBody = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11118,18 +15230,71 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
 			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NSQEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NSQEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11139,31 +15304,27 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11191,11 +15352,11 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11223,11 +15384,11 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11254,18 +15415,18 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11275,29 +15436,17 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			m.JSONBody = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11307,23 +15456,27 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11349,7 +15502,7 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
+func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11372,15 +15525,15 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubSubEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubSubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11408,11 +15561,11 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11440,7 +15593,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partition = string(dAtA[iNdEx:postIndex])
+			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -11476,9 +15629,9 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsFile", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11488,33 +15641,49 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CredentialsFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableWorkflowIdentity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			m.EnableWorkflowIdentity = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteSubscriptionOnFinish", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11524,28 +15693,32 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
+			m.DeleteSubscriptionOnFinish = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.JSONBody = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11570,7 +15743,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
+func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11593,15 +15766,15 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MQTTEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MQTTEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11629,13 +15802,13 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11645,27 +15818,31 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11693,13 +15870,13 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
-			var msglen int
+			m.DB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11709,33 +15886,16 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DB |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11745,12 +15905,24 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
@@ -11811,7 +15983,7 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
+func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11834,15 +16006,15 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSEventsSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSEventsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11870,13 +16042,13 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11886,27 +16058,31 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &ResourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11933,18 +16109,15 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11954,15 +16127,59 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11989,10 +16206,8 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NamespaceSelector = append(m.NamespaceSelector, Selector{})
+			if err := m.NamespaceSelector[len(m.NamespaceSelector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12020,7 +16235,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
+func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12043,15 +16258,15 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NSQEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NSQEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12079,13 +16294,13 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12095,29 +16310,31 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, Selector{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12127,27 +16344,29 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Fields = append(m.Fields, Selector{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12174,16 +16393,13 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterStart", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12200,10 +16416,10 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			m.AfterStart = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12230,13 +16446,43 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JSONPaths = append(m.JSONPaths, ResourceJSONPathFilter{})
+			if err := m.JSONPaths[len(m.JSONPaths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12261,7 +16507,7 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
+func (m *ResourceJSONPathFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12284,15 +16530,15 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubSubEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceJSONPathFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubSubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceJSONPathFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12320,11 +16566,11 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12352,11 +16598,64 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SASLConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SASLConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SASLConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12384,13 +16683,13 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Mechanism = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12400,29 +16699,33 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialsFile = string(dAtA[iNdEx:postIndex])
+			if m.User == nil {
+				m.User = &v1.SecretKeySelector{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableWorkflowIdentity", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12432,52 +16735,28 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableWorkflowIdentity = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteSubscriptionOnFinish", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.DeleteSubscriptionOnFinish = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
 			}
-			m.JSONBody = bool(v != 0)
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12502,7 +16781,7 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
+func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12525,17 +16804,85 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SNSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SNSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicArn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12545,27 +16892,31 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12592,14 +16943,14 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
@@ -12631,11 +16982,11 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.DB = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12645,14 +16996,27 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DB |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12680,13 +17044,13 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningCertHosts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12696,27 +17060,23 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SigningCertHosts = append(m.SigningCertHosts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12742,7 +17102,7 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
+func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12765,17 +17125,17 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12785,27 +17145,31 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12832,18 +17196,18 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ResourceFilter{}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12853,28 +17217,27 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12902,64 +17265,30 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WaitTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12987,13 +17316,13 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13003,31 +17332,29 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, Selector{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13037,31 +17364,17 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, Selector{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.JSONBody = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13071,45 +17384,24 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QueueAccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterStart", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AfterStart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13134,7 +17426,7 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
+func (m *Selector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13157,17 +17449,17 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SNSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Selector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SNSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Selector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13177,31 +17469,27 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13229,13 +17517,13 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicArn = string(dAtA[iNdEx:postIndex])
+			m.Operation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13245,31 +17533,80 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlackEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlackEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13296,18 +17633,18 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
+			if m.SigningSecret == nil {
+				m.SigningSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13317,29 +17654,33 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13349,27 +17690,31 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13397,7 +17742,7 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13423,7 +17768,7 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
+func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13446,15 +17791,83 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SQSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageGridEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SQSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageGridEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13481,18 +17894,18 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
+			if m.Filter == nil {
+				m.Filter = &StorageGridFilter{}
 			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13502,31 +17915,27 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TopicArn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13554,11 +17963,11 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13586,13 +17995,13 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeSeconds", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
-			m.WaitTimeSeconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13602,14 +18011,31 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WaitTimeSeconds |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthToken == nil {
+				m.AuthToken = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13637,11 +18063,64 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ApiURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageGridFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageGridFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13669,31 +18148,11 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13721,7 +18180,7 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueAccountId = string(dAtA[iNdEx:postIndex])
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13747,7 +18206,7 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Selector) Unmarshal(dAtA []byte) error {
+func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13757,28 +18216,120 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StripeEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StripeEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateWebhook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.APIKey == nil {
+				m.APIKey = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Selector: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Selector: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13806,11 +18357,11 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13838,13 +18389,13 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = string(dAtA[iNdEx:postIndex])
+			m.EventFilter = append(m.EventFilter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13854,24 +18405,47 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.WebhookSecret == nil {
+				m.WebhookSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
+			}
+			m.Tolerance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tolerance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13896,7 +18470,7 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
+func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13919,17 +18493,17 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlackEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: TLSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlackEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13939,33 +18513,29 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SigningSecret == nil {
-				m.SigningSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CACertPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13975,67 +18545,27 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientCertPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeyPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14063,7 +18593,7 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ClientKeyPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14089,7 +18619,7 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
+func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14112,17 +18642,17 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageGridEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchPathConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageGridEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchPathConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14132,31 +18662,27 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Directory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14184,13 +18710,13 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRegexp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14200,65 +18726,82 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &StorageGridFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PathRegexp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicArn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14268,29 +18811,33 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if m.BearerToken == nil {
+				m.BearerToken = &v1.SecretKeySelector{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HMAC", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14300,27 +18847,31 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.HMAC == nil {
+				m.HMAC = &WebhookHMAC{}
+			}
+			if err := m.HMAC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14347,16 +18898,16 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthToken == nil {
-				m.AuthToken = &v1.SecretKeySelector{}
+			if m.Basic == nil {
+				m.Basic = &WebhookBasicAuth{}
 			}
-			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCAPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14384,7 +18935,7 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiURL = string(dAtA[iNdEx:postIndex])
+			m.ClientCAPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14410,7 +18961,7 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
+func (m *WebhookBasicAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14433,17 +18984,17 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageGridFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookBasicAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageGridFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14453,29 +19004,33 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14485,23 +19040,27 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14527,7 +19086,7 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
+func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14550,17 +19109,17 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StripeEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StripeEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14570,33 +19129,29 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14606,17 +19161,29 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateWebhook = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14626,31 +19193,27 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.APIKey == nil {
-				m.APIKey = &v1.SecretKeySelector{}
-			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14678,11 +19241,11 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerCertPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14710,66 +19273,45 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventFilter = append(m.EventFilter, string(dAtA[iNdEx:postIndex]))
+			m.ServerCertPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerKeyPath", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLSConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TLSConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ServerKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14779,27 +19321,31 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CACertPath = string(dAtA[iNdEx:postIndex])
+			if m.Auth == nil {
+				m.Auth = &WebhookAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14827,13 +19373,13 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientCertPath = string(dAtA[iNdEx:postIndex])
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeyPath", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxPayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14843,82 +19389,16 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPayloadSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientKeyPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WatchPathConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchPathConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
-			var stringLen uint64
+			m.ReadTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14928,29 +19408,16 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadTimeout |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Directory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
 			}
-			var stringLen uint64
+			m.WriteTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14960,29 +19427,35 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WriteTimeout |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathRegexp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14992,23 +19465,27 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathRegexp = string(dAtA[iNdEx:postIndex])
+			if m.RateLimit == nil {
+				m.RateLimit = &WebhookRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15034,7 +19511,7 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebhookContext) Unmarshal(dAtA []byte) error {
+func (m *WebhookHMAC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15057,17 +19534,17 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebhookContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookHMAC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebhookContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookHMAC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15077,27 +19554,31 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			if m.Secret == nil {
+				m.Secret = &v1.SecretKeySelector{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15125,11 +19606,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Header = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15157,11 +19638,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15189,11 +19670,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerCertPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15221,13 +19702,66 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerCertPath = string(dAtA[iNdEx:postIndex])
+			m.Encoding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerKeyPath", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			m.RequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15237,24 +19771,30 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestsPerSecond |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ServerKeyPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29,9 +29,15 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPConsumeConfig":         schema_pkg_apis_eventsource_v1alpha1_AMQPConsumeConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPEventSource":           schema_pkg_apis_eventsource_v1alpha1_AMQPEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPExchangeDeclareConfig": schema_pkg_apis_eventsource_v1alpha1_AMQPExchangeDeclareConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPQoSConfig":             schema_pkg_apis_eventsource_v1alpha1_AMQPQoSConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPQueueDeclareConfig":    schema_pkg_apis_eventsource_v1alpha1_AMQPQueueDeclareConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AzureEventsHubEventSource": schema_pkg_apis_eventsource_v1alpha1_AzureEventsHubEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarCatchUp":           schema_pkg_apis_eventsource_v1alpha1_CalendarCatchUp(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarEventSource":       schema_pkg_apis_eventsource_v1alpha1_CalendarEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarPersistence":       schema_pkg_apis_eventsource_v1alpha1_CalendarPersistence(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.EmitterEventSource":        schema_pkg_apis_eventsource_v1alpha1_EmitterEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.EventSource":               schema_pkg_apis_eventsource_v1alpha1_EventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.EventSourceList":           schema_pkg_apis_eventsource_v1alpha1_EventSourceList(ref),
@@ -42,6 +48,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.GithubEventSource":         schema_pkg_apis_eventsource_v1alpha1_GithubEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.GitlabEventSource":         schema_pkg_apis_eventsource_v1alpha1_GitlabEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.HDFSEventSource":           schema_pkg_apis_eventsource_v1alpha1_HDFSEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.KafkaConsumerGroup":        schema_pkg_apis_eventsource_v1alpha1_KafkaConsumerGroup(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.KafkaEventSource":          schema_pkg_apis_eventsource_v1alpha1_KafkaEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.MQTTEventSource":           schema_pkg_apis_eventsource_v1alpha1_MQTTEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.NATSEventsSource":          schema_pkg_apis_eventsource_v1alpha1_NATSEventsSource(ref),
@@ -50,6 +57,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.RedisEventSource":          schema_pkg_apis_eventsource_v1alpha1_RedisEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceEventSource":       schema_pkg_apis_eventsource_v1alpha1_ResourceEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceFilter":            schema_pkg_apis_eventsource_v1alpha1_ResourceFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceJSONPathFilter":    schema_pkg_apis_eventsource_v1alpha1_ResourceJSONPathFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.SASLConfig":                schema_pkg_apis_eventsource_v1alpha1_SASLConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.SNSEventSource":            schema_pkg_apis_eventsource_v1alpha1_SNSEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.SQSEventSource":            schema_pkg_apis_eventsource_v1alpha1_SQSEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.Selector":                  schema_pkg_apis_eventsource_v1alpha1_Selector(ref),
@@ -59,7 +68,59 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.StripeEventSource":         schema_pkg_apis_eventsource_v1alpha1_StripeEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.TLSConfig":                 schema_pkg_apis_eventsource_v1alpha1_TLSConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WatchPathConfig":           schema_pkg_apis_eventsource_v1alpha1_WatchPathConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookAuth":               schema_pkg_apis_eventsource_v1alpha1_WebhookAuth(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookBasicAuth":          schema_pkg_apis_eventsource_v1alpha1_WebhookBasicAuth(ref),
 		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookContext":            schema_pkg_apis_eventsource_v1alpha1_WebhookContext(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookHMAC":               schema_pkg_apis_eventsource_v1alpha1_WebhookHMAC(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookRateLimit":          schema_pkg_apis_eventsource_v1alpha1_WebhookRateLimit(ref),
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_AMQPConsumeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AMQPConsumeConfig holds the configuration of the consumer of the queue",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"consumerTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsumerTag identifies the consumer. If empty, the server generates a tag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoAck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoAck acknowledges the messages as soon as they are delivered. By default, the messages are acknowledged once they are sent to the gateway client, and requeued if they can't be sent, in which case the event source is restarted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"exclusive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclusive consumers are the only consumers of the queue",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"noLocal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoLocal doesn't deliver the messages published on the same connection",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"noWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoWait consumes the queue without waiting for the confirmation of the server",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -117,12 +178,166 @@ func schema_pkg_apis_eventsource_v1alpha1_AMQPEventSource(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.TLSConfig"),
 						},
 					},
+					"exchangeDeclare": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExchangeDeclare holds the configuration of the exchange declaration. If not specified, the exchange is durable.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPExchangeDeclareConfig"),
+						},
+					},
+					"queueDeclare": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueDeclare holds the configuration of the queue declaration. If not specified, an exclusive queue with a generated name is declared, and the messages published while the gateway is down are lost.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPQueueDeclareConfig"),
+						},
+					},
+					"qos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QoS holds the configuration of the prefetching of the messages",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPQoSConfig"),
+						},
+					},
+					"consume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Consume holds the configuration of the consumer of the queue",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPConsumeConfig"),
+						},
+					},
 				},
 				Required: []string{"url", "exchangeName", "exchangeType", "routingKey"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.TLSConfig"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPConsumeConfig", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPExchangeDeclareConfig", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPQoSConfig", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.AMQPQueueDeclareConfig", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_AMQPExchangeDeclareConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AMQPExchangeDeclareConfig holds the configuration of the exchange declaration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"durable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Durable exchanges survive the restarts of the server",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"autoDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoDelete deletes the exchange once no queue is bound to it",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"internal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Internal exchanges don't accept the messages of the publishers",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"noWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoWait declares the exchange without waiting for the confirmation of the server",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_AMQPQoSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AMQPQoSConfig holds the configuration of the prefetching of the messages",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefetchCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefetchCount is the number of messages the server delivers before they are acknowledged",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"prefetchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefetchSize is the size, in bytes, of the messages the server delivers before they are acknowledged",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"global": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Global applies the limits to all the consumers of the channel",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_AMQPQueueDeclareConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AMQPQueueDeclareConfig holds the configuration of the queue declaration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the queue. If empty, the server generates a name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"durable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Durable queues survive the restarts of the server",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"autoDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoDelete deletes the queue once its last consumer is cancelled",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"exclusive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclusive queues are only accessible by the connection that declares them, and are deleted with it",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"noWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoWait declares the queue without waiting for the confirmation of the server",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments of the queue, i.e. the \"x-arguments\", as a JSON object, e.g. {\"x-queue-type\": \"quorum\"}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -175,6 +390,33 @@ func schema_pkg_apis_eventsource_v1alpha1_AzureEventsHubEventSource(ref common.R
 	}
 }
 
+func schema_pkg_apis_eventsource_v1alpha1_CalendarCatchUp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalendarCatchUp refers to the policy firing the missed occurrences of a calendar schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is one of \"none\", \"last\" to fire the last missed occurrence only, or \"all\" to fire the missed occurrences up to MaxEvents. Defaults to \"none\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEvents is the maximum number of missed occurrences fired with the \"all\" policy, the most recent ones. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_eventsource_v1alpha1_CalendarEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -223,10 +465,45 @@ func schema_pkg_apis_eventsource_v1alpha1_CalendarEventSource(ref common.Referen
 							Format:      "byte",
 						},
 					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Persistence stores the last scheduled time of the event source, so that the schedule resumes from it after a restart.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarPersistence"),
+						},
+					},
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp fires the occurrences of the schedule missed while the gateway was down, as per the persisted last scheduled time. Requires the persistence.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarCatchUp"),
+						},
+					},
 				},
 				Required: []string{"schedule", "interval"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarCatchUp", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.CalendarPersistence"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_CalendarPersistence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalendarPersistence refers to the persistence of the last scheduled time of a calendar event source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap is the name of the config map, in the namespace of the gateway, storing the last scheduled time under the name of the event source. The config map is created if it doesn't exist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"configMap"},
+			},
+		},
 	}
 }
 
@@ -943,7 +1220,7 @@ func schema_pkg_apis_eventsource_v1alpha1_GitlabEventSource(ref common.Reference
 					},
 					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProjectID is the id of project for which integration needs to setup",
+							Description: "ProjectID is the id of project for which integration needs to setup Deprecated: use ProjectIDs instead.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -989,8 +1266,42 @@ func schema_pkg_apis_eventsource_v1alpha1_GitlabEventSource(ref common.Reference
 							Format:      "",
 						},
 					},
+					"secretToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretToken refers to K8s secret that holds the secret token of the hooks. GitLab sends it in the X-Gitlab-Token header of the requests, the requests without it are rejected.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"projectIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectIDs are the ids of the projects to set up a project hook for",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"groupIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupIDs are the ids of the groups to set up a group hook for, which receives the events of all the projects of the group. Group hooks are only available on GitLab Premium.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"projectID", "events", "gitlabBaseURL"},
+				Required: []string{"events", "gitlabBaseURL"},
 			},
 		},
 		Dependencies: []string{
@@ -1115,6 +1426,34 @@ func schema_pkg_apis_eventsource_v1alpha1_HDFSEventSource(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_eventsource_v1alpha1_KafkaConsumerGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaConsumerGroup refers to the consumer group of a kafka event source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"groupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupName is the name of the consumer group",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rebalanceStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RebalanceStrategy assigns the partitions to the members of the group, one of \"range\", \"roundrobin\" or \"sticky\". Defaults to \"range\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"groupName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_eventsource_v1alpha1_KafkaEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1131,7 +1470,7 @@ func schema_pkg_apis_eventsource_v1alpha1_KafkaEventSource(ref common.ReferenceC
 					},
 					"partition": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Partition name",
+							Description: "Partition name. Required unless the messages are consumed by a consumer group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1162,23 +1501,63 @@ func schema_pkg_apis_eventsource_v1alpha1_KafkaEventSource(ref common.ReferenceC
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"url", "partition", "topic"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.TLSConfig"},
-	}
-}
-
-func schema_pkg_apis_eventsource_v1alpha1_MQTTEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MQTTEventSource refers to event-source for MQTT related events",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
+					"brokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Brokers are the addresses of the brokers of the kafka cluster, in addition to URL",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"consumerGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsumerGroup consumes the messages of all the partitions of the topic as a member of the consumer group, committing the offsets of the messages once they are dispatched. If not specified, the messages of Partition are consumed, and no offset is committed.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.KafkaConsumerGroup"),
+						},
+					},
+					"initialOffset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialOffset is the offset the messages are consumed from if no offset is committed, either \"oldest\" or \"newest\". Defaults to \"newest\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL configuration for the kafka client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.SASLConfig"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the kafka cluster, e.g. \"2.4.0\". Consumer groups require 0.10.2.0 or later. Defaults to 1.0.0.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"topic"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.KafkaConsumerGroup", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_MQTTEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MQTTEventSource refers to event-source for MQTT related events",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
 						SchemaProps: spec.SchemaProps{
 							Description: "URL to connect to broker",
 							Type:        []string{"string"},
@@ -1466,7 +1845,7 @@ func schema_pkg_apis_eventsource_v1alpha1_ResourceEventSource(ref common.Referen
 				Properties: map[string]spec.Schema{
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace where resource is deployed",
+							Description: "Namespace where resource is deployed. The resources of all the namespaces are watched if neither Namespace, Namespaces nor NamespaceSelector is specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1509,12 +1888,39 @@ func schema_pkg_apis_eventsource_v1alpha1_ResourceEventSource(ref common.Referen
 							},
 						},
 					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces are the namespaces the resources are watched in, in addition to Namespace",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector watches the resources of the namespaces whose labels match all the selectors. It can't be combined with Namespace and Namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.Selector"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"namespace", "group", "version", "resource", "eventTypes"},
+				Required: []string{"group", "version", "resource", "eventTypes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceFilter"},
+			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceFilter", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.Selector"},
 	}
 }
 
@@ -1571,11 +1977,108 @@ func schema_pkg_apis_eventsource_v1alpha1_ResourceFilter(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"jsonPaths": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPaths are applied on the resource, the event is valid if the resource matches all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceJSONPathFilter"),
+									},
+								},
+							},
+						},
+					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields are the JSONPaths of the fields, e.g. .status.phase, an UPDATE event is valid only if one of them is changed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.ResourceJSONPathFilter", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.Selector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_ResourceJSONPathFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceJSONPathFilter matches the values of a JSONPath of a resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSONPath, e.g. .status.phase or {.status.conditions[?(@.type==\"Ready\")].status}. Refer https://kubernetes.io/docs/reference/kubectl/jsonpath/ for more info.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are regular expressions, one of them must match a whole value of the path. If not specified, the path must exist in the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_SASLConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SASLConfig refers to SASL configuration for a client.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mechanism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mechanism is the SASL mechanism, one of \"PLAIN\", \"SCRAM-SHA-256\" or \"SCRAM-SHA-512\". Defaults to \"PLAIN\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User refers to the Kubernetes secret that holds the username",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password refers to the Kubernetes secret that holds the password",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"user", "password"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.Selector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -1632,6 +2135,20 @@ func schema_pkg_apis_eventsource_v1alpha1_SNSEventSource(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"signingCertHosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SigningCertHosts is the list of hosts the certificates signing the messages may be downloaded from. Defaults to the SNS hosts, i.e. sns.<region>.amazonaws.com",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"topicArn", "region"},
 			},
@@ -1940,6 +2457,19 @@ func schema_pkg_apis_eventsource_v1alpha1_StripeEventSource(ref common.Reference
 							},
 						},
 					},
+					"webhookSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookSecret refers to K8s secret that holds the signing secret of the webhook endpoint, used to verify the Stripe-Signature header of the requests. If CreateWebhook is enabled, the signing secret returned by Stripe is stored in it. Required unless CreateWebhook is enabled. More info at https://stripe.com/docs/webhooks/signatures",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerance is the maximum age, in seconds, of the signature timestamp. Older requests are rejected to prevent replays. Defaults to 300 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -2017,6 +2547,74 @@ func schema_pkg_apis_eventsource_v1alpha1_WatchPathConfig(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_eventsource_v1alpha1_WebhookAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookAuth describes the authentication of the requests of a webhook. A request is accepted only if it passes all the configured modes. The secrets are read from the namespace of the gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken refers to K8s secret that holds the token the requests must send in the Authorization header, i.e. \"Authorization: Bearer <token>\"",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"hmac": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HMAC verifies the signature of the request body",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookHMAC"),
+						},
+					},
+					"basic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Basic verifies the HTTP basic auth credentials of the requests",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookBasicAuth"),
+						},
+					},
+					"clientCAPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientCAPath refers the file that contains the CA certificates the client certificates must be signed by, i.e. mutual TLS. Requires ServerCertPath and ServerKeyPath.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookBasicAuth", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookHMAC", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_WebhookBasicAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookBasicAuth describes the HTTP basic auth credentials of the requests",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username refers to K8s secret that holds the username",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password refers to K8s secret that holds the password",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"username", "password"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_eventsource_v1alpha1_WebhookContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2066,9 +2664,143 @@ func schema_pkg_apis_eventsource_v1alpha1_WebhookContext(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth configures the authentication of the incoming requests. If not specified, the requests are not authenticated.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookAuth"),
+						},
+					},
+					"methods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Methods are the HTTP request methods allowed on the endpoint, in addition to Method. The requests with another method are rejected with 405. If neither is specified, all the methods are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"maxPayloadSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPayloadSize is the maximum size, in bytes, of the request body. Larger requests are rejected with 413. Defaults to 10MiB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadTimeout is the maximum duration, in seconds, for reading a request. Defaults to 30 seconds. The timeouts apply to the HTTP server of the port, and are taken from the first endpoint started on it. An endpoint setting timeouts different from those of the server already running on its port is rejected.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteTimeout is the maximum duration, in seconds, for processing a request and writing the response. Defaults to 30 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"idleTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTimeout is the maximum duration, in seconds, a keep-alive connection is kept open between requests. Defaults to 120 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the requests on the endpoint. The requests over the limit are rejected with 429. Only the requests with an allowed method, within the max payload size and passing the authentication count.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookRateLimit"),
+						},
+					},
 				},
 				Required: []string{"endpoint", "method", "port", "url"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookAuth", "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1.WebhookRateLimit"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_WebhookHMAC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookHMAC describes the HMAC signature of the request body",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret refers to K8s secret that holds the key of the HMAC",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header holding the signature. Defaults to X-Signature.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the hash function of the HMAC, either sha1, sha256 or sha512. Defaults to sha256.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix of the signature in the header, e.g. \"sha256=\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encoding of the signature, either hex or base64. Defaults to hex.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secret"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_eventsource_v1alpha1_WebhookRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookRateLimit describes the rate limit of the requests of an endpoint, as a token bucket",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requestsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestsPerSecond is the rate the requests are allowed at",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of requests allowed at once. Defaults to RequestsPerSecond.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"requestsPerSecond"},
+			},
+		},
 	}
 }
@@ -111,6 +111,32 @@ type CalendarEventSource struct {
 	// UserPayload will be sent to sensor as extra data once the event is triggered
 	// +optional
 	UserPayload json.RawMessage `json:"userPayload,omitempty" protobuf:"bytes,5,opt,name=userPayload,casttype=encoding/json.RawMessage"`
+	// Persistence stores the last scheduled time of the event source, so that the schedule resumes from it after a restart.
+	// +optional
+	Persistence *CalendarPersistence `json:"persistence,omitempty" protobuf:"bytes,6,opt,name=persistence"`
+	// CatchUp fires the occurrences of the schedule missed while the gateway was down, as per the persisted
+	// last scheduled time. Requires the persistence.
+	// +optional
+	CatchUp *CalendarCatchUp `json:"catchUp,omitempty" protobuf:"bytes,7,opt,name=catchUp"`
+}
+
+// CalendarPersistence refers to the persistence of the last scheduled time of a calendar event source
+type CalendarPersistence struct {
+	// ConfigMap is the name of the config map, in the namespace of the gateway, storing the last scheduled time
+	// under the name of the event source. The config map is created if it doesn't exist.
+	ConfigMap string `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+}
+
+// CalendarCatchUp refers to the policy firing the missed occurrences of a calendar schedule
+type CalendarCatchUp struct {
+	// Policy is one of "none", "last" to fire the last missed occurrence only, or "all" to fire the missed occurrences
+	// up to MaxEvents. Defaults to "none".
+	// +optional
+	Policy string `json:"policy,omitempty" protobuf:"bytes,1,opt,name=policy"`
+	// MaxEvents is the maximum number of missed occurrences fired with the "all" policy, the most recent ones.
+	// Defaults to 10.
+	// +optional
+	MaxEvents int32 `json:"maxEvents,omitempty" protobuf:"varint,2,opt,name=maxEvents"`
 }
 
 // FileEventSource describes an event-source for file related events.
@@ -136,8 +162,10 @@ const (
 
 // ResourceEventSource refers to a event-source for K8s resource related events.
 type ResourceEventSource struct {
-	// Namespace where resource is deployed
-	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Namespace where resource is deployed.
+	// The resources of all the namespaces are watched if neither Namespace, Namespaces nor NamespaceSelector is specified.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
 	// Filter is applied on the metadata of the resource
 	// If you apply filter, then the internal event informer will only monitor objects that pass the filter.
 	// +optional
@@ -147,6 +175,13 @@ type ResourceEventSource struct {
 	// EventTypes is the list of event type to watch.
 	// Possible values are - ADD, UPDATE and DELETE.
 	EventTypes []ResourceEventType `json:"eventTypes" protobuf:"bytes,4,rep,name=eventTypes,casttype=ResourceEventType"`
+	// Namespaces are the namespaces the resources are watched in, in addition to Namespace
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+	// NamespaceSelector watches the resources of the namespaces whose labels match all the selectors.
+	// It can't be combined with Namespace and Namespaces.
+	// +optional
+	NamespaceSelector []Selector `json:"namespaceSelector,omitempty" protobuf:"bytes,6,rep,name=namespaceSelector"`
 }
 
 // ResourceFilter contains K8 ObjectMeta information to further filter resource event objects
@@ -168,6 +203,24 @@ type ResourceFilter struct {
 	// If the resource is created after the start time then the event is treated as valid.
 	// +optional
 	AfterStart bool `json:"afterStart,omitempty" protobuf:"varint,5,opt,name=afterStart"`
+	// JSONPaths are applied on the resource, the event is valid if the resource matches all of them.
+	// +optional
+	JSONPaths []ResourceJSONPathFilter `json:"jsonPaths,omitempty" protobuf:"bytes,6,rep,name=jsonPaths"`
+	// ChangedFields are the JSONPaths of the fields, e.g. .status.phase, an UPDATE event is valid only if one of them
+	// is changed.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty" protobuf:"bytes,7,rep,name=changedFields"`
+}
+
+// ResourceJSONPathFilter matches the values of a JSONPath of a resource
+type ResourceJSONPathFilter struct {
+	// Path is the JSONPath, e.g. .status.phase or {.status.conditions[?(@.type=="Ready")].status}.
+	// Refer https://kubernetes.io/docs/reference/kubectl/jsonpath/ for more info.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Values are regular expressions, one of them must match a whole value of the path.
+	// If not specified, the path must exist in the resource.
+	// +optional
+	Values []string `json:"values,omitempty" protobuf:"bytes,2,rep,name=values"`
 }
 
 // Selector represents conditional operation to select K8s objects.
@@ -204,14 +257,101 @@ type AMQPEventSource struct {
 	// TLS configuration for the amqp client.
 	// +optional
 	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// ExchangeDeclare holds the configuration of the exchange declaration. If not specified, the exchange is durable.
+	// +optional
+	ExchangeDeclare *AMQPExchangeDeclareConfig `json:"exchangeDeclare,omitempty" protobuf:"bytes,8,opt,name=exchangeDeclare"`
+	// QueueDeclare holds the configuration of the queue declaration. If not specified, an exclusive queue with a
+	// generated name is declared, and the messages published while the gateway is down are lost.
+	// +optional
+	QueueDeclare *AMQPQueueDeclareConfig `json:"queueDeclare,omitempty" protobuf:"bytes,9,opt,name=queueDeclare"`
+	// QoS holds the configuration of the prefetching of the messages
+	// +optional
+	QoS *AMQPQoSConfig `json:"qos,omitempty" protobuf:"bytes,10,opt,name=qos"`
+	// Consume holds the configuration of the consumer of the queue
+	// +optional
+	Consume *AMQPConsumeConfig `json:"consume,omitempty" protobuf:"bytes,11,opt,name=consume"`
+}
+
+// AMQPExchangeDeclareConfig holds the configuration of the exchange declaration
+type AMQPExchangeDeclareConfig struct {
+	// Durable exchanges survive the restarts of the server
+	// +optional
+	Durable bool `json:"durable,omitempty" protobuf:"varint,1,opt,name=durable"`
+	// AutoDelete deletes the exchange once no queue is bound to it
+	// +optional
+	AutoDelete bool `json:"autoDelete,omitempty" protobuf:"varint,2,opt,name=autoDelete"`
+	// Internal exchanges don't accept the messages of the publishers
+	// +optional
+	Internal bool `json:"internal,omitempty" protobuf:"varint,3,opt,name=internal"`
+	// NoWait declares the exchange without waiting for the confirmation of the server
+	// +optional
+	NoWait bool `json:"noWait,omitempty" protobuf:"varint,4,opt,name=noWait"`
+}
+
+// AMQPQueueDeclareConfig holds the configuration of the queue declaration
+type AMQPQueueDeclareConfig struct {
+	// Name of the queue. If empty, the server generates a name.
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Durable queues survive the restarts of the server
+	// +optional
+	Durable bool `json:"durable,omitempty" protobuf:"varint,2,opt,name=durable"`
+	// AutoDelete deletes the queue once its last consumer is cancelled
+	// +optional
+	AutoDelete bool `json:"autoDelete,omitempty" protobuf:"varint,3,opt,name=autoDelete"`
+	// Exclusive queues are only accessible by the connection that declares them, and are deleted with it
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty" protobuf:"varint,4,opt,name=exclusive"`
+	// NoWait declares the queue without waiting for the confirmation of the server
+	// +optional
+	NoWait bool `json:"noWait,omitempty" protobuf:"varint,5,opt,name=noWait"`
+	// Arguments of the queue, i.e. the "x-arguments", as a JSON object, e.g. {"x-queue-type": "quorum"}
+	// +optional
+	Arguments string `json:"arguments,omitempty" protobuf:"bytes,6,opt,name=arguments"`
+}
+
+// AMQPQoSConfig holds the configuration of the prefetching of the messages
+type AMQPQoSConfig struct {
+	// PrefetchCount is the number of messages the server delivers before they are acknowledged
+	// +optional
+	PrefetchCount int32 `json:"prefetchCount,omitempty" protobuf:"varint,1,opt,name=prefetchCount"`
+	// PrefetchSize is the size, in bytes, of the messages the server delivers before they are acknowledged
+	// +optional
+	PrefetchSize int32 `json:"prefetchSize,omitempty" protobuf:"varint,2,opt,name=prefetchSize"`
+	// Global applies the limits to all the consumers of the channel
+	// +optional
+	Global bool `json:"global,omitempty" protobuf:"varint,3,opt,name=global"`
+}
+
+// AMQPConsumeConfig holds the configuration of the consumer of the queue
+type AMQPConsumeConfig struct {
+	// ConsumerTag identifies the consumer. If empty, the server generates a tag.
+	// +optional
+	ConsumerTag string `json:"consumerTag,omitempty" protobuf:"bytes,1,opt,name=consumerTag"`
+	// AutoAck acknowledges the messages as soon as they are delivered. By default, the messages are acknowledged
+	// once they are sent to the gateway client, and requeued if they can't be sent, in which case the event source
+	// is restarted.
+	// +optional
+	AutoAck bool `json:"autoAck,omitempty" protobuf:"varint,2,opt,name=autoAck"`
+	// Exclusive consumers are the only consumers of the queue
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty" protobuf:"varint,3,opt,name=exclusive"`
+	// NoLocal doesn't deliver the messages published on the same connection
+	// +optional
+	NoLocal bool `json:"noLocal,omitempty" protobuf:"varint,4,opt,name=noLocal"`
+	// NoWait consumes the queue without waiting for the confirmation of the server
+	// +optional
+	NoWait bool `json:"noWait,omitempty" protobuf:"varint,5,opt,name=noWait"`
 }
 
 // KafkaEventSource refers to event-source for Kafka related events
 type KafkaEventSource struct {
 	// URL to kafka cluster
-	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
-	// Partition name
-	Partition string `json:"partition" protobuf:"bytes,2,opt,name=partition"`
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Partition name. Required unless the messages are consumed by a consumer group.
+	// +optional
+	Partition string `json:"partition,omitempty" protobuf:"bytes,2,opt,name=partition"`
 	// Topic name
 	Topic string `json:"topic" protobuf:"bytes,3,opt,name=topic"`
 	// Backoff holds parameters applied to connection.
@@ -223,6 +363,35 @@ type KafkaEventSource struct {
 	// source will be JSON
 	// +optional
 	JSONBody bool `json:"jsonBody,omitempty" protobuf:"varint,6,opt,name=jsonBody"`
+	// Brokers are the addresses of the brokers of the kafka cluster, in addition to URL
+	// +optional
+	Brokers []string `json:"brokers,omitempty" protobuf:"bytes,7,rep,name=brokers"`
+	// ConsumerGroup consumes the messages of all the partitions of the topic as a member of the consumer group,
+	// committing the offsets of the messages once they are dispatched. If not specified, the messages of Partition
+	// are consumed, and no offset is committed.
+	// +optional
+	ConsumerGroup *KafkaConsumerGroup `json:"consumerGroup,omitempty" protobuf:"bytes,8,opt,name=consumerGroup"`
+	// InitialOffset is the offset the messages are consumed from if no offset is committed, either "oldest" or "newest".
+	// Defaults to "newest".
+	// +optional
+	InitialOffset string `json:"initialOffset,omitempty" protobuf:"bytes,9,opt,name=initialOffset"`
+	// SASL configuration for the kafka client.
+	// +optional
+	SASL *SASLConfig `json:"sasl,omitempty" protobuf:"bytes,10,opt,name=sasl"`
+	// Version is the version of the kafka cluster, e.g. "2.4.0". Consumer groups require 0.10.2.0 or later.
+	// Defaults to 1.0.0.
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,11,opt,name=version"`
+}
+
+// KafkaConsumerGroup refers to the consumer group of a kafka event source
+type KafkaConsumerGroup struct {
+	// GroupName is the name of the consumer group
+	GroupName string `json:"groupName" protobuf:"bytes,1,opt,name=groupName"`
+	// RebalanceStrategy assigns the partitions to the members of the group, one of "range", "roundrobin" or "sticky".
+	// Defaults to "range".
+	// +optional
+	RebalanceStrategy string `json:"rebalanceStrategy,omitempty" protobuf:"bytes,2,opt,name=rebalanceStrategy"`
 }
 
 // MQTTEventSource refers to event-source for MQTT related events
@@ -279,6 +448,10 @@ type SNSEventSource struct {
 	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
 	// +optional
 	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,7,opt,name=roleARN"`
+	// SigningCertHosts is the list of hosts the certificates signing the messages may be downloaded from.
+	// Defaults to the SNS hosts, i.e. sns.<region>.amazonaws.com
+	// +optional
+	SigningCertHosts []string `json:"signingCertHosts,omitempty" protobuf:"bytes,8,rep,name=signingCertHosts"`
 }
 
 // SQSEventSource refers to event-source for AWS SQS related events
@@ -379,7 +552,9 @@ type GitlabEventSource struct {
 	// Webhook holds configuration to run a http server
 	Webhook *WebhookContext `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
 	// ProjectID is the id of project for which integration needs to setup
-	ProjectID string `json:"projectID" protobuf:"bytes,9,opt,name=projectID"`
+	// Deprecated: use ProjectIDs instead.
+	// +optional
+	ProjectID string `json:"projectID,omitempty" protobuf:"bytes,9,opt,name=projectID"`
 	// Events are gitlab event to listen to.
 	// Refer https://github.com/xanzy/go-gitlab/blob/bf34eca5d13a9f4c3f501d8a97b8ac226d55e4d9/projects.go#L794.
 	Events []string `json:"events" protobuf:"bytes,3,opt,name=events"`
@@ -393,6 +568,30 @@ type GitlabEventSource struct {
 	// DeleteHookOnFinish determines whether to delete the GitLab hook for the project once the event source is stopped.
 	// +optional
 	DeleteHookOnFinish bool `json:"deleteHookOnFinish,omitempty" protobuf:"varint,8,opt,name=deleteHookOnFinish"`
+	// SecretToken refers to K8s secret that holds the secret token of the hooks. GitLab sends it in the X-Gitlab-Token
+	// header of the requests, the requests without it are rejected.
+	// +optional
+	SecretToken *corev1.SecretKeySelector `json:"secretToken,omitempty" protobuf:"bytes,10,opt,name=secretToken"`
+	// ProjectIDs are the ids of the projects to set up a project hook for
+	// +optional
+	ProjectIDs []string `json:"projectIDs,omitempty" protobuf:"bytes,11,rep,name=projectIDs"`
+	// GroupIDs are the ids of the groups to set up a group hook for, which receives the events of all the projects
+	// of the group. Group hooks are only available on GitLab Premium.
+	// +optional
+	GroupIDs []string `json:"groupIDs,omitempty" protobuf:"bytes,12,rep,name=groupIDs"`
+}
+
+// GetProjectIDs returns the ids of the projects to set up a project hook for
+func (g *GitlabEventSource) GetProjectIDs() []string {
+	if g.ProjectID == "" {
+		return g.ProjectIDs
+	}
+	for _, id := range g.ProjectIDs {
+		if id == g.ProjectID {
+			return g.ProjectIDs
+		}
+	}
+	return append([]string{g.ProjectID}, g.ProjectIDs...)
 }
 
 // HDFSEventSource refers to event-source for HDFS related events
@@ -512,6 +711,16 @@ type StripeEventSource struct {
 	// +optional
 
 	EventFilter []string `json:"eventFilter,omitempty" protobuf:"bytes,5,rep,name=eventFilter"`
+	// WebhookSecret refers to K8s secret that holds the signing secret of the webhook endpoint, used to verify the
+	// Stripe-Signature header of the requests. If CreateWebhook is enabled, the signing secret returned by Stripe is
+	// stored in it. Required unless CreateWebhook is enabled.
+	// More info at https://stripe.com/docs/webhooks/signatures
+	// +optional
+	WebhookSecret *corev1.SecretKeySelector `json:"webhookSecret,omitempty" protobuf:"bytes,6,opt,name=webhookSecret"`
+	// Tolerance is the maximum age, in seconds, of the signature timestamp. Older requests are rejected to prevent replays.
+	// Defaults to 300 seconds.
+	// +optional
+	Tolerance int64 `json:"tolerance,omitempty" protobuf:"varint,7,opt,name=tolerance"`
 }
 
 // EmitterEventSource describes the event source for emitter
@@ -603,6 +812,17 @@ type TLSConfig struct {
 	ClientKeyPath string `json:"clientKeyPath" protobuf:"bytes,3,opt,name=clientKeyPath"`
 }
 
+// SASLConfig refers to SASL configuration for a client.
+type SASLConfig struct {
+	// Mechanism is the SASL mechanism, one of "PLAIN", "SCRAM-SHA-256" or "SCRAM-SHA-512". Defaults to "PLAIN".
+	// +optional
+	Mechanism string `json:"mechanism,omitempty" protobuf:"bytes,1,opt,name=mechanism"`
+	// User refers to the Kubernetes secret that holds the username
+	User *corev1.SecretKeySelector `json:"user" protobuf:"bytes,2,opt,name=user"`
+	// Password refers to the Kubernetes secret that holds the password
+	Password *corev1.SecretKeySelector `json:"password" protobuf:"bytes,3,opt,name=password"`
+}
+
 // EventSourceStatus holds the status of the event-source resource
 type EventSourceStatus struct {
 	CreatedAt metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,1,opt,name=createdAt"`
@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 // WebhookContext holds a general purpose REST API context
 type WebhookContext struct {
 	// REST API endpoint
@@ -15,4 +19,87 @@ type WebhookContext struct {
 	ServerCertPath string `json:"serverCertPath,omitempty" protobuf:"bytes,5,opt,name=serverCertPath"`
 	// ServerKeyPath refers the file that contains private key
 	ServerKeyPath string `json:"serverKeyPath,omitempty" protobuf:"bytes,6,opt,name=serverKeyPath"`
+	// Auth configures the authentication of the incoming requests. If not specified, the requests are not authenticated.
+	// +optional
+	Auth *WebhookAuth `json:"auth,omitempty" protobuf:"bytes,7,opt,name=auth"`
+	// Methods are the HTTP request methods allowed on the endpoint, in addition to Method.
+	// The requests with another method are rejected with 405. If neither is specified, all the methods are allowed.
+	// +optional
+	Methods []string `json:"methods,omitempty" protobuf:"bytes,8,rep,name=methods"`
+	// MaxPayloadSize is the maximum size, in bytes, of the request body. Larger requests are rejected with 413.
+	// Defaults to 10MiB.
+	// +optional
+	MaxPayloadSize int64 `json:"maxPayloadSize,omitempty" protobuf:"varint,9,opt,name=maxPayloadSize"`
+	// ReadTimeout is the maximum duration, in seconds, for reading a request. Defaults to 30 seconds.
+	// The timeouts apply to the HTTP server of the port, and are taken from the first endpoint started on it.
+	// An endpoint setting timeouts different from those of the server already running on its port is rejected.
+	// +optional
+	ReadTimeout int64 `json:"readTimeout,omitempty" protobuf:"varint,10,opt,name=readTimeout"`
+	// WriteTimeout is the maximum duration, in seconds, for processing a request and writing the response.
+	// Defaults to 30 seconds.
+	// +optional
+	WriteTimeout int64 `json:"writeTimeout,omitempty" protobuf:"varint,11,opt,name=writeTimeout"`
+	// IdleTimeout is the maximum duration, in seconds, a keep-alive connection is kept open between requests.
+	// Defaults to 120 seconds.
+	// +optional
+	IdleTimeout int64 `json:"idleTimeout,omitempty" protobuf:"varint,12,opt,name=idleTimeout"`
+	// RateLimit limits the rate of the requests on the endpoint. The requests over the limit are rejected with 429.
+	// Only the requests with an allowed method, within the max payload size and passing the authentication count.
+	// +optional
+	RateLimit *WebhookRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,13,opt,name=rateLimit"`
+}
+
+// WebhookRateLimit describes the rate limit of the requests of an endpoint, as a token bucket
+type WebhookRateLimit struct {
+	// RequestsPerSecond is the rate the requests are allowed at
+	RequestsPerSecond int32 `json:"requestsPerSecond" protobuf:"varint,1,opt,name=requestsPerSecond"`
+	// Burst is the number of requests allowed at once. Defaults to RequestsPerSecond.
+	// +optional
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,2,opt,name=burst"`
+}
+
+// WebhookAuth describes the authentication of the requests of a webhook.
+// A request is accepted only if it passes all the configured modes.
+// The secrets are read from the namespace of the gateway.
+type WebhookAuth struct {
+	// BearerToken refers to K8s secret that holds the token the requests must send in the Authorization header,
+	// i.e. "Authorization: Bearer <token>"
+	// +optional
+	BearerToken *corev1.SecretKeySelector `json:"bearerToken,omitempty" protobuf:"bytes,1,opt,name=bearerToken"`
+	// HMAC verifies the signature of the request body
+	// +optional
+	HMAC *WebhookHMAC `json:"hmac,omitempty" protobuf:"bytes,2,opt,name=hmac"`
+	// Basic verifies the HTTP basic auth credentials of the requests
+	// +optional
+	Basic *WebhookBasicAuth `json:"basic,omitempty" protobuf:"bytes,3,opt,name=basic"`
+	// ClientCAPath refers the file that contains the CA certificates the client certificates must be signed by,
+	// i.e. mutual TLS. Requires ServerCertPath and ServerKeyPath.
+	// +optional
+	ClientCAPath string `json:"clientCAPath,omitempty" protobuf:"bytes,4,opt,name=clientCAPath"`
+}
+
+// WebhookHMAC describes the HMAC signature of the request body
+type WebhookHMAC struct {
+	// Secret refers to K8s secret that holds the key of the HMAC
+	Secret *corev1.SecretKeySelector `json:"secret" protobuf:"bytes,1,opt,name=secret"`
+	// Header holding the signature. Defaults to X-Signature.
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
+	// Algorithm is the hash function of the HMAC, either sha1, sha256 or sha512. Defaults to sha256.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty" protobuf:"bytes,3,opt,name=algorithm"`
+	// Prefix of the signature in the header, e.g. "sha256=".
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,4,opt,name=prefix"`
+	// Encoding of the signature, either hex or base64. Defaults to hex.
+	// +optional
+	Encoding string `json:"encoding,omitempty" protobuf:"bytes,5,opt,name=encoding"`
+}
+
+// WebhookBasicAuth describes the HTTP basic auth credentials of the requests
+type WebhookBasicAuth struct {
+	// Username refers to K8s secret that holds the username
+	Username *corev1.SecretKeySelector `json:"username" protobuf:"bytes,1,opt,name=username"`
+	// Password refers to K8s secret that holds the password
+	Password *corev1.SecretKeySelector `json:"password" protobuf:"bytes,2,opt,name=password"`
 }
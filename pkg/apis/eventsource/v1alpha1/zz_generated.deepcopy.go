@@ -28,6 +28,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPConsumeConfig) DeepCopyInto(out *AMQPConsumeConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPConsumeConfig.
+func (in *AMQPConsumeConfig) DeepCopy() *AMQPConsumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPConsumeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AMQPEventSource) DeepCopyInto(out *AMQPEventSource) {
 	*out = *in
@@ -41,6 +57,26 @@ func (in *AMQPEventSource) DeepCopyInto(out *AMQPEventSource) {
 		*out = new(TLSConfig)
 		**out = **in
 	}
+	if in.ExchangeDeclare != nil {
+		in, out := &in.ExchangeDeclare, &out.ExchangeDeclare
+		*out = new(AMQPExchangeDeclareConfig)
+		**out = **in
+	}
+	if in.QueueDeclare != nil {
+		in, out := &in.QueueDeclare, &out.QueueDeclare
+		*out = new(AMQPQueueDeclareConfig)
+		**out = **in
+	}
+	if in.QoS != nil {
+		in, out := &in.QoS, &out.QoS
+		*out = new(AMQPQoSConfig)
+		**out = **in
+	}
+	if in.Consume != nil {
+		in, out := &in.Consume, &out.Consume
+		*out = new(AMQPConsumeConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -54,6 +90,54 @@ func (in *AMQPEventSource) DeepCopy() *AMQPEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPExchangeDeclareConfig) DeepCopyInto(out *AMQPExchangeDeclareConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPExchangeDeclareConfig.
+func (in *AMQPExchangeDeclareConfig) DeepCopy() *AMQPExchangeDeclareConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPExchangeDeclareConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPQoSConfig) DeepCopyInto(out *AMQPQoSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPQoSConfig.
+func (in *AMQPQoSConfig) DeepCopy() *AMQPQoSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPQoSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPQueueDeclareConfig) DeepCopyInto(out *AMQPQueueDeclareConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPQueueDeclareConfig.
+func (in *AMQPQueueDeclareConfig) DeepCopy() *AMQPQueueDeclareConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPQueueDeclareConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventsHubEventSource) DeepCopyInto(out *AzureEventsHubEventSource) {
 	*out = *in
@@ -80,6 +164,22 @@ func (in *AzureEventsHubEventSource) DeepCopy() *AzureEventsHubEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarCatchUp) DeepCopyInto(out *CalendarCatchUp) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarCatchUp.
+func (in *CalendarCatchUp) DeepCopy() *CalendarCatchUp {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarCatchUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalendarEventSource) DeepCopyInto(out *CalendarEventSource) {
 	*out = *in
@@ -93,6 +193,16 @@ func (in *CalendarEventSource) DeepCopyInto(out *CalendarEventSource) {
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(CalendarPersistence)
+		**out = **in
+	}
+	if in.CatchUp != nil {
+		in, out := &in.CatchUp, &out.CatchUp
+		*out = new(CalendarCatchUp)
+		**out = **in
+	}
 	return
 }
 
@@ -106,6 +216,22 @@ func (in *CalendarEventSource) DeepCopy() *CalendarEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarPersistence) DeepCopyInto(out *CalendarPersistence) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarPersistence.
+func (in *CalendarPersistence) DeepCopy() *CalendarPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmitterEventSource) DeepCopyInto(out *EmitterEventSource) {
 	*out = *in
@@ -238,7 +364,7 @@ func (in *EventSourceSpec) DeepCopyInto(out *EventSourceSpec) {
 		in, out := &in.Webhook, &out.Webhook
 		*out = make(map[string]WebhookContext, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	if in.AMQP != nil {
@@ -436,7 +562,7 @@ func (in *GithubEventSource) DeepCopyInto(out *GithubEventSource) {
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Events != nil {
 		in, out := &in.Events, &out.Events
@@ -472,7 +598,7 @@ func (in *GitlabEventSource) DeepCopyInto(out *GitlabEventSource) {
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Events != nil {
 		in, out := &in.Events, &out.Events
@@ -484,6 +610,21 @@ func (in *GitlabEventSource) DeepCopyInto(out *GitlabEventSource) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretToken != nil {
+		in, out := &in.SecretToken, &out.SecretToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDs != nil {
+		in, out := &in.ProjectIDs, &out.ProjectIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupIDs != nil {
+		in, out := &in.GroupIDs, &out.GroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -534,6 +675,22 @@ func (in *HDFSEventSource) DeepCopy() *HDFSEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConsumerGroup) DeepCopyInto(out *KafkaConsumerGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConsumerGroup.
+func (in *KafkaConsumerGroup) DeepCopy() *KafkaConsumerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConsumerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaEventSource) DeepCopyInto(out *KafkaEventSource) {
 	*out = *in
@@ -547,6 +704,21 @@ func (in *KafkaEventSource) DeepCopyInto(out *KafkaEventSource) {
 		*out = new(TLSConfig)
 		**out = **in
 	}
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(KafkaConsumerGroup)
+		**out = **in
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(SASLConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -699,6 +871,16 @@ func (in *ResourceEventSource) DeepCopyInto(out *ResourceEventSource) {
 		*out = make([]ResourceEventType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = make([]Selector, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -726,6 +908,18 @@ func (in *ResourceFilter) DeepCopyInto(out *ResourceFilter) {
 		copy(*out, *in)
 	}
 	in.CreatedBy.DeepCopyInto(&out.CreatedBy)
+	if in.JSONPaths != nil {
+		in, out := &in.JSONPaths, &out.JSONPaths
+		*out = make([]ResourceJSONPathFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -739,13 +933,60 @@ func (in *ResourceFilter) DeepCopy() *ResourceFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceJSONPathFilter) DeepCopyInto(out *ResourceJSONPathFilter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceJSONPathFilter.
+func (in *ResourceJSONPathFilter) DeepCopy() *ResourceJSONPathFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceJSONPathFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SASLConfig) DeepCopyInto(out *SASLConfig) {
+	*out = *in
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLConfig.
+func (in *SASLConfig) DeepCopy() *SASLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SASLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNSEventSource) DeepCopyInto(out *SNSEventSource) {
 	*out = *in
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.AccessKey != nil {
 		in, out := &in.AccessKey, &out.AccessKey
@@ -757,6 +998,11 @@ func (in *SNSEventSource) DeepCopyInto(out *SNSEventSource) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SigningCertHosts != nil {
+		in, out := &in.SigningCertHosts, &out.SigningCertHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -828,7 +1074,7 @@ func (in *SlackEventSource) DeepCopyInto(out *SlackEventSource) {
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -849,7 +1095,7 @@ func (in *StorageGridEventSource) DeepCopyInto(out *StorageGridEventSource) {
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Events != nil {
 		in, out := &in.Events, &out.Events
@@ -901,7 +1147,7 @@ func (in *StripeEventSource) DeepCopyInto(out *StripeEventSource) {
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.APIKey != nil {
 		in, out := &in.APIKey, &out.APIKey
@@ -913,6 +1159,11 @@ func (in *StripeEventSource) DeepCopyInto(out *StripeEventSource) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -958,9 +1209,81 @@ func (in *WatchPathConfig) DeepCopy() *WatchPathConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookAuth) DeepCopyInto(out *WebhookAuth) {
+	*out = *in
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HMAC != nil {
+		in, out := &in.HMAC, &out.HMAC
+		*out = new(WebhookHMAC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Basic != nil {
+		in, out := &in.Basic, &out.Basic
+		*out = new(WebhookBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAuth.
+func (in *WebhookAuth) DeepCopy() *WebhookAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookBasicAuth) DeepCopyInto(out *WebhookBasicAuth) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookBasicAuth.
+func (in *WebhookBasicAuth) DeepCopy() *WebhookBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookContext) DeepCopyInto(out *WebhookContext) {
 	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(WebhookAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(WebhookRateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -973,3 +1296,40 @@ func (in *WebhookContext) DeepCopy() *WebhookContext {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookHMAC) DeepCopyInto(out *WebhookHMAC) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookHMAC.
+func (in *WebhookHMAC) DeepCopy() *WebhookHMAC {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookHMAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookRateLimit) DeepCopyInto(out *WebhookRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookRateLimit.
+func (in *WebhookRateLimit) DeepCopy() *WebhookRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
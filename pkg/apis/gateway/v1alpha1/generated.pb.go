@@ -21,6 +21,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 
+	common "github.com/argoproj/argo-events/pkg/apis/common"
 	github_com_argoproj_argo_events_pkg_apis_common "github.com/argoproj/argo-events/pkg/apis/common"
 
 	io "io"
@@ -47,10 +48,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *DeadLetter) Reset()      { *m = DeadLetter{} }
+func (*DeadLetter) ProtoMessage() {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba11c13056ce1980, []int{0}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
 func (m *EventSourceRef) Reset()      { *m = EventSourceRef{} }
 func (*EventSourceRef) ProtoMessage() {}
 func (*EventSourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{0}
+	return fileDescriptor_ba11c13056ce1980, []int{1}
 }
 func (m *EventSourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +107,7 @@ var xxx_messageInfo_EventSourceRef proto.InternalMessageInfo
 func (m *Gateway) Reset()      { *m = Gateway{} }
 func (*Gateway) ProtoMessage() {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{1}
+	return fileDescriptor_ba11c13056ce1980, []int{2}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,7 +135,7 @@ var xxx_messageInfo_Gateway proto.InternalMessageInfo
 func (m *GatewayList) Reset()      { *m = GatewayList{} }
 func (*GatewayList) ProtoMessage() {}
 func (*GatewayList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{2}
+	return fileDescriptor_ba11c13056ce1980, []int{3}
 }
 func (m *GatewayList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,7 +163,7 @@ var xxx_messageInfo_GatewayList proto.InternalMessageInfo
 func (m *GatewayResource) Reset()      { *m = GatewayResource{} }
 func (*GatewayResource) ProtoMessage() {}
 func (*GatewayResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{3}
+	return fileDescriptor_ba11c13056ce1980, []int{4}
 }
 func (m *GatewayResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +191,7 @@ var xxx_messageInfo_GatewayResource proto.InternalMessageInfo
 func (m *GatewaySpec) Reset()      { *m = GatewaySpec{} }
 func (*GatewaySpec) ProtoMessage() {}
 func (*GatewaySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{4}
+	return fileDescriptor_ba11c13056ce1980, []int{5}
 }
 func (m *GatewaySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +219,7 @@ var xxx_messageInfo_GatewaySpec proto.InternalMessageInfo
 func (m *GatewayStatus) Reset()      { *m = GatewayStatus{} }
 func (*GatewayStatus) ProtoMessage() {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{5}
+	return fileDescriptor_ba11c13056ce1980, []int{6}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,10 +244,38 @@ func (m *GatewayStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GatewayStatus proto.InternalMessageInfo
 
+func (m *HTTPSubscriber) Reset()      { *m = HTTPSubscriber{} }
+func (*HTTPSubscriber) ProtoMessage() {}
+func (*HTTPSubscriber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba11c13056ce1980, []int{7}
+}
+func (m *HTTPSubscriber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSubscriber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSubscriber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSubscriber.Merge(m, src)
+}
+func (m *HTTPSubscriber) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSubscriber) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSubscriber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSubscriber proto.InternalMessageInfo
+
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{6}
+	return fileDescriptor_ba11c13056ce1980, []int{8}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,10 +300,38 @@ func (m *Metadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Metadata proto.InternalMessageInfo
 
+func (m *NATSDeadLetter) Reset()      { *m = NATSDeadLetter{} }
+func (*NATSDeadLetter) ProtoMessage() {}
+func (*NATSDeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba11c13056ce1980, []int{9}
+}
+func (m *NATSDeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NATSDeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NATSDeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NATSDeadLetter.Merge(m, src)
+}
+func (m *NATSDeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *NATSDeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_NATSDeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NATSDeadLetter proto.InternalMessageInfo
+
 func (m *NATSSubscriber) Reset()      { *m = NATSSubscriber{} }
 func (*NATSSubscriber) ProtoMessage() {}
 func (*NATSSubscriber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{7}
+	return fileDescriptor_ba11c13056ce1980, []int{10}
 }
 func (m *NATSSubscriber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +359,7 @@ var xxx_messageInfo_NATSSubscriber proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{8}
+	return fileDescriptor_ba11c13056ce1980, []int{11}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +387,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{9}
+	return fileDescriptor_ba11c13056ce1980, []int{12}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +415,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *Subscribers) Reset()      { *m = Subscribers{} }
 func (*Subscribers) ProtoMessage() {}
 func (*Subscribers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{10}
+	return fileDescriptor_ba11c13056ce1980, []int{13}
 }
 func (m *Subscribers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +443,7 @@ var xxx_messageInfo_Subscribers proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba11c13056ce1980, []int{11}
+	return fileDescriptor_ba11c13056ce1980, []int{14}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,6 +469,7 @@ func (m *Template) XXX_DiscardUnknown() {
 var xxx_messageInfo_Template proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*DeadLetter)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.DeadLetter")
 	proto.RegisterType((*EventSourceRef)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.EventSourceRef")
 	proto.RegisterType((*Gateway)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Gateway")
 	proto.RegisterType((*GatewayList)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayList")
@@ -391,9 +477,11 @@ func init() {
 	proto.RegisterType((*GatewaySpec)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewaySpec")
 	proto.RegisterType((*GatewayStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayStatus")
 	proto.RegisterMapType((map[string]NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.GatewayStatus.NodesEntry")
+	proto.RegisterType((*HTTPSubscriber)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.HTTPSubscriber")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Metadata.LabelsEntry")
+	proto.RegisterType((*NATSDeadLetter)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.NATSDeadLetter")
 	proto.RegisterType((*NATSSubscriber)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.NATSSubscriber")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.NodeStatus")
 	proto.RegisterType((*Service)(nil), "github.com.argoproj.argo_events.pkg.apis.gateway.v1alpha1.Service")
@@ -406,97 +494,156 @@ func init() {
 }
 
 var fileDescriptor_ba11c13056ce1980 = []byte{
-	// 1440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x3a, 0x76, 0x6c, 0x8f, 0x9b, 0xa4, 0xdf, 0xe9, 0x17, 0xc9, 0x32, 0xc5, 0xae, 0x7c,
-	0x40, 0x05, 0xa9, 0xeb, 0x36, 0x02, 0xd4, 0x82, 0x00, 0xc5, 0x49, 0x4a, 0x53, 0x35, 0x69, 0x34,
-	0x76, 0x41, 0xa2, 0x48, 0x74, 0xb2, 0x9e, 0xd8, 0xdb, 0x78, 0x77, 0x96, 0x9d, 0x59, 0xb7, 0x3e,
-	0xc1, 0x85, 0x13, 0x08, 0x71, 0xe4, 0xc2, 0x91, 0xbf, 0x02, 0x21, 0x8e, 0xf4, 0xc0, 0xa1, 0x07,
-	0x0e, 0x3d, 0x59, 0xd4, 0xfc, 0x17, 0x3d, 0xa1, 0x99, 0x9d, 0xd9, 0x59, 0x3b, 0x0e, 0x4d, 0xe3,
-	0x9c, 0xbc, 0xf3, 0xe6, 0xcd, 0xe7, 0xf3, 0x66, 0xde, 0x4f, 0x83, 0xed, 0xae, 0xcb, 0x7b, 0xd1,
-	0xbe, 0xed, 0x50, 0xaf, 0x81, 0xc3, 0x2e, 0x0d, 0x42, 0xfa, 0x50, 0x7e, 0x5c, 0x21, 0x03, 0xe2,
-	0x73, 0xd6, 0x08, 0x0e, 0xbb, 0x0d, 0x1c, 0xb8, 0xac, 0xd1, 0xc5, 0x9c, 0x3c, 0xc2, 0xc3, 0xc6,
-	0xe0, 0x1a, 0xee, 0x07, 0x3d, 0x7c, 0xad, 0xd1, 0x25, 0x3e, 0x09, 0x31, 0x27, 0x1d, 0x3b, 0x08,
-	0x29, 0xa7, 0xf0, 0x86, 0x81, 0xb2, 0x35, 0x94, 0xfc, 0xf8, 0x32, 0x86, 0xb2, 0x83, 0xc3, 0xae,
-	0x2d, 0xa0, 0x6c, 0x05, 0x65, 0x6b, 0xa8, 0xca, 0xc7, 0x27, 0xb6, 0xc2, 0xa1, 0x9e, 0x47, 0xfd,
-	0x69, 0xee, 0xca, 0x95, 0x14, 0x40, 0x97, 0x76, 0x69, 0x43, 0x8a, 0xf7, 0xa3, 0x03, 0xb9, 0x92,
-	0x0b, 0xf9, 0xa5, 0xd4, 0xeb, 0x87, 0xd7, 0x99, 0xed, 0x52, 0x01, 0xd9, 0x70, 0x68, 0x48, 0x1a,
-	0x83, 0x23, 0xd7, 0xa9, 0xbc, 0x63, 0x74, 0x3c, 0xec, 0xf4, 0x5c, 0x9f, 0x84, 0x43, 0x63, 0x87,
-	0x47, 0x38, 0x9e, 0x75, 0xaa, 0x71, 0xdc, 0xa9, 0x30, 0xf2, 0xb9, 0xeb, 0x91, 0x23, 0x07, 0xde,
-	0x7b, 0xd9, 0x01, 0xe6, 0xf4, 0x88, 0x87, 0xa7, 0xcf, 0xd5, 0x1d, 0xb0, 0xb2, 0x25, 0xde, 0xa6,
-	0x45, 0xa3, 0xd0, 0x21, 0x88, 0x1c, 0xc0, 0x4b, 0x20, 0xeb, 0x63, 0x8f, 0x94, 0xad, 0x4b, 0xd6,
-	0xe5, 0x62, 0xf3, 0xdc, 0x93, 0x51, 0x6d, 0x61, 0x3c, 0xaa, 0x65, 0x77, 0xb1, 0x47, 0x90, 0xdc,
-	0x81, 0x0d, 0x50, 0x14, 0xbf, 0x2c, 0xc0, 0x0e, 0x29, 0x67, 0xa4, 0xda, 0xff, 0x94, 0x5a, 0x71,
-	0x57, 0x6f, 0x20, 0xa3, 0x53, 0xff, 0x23, 0x03, 0xf2, 0x9f, 0xc4, 0xce, 0x82, 0x0f, 0x40, 0x41,
-	0x5c, 0xba, 0x83, 0x39, 0x96, 0x14, 0xa5, 0xb5, 0xab, 0x76, 0x6c, 0xbb, 0x9d, 0xb6, 0xdd, 0x78,
-	0x59, 0x68, 0xdb, 0x83, 0x6b, 0xf6, 0xdd, 0xfd, 0x87, 0xc4, 0xe1, 0x3b, 0x84, 0xe3, 0x26, 0x54,
-	0x6c, 0xc0, 0xc8, 0x50, 0x82, 0x0a, 0x03, 0xb0, 0xc4, 0x38, 0xe6, 0x11, 0x93, 0xb6, 0x95, 0xd6,
-	0x6e, 0xd9, 0xa7, 0x8e, 0x28, 0x5b, 0x59, 0xdd, 0x92, 0x78, 0xcd, 0x15, 0xc5, 0xbb, 0x14, 0xaf,
-	0x91, 0xe2, 0x81, 0x3d, 0x90, 0x65, 0x01, 0x71, 0xca, 0x8b, 0x92, 0xef, 0xe6, 0x19, 0xf0, 0x05,
-	0xc4, 0x31, 0x4f, 0x2f, 0x56, 0x48, 0x32, 0xd4, 0xff, 0xb2, 0x40, 0x49, 0xe9, 0xdc, 0x71, 0x19,
-	0x87, 0x5f, 0x1c, 0x79, 0x4d, 0xfb, 0x64, 0xaf, 0x29, 0x4e, 0xcb, 0xb7, 0x3c, 0xaf, 0x58, 0x0a,
-	0x5a, 0x92, 0x7a, 0xc9, 0x2e, 0xc8, 0xb9, 0x9c, 0x78, 0xe2, 0x21, 0x17, 0x2f, 0x97, 0xd6, 0x9a,
-	0xf3, 0x5f, 0xac, 0xb9, 0xac, 0xe8, 0x72, 0xdb, 0x02, 0x18, 0xc5, 0xf8, 0xf5, 0x3f, 0x2d, 0xb0,
-	0xaa, 0x34, 0x10, 0x61, 0x32, 0x14, 0xe1, 0x03, 0x00, 0x3a, 0x24, 0xe8, 0xd3, 0xa1, 0x47, 0x7c,
-	0x7e, 0xea, 0x50, 0x59, 0x11, 0x61, 0xb2, 0x99, 0xe0, 0xa0, 0x14, 0x26, 0xfc, 0x0c, 0xe4, 0x19,
-	0x09, 0x07, 0xae, 0x8a, 0xe2, 0xd3, 0xc0, 0x97, 0xc6, 0xa3, 0x5a, 0xbe, 0x15, 0x83, 0x20, 0x8d,
-	0x56, 0xff, 0x35, 0x97, 0x78, 0x49, 0xf8, 0x0e, 0x7e, 0x05, 0x0a, 0x9c, 0x78, 0x41, 0x1f, 0x73,
-	0xa2, 0x2e, 0xb2, 0x31, 0xc7, 0x53, 0xb6, 0x15, 0x94, 0x71, 0x9d, 0x96, 0xa0, 0x84, 0x06, 0x7e,
-	0x6b, 0x81, 0x15, 0x32, 0x91, 0xd8, 0xea, 0x8e, 0xdb, 0x73, 0x30, 0x4f, 0x56, 0x8a, 0x26, 0x1c,
-	0x8f, 0x6a, 0x53, 0xd5, 0x03, 0x4d, 0x91, 0x42, 0x07, 0x64, 0xf9, 0x30, 0x20, 0x32, 0x35, 0x8a,
-	0xcd, 0xbb, 0x3a, 0xa4, 0xdb, 0xc3, 0x80, 0xbc, 0x18, 0xd5, 0x5e, 0xb5, 0x60, 0xa7, 0x2d, 0x10,
-	0x10, 0x48, 0x82, 0x43, 0xd7, 0x38, 0x32, 0x2b, 0x2f, 0x39, 0x4f, 0xa4, 0x2a, 0x6f, 0xce, 0x76,
-	0x2d, 0x1c, 0x82, 0x12, 0x8b, 0xf6, 0x99, 0x13, 0xba, 0xfb, 0x24, 0x64, 0xe5, 0xdc, 0xdc, 0x19,
-	0xdf, 0x32, 0x68, 0xcd, 0xd5, 0xf1, 0xa8, 0x56, 0x4a, 0x09, 0x50, 0x9a, 0x0b, 0x7e, 0x00, 0x96,
-	0x83, 0x90, 0x3a, 0x84, 0x31, 0x1a, 0xee, 0xd1, 0x90, 0x97, 0x97, 0xe4, 0x9b, 0xbe, 0xa6, 0xde,
-	0x74, 0x79, 0x2f, 0xbd, 0x89, 0x26, 0x75, 0xe1, 0x5b, 0x20, 0x1f, 0x92, 0xa0, 0xef, 0x3a, 0xb8,
-	0x9c, 0xbf, 0x64, 0x5d, 0xce, 0x35, 0x57, 0xd5, 0xb1, 0x3c, 0x8a, 0xc5, 0x48, 0xef, 0xd7, 0x7f,
-	0xcb, 0x82, 0xe5, 0x89, 0xba, 0x07, 0xaf, 0x82, 0x5c, 0xd0, 0xc3, 0x4c, 0xf7, 0x84, 0x8a, 0xce,
-	0xe1, 0x3d, 0x21, 0x7c, 0x21, 0xaa, 0x3e, 0xed, 0x10, 0xb9, 0x40, 0xb1, 0x22, 0xbc, 0x0f, 0x8a,
-	0x8c, 0xe3, 0x90, 0x93, 0xce, 0x3a, 0x57, 0x81, 0xf7, 0xf6, 0xc9, 0x92, 0xab, 0xed, 0x7a, 0xc4,
-	0xb4, 0x93, 0x96, 0x06, 0x41, 0x06, 0x4f, 0xdc, 0xc5, 0x23, 0x8c, 0xe1, 0xae, 0x0e, 0xab, 0xe4,
-	0x2e, 0x3b, 0xb1, 0x18, 0xe9, 0x7d, 0xf8, 0x18, 0xe4, 0x7c, 0xda, 0x21, 0xac, 0x9c, 0x95, 0x15,
-	0xac, 0x75, 0x56, 0xad, 0xc0, 0x16, 0x37, 0x66, 0x5b, 0x3e, 0x0f, 0x53, 0x25, 0x4d, 0xca, 0x50,
-	0x4c, 0x08, 0x1f, 0x81, 0x62, 0xa8, 0x4a, 0x99, 0x0e, 0x93, 0xdb, 0xf3, 0xb3, 0xeb, 0xea, 0xd8,
-	0x5c, 0x16, 0xaf, 0xa3, 0x57, 0x0c, 0x19, 0xae, 0xca, 0xd7, 0x00, 0x18, 0xe3, 0xe0, 0x79, 0xb0,
-	0x78, 0x48, 0x86, 0xb1, 0xe3, 0x90, 0xf8, 0x84, 0xf7, 0x41, 0x6e, 0x80, 0xfb, 0x91, 0xae, 0x79,
-	0x5b, 0x73, 0x18, 0x25, 0x78, 0x54, 0x2b, 0x8c, 0x31, 0xdf, 0xcf, 0x5c, 0xb7, 0xea, 0xdf, 0x2f,
-	0x82, 0xc2, 0x8e, 0x6e, 0x21, 0xdf, 0x59, 0xa0, 0x84, 0x7d, 0x9f, 0x72, 0xcc, 0x5d, 0xea, 0xb3,
-	0xb2, 0x25, 0xfd, 0xd0, 0x9e, 0x83, 0x54, 0x43, 0xdb, 0xeb, 0x06, 0x36, 0x76, 0xc4, 0x05, 0xe5,
-	0x88, 0x52, 0x6a, 0x07, 0xa5, 0xd9, 0xe1, 0x23, 0xb0, 0xd4, 0xc7, 0xfb, 0xa4, 0xaf, 0x3b, 0xda,
-	0xdd, 0xb3, 0xb0, 0xe3, 0x8e, 0x44, 0x8c, 0x4d, 0x48, 0x26, 0x84, 0x58, 0x88, 0x14, 0x5d, 0xe5,
-	0x23, 0x70, 0x7e, 0xda, 0xdc, 0x19, 0xae, 0xf9, 0x7f, 0xda, 0x35, 0xc5, 0xd4, 0x9b, 0x56, 0x6e,
-	0x80, 0x52, 0x8a, 0xe6, 0x55, 0x8e, 0xd6, 0x7f, 0xb0, 0xc0, 0xca, 0xee, 0x7a, 0xbb, 0x65, 0xea,
-	0x8a, 0x18, 0xe0, 0x44, 0x3d, 0x23, 0xe1, 0x3d, 0x74, 0x47, 0xe5, 0xb4, 0xc9, 0x38, 0xbd, 0x81,
-	0x8c, 0x8e, 0xc8, 0x38, 0x16, 0xc9, 0xae, 0xa7, 0xe6, 0xbd, 0x24, 0xe3, 0x5a, 0xb1, 0x18, 0xe9,
-	0xfd, 0x64, 0x7c, 0x5c, 0x3c, 0x6e, 0x7c, 0xac, 0xff, 0xb2, 0x18, 0x47, 0xa8, 0x2a, 0x2e, 0x15,
-	0x90, 0x71, 0x3b, 0xca, 0x0a, 0xa0, 0xd4, 0x33, 0xdb, 0x9b, 0x28, 0xe3, 0x76, 0x12, 0xb0, 0xcc,
-	0xb1, 0xb3, 0xe8, 0xbb, 0xa0, 0xd4, 0x71, 0x59, 0xd0, 0xc7, 0xc3, 0x5d, 0xc3, 0x9a, 0x04, 0xc2,
-	0xa6, 0xd9, 0x42, 0x69, 0x3d, 0x53, 0xd1, 0xb2, 0x27, 0xad, 0x68, 0x0f, 0xd2, 0x15, 0x2d, 0xce,
-	0xe7, 0xc6, 0xc9, 0x2a, 0xda, 0x8e, 0xeb, 0x84, 0xf4, 0xd5, 0xca, 0xda, 0xd2, 0x4b, 0xca, 0x9a,
-	0x03, 0x40, 0x14, 0x74, 0x30, 0x27, 0x02, 0x56, 0x16, 0xf4, 0x53, 0x58, 0x93, 0x4c, 0xd1, 0xf7,
-	0x12, 0x28, 0x94, 0x82, 0xad, 0xff, 0x6e, 0x01, 0xdd, 0xff, 0xe0, 0x26, 0xc8, 0x05, 0x34, 0xe4,
-	0x3a, 0x7f, 0x6b, 0x29, 0x2e, 0x5b, 0xfc, 0xf3, 0x11, 0xc8, 0x4a, 0x57, 0xb4, 0x1b, 0x53, 0x13,
-	0xc5, 0x8a, 0xa1, 0xf8, 0xb0, 0x88, 0x3b, 0xa7, 0x1f, 0x31, 0x4e, 0xc2, 0xed, 0xbd, 0xe9, 0x3f,
-	0x0e, 0x1b, 0x7a, 0x03, 0x19, 0x1d, 0xf8, 0xe1, 0xc4, 0x60, 0xfd, 0x5f, 0xac, 0x72, 0x62, 0x2e,
-	0x4c, 0x4d, 0xcb, 0x3f, 0x59, 0x20, 0xdd, 0x4e, 0xe1, 0x45, 0x90, 0xed, 0x71, 0x1e, 0xc8, 0x4b,
-	0x14, 0x63, 0xed, 0x5b, 0xed, 0xf6, 0x1e, 0x92, 0x52, 0x78, 0x28, 0x82, 0x8d, 0xeb, 0xd2, 0x30,
-	0xcf, 0x9c, 0x34, 0x99, 0x6e, 0xa9, 0xb8, 0x5d, 0x6f, 0xb7, 0x90, 0x24, 0xa9, 0xff, 0x9c, 0x03,
-	0xc9, 0xd8, 0x26, 0xe6, 0xc3, 0xa9, 0x29, 0x7e, 0xe3, 0x0c, 0x0a, 0x93, 0x99, 0x0f, 0xb5, 0x24,
-	0x35, 0xda, 0xdf, 0x06, 0x50, 0x8d, 0x34, 0xeb, 0x8e, 0x43, 0x23, 0x9f, 0xef, 0x9a, 0x3c, 0xd3,
-	0xd9, 0x00, 0x5b, 0x47, 0x34, 0xd0, 0x8c, 0x53, 0xf0, 0x36, 0x28, 0x3a, 0xd4, 0xe7, 0x58, 0x44,
-	0x9c, 0x72, 0xd5, 0x1b, 0xb3, 0x5c, 0xb5, 0xa1, 0x95, 0xe2, 0xee, 0x95, 0x2c, 0x91, 0x39, 0x0e,
-	0xb7, 0x40, 0x7e, 0x40, 0xfb, 0x91, 0x97, 0xb4, 0xec, 0xca, 0x2c, 0xa4, 0x4f, 0xa5, 0x8a, 0x49,
-	0x90, 0x78, 0xcd, 0x90, 0x3e, 0x0b, 0x09, 0x58, 0x65, 0xc4, 0x89, 0x42, 0x97, 0x0f, 0x05, 0x0d,
-	0x79, 0xac, 0x73, 0xf6, 0xcd, 0x59, 0x70, 0x7b, 0xb4, 0xd3, 0x9a, 0xd4, 0x6e, 0x5e, 0x18, 0x8f,
-	0x6a, 0xab, 0x53, 0x42, 0x34, 0x8d, 0x09, 0x6f, 0x82, 0x02, 0x3e, 0x38, 0x70, 0x7d, 0x97, 0x0f,
-	0x65, 0xce, 0x96, 0xd6, 0x2e, 0xce, 0xc2, 0x5f, 0x57, 0x3a, 0xcd, 0x73, 0xc2, 0x1b, 0x7a, 0x85,
-	0x92, 0xb3, 0xf0, 0x1e, 0x28, 0x71, 0xda, 0x17, 0x7f, 0xcc, 0x65, 0x93, 0xcc, 0xcb, 0x9b, 0x57,
-	0x67, 0x41, 0xb5, 0x13, 0x35, 0x53, 0xe5, 0x8c, 0x8c, 0xa1, 0x34, 0x0e, 0xbc, 0xa1, 0xd2, 0xa7,
-	0x20, 0x4d, 0x7b, 0xfd, 0xb8, 0xab, 0xcf, 0x48, 0x9d, 0xa6, 0xfd, 0xe4, 0x79, 0x75, 0xe1, 0xe9,
-	0xf3, 0xea, 0xc2, 0xb3, 0xe7, 0xd5, 0x85, 0x6f, 0xc6, 0x55, 0xeb, 0xc9, 0xb8, 0x6a, 0x3d, 0x1d,
-	0x57, 0xad, 0x67, 0xe3, 0xaa, 0xf5, 0xf7, 0xb8, 0x6a, 0xfd, 0xf8, 0x4f, 0x75, 0xe1, 0xf3, 0x82,
-	0x0e, 0xba, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x76, 0x7d, 0x54, 0x01, 0x12, 0x00, 0x00,
+	// 1650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0x37,
+	0x16, 0xf7, 0xe8, 0xc3, 0x92, 0x28, 0x7f, 0x24, 0x4c, 0x16, 0x2b, 0x68, 0x13, 0xd9, 0xd0, 0x61,
+	0xe1, 0x5d, 0x20, 0xa3, 0xc4, 0xd8, 0x5d, 0x38, 0x5b, 0xb4, 0x85, 0xc7, 0x76, 0x1a, 0x07, 0xb6,
+	0x63, 0x50, 0x72, 0x0b, 0x34, 0x05, 0x1a, 0x7a, 0x44, 0xcb, 0x13, 0x6b, 0x3e, 0x32, 0xe4, 0x38,
+	0xd1, 0xa9, 0xbd, 0xf4, 0xd4, 0x1c, 0xda, 0x63, 0x0f, 0x3d, 0xf6, 0x5f, 0xe8, 0xad, 0xe8, 0xb1,
+	0x39, 0xf4, 0x90, 0x43, 0x0f, 0x39, 0x19, 0x8d, 0xfa, 0x37, 0x14, 0x28, 0x72, 0x2a, 0xc8, 0x21,
+	0x87, 0x23, 0x59, 0x6e, 0x1c, 0xcb, 0xcd, 0xa5, 0x27, 0x89, 0x8f, 0x8f, 0xbf, 0xdf, 0xe3, 0xe3,
+	0xe3, 0x7b, 0x8f, 0x03, 0xd6, 0x3b, 0x0e, 0xdb, 0x8f, 0x76, 0x4d, 0xdb, 0x77, 0x1b, 0x38, 0xec,
+	0xf8, 0x41, 0xe8, 0x3f, 0x10, 0x7f, 0xae, 0x91, 0x43, 0xe2, 0x31, 0xda, 0x08, 0x0e, 0x3a, 0x0d,
+	0x1c, 0x38, 0xb4, 0xd1, 0xc1, 0x8c, 0x3c, 0xc2, 0xbd, 0xc6, 0xe1, 0x0d, 0xdc, 0x0d, 0xf6, 0xf1,
+	0x8d, 0x46, 0x87, 0x78, 0x24, 0xc4, 0x8c, 0xb4, 0xcd, 0x20, 0xf4, 0x99, 0x0f, 0x6f, 0x6a, 0x28,
+	0x53, 0x41, 0x89, 0x3f, 0x1f, 0xc7, 0x50, 0x66, 0x70, 0xd0, 0x31, 0x39, 0x94, 0x29, 0xa1, 0x4c,
+	0x05, 0x55, 0x7d, 0xf7, 0xd4, 0x56, 0xd8, 0xbe, 0xeb, 0xfa, 0xde, 0x30, 0x77, 0xf5, 0x5a, 0x0a,
+	0xa0, 0xe3, 0x77, 0xfc, 0x86, 0x10, 0xef, 0x46, 0x7b, 0x62, 0x24, 0x06, 0xe2, 0x9f, 0x54, 0xaf,
+	0x1f, 0x2c, 0x51, 0xd3, 0xf1, 0x39, 0x64, 0xc3, 0xf6, 0x43, 0xd2, 0x38, 0x3c, 0xb6, 0x9d, 0xea,
+	0x7f, 0xb4, 0x8e, 0x8b, 0xed, 0x7d, 0xc7, 0x23, 0x61, 0x4f, 0xdb, 0xe1, 0x12, 0x86, 0x47, 0xad,
+	0x6a, 0x9c, 0xb4, 0x2a, 0x8c, 0x3c, 0xe6, 0xb8, 0xe4, 0xd8, 0x82, 0xff, 0xbd, 0x6a, 0x01, 0xb5,
+	0xf7, 0x89, 0x8b, 0x87, 0xd7, 0xd5, 0xbf, 0x35, 0x00, 0x58, 0x25, 0xb8, 0xbd, 0x41, 0x18, 0x23,
+	0x21, 0xec, 0x80, 0x9c, 0x87, 0x19, 0xad, 0x18, 0xf3, 0xc6, 0x42, 0x79, 0x71, 0xdd, 0x3c, 0xf3,
+	0x59, 0x98, 0x5b, 0xcb, 0xad, 0xa6, 0x06, 0xb6, 0x8a, 0xfd, 0xa3, 0xb9, 0x1c, 0x97, 0x21, 0x41,
+	0x00, 0xe7, 0x41, 0x6e, 0xcf, 0xe9, 0x92, 0x4a, 0x66, 0xde, 0x58, 0x28, 0x59, 0x53, 0x4f, 0x8f,
+	0xe6, 0x26, 0xb8, 0xc6, 0x2d, 0xa7, 0x4b, 0x90, 0x98, 0x81, 0x57, 0x41, 0x36, 0x0a, 0xbb, 0x95,
+	0xac, 0x50, 0x28, 0x4b, 0x85, 0xec, 0x0e, 0xda, 0x40, 0x5c, 0x5e, 0xb7, 0xc1, 0xcc, 0x1a, 0xb7,
+	0xa1, 0xe9, 0x47, 0xa1, 0x4d, 0x10, 0xd9, 0xe3, 0x90, 0x1e, 0x76, 0x49, 0xc5, 0x18, 0x84, 0xdc,
+	0xc2, 0x2e, 0x41, 0x62, 0x06, 0x36, 0x40, 0x89, 0xff, 0xd2, 0x00, 0xdb, 0x8a, 0xf9, 0xa2, 0x54,
+	0x2b, 0x6d, 0xa9, 0x09, 0xa4, 0x75, 0xea, 0x3f, 0x64, 0x40, 0xe1, 0xbd, 0x78, 0x67, 0xf0, 0x3e,
+	0x28, 0xf2, 0xd3, 0x6a, 0x63, 0x86, 0xa5, 0x7b, 0xae, 0x9b, 0xb1, 0xd3, 0xcd, 0xb4, 0xd3, 0xb5,
+	0x4b, 0xb8, 0xb6, 0x79, 0x78, 0xc3, 0xbc, 0xbb, 0xfb, 0x80, 0xd8, 0x6c, 0x93, 0x30, 0x6c, 0x41,
+	0xc9, 0x06, 0xb4, 0x0c, 0x25, 0xa8, 0x30, 0x00, 0x93, 0x94, 0x61, 0x16, 0x51, 0x61, 0x5b, 0x79,
+	0xf1, 0xf6, 0x18, 0xee, 0x97, 0x56, 0x37, 0x05, 0x9e, 0x35, 0x23, 0x79, 0x27, 0xe3, 0x31, 0x92,
+	0x3c, 0x70, 0x1f, 0xe4, 0x68, 0x40, 0x6c, 0xe1, 0xe4, 0xf2, 0xe2, 0xad, 0x73, 0xe0, 0x0b, 0x88,
+	0xad, 0x5d, 0xcf, 0x47, 0x48, 0x30, 0xd4, 0x7f, 0x32, 0x40, 0x59, 0xea, 0x6c, 0x38, 0x94, 0xc1,
+	0x8f, 0x8e, 0x79, 0xd3, 0x3c, 0x9d, 0x37, 0xf9, 0x6a, 0xe1, 0xcb, 0x0b, 0x92, 0xa5, 0xa8, 0x24,
+	0x29, 0x4f, 0x76, 0x40, 0xde, 0x61, 0xc4, 0xe5, 0x8e, 0xcc, 0x2e, 0x94, 0x17, 0xad, 0xf1, 0x37,
+	0x66, 0x4d, 0x4b, 0xba, 0xfc, 0x3a, 0x07, 0x46, 0x31, 0x7e, 0xfd, 0x47, 0x03, 0xcc, 0x4a, 0x0d,
+	0x44, 0xa8, 0x08, 0x45, 0x78, 0x1f, 0x80, 0x36, 0x09, 0xba, 0x7e, 0xcf, 0x25, 0x1e, 0x3b, 0x73,
+	0xa8, 0xcc, 0xf0, 0x30, 0x59, 0x4d, 0x70, 0x50, 0x0a, 0x13, 0x7e, 0x00, 0x0a, 0x94, 0x84, 0x87,
+	0x8e, 0x8c, 0xe2, 0xb3, 0xc0, 0x97, 0xfb, 0x47, 0x73, 0x85, 0x66, 0x0c, 0x82, 0x14, 0x5a, 0xfd,
+	0xd7, 0x7c, 0x72, 0x4a, 0xfc, 0xec, 0xe0, 0x43, 0x50, 0x64, 0xc4, 0x0d, 0xba, 0x98, 0x11, 0xb9,
+	0x91, 0x95, 0x31, 0x5c, 0xd9, 0x92, 0x50, 0xfa, 0xe8, 0x94, 0x04, 0x25, 0x34, 0xf0, 0x33, 0x03,
+	0xcc, 0x90, 0x81, 0x8b, 0x5d, 0xc9, 0x8c, 0x9d, 0x8c, 0x06, 0x33, 0x85, 0x05, 0xfb, 0x47, 0x73,
+	0x43, 0xd9, 0x03, 0x0d, 0x91, 0x42, 0x1b, 0xe4, 0x58, 0x2f, 0x20, 0x32, 0xff, 0xdc, 0x55, 0x21,
+	0xdd, 0xea, 0x05, 0xe4, 0xe5, 0xd1, 0xdc, 0xeb, 0x56, 0x9a, 0xb4, 0x05, 0x1c, 0x02, 0x09, 0x70,
+	0xe8, 0xe8, 0x83, 0xcc, 0xcd, 0x1b, 0x63, 0x46, 0xaa, 0x3c, 0xcd, 0xd1, 0x47, 0x0b, 0x7b, 0xa0,
+	0x4c, 0xa3, 0x5d, 0x6a, 0x87, 0xce, 0x2e, 0x09, 0x69, 0x25, 0x3f, 0xf6, 0x8d, 0x6f, 0x6a, 0x34,
+	0x6b, 0xb6, 0x7f, 0x34, 0x57, 0x4e, 0x09, 0x50, 0x9a, 0x0b, 0xbe, 0x05, 0xa6, 0x83, 0xd0, 0xb7,
+	0x09, 0xa5, 0x7e, 0xb8, 0xed, 0x87, 0xac, 0x32, 0x29, 0x7c, 0xfa, 0x37, 0xe9, 0xd3, 0xe9, 0xed,
+	0xf4, 0x24, 0x1a, 0xd4, 0x85, 0xff, 0x02, 0x85, 0x90, 0x04, 0x5d, 0xc7, 0xc6, 0x95, 0xc2, 0xbc,
+	0xb1, 0x90, 0xb7, 0x66, 0xe5, 0xb2, 0x02, 0x8a, 0xc5, 0x48, 0xcd, 0xc3, 0x25, 0x30, 0x25, 0xac,
+	0xb6, 0x22, 0xca, 0xb3, 0x79, 0xa5, 0x28, 0x68, 0x2e, 0x4b, 0xfd, 0xa9, 0xb5, 0xd4, 0x1c, 0x1a,
+	0xd0, 0xac, 0x7f, 0x97, 0x03, 0xd3, 0x03, 0x19, 0x13, 0x5e, 0x07, 0xf9, 0x60, 0x1f, 0x53, 0x55,
+	0x4d, 0xaa, 0xea, 0xf6, 0x6f, 0x73, 0xe1, 0x4b, 0x5e, 0x2f, 0xfc, 0x36, 0x11, 0x03, 0x14, 0x2b,
+	0xc2, 0x7b, 0xa0, 0x44, 0x19, 0x0e, 0x19, 0x69, 0x2f, 0x33, 0x19, 0xb2, 0xff, 0x3e, 0xdd, 0xb5,
+	0x6c, 0x39, 0x2e, 0xd1, 0x85, 0xa8, 0xa9, 0x40, 0x90, 0xc6, 0xe3, 0x5e, 0x70, 0x09, 0xa5, 0xb8,
+	0xa3, 0x02, 0x32, 0xf1, 0xc2, 0x66, 0x2c, 0x46, 0x6a, 0x1e, 0x3e, 0x06, 0x79, 0xcf, 0x6f, 0x13,
+	0x5a, 0xc9, 0x89, 0xdc, 0xd7, 0x3c, 0xaf, 0x22, 0x62, 0xf2, 0x1d, 0xd3, 0x35, 0x8f, 0x85, 0xa9,
+	0x64, 0x28, 0x64, 0x28, 0x26, 0x84, 0x8f, 0x40, 0x29, 0x94, 0x49, 0x50, 0x05, 0xd8, 0x9d, 0xf1,
+	0xd9, 0x55, 0x5e, 0xb5, 0xa6, 0xb9, 0x77, 0xd4, 0x88, 0x22, 0xcd, 0x55, 0xfd, 0x04, 0x00, 0x6d,
+	0x1c, 0xbc, 0x00, 0xb2, 0x07, 0xa4, 0x17, 0x1f, 0x1c, 0xe2, 0x7f, 0xe1, 0x3d, 0x90, 0x3f, 0xc4,
+	0xdd, 0x48, 0x65, 0xcb, 0xb5, 0x71, 0xda, 0x1a, 0xbf, 0x4d, 0x64, 0x11, 0x8d, 0x31, 0xff, 0x9f,
+	0x59, 0x32, 0xea, 0x5f, 0x65, 0xc0, 0xcc, 0xed, 0x56, 0x6b, 0x5b, 0x5f, 0x01, 0xd5, 0xbe, 0x18,
+	0xa3, 0xdb, 0x17, 0xf8, 0x10, 0x4c, 0x87, 0x84, 0x85, 0xbd, 0x26, 0x0b, 0x31, 0x23, 0x9d, 0x9e,
+	0x34, 0x6d, 0xe9, 0xf4, 0xa6, 0xc9, 0xc4, 0x62, 0x61, 0xfb, 0xc0, 0xdf, 0xdb, 0xb3, 0x2e, 0xf2,
+	0x9b, 0x84, 0xd2, 0x90, 0x68, 0x90, 0x01, 0x46, 0xbc, 0x2e, 0xa9, 0x86, 0xac, 0x92, 0x1d, 0xdb,
+	0x15, 0xa9, 0xee, 0x4e, 0x16, 0x2b, 0x35, 0x46, 0x29, 0xa2, 0xfa, 0x93, 0x2c, 0x28, 0x6e, 0xaa,
+	0xc2, 0xfc, 0xb9, 0x01, 0xca, 0xd8, 0xf3, 0x7c, 0x86, 0x99, 0xe3, 0x7b, 0xbc, 0xcf, 0xe4, 0x31,
+	0xda, 0x1a, 0xc3, 0x0a, 0x05, 0x6d, 0x2e, 0x6b, 0xd8, 0x38, 0x48, 0x2f, 0x49, 0xa7, 0x97, 0x53,
+	0x33, 0x28, 0xcd, 0x0e, 0x1f, 0x81, 0xc9, 0x2e, 0xde, 0x25, 0x5d, 0xd5, 0x27, 0xdc, 0x3d, 0x0f,
+	0x3b, 0x36, 0x04, 0x62, 0x6c, 0x42, 0xd2, 0x77, 0xc5, 0x42, 0x24, 0xe9, 0xaa, 0xef, 0x80, 0x0b,
+	0xc3, 0xe6, 0x8e, 0x08, 0xdb, 0xcb, 0xe9, 0xb0, 0x2d, 0xa5, 0xe2, 0xad, 0x7a, 0x13, 0x94, 0x53,
+	0x34, 0xaf, 0xb3, 0xb4, 0xde, 0x05, 0x33, 0x83, 0xad, 0x39, 0xef, 0x8a, 0x79, 0x91, 0x20, 0xe1,
+	0x0e, 0xda, 0x90, 0xf1, 0xaa, 0x93, 0x91, 0x9a, 0x40, 0x5a, 0x87, 0x27, 0x23, 0x1a, 0x89, 0x56,
+	0xa2, 0x92, 0x19, 0x4c, 0x46, 0xcd, 0x58, 0x8c, 0xd4, 0x7c, 0xfd, 0xb7, 0x4c, 0x4c, 0x97, 0xba,
+	0x18, 0x7f, 0x22, 0x5d, 0xf2, 0x04, 0xc8, 0x9e, 0xf8, 0x04, 0x38, 0x76, 0xef, 0x72, 0x6f, 0xf8,
+	0xde, 0xe5, 0xdf, 0xd4, 0xbd, 0xfb, 0x26, 0x0b, 0x80, 0xce, 0x56, 0xb0, 0x0a, 0x32, 0x4e, 0x5b,
+	0xfa, 0x1b, 0x48, 0xc7, 0x64, 0xd6, 0x57, 0x51, 0xc6, 0x69, 0x27, 0x6e, 0xcb, 0x9c, 0xe8, 0xb6,
+	0xff, 0x82, 0x72, 0xdb, 0xa1, 0x41, 0x17, 0xf7, 0xb6, 0xb4, 0x7f, 0x93, 0x0b, 0xb6, 0xaa, 0xa7,
+	0x50, 0x5a, 0x4f, 0x57, 0xd1, 0xdc, 0x69, 0xab, 0xe8, 0xfd, 0x74, 0x15, 0x8d, 0x7d, 0xd5, 0x38,
+	0x5d, 0x15, 0xdd, 0x74, 0xec, 0xd0, 0x7f, 0xbd, 0x52, 0x3a, 0xf9, 0x8a, 0x52, 0x6a, 0x03, 0x10,
+	0x05, 0x6d, 0xcc, 0x08, 0x87, 0xad, 0x14, 0xce, 0x66, 0x4d, 0xf2, 0xe6, 0xdb, 0x49, 0xa0, 0x50,
+	0x0a, 0xb6, 0xfe, 0xbd, 0x01, 0x54, 0xb7, 0x06, 0x57, 0x41, 0x3e, 0xf0, 0x43, 0xa6, 0xf2, 0xe2,
+	0x5c, 0x8a, 0xcb, 0xb4, 0xfd, 0x90, 0x70, 0x64, 0xa9, 0xcb, 0x9b, 0x23, 0x5d, 0x87, 0xf9, 0x88,
+	0xa2, 0x78, 0x31, 0xbf, 0x61, 0x76, 0x37, 0xa2, 0x8c, 0x84, 0xeb, 0xdb, 0xc3, 0xcf, 0xdc, 0x15,
+	0x35, 0x81, 0xb4, 0x0e, 0x7c, 0x7b, 0xe0, 0x19, 0xf8, 0x47, 0xac, 0xe2, 0x7d, 0x57, 0x1c, 0x7a,
+	0xdb, 0x7d, 0x99, 0x03, 0xe9, 0xe6, 0x0f, 0x5e, 0x01, 0xb9, 0x7d, 0xc6, 0x02, 0xb1, 0x89, 0x52,
+	0xac, 0xcd, 0x8b, 0x23, 0x12, 0x52, 0x78, 0x20, 0x3f, 0x31, 0xc4, 0x29, 0x77, 0xdc, 0x4f, 0x0c,
+	0x9a, 0x37, 0x15, 0xb7, 0xfa, 0x33, 0xc3, 0x13, 0x03, 0xcc, 0x72, 0xd6, 0x94, 0x79, 0x95, 0xec,
+	0xd8, 0xc4, 0x83, 0xa5, 0xde, 0xfa, 0xbb, 0x24, 0x9e, 0x1d, 0x94, 0x53, 0x34, 0x4c, 0xfd, 0x17,
+	0xca, 0x3e, 0x5f, 0xe7, 0x41, 0xf2, 0xba, 0xe3, 0xcf, 0xc8, 0xa1, 0xc7, 0xfe, 0xca, 0x39, 0x54,
+	0x5a, 0xfd, 0x8c, 0x54, 0x92, 0xd4, 0x17, 0x80, 0x3b, 0x00, 0xca, 0x97, 0xcf, 0xb2, 0x6d, 0xfb,
+	0x91, 0xc7, 0xb6, 0x74, 0x82, 0x53, 0x69, 0x08, 0x36, 0x8f, 0x69, 0xa0, 0x11, 0xab, 0xe0, 0x1d,
+	0x50, 0xb2, 0x7d, 0x8f, 0x61, 0x7e, 0xd5, 0xe5, 0x1d, 0xb9, 0x3a, 0xea, 0x8e, 0xac, 0x28, 0xa5,
+	0xb8, 0x55, 0x4d, 0x86, 0x48, 0x2f, 0x87, 0x6b, 0xa0, 0x70, 0xe8, 0x77, 0x23, 0x37, 0xe9, 0xcf,
+	0xab, 0xa3, 0x90, 0xde, 0x17, 0x2a, 0x3a, 0x33, 0xc5, 0x63, 0x8a, 0xd4, 0x5a, 0x48, 0xc0, 0x2c,
+	0x25, 0x76, 0x14, 0x3a, 0xac, 0xc7, 0x69, 0xc8, 0x63, 0x95, 0x2c, 0xff, 0x39, 0x0a, 0x6e, 0xdb,
+	0x6f, 0x37, 0x07, 0xb5, 0xad, 0x4b, 0x3c, 0x5e, 0x87, 0x84, 0x68, 0x18, 0x13, 0xde, 0x02, 0x45,
+	0xbc, 0xb7, 0xe7, 0x78, 0x0e, 0xeb, 0x89, 0x64, 0x59, 0x5e, 0xbc, 0x32, 0x0a, 0x7f, 0x59, 0xea,
+	0x58, 0x53, 0xfc, 0x34, 0xd4, 0x08, 0x25, 0x6b, 0xe1, 0x0e, 0x28, 0x33, 0xbf, 0x4b, 0x42, 0xd9,
+	0xf5, 0x15, 0xc4, 0xce, 0x6b, 0xa3, 0xa0, 0x5a, 0x89, 0x9a, 0x2e, 0x2f, 0x5a, 0x46, 0x51, 0x1a,
+	0x07, 0xde, 0x94, 0x79, 0xab, 0x28, 0x4c, 0xfb, 0xc7, 0x49, 0x5b, 0x1f, 0x91, 0xb3, 0x2c, 0xf3,
+	0xe9, 0x8b, 0xda, 0xc4, 0xb3, 0x17, 0xb5, 0x89, 0xe7, 0x2f, 0x6a, 0x13, 0x9f, 0xf6, 0x6b, 0xc6,
+	0xd3, 0x7e, 0xcd, 0x78, 0xd6, 0xaf, 0x19, 0xcf, 0xfb, 0x35, 0xe3, 0xe7, 0x7e, 0xcd, 0xf8, 0xe2,
+	0x97, 0xda, 0xc4, 0x87, 0x45, 0x15, 0x74, 0xbf, 0x0f, 0x00, 0x99, 0x73, 0x5d, 0x65, 0xe1, 0x16,
+	0x00, 0x00,
+}
+
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.File)
+	copy(dAtA[i:], m.File)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.File)))
+	i--
+	dAtA[i] = 0x12
+	if m.NATS != nil {
+		{
+			size, err := m.NATS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *EventSourceRef) Marshal() (dAtA []byte, err error) {
@@ -699,6 +846,11 @@ func (m *GatewaySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.EventBusName)
+	copy(dAtA[i:], m.EventBusName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventBusName)))
+	i--
+	dAtA[i] = 0x42
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Replica))
 	i--
 	dAtA[i] = 0x38
@@ -845,6 +997,58 @@ func (m *GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPSubscriber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPSubscriber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPSubscriber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadLetter != nil {
+		{
+			size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -916,6 +1120,39 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NATSDeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NATSDeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NATSDeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ServerURL)
+	copy(dAtA[i:], m.ServerURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NATSSubscriber) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -936,6 +1173,30 @@ func (m *NATSSubscriber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadLetter != nil {
+		{
+			size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -1096,6 +1357,44 @@ func (m *Subscribers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadLetter != nil {
+		{
+			size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HTTPSubscribers) > 0 {
+		for iNdEx := len(m.HTTPSubscribers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HTTPSubscribers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.NATS) > 0 {
 		for iNdEx := len(m.NATS) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1247,6 +1546,23 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *DeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NATS != nil {
+		l = m.NATS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.File)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *EventSourceRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1334,6 +1650,8 @@ func (m *GatewaySpec) Size() (n int) {
 	l = len(m.ProcessorPort)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 1 + sovGenerated(uint64(m.Replica))
+	l = len(m.EventBusName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1365,6 +1683,25 @@ func (m *GatewayStatus) Size() (n int) {
 	return n
 }
 
+func (m *HTTPSubscriber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DeadLetter != nil {
+		l = m.DeadLetter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1390,7 +1727,20 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
-func (m *NATSSubscriber) Size() (n int) {
+func (m *NATSDeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NATSSubscriber) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1402,6 +1752,14 @@ func (m *NATSSubscriber) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DeadLetter != nil {
+		l = m.DeadLetter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1467,6 +1825,20 @@ func (m *Subscribers) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.HTTPSubscribers) > 0 {
+		for _, e := range m.HTTPSubscribers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DeadLetter != nil {
+		l = m.DeadLetter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1517,6 +1889,18 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *DeadLetter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadLetter{`,
+		`NATS:` + strings.Replace(this.NATS.String(), "NATSDeadLetter", "NATSDeadLetter", 1) + `,`,
+		`File:` + fmt.Sprintf("%v", this.File) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventSourceRef) String() string {
 	if this == nil {
 		return "nil"
@@ -1579,6 +1963,7 @@ func (this *GatewaySpec) String() string {
 		`Subscribers:` + strings.Replace(this.Subscribers.String(), "Subscribers", "Subscribers", 1) + `,`,
 		`ProcessorPort:` + fmt.Sprintf("%v", this.ProcessorPort) + `,`,
 		`Replica:` + fmt.Sprintf("%v", this.Replica) + `,`,
+		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1607,6 +1992,18 @@ func (this *GatewayStatus) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPSubscriber) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPSubscriber{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`DeadLetter:` + strings.Replace(this.DeadLetter.String(), "DeadLetter", "DeadLetter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Metadata) String() string {
 	if this == nil {
 		return "nil"
@@ -1638,6 +2035,17 @@ func (this *Metadata) String() string {
 	}, "")
 	return s
 }
+func (this *NATSDeadLetter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NATSDeadLetter{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NATSSubscriber) String() string {
 	if this == nil {
 		return "nil"
@@ -1646,6 +2054,8 @@ func (this *NATSSubscriber) String() string {
 		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
 		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`DeadLetter:` + strings.Replace(this.DeadLetter.String(), "DeadLetter", "DeadLetter", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1692,9 +2102,17 @@ func (this *Subscribers) String() string {
 		repeatedStringForNATS += strings.Replace(strings.Replace(f.String(), "NATSSubscriber", "NATSSubscriber", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForNATS += "}"
+	repeatedStringForHTTPSubscribers := "[]HTTPSubscriber{"
+	for _, f := range this.HTTPSubscribers {
+		repeatedStringForHTTPSubscribers += strings.Replace(strings.Replace(f.String(), "HTTPSubscriber", "HTTPSubscriber", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHTTPSubscribers += "}"
 	s := strings.Join([]string{`&Subscribers{`,
 		`HTTP:` + fmt.Sprintf("%v", this.HTTP) + `,`,
 		`NATS:` + repeatedStringForNATS + `,`,
+		`HTTPSubscribers:` + repeatedStringForHTTPSubscribers + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`DeadLetter:` + strings.Replace(this.DeadLetter.String(), "DeadLetter", "DeadLetter", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1734,6 +2152,159 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NATS == nil {
+				m.NATS = &NATSDeadLetter{}
+			}
+			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventSourceRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2501,6 +3072,38 @@ func (m *GatewaySpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBusName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2840,7 +3443,7 @@ func (m *GatewayStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *HTTPSubscriber) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2863,15 +3466,172 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPSubscriber: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPSubscriber: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetter == nil {
+				m.DeadLetter = &DeadLetter{}
+			}
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3147,6 +3907,123 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NATSDeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSDeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSDeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NATSSubscriber) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3272,6 +4149,78 @@ func (m *NATSSubscriber) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetter == nil {
+				m.DeadLetter = &DeadLetter{}
+			}
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3825,6 +4774,112 @@ func (m *Subscribers) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPSubscribers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTTPSubscribers = append(m.HTTPSubscribers, HTTPSubscriber{})
+			if err := m.HTTPSubscribers[len(m.HTTPSubscribers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetter == nil {
+				m.DeadLetter = &DeadLetter{}
+			}
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
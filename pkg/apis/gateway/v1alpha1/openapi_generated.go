@@ -29,13 +29,16 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter":      schema_pkg_apis_gateway_v1alpha1_DeadLetter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.EventSourceRef":  schema_pkg_apis_gateway_v1alpha1_EventSourceRef(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Gateway":         schema_pkg_apis_gateway_v1alpha1_Gateway(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayList":     schema_pkg_apis_gateway_v1alpha1_GatewayList(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayResource": schema_pkg_apis_gateway_v1alpha1_GatewayResource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewaySpec":     schema_pkg_apis_gateway_v1alpha1_GatewaySpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.GatewayStatus":   schema_pkg_apis_gateway_v1alpha1_GatewayStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.HTTPSubscriber":  schema_pkg_apis_gateway_v1alpha1_HTTPSubscriber(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Metadata":        schema_pkg_apis_gateway_v1alpha1_Metadata(ref),
+		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NATSDeadLetter":  schema_pkg_apis_gateway_v1alpha1_NATSDeadLetter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NATSSubscriber":  schema_pkg_apis_gateway_v1alpha1_NATSSubscriber(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NodeStatus":      schema_pkg_apis_gateway_v1alpha1_NodeStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.Service":         schema_pkg_apis_gateway_v1alpha1_Service(ref),
@@ -44,6 +47,41 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_gateway_v1alpha1_DeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetter is the target where the events that couldn't be delivered to a subscriber are written, along with the failure metadata as CloudEvent extensions, for later replay. Exactly one target must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NATS publishes the undeliverable events on a NATS subject.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NATSDeadLetter"),
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File appends the undeliverable events, one JSON per line, to the file at the given path, e.g. on a persistent volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTP endpoint to post the undeliverable events to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NATSDeadLetter"},
+	}
+}
+
 func schema_pkg_apis_gateway_v1alpha1_EventSourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -242,6 +280,13 @@ func schema_pkg_apis_gateway_v1alpha1_GatewaySpec(ref common.ReferenceCallback)
 							Format:      "int32",
 						},
 					},
+					"eventBusName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusName references to a EventBus name. By default the value is \"default\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"type", "processorPort"},
 			},
@@ -307,6 +352,41 @@ func schema_pkg_apis_gateway_v1alpha1_GatewayStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_gateway_v1alpha1_HTTPSubscriber(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPSubscriber holds the context of subscriber over HTTP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the HTTP endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy to send the event to the subscriber. Requests are retried on errors and 408, 429 and 5xx responses, other non 2xx responses are not retried.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter is the target for the events that couldn't be sent to the subscriber.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter"),
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter"},
+	}
+}
+
 func schema_pkg_apis_gateway_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -348,6 +428,34 @@ func schema_pkg_apis_gateway_v1alpha1_Metadata(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_gateway_v1alpha1_NATSDeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSDeadLetter refers to a NATS subject to publish undeliverable events on.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerURL refers to the NATS server URL.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject refers to the NATS subject name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"serverURL", "subject"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_gateway_v1alpha1_NATSSubscriber(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -376,10 +484,24 @@ func schema_pkg_apis_gateway_v1alpha1_NATSSubscriber(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy to publish the event to the subscriber.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter is the target for the events that couldn't be published to the subscriber.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter"),
+						},
+					},
 				},
 				Required: []string{"serverURL", "subject", "name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter"},
 	}
 }
 
@@ -500,7 +622,8 @@ func schema_pkg_apis_gateway_v1alpha1_Subscribers(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"http": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "HTTP subscribers are HTTP endpoints to send events to. Deprecated: use HTTPSubscribers instead, the URLs are sent the events as HTTP subscribers with the default retry strategy and dead-letter target.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -523,11 +646,36 @@ func schema_pkg_apis_gateway_v1alpha1_Subscribers(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"httpSubscribers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPSubscribers are HTTP endpoints to send events to, with their own retry strategy and dead-letter target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.HTTPSubscriber"),
+									},
+								},
+							},
+						},
+					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy is the default retry strategy for the subscribers that don't define their own. If not set, the event is sent only once. Every subscriber is sent the events in order, independently of the others, with up to 100 events pending; the events beyond are written to the dead-letter target.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter is the default dead-letter target for the subscribers that don't define their own.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NATSSubscriber"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.DeadLetter", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.HTTPSubscriber", "github.com/argoproj/argo-events/pkg/apis/gateway/v1alpha1.NATSSubscriber"},
 	}
 }
 
@@ -75,6 +75,9 @@ type GatewaySpec struct {
 	ProcessorPort string `json:"processorPort" protobuf:"bytes,6,opt,name=processorPort"`
 	// Replica is the gateway deployment replicas
 	Replica int32 `json:"replica,omitempty" protobuf:"varint,7,opt,name=replica"`
+	// EventBusName references to a EventBus name. By default the value is "default"
+	// +optional
+	EventBusName string `json:"eventBusName,omitempty" protobuf:"bytes,8,opt,name=eventBusName"`
 }
 
 // Template holds the information of a Gateway deployment template
@@ -139,13 +142,50 @@ type Service struct {
 
 type Subscribers struct {
 	// HTTP subscribers are HTTP endpoints to send events to.
-
+	// Deprecated: use HTTPSubscribers instead, the URLs are sent the events as HTTP subscribers with the default
+	// retry strategy and dead-letter target.
 	// +optional
 	HTTP []string `json:"http,omitempty" protobuf:"bytes,1,rep,name=http"`
 	// NATS refers to the subscribers over NATS protocol.
 
 	// +optional
 	NATS []NATSSubscriber `json:"nats,omitempty" protobuf:"bytes,2,rep,name=nats"`
+	// HTTPSubscribers are HTTP endpoints to send events to, with their own retry strategy and dead-letter target.
+	// +optional
+	HTTPSubscribers []HTTPSubscriber `json:"httpSubscribers,omitempty" protobuf:"bytes,3,rep,name=httpSubscribers"`
+	// RetryStrategy is the default retry strategy for the subscribers that don't define their own.
+	// If not set, the event is sent only once. Every subscriber is sent the events in order, independently of the
+	// others, with up to 100 events pending; the events beyond are written to the dead-letter target.
+	// +optional
+	RetryStrategy *apicommon.Backoff `json:"retryStrategy,omitempty" protobuf:"bytes,4,opt,name=retryStrategy"`
+	// DeadLetter is the default dead-letter target for the subscribers that don't define their own.
+	// +optional
+	DeadLetter *DeadLetter `json:"deadLetter,omitempty" protobuf:"bytes,5,opt,name=deadLetter"`
+}
+
+// GetHTTPSubscribers returns the HTTP subscribers, including those of the deprecated HTTP URLs
+func (s *Subscribers) GetHTTPSubscribers() []HTTPSubscriber {
+	if len(s.HTTP) == 0 {
+		return s.HTTPSubscribers
+	}
+	subscribers := make([]HTTPSubscriber, 0, len(s.HTTP)+len(s.HTTPSubscribers))
+	for _, url := range s.HTTP {
+		subscribers = append(subscribers, HTTPSubscriber{URL: url})
+	}
+	return append(subscribers, s.HTTPSubscribers...)
+}
+
+// HTTPSubscriber holds the context of subscriber over HTTP.
+type HTTPSubscriber struct {
+	// URL of the HTTP endpoint.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// RetryStrategy to send the event to the subscriber. Requests are retried on errors
+	// and 408, 429 and 5xx responses, other non 2xx responses are not retried.
+	// +optional
+	RetryStrategy *apicommon.Backoff `json:"retryStrategy,omitempty" protobuf:"bytes,2,opt,name=retryStrategy"`
+	// DeadLetter is the target for the events that couldn't be sent to the subscriber.
+	// +optional
+	DeadLetter *DeadLetter `json:"deadLetter,omitempty" protobuf:"bytes,3,opt,name=deadLetter"`
 }
 
 // NATSSubscriber holds the context of subscriber over NATS.
@@ -156,6 +196,34 @@ type NATSSubscriber struct {
 	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
 	// Name of the subscription. Must be unique.
 	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// RetryStrategy to publish the event to the subscriber.
+	// +optional
+	RetryStrategy *apicommon.Backoff `json:"retryStrategy,omitempty" protobuf:"bytes,4,opt,name=retryStrategy"`
+	// DeadLetter is the target for the events that couldn't be published to the subscriber.
+	// +optional
+	DeadLetter *DeadLetter `json:"deadLetter,omitempty" protobuf:"bytes,5,opt,name=deadLetter"`
+}
+
+// DeadLetter is the target where the events that couldn't be delivered to a subscriber are written,
+// along with the failure metadata as CloudEvent extensions, for later replay. Exactly one target must be set.
+type DeadLetter struct {
+	// NATS publishes the undeliverable events on a NATS subject.
+	// +optional
+	NATS *NATSDeadLetter `json:"nats,omitempty" protobuf:"bytes,1,opt,name=nats"`
+	// File appends the undeliverable events, one JSON per line, to the file at the given path, e.g. on a persistent volume.
+	// +optional
+	File string `json:"file,omitempty" protobuf:"bytes,2,opt,name=file"`
+	// URL is the HTTP endpoint to post the undeliverable events to.
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,3,opt,name=url"`
+}
+
+// NATSDeadLetter refers to a NATS subject to publish undeliverable events on.
+type NATSDeadLetter struct {
+	// ServerURL refers to the NATS server URL.
+	ServerURL string `json:"serverURL" protobuf:"bytes,1,opt,name=serverURL"`
+	// Subject refers to the NATS subject name.
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
 }
 
 // EventSourceRef holds information about the EventSourceRef custom resource
@@ -21,11 +21,33 @@ limitations under the License.
 package v1alpha1
 
 import (
+	common "github.com/argoproj/argo-events/pkg/apis/common"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetter) DeepCopyInto(out *DeadLetter) {
+	*out = *in
+	if in.NATS != nil {
+		in, out := &in.NATS, &out.NATS
+		*out = new(NATSDeadLetter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetter.
+func (in *DeadLetter) DeepCopy() *DeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventSourceRef) DeepCopyInto(out *EventSourceRef) {
 	*out = *in
@@ -190,6 +212,32 @@ func (in *GatewayStatus) DeepCopy() *GatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSubscriber) DeepCopyInto(out *HTTPSubscriber) {
+	*out = *in
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSubscriber.
+func (in *HTTPSubscriber) DeepCopy() *HTTPSubscriber {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSubscriber)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -220,9 +268,35 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSDeadLetter) DeepCopyInto(out *NATSDeadLetter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSDeadLetter.
+func (in *NATSDeadLetter) DeepCopy() *NATSDeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSDeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATSSubscriber) DeepCopyInto(out *NATSSubscriber) {
 	*out = *in
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -291,7 +365,26 @@ func (in *Subscribers) DeepCopyInto(out *Subscribers) {
 	if in.NATS != nil {
 		in, out := &in.NATS, &out.NATS
 		*out = make([]NATSSubscriber, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPSubscribers != nil {
+		in, out := &in.HTTPSubscribers, &out.HTTPSubscribers
+		*out = make([]HTTPSubscriber, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetter)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
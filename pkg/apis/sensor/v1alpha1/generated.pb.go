@@ -28,6 +28,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	v1 "k8s.io/api/core/v1"
+	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
@@ -161,10 +162,38 @@ func (m *BasicAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 
+func (m *CompletionPolicy) Reset()      { *m = CompletionPolicy{} }
+func (*CompletionPolicy) ProtoMessage() {}
+func (*CompletionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{4}
+}
+func (m *CompletionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CompletionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionPolicy.Merge(m, src)
+}
+func (m *CompletionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionPolicy proto.InternalMessageInfo
+
 func (m *ConfigmapArtifact) Reset()      { *m = ConfigmapArtifact{} }
 func (*ConfigmapArtifact) ProtoMessage() {}
 func (*ConfigmapArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{4}
+	return fileDescriptor_6c4bded897df1f16, []int{5}
 }
 func (m *ConfigmapArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +221,7 @@ var xxx_messageInfo_ConfigmapArtifact proto.InternalMessageInfo
 func (m *CustomTrigger) Reset()      { *m = CustomTrigger{} }
 func (*CustomTrigger) ProtoMessage() {}
 func (*CustomTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{5}
+	return fileDescriptor_6c4bded897df1f16, []int{6}
 }
 func (m *CustomTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +249,7 @@ var xxx_messageInfo_CustomTrigger proto.InternalMessageInfo
 func (m *DataFilter) Reset()      { *m = DataFilter{} }
 func (*DataFilter) ProtoMessage() {}
 func (*DataFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{6}
+	return fileDescriptor_6c4bded897df1f16, []int{7}
 }
 func (m *DataFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +277,7 @@ var xxx_messageInfo_DataFilter proto.InternalMessageInfo
 func (m *DependencyGroup) Reset()      { *m = DependencyGroup{} }
 func (*DependencyGroup) ProtoMessage() {}
 func (*DependencyGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{7}
+	return fileDescriptor_6c4bded897df1f16, []int{8}
 }
 func (m *DependencyGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +305,7 @@ var xxx_messageInfo_DependencyGroup proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{8}
+	return fileDescriptor_6c4bded897df1f16, []int{9}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +333,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *EventContext) Reset()      { *m = EventContext{} }
 func (*EventContext) ProtoMessage() {}
 func (*EventContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{9}
+	return fileDescriptor_6c4bded897df1f16, []int{10}
 }
 func (m *EventContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,7 +361,7 @@ var xxx_messageInfo_EventContext proto.InternalMessageInfo
 func (m *EventDependency) Reset()      { *m = EventDependency{} }
 func (*EventDependency) ProtoMessage() {}
 func (*EventDependency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{10}
+	return fileDescriptor_6c4bded897df1f16, []int{11}
 }
 func (m *EventDependency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +389,7 @@ var xxx_messageInfo_EventDependency proto.InternalMessageInfo
 func (m *EventDependencyFilter) Reset()      { *m = EventDependencyFilter{} }
 func (*EventDependencyFilter) ProtoMessage() {}
 func (*EventDependencyFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{11}
+	return fileDescriptor_6c4bded897df1f16, []int{12}
 }
 func (m *EventDependencyFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +417,7 @@ var xxx_messageInfo_EventDependencyFilter proto.InternalMessageInfo
 func (m *FileArtifact) Reset()      { *m = FileArtifact{} }
 func (*FileArtifact) ProtoMessage() {}
 func (*FileArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{12}
+	return fileDescriptor_6c4bded897df1f16, []int{13}
 }
 func (m *FileArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +445,7 @@ var xxx_messageInfo_FileArtifact proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{13}
+	return fileDescriptor_6c4bded897df1f16, []int{14}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +473,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *GitCreds) Reset()      { *m = GitCreds{} }
 func (*GitCreds) ProtoMessage() {}
 func (*GitCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{14}
+	return fileDescriptor_6c4bded897df1f16, []int{15}
 }
 func (m *GitCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +501,7 @@ var xxx_messageInfo_GitCreds proto.InternalMessageInfo
 func (m *GitRemoteConfig) Reset()      { *m = GitRemoteConfig{} }
 func (*GitRemoteConfig) ProtoMessage() {}
 func (*GitRemoteConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{15}
+	return fileDescriptor_6c4bded897df1f16, []int{16}
 }
 func (m *GitRemoteConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +529,7 @@ var xxx_messageInfo_GitRemoteConfig proto.InternalMessageInfo
 func (m *HTTPSubscription) Reset()      { *m = HTTPSubscription{} }
 func (*HTTPSubscription) ProtoMessage() {}
 func (*HTTPSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{16}
+	return fileDescriptor_6c4bded897df1f16, []int{17}
 }
 func (m *HTTPSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +557,7 @@ var xxx_messageInfo_HTTPSubscription proto.InternalMessageInfo
 func (m *HTTPTrigger) Reset()      { *m = HTTPTrigger{} }
 func (*HTTPTrigger) ProtoMessage() {}
 func (*HTTPTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{17}
+	return fileDescriptor_6c4bded897df1f16, []int{18}
 }
 func (m *HTTPTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,10 +582,66 @@ func (m *HTTPTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPTrigger proto.InternalMessageInfo
 
+func (m *K8SApplyOptions) Reset()      { *m = K8SApplyOptions{} }
+func (*K8SApplyOptions) ProtoMessage() {}
+func (*K8SApplyOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{19}
+}
+func (m *K8SApplyOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *K8SApplyOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *K8SApplyOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_K8SApplyOptions.Merge(m, src)
+}
+func (m *K8SApplyOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *K8SApplyOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_K8SApplyOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_K8SApplyOptions proto.InternalMessageInfo
+
+func (m *K8SDeleteOptions) Reset()      { *m = K8SDeleteOptions{} }
+func (*K8SDeleteOptions) ProtoMessage() {}
+func (*K8SDeleteOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{20}
+}
+func (m *K8SDeleteOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *K8SDeleteOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *K8SDeleteOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_K8SDeleteOptions.Merge(m, src)
+}
+func (m *K8SDeleteOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *K8SDeleteOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_K8SDeleteOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_K8SDeleteOptions proto.InternalMessageInfo
+
 func (m *K8SResourcePolicy) Reset()      { *m = K8SResourcePolicy{} }
 func (*K8SResourcePolicy) ProtoMessage() {}
 func (*K8SResourcePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{18}
+	return fileDescriptor_6c4bded897df1f16, []int{21}
 }
 func (m *K8SResourcePolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +669,7 @@ var xxx_messageInfo_K8SResourcePolicy proto.InternalMessageInfo
 func (m *KafkaTrigger) Reset()      { *m = KafkaTrigger{} }
 func (*KafkaTrigger) ProtoMessage() {}
 func (*KafkaTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{19}
+	return fileDescriptor_6c4bded897df1f16, []int{22}
 }
 func (m *KafkaTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +697,7 @@ var xxx_messageInfo_KafkaTrigger proto.InternalMessageInfo
 func (m *NATSSubscription) Reset()      { *m = NATSSubscription{} }
 func (*NATSSubscription) ProtoMessage() {}
 func (*NATSSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{20}
+	return fileDescriptor_6c4bded897df1f16, []int{23}
 }
 func (m *NATSSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +725,7 @@ var xxx_messageInfo_NATSSubscription proto.InternalMessageInfo
 func (m *NATSTrigger) Reset()      { *m = NATSTrigger{} }
 func (*NATSTrigger) ProtoMessage() {}
 func (*NATSTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{21}
+	return fileDescriptor_6c4bded897df1f16, []int{24}
 }
 func (m *NATSTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +753,7 @@ var xxx_messageInfo_NATSTrigger proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{22}
+	return fileDescriptor_6c4bded897df1f16, []int{25}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +781,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *OpenWhiskTrigger) Reset()      { *m = OpenWhiskTrigger{} }
 func (*OpenWhiskTrigger) ProtoMessage() {}
 func (*OpenWhiskTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{23}
+	return fileDescriptor_6c4bded897df1f16, []int{26}
 }
 func (m *OpenWhiskTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,10 +806,38 @@ func (m *OpenWhiskTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenWhiskTrigger proto.InternalMessageInfo
 
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{27}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
 func (m *Sensor) Reset()      { *m = Sensor{} }
 func (*Sensor) ProtoMessage() {}
 func (*Sensor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{24}
+	return fileDescriptor_6c4bded897df1f16, []int{28}
 }
 func (m *Sensor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +865,7 @@ var xxx_messageInfo_Sensor proto.InternalMessageInfo
 func (m *SensorList) Reset()      { *m = SensorList{} }
 func (*SensorList) ProtoMessage() {}
 func (*SensorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{25}
+	return fileDescriptor_6c4bded897df1f16, []int{29}
 }
 func (m *SensorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +893,7 @@ var xxx_messageInfo_SensorList proto.InternalMessageInfo
 func (m *SensorResources) Reset()      { *m = SensorResources{} }
 func (*SensorResources) ProtoMessage() {}
 func (*SensorResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{26}
+	return fileDescriptor_6c4bded897df1f16, []int{30}
 }
 func (m *SensorResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,7 +921,7 @@ var xxx_messageInfo_SensorResources proto.InternalMessageInfo
 func (m *SensorSpec) Reset()      { *m = SensorSpec{} }
 func (*SensorSpec) ProtoMessage() {}
 func (*SensorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{27}
+	return fileDescriptor_6c4bded897df1f16, []int{31}
 }
 func (m *SensorSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +949,7 @@ var xxx_messageInfo_SensorSpec proto.InternalMessageInfo
 func (m *SensorStatus) Reset()      { *m = SensorStatus{} }
 func (*SensorStatus) ProtoMessage() {}
 func (*SensorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{28}
+	return fileDescriptor_6c4bded897df1f16, []int{32}
 }
 func (m *SensorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +977,7 @@ var xxx_messageInfo_SensorStatus proto.InternalMessageInfo
 func (m *SlackTrigger) Reset()      { *m = SlackTrigger{} }
 func (*SlackTrigger) ProtoMessage() {}
 func (*SlackTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{29}
+	return fileDescriptor_6c4bded897df1f16, []int{33}
 }
 func (m *SlackTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +1005,7 @@ var xxx_messageInfo_SlackTrigger proto.InternalMessageInfo
 func (m *StandardK8STrigger) Reset()      { *m = StandardK8STrigger{} }
 func (*StandardK8STrigger) ProtoMessage() {}
 func (*StandardK8STrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{30}
+	return fileDescriptor_6c4bded897df1f16, []int{34}
 }
 func (m *StandardK8STrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,7 +1033,7 @@ var xxx_messageInfo_StandardK8STrigger proto.InternalMessageInfo
 func (m *StatusPolicy) Reset()      { *m = StatusPolicy{} }
 func (*StatusPolicy) ProtoMessage() {}
 func (*StatusPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{31}
+	return fileDescriptor_6c4bded897df1f16, []int{35}
 }
 func (m *StatusPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,7 +1061,7 @@ var xxx_messageInfo_StatusPolicy proto.InternalMessageInfo
 func (m *Subscription) Reset()      { *m = Subscription{} }
 func (*Subscription) ProtoMessage() {}
 func (*Subscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{32}
+	return fileDescriptor_6c4bded897df1f16, []int{36}
 }
 func (m *Subscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -976,7 +1089,7 @@ var xxx_messageInfo_Subscription proto.InternalMessageInfo
 func (m *TLSConfig) Reset()      { *m = TLSConfig{} }
 func (*TLSConfig) ProtoMessage() {}
 func (*TLSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{33}
+	return fileDescriptor_6c4bded897df1f16, []int{37}
 }
 func (m *TLSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1117,7 @@ var xxx_messageInfo_TLSConfig proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{34}
+	return fileDescriptor_6c4bded897df1f16, []int{38}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1145,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TimeFilter) Reset()      { *m = TimeFilter{} }
 func (*TimeFilter) ProtoMessage() {}
 func (*TimeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{35}
+	return fileDescriptor_6c4bded897df1f16, []int{39}
 }
 func (m *TimeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1173,7 @@ var xxx_messageInfo_TimeFilter proto.InternalMessageInfo
 func (m *Trigger) Reset()      { *m = Trigger{} }
 func (*Trigger) ProtoMessage() {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{36}
+	return fileDescriptor_6c4bded897df1f16, []int{40}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,10 +1198,38 @@ func (m *Trigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Trigger proto.InternalMessageInfo
 
+func (m *TriggerDependency) Reset()      { *m = TriggerDependency{} }
+func (*TriggerDependency) ProtoMessage() {}
+func (*TriggerDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{41}
+}
+func (m *TriggerDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TriggerDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDependency.Merge(m, src)
+}
+func (m *TriggerDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDependency proto.InternalMessageInfo
+
 func (m *TriggerParameter) Reset()      { *m = TriggerParameter{} }
 func (*TriggerParameter) ProtoMessage() {}
 func (*TriggerParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{37}
+	return fileDescriptor_6c4bded897df1f16, []int{42}
 }
 func (m *TriggerParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1257,7 @@ var xxx_messageInfo_TriggerParameter proto.InternalMessageInfo
 func (m *TriggerParameterSource) Reset()      { *m = TriggerParameterSource{} }
 func (*TriggerParameterSource) ProtoMessage() {}
 func (*TriggerParameterSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{38}
+	return fileDescriptor_6c4bded897df1f16, []int{43}
 }
 func (m *TriggerParameterSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,7 +1285,7 @@ var xxx_messageInfo_TriggerParameterSource proto.InternalMessageInfo
 func (m *TriggerPolicy) Reset()      { *m = TriggerPolicy{} }
 func (*TriggerPolicy) ProtoMessage() {}
 func (*TriggerPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{39}
+	return fileDescriptor_6c4bded897df1f16, []int{44}
 }
 func (m *TriggerPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1172,7 +1313,7 @@ var xxx_messageInfo_TriggerPolicy proto.InternalMessageInfo
 func (m *TriggerSwitch) Reset()      { *m = TriggerSwitch{} }
 func (*TriggerSwitch) ProtoMessage() {}
 func (*TriggerSwitch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{40}
+	return fileDescriptor_6c4bded897df1f16, []int{45}
 }
 func (m *TriggerSwitch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1200,7 +1341,7 @@ var xxx_messageInfo_TriggerSwitch proto.InternalMessageInfo
 func (m *TriggerTemplate) Reset()      { *m = TriggerTemplate{} }
 func (*TriggerTemplate) ProtoMessage() {}
 func (*TriggerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{41}
+	return fileDescriptor_6c4bded897df1f16, []int{46}
 }
 func (m *TriggerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1228,7 +1369,7 @@ var xxx_messageInfo_TriggerTemplate proto.InternalMessageInfo
 func (m *URLArtifact) Reset()      { *m = URLArtifact{} }
 func (*URLArtifact) ProtoMessage() {}
 func (*URLArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{42}
+	return fileDescriptor_6c4bded897df1f16, []int{47}
 }
 func (m *URLArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,11 +1394,40 @@ func (m *URLArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_URLArtifact proto.InternalMessageInfo
 
+func (m *WorkflowReference) Reset()      { *m = WorkflowReference{} }
+func (*WorkflowReference) ProtoMessage() {}
+func (*WorkflowReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{48}
+}
+func (m *WorkflowReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowReference.Merge(m, src)
+}
+func (m *WorkflowReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowReference proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AWSLambdaTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSLambdaTrigger")
 	proto.RegisterType((*ArgoWorkflowTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArgoWorkflowTrigger")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.BasicAuth")
+	proto.RegisterType((*CompletionPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CompletionPolicy")
 	proto.RegisterType((*ConfigmapArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ConfigmapArtifact")
 	proto.RegisterType((*CustomTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CustomTrigger")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CustomTrigger.SpecEntry")
@@ -1274,6 +1444,8 @@ func init() {
 	proto.RegisterType((*HTTPSubscription)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPSubscription")
 	proto.RegisterType((*HTTPTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPTrigger")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPTrigger.HeadersEntry")
+	proto.RegisterType((*K8SApplyOptions)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SApplyOptions")
+	proto.RegisterType((*K8SDeleteOptions)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SDeleteOptions")
 	proto.RegisterType((*K8SResourcePolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SResourcePolicy")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SResourcePolicy.LabelsEntry")
 	proto.RegisterType((*KafkaTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.KafkaTrigger")
@@ -1281,6 +1453,7 @@ func init() {
 	proto.RegisterType((*NATSTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.NATSTrigger")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.NodeStatus")
 	proto.RegisterType((*OpenWhiskTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.OpenWhiskTrigger")
+	proto.RegisterType((*RateLimit)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.RateLimit")
 	proto.RegisterType((*Sensor)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Sensor")
 	proto.RegisterType((*SensorList)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorList")
 	proto.RegisterType((*SensorResources)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorResources")
@@ -1297,12 +1470,14 @@ func init() {
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Template")
 	proto.RegisterType((*TimeFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TimeFilter")
 	proto.RegisterType((*Trigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Trigger")
+	proto.RegisterType((*TriggerDependency)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerDependency")
 	proto.RegisterType((*TriggerParameter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerParameter")
 	proto.RegisterType((*TriggerParameterSource)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerParameterSource")
 	proto.RegisterType((*TriggerPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerPolicy")
 	proto.RegisterType((*TriggerSwitch)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerSwitch")
 	proto.RegisterType((*TriggerTemplate)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerTemplate")
 	proto.RegisterType((*URLArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URLArtifact")
+	proto.RegisterType((*WorkflowReference)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.WorkflowReference")
 }
 
 func init() {
@@ -1310,259 +1485,309 @@ func init() {
 }
 
 var fileDescriptor_6c4bded897df1f16 = []byte{
-	// 4028 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x4d, 0x6f, 0x24, 0xc7,
-	0x75, 0x3b, 0x5f, 0xe4, 0xcc, 0xe3, 0xac, 0xc8, 0x2d, 0x69, 0x6d, 0x8a, 0x96, 0xc8, 0x45, 0x1b,
-	0x71, 0x64, 0xc3, 0x1e, 0x4a, 0x2b, 0x39, 0xa1, 0x64, 0x20, 0x16, 0x67, 0xc8, 0xfd, 0x22, 0x77,
-	0x49, 0x57, 0x73, 0xb5, 0x80, 0x63, 0xc4, 0xdb, 0xec, 0xa9, 0x99, 0x69, 0xb1, 0xa7, 0xbb, 0xd3,
-	0x55, 0xc3, 0xf5, 0x00, 0x89, 0x13, 0x40, 0xc8, 0x21, 0x48, 0x00, 0x27, 0x88, 0x7e, 0x44, 0x0e,
-	0x81, 0xef, 0x01, 0x02, 0x04, 0x08, 0x12, 0x44, 0x87, 0x1c, 0x9c, 0x43, 0x02, 0x9f, 0x88, 0x88,
-	0x3e, 0xe4, 0x90, 0x00, 0xb9, 0xe4, 0xb4, 0x17, 0x07, 0xf5, 0xd5, 0x5d, 0xdd, 0x33, 0xbb, 0x3b,
-	0xc3, 0x5e, 0x53, 0x01, 0x7c, 0x9b, 0x79, 0xef, 0xd5, 0x7b, 0x55, 0xaf, 0x5e, 0xbd, 0xaf, 0xaa,
-	0x86, 0x3b, 0x7d, 0x8f, 0x0d, 0x46, 0xc7, 0x2d, 0x37, 0x1c, 0x6e, 0x3a, 0x71, 0x3f, 0x8c, 0xe2,
-	0xf0, 0x63, 0xf1, 0xe3, 0x5b, 0xe4, 0x94, 0x04, 0x8c, 0x6e, 0x46, 0x27, 0xfd, 0x4d, 0x27, 0xf2,
-	0xe8, 0x26, 0x25, 0x01, 0x0d, 0xe3, 0xcd, 0xd3, 0x77, 0x1c, 0x3f, 0x1a, 0x38, 0xef, 0x6c, 0xf6,
-	0x49, 0x40, 0x62, 0x87, 0x91, 0x6e, 0x2b, 0x8a, 0x43, 0x16, 0xa2, 0xad, 0x94, 0x53, 0x4b, 0x73,
-	0x12, 0x3f, 0x7e, 0x28, 0x39, 0xb5, 0xa2, 0x93, 0x7e, 0x8b, 0x73, 0x6a, 0x49, 0x4e, 0x2d, 0xcd,
-	0x69, 0xed, 0xbb, 0x33, 0xcf, 0xc1, 0x0d, 0x87, 0xc3, 0x30, 0xc8, 0x8b, 0x5e, 0xfb, 0x96, 0xc1,
-	0xa0, 0x1f, 0xf6, 0xc3, 0x4d, 0x01, 0x3e, 0x1e, 0xf5, 0xc4, 0x3f, 0xf1, 0x47, 0xfc, 0x52, 0xe4,
-	0xd6, 0xc9, 0x16, 0x6d, 0x79, 0x21, 0x67, 0xb9, 0xe9, 0x86, 0x31, 0xd9, 0x3c, 0x9d, 0x58, 0xcd,
-	0xda, 0x7b, 0x29, 0xcd, 0xd0, 0x71, 0x07, 0x5e, 0x40, 0xe2, 0x71, 0x3a, 0x8f, 0x21, 0x61, 0xce,
-	0xb4, 0x51, 0x9b, 0xcf, 0x1a, 0x15, 0x8f, 0x02, 0xe6, 0x0d, 0xc9, 0xc4, 0x80, 0xdf, 0x7a, 0xd1,
-	0x00, 0xea, 0x0e, 0xc8, 0xd0, 0xc9, 0x8f, 0xb3, 0xfe, 0xb1, 0x0a, 0x2b, 0xdb, 0x8f, 0xec, 0x7d,
-	0x67, 0x78, 0xdc, 0x75, 0x8e, 0x62, 0xaf, 0xdf, 0x27, 0x31, 0xda, 0x82, 0x66, 0x6f, 0x14, 0xb8,
-	0xcc, 0x0b, 0x83, 0x07, 0xce, 0x90, 0xac, 0x96, 0x6e, 0x94, 0xde, 0x6a, 0xb4, 0x5f, 0xfb, 0xec,
-	0x6c, 0xe3, 0xca, 0xf9, 0xd9, 0x46, 0xf3, 0x96, 0x81, 0xc3, 0x19, 0x4a, 0x84, 0xa1, 0xe1, 0xb8,
-	0x2e, 0xa1, 0x74, 0x8f, 0x8c, 0x57, 0xcb, 0x37, 0x4a, 0x6f, 0x2d, 0xdd, 0xfc, 0x8d, 0x96, 0x9c,
-	0x1a, 0xdf, 0xb2, 0x16, 0xd7, 0x52, 0xeb, 0xf4, 0x9d, 0x96, 0x4d, 0xdc, 0x98, 0xb0, 0x3d, 0x32,
-	0xb6, 0x89, 0x4f, 0x5c, 0x16, 0xc6, 0xed, 0xab, 0xe7, 0x67, 0x1b, 0x8d, 0x6d, 0x3d, 0x16, 0xa7,
-	0x6c, 0x38, 0x4f, 0xaa, 0xc9, 0x57, 0x2b, 0x73, 0xf3, 0x4c, 0xc0, 0x38, 0x65, 0x83, 0x36, 0xa1,
-	0x11, 0x38, 0x43, 0x42, 0x23, 0xc7, 0x25, 0xab, 0x55, 0xb1, 0xbc, 0x6b, 0x6a, 0x79, 0x8d, 0x07,
-	0x1a, 0x81, 0x53, 0x1a, 0xf4, 0x35, 0x58, 0x88, 0x49, 0xdf, 0x0b, 0x83, 0xd5, 0x9a, 0xa0, 0x7e,
-	0x45, 0x51, 0x2f, 0x60, 0x01, 0xc5, 0x0a, 0x8b, 0x46, 0xb0, 0x18, 0x39, 0x63, 0x3f, 0x74, 0xba,
-	0xab, 0x0b, 0x37, 0x2a, 0x6f, 0x2d, 0xdd, 0xbc, 0xd7, 0xba, 0xa8, 0x39, 0xb7, 0xd4, 0x76, 0x1c,
-	0x3a, 0xb1, 0x33, 0x24, 0x8c, 0xc4, 0xed, 0x65, 0x25, 0x74, 0xf1, 0x50, 0x8a, 0xc0, 0x5a, 0x16,
-	0xfa, 0x31, 0x40, 0xa4, 0xc9, 0xe8, 0xea, 0xe2, 0x4b, 0x97, 0x8c, 0x94, 0x64, 0x48, 0x40, 0x14,
-	0x1b, 0x12, 0xad, 0xb3, 0x0a, 0xbc, 0xba, 0x1d, 0xf7, 0xc3, 0x47, 0x61, 0x7c, 0xd2, 0xf3, 0xc3,
-	0x27, 0xda, 0x92, 0x02, 0x58, 0xa0, 0xe1, 0x28, 0x76, 0xa5, 0x0d, 0x15, 0x9a, 0xd3, 0x76, 0xcc,
-	0xbc, 0x9e, 0xe3, 0xb2, 0xfd, 0xd0, 0x75, 0xb8, 0xbd, 0xb5, 0x81, 0xab, 0xdf, 0x16, 0xdc, 0xb1,
-	0x92, 0x82, 0xee, 0x40, 0x23, 0x8c, 0xb8, 0x81, 0xf3, 0x9d, 0x2a, 0x8b, 0x9d, 0xfa, 0x86, 0xde,
-	0xd7, 0x03, 0x8d, 0x78, 0x7a, 0xb6, 0x71, 0xdd, 0x9c, 0x6c, 0x82, 0xc0, 0xe9, 0xe0, 0x9c, 0x46,
-	0x2b, 0x97, 0xad, 0x51, 0xf4, 0xe7, 0x25, 0x78, 0xad, 0x1f, 0x87, 0xa3, 0xe8, 0x23, 0x12, 0x53,
-	0x3e, 0x37, 0xa2, 0x14, 0x59, 0x15, 0x8a, 0xfc, 0xc0, 0x38, 0x01, 0xc9, 0x81, 0x4f, 0xc5, 0x73,
-	0xbf, 0xc2, 0xcf, 0xc4, 0xed, 0x29, 0x1c, 0xda, 0x6f, 0x28, 0xd1, 0xaf, 0x4d, 0xc3, 0xe2, 0xa9,
-	0x52, 0xad, 0x4f, 0x6b, 0xb0, 0x92, 0xdf, 0x01, 0x64, 0x43, 0x99, 0xbe, 0xab, 0x76, 0xf6, 0x3b,
-	0xb3, 0xeb, 0x46, 0x3a, 0xdf, 0x96, 0xfd, 0xae, 0x66, 0xd8, 0x5e, 0x38, 0x3f, 0xdb, 0x28, 0xdb,
-	0xef, 0xe2, 0x32, 0x7d, 0x17, 0x59, 0xb0, 0xe0, 0x05, 0xbe, 0x17, 0x10, 0xb5, 0x7f, 0x62, 0x9b,
-	0xef, 0x0a, 0x08, 0x56, 0x18, 0xd4, 0x85, 0x6a, 0xcf, 0xf3, 0x89, 0xf2, 0x06, 0xb7, 0x2e, 0xbe,
-	0x2d, 0xb7, 0x3c, 0x9f, 0x24, 0xb3, 0xa8, 0x9f, 0x9f, 0x6d, 0x54, 0x39, 0x04, 0x0b, 0xee, 0xe8,
-	0x31, 0x54, 0x46, 0xb1, 0xaf, 0x14, 0xbe, 0x7b, 0x71, 0x21, 0x0f, 0xf1, 0x7e, 0x22, 0x63, 0xf1,
-	0xfc, 0x6c, 0xa3, 0xf2, 0x10, 0xef, 0x63, 0xce, 0x1a, 0xfd, 0x08, 0x1a, 0x6e, 0x18, 0xf4, 0xbc,
-	0xfe, 0xd0, 0x89, 0x84, 0x63, 0x59, 0xba, 0xb9, 0x77, 0x71, 0x39, 0x1d, 0xcd, 0x2a, 0x91, 0x26,
-	0x1c, 0x60, 0x02, 0xc6, 0xa9, 0x30, 0xbe, 0xb6, 0xbe, 0xc7, 0x56, 0x17, 0x8a, 0xae, 0xed, 0xb6,
-	0xc7, 0xb2, 0x6b, 0xbb, 0xed, 0x31, 0xcc, 0x59, 0x23, 0x17, 0xea, 0xb1, 0xb6, 0xd9, 0x45, 0x21,
-	0xe6, 0xfd, 0xb9, 0x4d, 0x24, 0x31, 0xd9, 0xe6, 0xf9, 0xd9, 0x46, 0x3d, 0x31, 0xd1, 0x84, 0xb1,
-	0x75, 0x56, 0x82, 0x46, 0xdb, 0xa1, 0x9e, 0xbb, 0x3d, 0x62, 0x03, 0x74, 0x00, 0xf5, 0x11, 0x25,
-	0x71, 0xa0, 0x63, 0xd6, 0xcc, 0x81, 0x42, 0xb0, 0x7f, 0xa8, 0x86, 0xe2, 0x84, 0x09, 0x67, 0x18,
-	0x39, 0x94, 0x3e, 0x09, 0xe3, 0xee, 0x7c, 0xd1, 0x4c, 0x30, 0x3c, 0x54, 0x43, 0x71, 0xc2, 0x24,
-	0x1b, 0x77, 0x2a, 0x2f, 0x8e, 0x3b, 0xd6, 0x9f, 0x94, 0xe0, 0xda, 0xc4, 0xbe, 0xa2, 0x1b, 0x50,
-	0x0d, 0xd2, 0xc0, 0xdc, 0x54, 0x1c, 0xaa, 0x22, 0x20, 0x0b, 0x4c, 0x56, 0x50, 0x79, 0x86, 0x00,
-	0xf7, 0x26, 0x54, 0x4e, 0x54, 0x7c, 0x6d, 0xb4, 0x97, 0x14, 0x69, 0x85, 0x87, 0x4d, 0x0e, 0xb7,
-	0xfe, 0xaa, 0x06, 0x57, 0x3b, 0x23, 0xca, 0xc2, 0xa1, 0x76, 0xed, 0x9b, 0x3c, 0x2c, 0xc7, 0xa7,
-	0x24, 0x7e, 0x88, 0xf7, 0xd5, 0x44, 0x12, 0x09, 0xb6, 0x46, 0xe0, 0x94, 0x86, 0x87, 0x50, 0x4a,
-	0xdc, 0x51, 0x2c, 0xe7, 0x53, 0x4f, 0x43, 0xa8, 0x2d, 0xa0, 0x58, 0x61, 0x79, 0xf6, 0xe1, 0x92,
-	0x98, 0xf1, 0x83, 0x78, 0xe8, 0xb0, 0x81, 0x9a, 0x52, 0x92, 0x7d, 0x74, 0x0c, 0x1c, 0xce, 0x50,
-	0xa2, 0x7b, 0x80, 0xa4, 0x38, 0xbe, 0xc2, 0x83, 0x53, 0x12, 0xc7, 0x5e, 0x57, 0x87, 0xf7, 0x35,
-	0x35, 0x1e, 0xd9, 0x13, 0x14, 0x78, 0xca, 0x28, 0x44, 0xa1, 0x4a, 0x23, 0xe2, 0xae, 0xd6, 0x84,
-	0xe7, 0xff, 0x5e, 0x81, 0x53, 0x69, 0x6a, 0xad, 0x65, 0x47, 0xc4, 0xdd, 0x0d, 0x58, 0x3c, 0x4e,
-	0x77, 0x8d, 0x83, 0xb0, 0x10, 0x96, 0x0b, 0x3a, 0x0b, 0x97, 0x1e, 0x74, 0x8c, 0xec, 0x65, 0xf1,
-	0xf2, 0xb2, 0x97, 0xb5, 0xdf, 0x86, 0x46, 0xa2, 0x17, 0xb4, 0x22, 0x0d, 0x51, 0x58, 0x94, 0xb0,
-	0x3d, 0xf4, 0x1a, 0xd4, 0x4e, 0x1d, 0x7f, 0xa4, 0xec, 0x18, 0xcb, 0x3f, 0x1f, 0x94, 0xb7, 0x4a,
-	0xd6, 0xdf, 0x97, 0x00, 0x76, 0x1c, 0xe6, 0xdc, 0xf2, 0x7c, 0x46, 0x62, 0x7e, 0x2c, 0x22, 0x6e,
-	0x31, 0xb9, 0x63, 0x21, 0x2c, 0x45, 0x60, 0xd0, 0x37, 0xa1, 0xca, 0xc6, 0x91, 0x3e, 0x11, 0xab,
-	0x9a, 0xe2, 0x68, 0x1c, 0x91, 0xa7, 0x67, 0x1b, 0xf5, 0x7b, 0xf6, 0xc1, 0x03, 0xfe, 0x1b, 0x0b,
-	0x2a, 0xb4, 0xa1, 0x05, 0xf3, 0xf0, 0xdf, 0x68, 0x37, 0xce, 0xcf, 0x36, 0x6a, 0x1f, 0x71, 0x80,
-	0x9a, 0x03, 0xfa, 0x10, 0xc0, 0x0d, 0x87, 0x5c, 0x81, 0x2c, 0x8c, 0x95, 0xa1, 0xdd, 0xd0, 0x3a,
-	0xee, 0x24, 0x98, 0xa7, 0x99, 0x7f, 0xd8, 0x18, 0x63, 0x79, 0xb0, 0xbc, 0x43, 0x22, 0x12, 0x74,
-	0x49, 0xe0, 0x8e, 0x45, 0x3c, 0x9e, 0xe1, 0x70, 0xbf, 0x07, 0xcd, 0xae, 0x1e, 0xe4, 0x11, 0xba,
-	0x5a, 0x16, 0xd3, 0x5b, 0xe1, 0xa7, 0x63, 0xc7, 0x80, 0xe3, 0x0c, 0x95, 0xf5, 0x69, 0x09, 0x6a,
-	0xbb, 0x7c, 0xd3, 0xd0, 0x10, 0x16, 0xdd, 0x30, 0x60, 0xe4, 0x47, 0x4c, 0xb9, 0xc9, 0x02, 0x11,
-	0x54, 0x70, 0xec, 0x48, 0x6e, 0xed, 0x25, 0xbe, 0xbd, 0xea, 0x0f, 0xd6, 0x32, 0xd0, 0x1b, 0x50,
-	0xed, 0x3a, 0xcc, 0x11, 0x4a, 0x6f, 0xca, 0x28, 0xcb, 0x37, 0x0d, 0x0b, 0xa8, 0xf5, 0x9f, 0x65,
-	0x68, 0x9a, 0x4c, 0xd0, 0x1a, 0x94, 0xbd, 0xae, 0x5a, 0x3d, 0xa8, 0xd5, 0x97, 0xef, 0xee, 0xe0,
-	0xb2, 0xd7, 0x15, 0x3e, 0x44, 0x86, 0x94, 0x72, 0x36, 0x0d, 0xcf, 0xe5, 0x81, 0xdf, 0x86, 0x25,
-	0x7e, 0xa0, 0x4e, 0x65, 0x16, 0xa3, 0x5c, 0xc8, 0xab, 0x8a, 0x78, 0x89, 0x1b, 0x9b, 0x4e, 0x70,
-	0x4c, 0x3a, 0xae, 0x7a, 0x61, 0x1e, 0xd5, 0xac, 0xea, 0x0d, 0x93, 0xd8, 0x86, 0x65, 0x3e, 0x6b,
-	0x31, 0xd7, 0x80, 0x71, 0x84, 0x2a, 0x08, 0xbe, 0xac, 0x88, 0x97, 0x77, 0xb2, 0x68, 0x9c, 0xa7,
-	0x47, 0x5f, 0x87, 0x45, 0x3a, 0x3a, 0xfe, 0x98, 0xb8, 0x32, 0xfc, 0x36, 0xd2, 0x83, 0x61, 0x4b,
-	0x30, 0xd6, 0x78, 0xb4, 0x0f, 0x55, 0x5e, 0xbc, 0xa9, 0xf8, 0xf9, 0x8d, 0xd9, 0x72, 0xbe, 0x23,
-	0x6f, 0x48, 0x8c, 0xb9, 0x7b, 0xdc, 0x6c, 0x38, 0x17, 0xeb, 0xdf, 0xcb, 0xb0, 0x2c, 0x34, 0x9d,
-	0x5a, 0xdc, 0x0c, 0xc6, 0xf6, 0x6d, 0x58, 0xea, 0x3b, 0x8c, 0x3c, 0x71, 0xc6, 0xa2, 0x16, 0x2c,
-	0x67, 0x55, 0x79, 0x3b, 0x45, 0x61, 0x93, 0x8e, 0x2b, 0x4a, 0x98, 0x8e, 0xdc, 0x18, 0x31, 0xb4,
-	0x92, 0x55, 0xd4, 0x6e, 0x16, 0x8d, 0xf3, 0xf4, 0x3c, 0xc2, 0x08, 0x90, 0x18, 0x9c, 0x2b, 0xd2,
-	0x76, 0x35, 0x02, 0xa7, 0x34, 0xe8, 0x14, 0x16, 0x7b, 0xc2, 0x13, 0x50, 0x95, 0x4c, 0x1d, 0x14,
-	0xb4, 0xeb, 0x54, 0x51, 0xd2, 0xc3, 0x48, 0x03, 0x97, 0xbf, 0x29, 0xd6, 0xc2, 0xac, 0xff, 0x2d,
-	0xc3, 0xf5, 0xa9, 0xf4, 0x33, 0xa8, 0xf7, 0x58, 0x6d, 0xb1, 0x4c, 0x2f, 0x76, 0x0a, 0xf8, 0x5b,
-	0x6f, 0x48, 0xd4, 0x2c, 0xeb, 0xd9, 0x8d, 0x37, 0xcf, 0x7b, 0xe5, 0x12, 0xce, 0x7b, 0x4f, 0x9d,
-	0xf7, 0xaa, 0x08, 0x21, 0x05, 0x96, 0x94, 0xba, 0xf6, 0x54, 0x75, 0x86, 0xe7, 0x78, 0x1b, 0x9a,
-	0x66, 0xfe, 0xfe, 0x62, 0xf7, 0x6f, 0xfd, 0x6d, 0x15, 0x96, 0x8c, 0x8c, 0x95, 0x27, 0x3d, 0x3c,
-	0xc3, 0x2f, 0x65, 0x93, 0x9e, 0x24, 0x3d, 0xff, 0x1d, 0x78, 0xc5, 0xf5, 0xc3, 0x80, 0xec, 0x78,
-	0xb1, 0x48, 0xeb, 0xc6, 0xca, 0xfa, 0xbf, 0xa4, 0x28, 0x5f, 0xe9, 0x64, 0xb0, 0x38, 0x47, 0x8d,
-	0x5c, 0xa8, 0xb9, 0x31, 0xe9, 0x52, 0xa5, 0xf5, 0x76, 0xa1, 0x34, 0xbb, 0xc3, 0x39, 0xc9, 0x18,
-	0x24, 0x7e, 0x62, 0xc9, 0x7b, 0xfe, 0x56, 0xc6, 0x4d, 0x00, 0x4a, 0x07, 0x7b, 0x64, 0x2c, 0xb2,
-	0x2b, 0xe9, 0xbd, 0x92, 0xc4, 0xc0, 0xb6, 0xef, 0x28, 0x0c, 0x36, 0xa8, 0xd0, 0x37, 0xa1, 0xde,
-	0xd3, 0xf9, 0x98, 0x74, 0x5a, 0x2b, 0x6a, 0x44, 0x3d, 0xc9, 0xc5, 0x12, 0x0a, 0xee, 0xa5, 0x8f,
-	0x63, 0x27, 0x70, 0x07, 0xc2, 0x71, 0x19, 0x5e, 0xba, 0x2d, 0xa0, 0x58, 0x61, 0xb9, 0xfa, 0x99,
-	0xd3, 0x5f, 0xad, 0x67, 0xd5, 0x7f, 0xe4, 0xf4, 0x31, 0x87, 0x73, 0x74, 0x4c, 0x7a, 0xab, 0x8d,
-	0x2c, 0x1a, 0x93, 0x1e, 0xe6, 0x70, 0x34, 0x84, 0x85, 0x98, 0x0c, 0x43, 0x46, 0x56, 0x41, 0xa8,
-	0xf7, 0x6e, 0x21, 0xf5, 0x62, 0xc1, 0x4a, 0xa6, 0xda, 0xb2, 0xe6, 0x94, 0x10, 0xac, 0x84, 0x58,
-	0x7f, 0x53, 0x82, 0xba, 0xde, 0x86, 0xff, 0xff, 0x95, 0x86, 0xf5, 0x3d, 0x58, 0xce, 0xad, 0x6a,
-	0x06, 0x67, 0xf4, 0x06, 0x54, 0x47, 0xb1, 0xaf, 0x13, 0x0a, 0xe1, 0x46, 0x1e, 0xe2, 0x7d, 0x1b,
-	0x0b, 0xa8, 0xf5, 0x1e, 0xac, 0xdc, 0x39, 0x3a, 0x3a, 0xb4, 0x47, 0xc7, 0xd4, 0x8d, 0xbd, 0x88,
-	0xa9, 0x88, 0x19, 0x85, 0xb1, 0xcc, 0x23, 0x6a, 0xc6, 0x99, 0x0b, 0x63, 0x86, 0x05, 0xc6, 0xfa,
-	0x64, 0x01, 0x96, 0xf8, 0x30, 0x5d, 0x37, 0xbc, 0xe0, 0xcc, 0x19, 0x29, 0x68, 0xf9, 0x12, 0x1b,
-	0x68, 0xbf, 0x07, 0x15, 0xe6, 0xeb, 0x83, 0xda, 0x29, 0x20, 0x72, 0xdf, 0x56, 0x36, 0x24, 0xaa,
-	0xe1, 0xa3, 0x7d, 0x1b, 0x73, 0xc6, 0xfc, 0x48, 0x0c, 0x09, 0x1b, 0x84, 0x5d, 0x75, 0x44, 0x93,
-	0x23, 0x71, 0x5f, 0x40, 0xb1, 0xc2, 0xe6, 0x2a, 0x80, 0xda, 0xa5, 0x57, 0x00, 0x5f, 0x87, 0x45,
-	0x1e, 0x32, 0xc2, 0x91, 0x4c, 0x4e, 0x2a, 0xa9, 0xca, 0x8e, 0x24, 0x18, 0x6b, 0x3c, 0x8a, 0xa0,
-	0x71, 0xac, 0x4b, 0x6f, 0x95, 0xa1, 0x14, 0x50, 0x5c, 0x52, 0xc5, 0xcb, 0xa6, 0x45, 0xf2, 0x17,
-	0xa7, 0x42, 0xd0, 0x1f, 0xc2, 0xe2, 0x80, 0x38, 0x5d, 0xae, 0x99, 0xba, 0xd0, 0x0c, 0xbe, 0xb8,
-	0x3c, 0xc3, 0x24, 0x5b, 0x77, 0x24, 0x53, 0x59, 0x97, 0x25, 0x0b, 0x56, 0x50, 0xac, 0x65, 0xae,
-	0x7d, 0x00, 0x4d, 0x93, 0x72, 0xae, 0x4a, 0xe5, 0x4f, 0x2b, 0x70, 0x6d, 0x6f, 0xcb, 0xd6, 0x2d,
-	0x8c, 0xc3, 0xd0, 0xf7, 0xdc, 0x31, 0xfa, 0x23, 0x58, 0xf0, 0x9d, 0x63, 0xe2, 0xd3, 0xd5, 0x92,
-	0x58, 0xcf, 0xa3, 0x8b, 0xaf, 0x67, 0x82, 0x79, 0x6b, 0x5f, 0x70, 0x96, 0x8b, 0x4a, 0xcc, 0x4d,
-	0x02, 0xb1, 0x12, 0x8b, 0x5c, 0x58, 0x3c, 0x76, 0xdc, 0x93, 0xb0, 0xd7, 0x53, 0x5e, 0x67, 0x6b,
-	0xee, 0x1e, 0x4d, 0x5b, 0x8e, 0x4f, 0xf5, 0xa6, 0x00, 0x58, 0x73, 0x46, 0x36, 0x5c, 0x27, 0x71,
-	0x1c, 0xc6, 0x07, 0x81, 0x42, 0x29, 0x53, 0x12, 0xa7, 0xad, 0xde, 0x7e, 0x53, 0x0d, 0xbc, 0xbe,
-	0x3b, 0x8d, 0x08, 0x4f, 0x1f, 0xbb, 0xf6, 0x3e, 0x2c, 0x19, 0x0b, 0x9c, 0x6b, 0x2f, 0xfe, 0xa9,
-	0x06, 0xcd, 0x3d, 0xa7, 0x77, 0xe2, 0xcc, 0xe8, 0x92, 0xbe, 0x0a, 0x35, 0x16, 0x46, 0x9e, 0xab,
-	0xa2, 0xff, 0x55, 0x45, 0x50, 0x3b, 0xe2, 0x40, 0x2c, 0x71, 0x3c, 0x0c, 0x47, 0x4e, 0xcc, 0x3c,
-	0xa6, 0xeb, 0x8d, 0x5a, 0x1a, 0x86, 0x0f, 0x35, 0x02, 0xa7, 0x34, 0xb9, 0x93, 0x5e, 0xbd, 0xf4,
-	0x93, 0xbe, 0x05, 0xcd, 0x98, 0xfc, 0xfe, 0xc8, 0x8b, 0x49, 0x77, 0xdb, 0x3d, 0x91, 0x19, 0x73,
-	0x2d, 0x6d, 0xb3, 0x60, 0x03, 0x87, 0x33, 0x94, 0x3c, 0x19, 0xe0, 0x15, 0x6c, 0x4c, 0x28, 0x15,
-	0x4e, 0xa2, 0x9e, 0x26, 0x03, 0x1d, 0x05, 0xc7, 0x09, 0x05, 0x4f, 0xa2, 0x7a, 0xfe, 0x88, 0x0e,
-	0x6e, 0x71, 0x1e, 0x3c, 0x35, 0x16, 0xbe, 0xa2, 0x96, 0x26, 0x51, 0xb7, 0x32, 0x58, 0x9c, 0xa3,
-	0xd6, 0x9e, 0xb9, 0xfe, 0xab, 0xf2, 0xcc, 0x46, 0xc0, 0x69, 0x5c, 0x62, 0xc0, 0xd9, 0x86, 0xe5,
-	0xc4, 0x16, 0xbc, 0xa0, 0xbf, 0x47, 0xc6, 0x22, 0x8d, 0x31, 0xea, 0xa3, 0xc3, 0x2c, 0x1a, 0xe7,
-	0xe9, 0xad, 0x00, 0x56, 0x1e, 0x6c, 0x1f, 0xd9, 0x99, 0x78, 0x3c, 0x77, 0x57, 0xce, 0xa8, 0x46,
-	0xcb, 0xcf, 0xaf, 0x46, 0xad, 0x9f, 0x56, 0x60, 0x89, 0x0b, 0x9c, 0xf1, 0xd8, 0xcc, 0xce, 0xd9,
-	0xdc, 0x83, 0xca, 0x17, 0x76, 0x6b, 0x76, 0xf9, 0x47, 0x50, 0x99, 0x76, 0xed, 0x57, 0x64, 0xda,
-	0xd6, 0x2f, 0x6b, 0x00, 0x0f, 0xc2, 0x2e, 0xb1, 0x99, 0xc3, 0x46, 0xf4, 0xb9, 0x8d, 0x15, 0x9d,
-	0x1b, 0x96, 0x9f, 0xd7, 0x07, 0xe8, 0x7a, 0x34, 0xf2, 0x55, 0x1f, 0x20, 0xd7, 0x52, 0xd9, 0x49,
-	0x51, 0xd8, 0xa4, 0x4b, 0x3a, 0x6e, 0xd5, 0xe9, 0x1d, 0x37, 0x3e, 0x3d, 0xa3, 0xbd, 0xf2, 0x36,
-	0xd4, 0xa2, 0x81, 0x43, 0x75, 0x53, 0x45, 0x37, 0x6d, 0x6b, 0x87, 0x1c, 0xf8, 0x94, 0x57, 0x34,
-	0x61, 0x97, 0x88, 0x3f, 0x58, 0x12, 0xa2, 0xc7, 0xd0, 0xa0, 0xcc, 0x89, 0x19, 0xe9, 0x6e, 0xeb,
-	0xeb, 0x8c, 0xcd, 0xd9, 0xfa, 0x24, 0xf7, 0x3d, 0x37, 0x0e, 0x45, 0xb3, 0x24, 0x3d, 0x21, 0x9a,
-	0x13, 0x4e, 0x99, 0xa2, 0x1e, 0x2c, 0x71, 0x67, 0xe6, 0x13, 0x29, 0x63, 0xf1, 0x62, 0x32, 0x12,
-	0x4d, 0x75, 0x52, 0x5e, 0xd8, 0x64, 0xcc, 0xcf, 0xcb, 0x90, 0x50, 0xea, 0xf4, 0x89, 0xaa, 0x88,
-	0x12, 0xc3, 0xbd, 0x2f, 0xc1, 0x58, 0xe3, 0xd1, 0x63, 0xa8, 0x09, 0x9b, 0x10, 0xb5, 0xd1, 0xd2,
-	0xcd, 0xef, 0x16, 0x2c, 0xe7, 0x65, 0x55, 0x29, 0x7e, 0x62, 0xc9, 0x98, 0xab, 0x75, 0x14, 0x75,
-	0x1d, 0xb9, 0x64, 0x28, 0xa8, 0xd6, 0x87, 0x9a, 0x13, 0x4e, 0x99, 0x22, 0x17, 0x20, 0x26, 0x34,
-	0xf4, 0x4f, 0x85, 0x88, 0xa5, 0x8b, 0x89, 0x48, 0x4e, 0x18, 0x4e, 0x58, 0x61, 0x83, 0xad, 0xf5,
-	0x93, 0x2a, 0xac, 0x1c, 0x44, 0x24, 0x78, 0x34, 0xf0, 0xe8, 0x89, 0xf6, 0x5b, 0x37, 0xa0, 0x3a,
-	0x08, 0x29, 0xcb, 0xd7, 0x41, 0x77, 0x42, 0xca, 0xb0, 0xc0, 0xf0, 0xad, 0xd0, 0xad, 0xc3, 0x9c,
-	0xeb, 0xd2, 0x6d, 0x43, 0x8d, 0x9f, 0xfb, 0x46, 0x47, 0x3c, 0x91, 0x18, 0xb1, 0xc1, 0x51, 0x78,
-	0x42, 0x02, 0x75, 0xb7, 0x38, 0xd7, 0x13, 0x09, 0x3d, 0x16, 0xa7, 0x6c, 0x78, 0x49, 0xef, 0xa4,
-	0xcf, 0x35, 0x72, 0x25, 0xfd, 0x76, 0xfa, 0x58, 0xc3, 0xa0, 0xfa, 0x75, 0x7d, 0xa9, 0xf0, 0x0f,
-	0x65, 0x58, 0xb0, 0x05, 0x13, 0xf4, 0x18, 0xea, 0xdc, 0xa2, 0x44, 0xaf, 0x4a, 0x16, 0xf1, 0x6f,
-	0xcf, 0x66, 0x7f, 0x07, 0x22, 0x6a, 0xdd, 0x27, 0xcc, 0x49, 0xc5, 0xa5, 0x30, 0x9c, 0x70, 0x45,
-	0x3d, 0x75, 0x89, 0x54, 0xb8, 0xb9, 0x27, 0x67, 0x6c, 0x47, 0xc4, 0x9d, 0x7a, 0x6f, 0x14, 0xc0,
-	0x02, 0x15, 0x3e, 0xbe, 0x78, 0x7f, 0x4f, 0x49, 0x12, 0xdc, 0x8c, 0xf6, 0xba, 0xf8, 0x8f, 0x95,
-	0x14, 0xeb, 0x5f, 0x4b, 0x00, 0x92, 0x70, 0xdf, 0xa3, 0x0c, 0xfd, 0x60, 0x42, 0x91, 0xad, 0xd9,
-	0x14, 0xc9, 0x47, 0x0b, 0x35, 0x26, 0x09, 0xa4, 0x86, 0x18, 0x4a, 0x24, 0x50, 0xf3, 0x18, 0x19,
-	0x52, 0xd5, 0x0f, 0xf8, 0xb0, 0xe8, 0xda, 0xd2, 0x04, 0xfe, 0x2e, 0x67, 0x8b, 0x25, 0x77, 0xeb,
-	0x5f, 0x4a, 0xb0, 0x2c, 0x09, 0x74, 0x1d, 0x45, 0xd1, 0x63, 0x80, 0x2e, 0x89, 0xfc, 0x70, 0x3c,
-	0xe4, 0xce, 0xf6, 0xa2, 0x36, 0xf2, 0x0a, 0xb7, 0x8f, 0x9d, 0x84, 0x0f, 0x36, 0x78, 0xa2, 0x47,
-	0xb0, 0xc8, 0x73, 0x31, 0xcf, 0xd5, 0x1d, 0xe0, 0xf9, 0xd9, 0x8b, 0x26, 0xac, 0x2d, 0x99, 0x60,
-	0xcd, 0xcd, 0xfa, 0xe7, 0x86, 0xde, 0x22, 0x6e, 0x27, 0xe8, 0x93, 0x52, 0xee, 0xce, 0x48, 0x16,
-	0x9c, 0x77, 0x5f, 0x5a, 0x83, 0x3c, 0xad, 0x1c, 0x9e, 0x7d, 0x05, 0x85, 0x42, 0xa8, 0x33, 0x79,
-	0x60, 0xf5, 0x6e, 0x6e, 0x17, 0x3e, 0xfa, 0xa9, 0xed, 0x28, 0x00, 0xc5, 0x89, 0x10, 0x14, 0x41,
-	0x9d, 0x91, 0x61, 0xe4, 0x3b, 0x8c, 0x14, 0x6f, 0xc2, 0x1e, 0x29, 0x4e, 0x86, 0x44, 0x05, 0xc1,
-	0x89, 0x14, 0xf4, 0x07, 0xd0, 0xa4, 0x46, 0x42, 0xae, 0x3c, 0x7c, 0x91, 0x03, 0x69, 0x70, 0x93,
-	0x77, 0x7c, 0x26, 0x04, 0x67, 0xa4, 0xf1, 0xc0, 0xe5, 0x7a, 0xb1, 0x3b, 0xf2, 0x98, 0x8a, 0x02,
-	0x89, 0x23, 0xee, 0x48, 0x30, 0xd6, 0x78, 0xf4, 0x93, 0x12, 0xac, 0x74, 0xb3, 0x57, 0x8f, 0xfa,
-	0xca, 0xb9, 0x80, 0x55, 0xe4, 0x2e, 0x33, 0x93, 0x84, 0x6f, 0x25, 0x87, 0xa0, 0x78, 0x42, 0x38,
-	0xba, 0x07, 0x48, 0xd5, 0xfa, 0xb7, 0x1c, 0xcf, 0x27, 0x5d, 0x1c, 0x8e, 0x82, 0xae, 0xc8, 0xb7,
-	0xea, 0xe9, 0xf5, 0xfd, 0xee, 0x04, 0x05, 0x9e, 0x32, 0x0a, 0x7d, 0x5a, 0x82, 0xab, 0xea, 0x28,
-	0xc8, 0x36, 0x81, 0xea, 0x18, 0x3d, 0x7a, 0x19, 0x3e, 0xb8, 0x65, 0x9b, 0x9c, 0x65, 0x87, 0xe5,
-	0xba, 0x9a, 0xe0, 0xd5, 0x0c, 0x0e, 0x67, 0x27, 0x81, 0xfe, 0xba, 0x24, 0x9f, 0x28, 0x78, 0x2e,
-	0xd9, 0x0e, 0x82, 0x90, 0x89, 0x77, 0x54, 0x54, 0x15, 0x9e, 0x3f, 0x78, 0x99, 0x73, 0x33, 0xd8,
-	0xcb, 0x09, 0x66, 0x1e, 0x40, 0x64, 0x09, 0xf0, 0x94, 0x39, 0xad, 0x7d, 0x08, 0x68, 0x72, 0x99,
-	0xf3, 0xf4, 0x59, 0xd6, 0x76, 0xe1, 0xcb, 0xcf, 0x98, 0xcc, 0x5c, 0xed, 0x9a, 0x9f, 0x2e, 0x42,
-	0xd3, 0x8c, 0x4a, 0x69, 0x91, 0x50, 0x9a, 0xb5, 0x48, 0xf8, 0x5d, 0xb3, 0x48, 0x28, 0xcf, 0x7d,
-	0x99, 0xfa, 0xfc, 0xfa, 0xc0, 0xc9, 0xd6, 0x07, 0x95, 0xb9, 0xd9, 0xcf, 0x55, 0x1a, 0x54, 0x5f,
-	0x50, 0x1a, 0x9c, 0x42, 0x2d, 0x08, 0xbb, 0x84, 0x16, 0x7f, 0xb8, 0x62, 0xea, 0xbc, 0xc5, 0x55,
-	0xaa, 0x0c, 0x29, 0x09, 0x9f, 0x02, 0x86, 0xa5, 0x38, 0x74, 0x1b, 0xae, 0x29, 0xaf, 0xdb, 0x19,
-	0xbb, 0x3e, 0xe9, 0x84, 0xa3, 0x40, 0xd6, 0x63, 0xb5, 0xf6, 0xeb, 0x6a, 0xc0, 0xb5, 0xa3, 0x3c,
-	0x01, 0x9e, 0x1c, 0x83, 0x7e, 0x08, 0xc8, 0x04, 0x4a, 0xf9, 0xea, 0x22, 0x69, 0x53, 0xdb, 0xf0,
-	0xd1, 0x04, 0xc5, 0xd3, 0x1c, 0x7f, 0x0e, 0x25, 0x78, 0x0a, 0x2b, 0xd4, 0x87, 0xab, 0xbe, 0x43,
-	0x99, 0x00, 0x71, 0xfd, 0xab, 0xd6, 0xd2, 0x3c, 0x3b, 0x96, 0x1c, 0xf6, 0x7d, 0x93, 0x11, 0xce,
-	0xf2, 0x45, 0xa7, 0xd0, 0xd0, 0x0f, 0xd5, 0xa8, 0xaa, 0xd4, 0xee, 0x16, 0xdd, 0x8e, 0x24, 0x37,
-	0x91, 0xd5, 0x40, 0xf2, 0x17, 0xa7, 0xa2, 0xd6, 0x7e, 0x2c, 0xab, 0xfe, 0x67, 0x1e, 0xb5, 0xef,
-	0x9b, 0x47, 0xad, 0x50, 0x5a, 0x9a, 0x36, 0x17, 0xcc, 0x03, 0xfb, 0xdf, 0x65, 0x68, 0xda, 0xbe,
-	0xe3, 0x26, 0x05, 0x57, 0x36, 0xe7, 0x2f, 0x5d, 0x7a, 0x9f, 0xe5, 0x21, 0x00, 0x15, 0xf3, 0x11,
-	0x35, 0xd7, 0x5c, 0xd7, 0x6b, 0x22, 0x77, 0xb3, 0x93, 0xc1, 0xd8, 0x60, 0x34, 0x7f, 0xe9, 0xc7,
-	0xa3, 0xf3, 0xc0, 0x09, 0x02, 0xe2, 0xe7, 0x8f, 0x71, 0x47, 0x82, 0xb1, 0xc6, 0x9b, 0x27, 0xbe,
-	0xf6, 0xfc, 0x13, 0x6f, 0xfd, 0xb2, 0x0a, 0xc8, 0x66, 0x4e, 0xd0, 0x75, 0xe2, 0xee, 0xde, 0x56,
-	0xd2, 0x9d, 0x7b, 0xe6, 0x03, 0xe2, 0xd2, 0x17, 0xf1, 0x80, 0xd8, 0x78, 0x09, 0x5e, 0xbe, 0x94,
-	0x97, 0xe0, 0x0f, 0xcc, 0x97, 0xe0, 0x72, 0x73, 0xde, 0x9e, 0xf6, 0x12, 0xfc, 0x2b, 0x7b, 0xa3,
-	0x63, 0x12, 0x07, 0x84, 0x11, 0xaa, 0xe7, 0x3a, 0xc3, 0x7b, 0xf0, 0xcb, 0xef, 0x15, 0xf6, 0xe0,
-	0x6a, 0xe4, 0x30, 0x77, 0x60, 0xb3, 0xd8, 0x61, 0xa4, 0x3f, 0x56, 0x66, 0xf1, 0xa1, 0xf6, 0x44,
-	0x87, 0x26, 0xf2, 0xe9, 0xd9, 0xc6, 0x6f, 0x3e, 0xeb, 0x83, 0x10, 0x36, 0x8e, 0x08, 0x6d, 0x09,
-	0x72, 0xd1, 0x78, 0xcb, 0xb2, 0x45, 0x37, 0x01, 0x7c, 0xef, 0x94, 0x1c, 0xa4, 0x0f, 0x94, 0xea,
-	0xe9, 0xdc, 0xf6, 0x13, 0x0c, 0x36, 0xa8, 0xac, 0x4d, 0x68, 0x4a, 0x2f, 0xa0, 0xae, 0xb5, 0x36,
-	0xa0, 0xe6, 0xf8, 0x7e, 0xf8, 0x44, 0x1c, 0xf5, 0x9a, 0xec, 0x2e, 0x6d, 0x73, 0x00, 0x96, 0x70,
-	0xeb, 0xbc, 0x04, 0x99, 0x2c, 0x16, 0x0d, 0xa0, 0x3a, 0x60, 0x2c, 0x2a, 0xfe, 0x95, 0x40, 0xfe,
-	0x82, 0x5a, 0x5e, 0x62, 0x73, 0x28, 0x16, 0x12, 0xb8, 0xa4, 0xc0, 0x61, 0xb4, 0xb8, 0x15, 0xe6,
-	0x5b, 0xef, 0x52, 0x12, 0x87, 0x62, 0x21, 0xc1, 0xfa, 0xbb, 0x12, 0x34, 0x92, 0xce, 0x2c, 0xd7,
-	0xab, 0xeb, 0x74, 0x48, 0xcc, 0x0e, 0xd3, 0x27, 0x2a, 0x89, 0x5e, 0x3b, 0xdb, 0x1a, 0x83, 0x0d,
-	0x2a, 0xf9, 0xfe, 0xc4, 0x23, 0x01, 0x4b, 0xc6, 0x4d, 0xbc, 0x3f, 0x31, 0xb1, 0x38, 0x47, 0x8d,
-	0xbe, 0x03, 0x57, 0x25, 0x44, 0x3f, 0xf6, 0x90, 0xe7, 0x20, 0x89, 0x5e, 0x1d, 0x13, 0x89, 0xb3,
-	0xb4, 0xd6, 0x9f, 0x55, 0x20, 0xa9, 0x6f, 0xf4, 0xcb, 0x5a, 0x9e, 0xca, 0xb9, 0x2e, 0x0f, 0xd3,
-	0xc6, 0x77, 0x41, 0x13, 0x89, 0x65, 0x4a, 0x81, 0xa7, 0x8c, 0x42, 0xf7, 0xc4, 0xa3, 0x77, 0xe6,
-	0x70, 0x93, 0x54, 0xdb, 0xf0, 0xe6, 0x34, 0x67, 0xdc, 0xd1, 0x44, 0xc9, 0x33, 0x76, 0xf9, 0x17,
-	0xa7, 0xc3, 0xd1, 0x2e, 0x2c, 0x9e, 0x86, 0xfe, 0x68, 0x48, 0xf4, 0x27, 0x1a, 0x6b, 0xd3, 0x38,
-	0x7d, 0x24, 0x48, 0x8c, 0x26, 0x9e, 0x1c, 0x82, 0xf5, 0x58, 0x44, 0x60, 0x59, 0x3c, 0x3e, 0xf6,
-	0xd8, 0x58, 0xbd, 0x66, 0x52, 0x75, 0xdb, 0xd7, 0xa6, 0xb1, 0x3b, 0x0c, 0xbb, 0x76, 0x96, 0xba,
-	0xfd, 0xea, 0xf9, 0xd9, 0xc6, 0x72, 0x0e, 0x88, 0xf3, 0x3c, 0xd1, 0xfb, 0xc9, 0x9b, 0x62, 0xce,
-	0xfb, 0x2b, 0xcf, 0xe2, 0x1d, 0x11, 0x57, 0x1a, 0x53, 0xda, 0xe1, 0xb1, 0x6c, 0x80, 0xf4, 0x81,
-	0x17, 0xfa, 0x2a, 0xd4, 0x44, 0xfe, 0xa9, 0x76, 0x20, 0xc9, 0xc8, 0x44, 0x7e, 0x8a, 0x25, 0x0e,
-	0xdd, 0x80, 0x2a, 0x65, 0x61, 0x94, 0x6f, 0xe9, 0xdb, 0x2c, 0x8c, 0xb0, 0xc0, 0x58, 0xff, 0x55,
-	0x86, 0x45, 0x1d, 0x2e, 0xa8, 0x51, 0x29, 0x97, 0x8a, 0xe6, 0x2a, 0x8a, 0x69, 0x52, 0x30, 0x37,
-	0x9f, 0x51, 0x2c, 0x67, 0x9d, 0x6a, 0xf9, 0xd2, 0x9d, 0xea, 0x09, 0x2c, 0x44, 0xc2, 0x65, 0xa9,
-	0xac, 0xfd, 0x76, 0x71, 0xd9, 0x82, 0x9d, 0x8c, 0x48, 0xf2, 0x37, 0x56, 0x22, 0xac, 0xff, 0x29,
-	0xc1, 0x4a, 0x7e, 0x86, 0xe8, 0x04, 0x2a, 0x34, 0x76, 0x95, 0xc6, 0x0f, 0x5f, 0xde, 0xd2, 0x65,
-	0x34, 0x94, 0xf7, 0x41, 0x76, 0xec, 0x62, 0x2e, 0x85, 0x5b, 0x44, 0x97, 0x50, 0x96, 0xb7, 0x88,
-	0x1d, 0x42, 0x19, 0x16, 0x18, 0xb4, 0x3f, 0x19, 0x35, 0x5b, 0xd3, 0xa2, 0xe6, 0xeb, 0x79, 0x79,
-	0xd3, 0x62, 0xa6, 0xf5, 0x6f, 0x65, 0xf8, 0xd2, 0xf4, 0x89, 0x71, 0xd7, 0x96, 0xd6, 0xff, 0x86,
-	0x33, 0x49, 0x5c, 0xdb, 0x4e, 0x06, 0x8b, 0x73, 0xd4, 0xc2, 0x9d, 0xca, 0x53, 0xa5, 0xbf, 0x34,
-	0x34, 0xdd, 0x69, 0x82, 0xc1, 0x06, 0x15, 0xda, 0x86, 0x65, 0xf5, 0xef, 0xc8, 0xec, 0x09, 0x19,
-	0x57, 0xae, 0x9d, 0x2c, 0x1a, 0xe7, 0xe9, 0x79, 0x5a, 0xd6, 0x75, 0x98, 0xc3, 0x65, 0xe6, 0x32,
-	0xb8, 0x1d, 0x09, 0xc6, 0x1a, 0x8f, 0xb6, 0xa0, 0xc9, 0x7f, 0x26, 0xa2, 0x6a, 0xd9, 0xcf, 0x18,
-	0x76, 0x0c, 0x1c, 0xce, 0x50, 0xa6, 0xcf, 0xce, 0xe5, 0x4b, 0xbb, 0x89, 0x67, 0xe7, 0xd6, 0x2f,
-	0x4a, 0x70, 0x35, 0x63, 0x6f, 0xa8, 0x07, 0x95, 0x93, 0x2d, 0xaa, 0xcc, 0x68, 0xef, 0x25, 0xbe,
-	0x22, 0x91, 0x16, 0xb4, 0xb7, 0x45, 0x31, 0x17, 0x80, 0x3e, 0x4e, 0x1a, 0xcd, 0xe5, 0xc2, 0x7d,
-	0x2d, 0x23, 0x63, 0x50, 0x19, 0x5c, 0xb6, 0xc9, 0xbc, 0x9b, 0x2c, 0xd2, 0x7e, 0xe2, 0x31, 0x77,
-	0x80, 0x5e, 0x87, 0x8a, 0x13, 0x8c, 0x45, 0x52, 0xd1, 0x90, 0xf3, 0xda, 0x0e, 0xc6, 0x98, 0xc3,
-	0x04, 0xca, 0xf7, 0xd5, 0xbb, 0x35, 0x89, 0xf2, 0x7d, 0xcc, 0x61, 0xd6, 0x5f, 0x36, 0x60, 0x39,
-	0xe7, 0x8f, 0x66, 0x78, 0x09, 0x77, 0x02, 0x0b, 0x54, 0x48, 0x55, 0x0b, 0x2d, 0xee, 0x19, 0xe4,
-	0x22, 0xd4, 0x4a, 0xc5, 0x6f, 0xac, 0x44, 0xa0, 0xbe, 0xdc, 0x3d, 0xe9, 0x83, 0xf6, 0x0b, 0xa9,
-	0x34, 0x57, 0x05, 0xe4, 0xb6, 0xef, 0x93, 0x12, 0x34, 0x1d, 0xe3, 0xcb, 0x47, 0x15, 0xe5, 0xee,
-	0x17, 0xc9, 0xc5, 0x27, 0x3e, 0xfa, 0x94, 0x4d, 0x4a, 0x13, 0x81, 0x33, 0x42, 0x91, 0xab, 0x92,
-	0xbd, 0x5a, 0xd1, 0x8f, 0xcf, 0x8c, 0x37, 0x5c, 0x13, 0x79, 0xde, 0x13, 0x68, 0x38, 0x4f, 0xa8,
-	0xfc, 0xae, 0x59, 0xdd, 0x0b, 0x17, 0x29, 0x39, 0x72, 0x9f, 0x48, 0xab, 0xbb, 0x38, 0x0d, 0xc5,
-	0xa9, 0x2c, 0x14, 0xc3, 0x82, 0x2b, 0x3e, 0xf9, 0x51, 0x37, 0xc5, 0xb7, 0x5f, 0xd2, 0xa7, 0x43,
-	0xed, 0x6b, 0x22, 0x65, 0x33, 0x41, 0x58, 0x49, 0x42, 0x7d, 0xa8, 0x9d, 0x38, 0xbd, 0x13, 0x47,
-	0xb5, 0x32, 0x0a, 0x9c, 0x4a, 0xf3, 0x5d, 0x94, 0xf4, 0x3c, 0x02, 0x82, 0x25, 0x7f, 0xbe, 0x75,
-	0x22, 0x7b, 0x6e, 0x14, 0xdd, 0x3a, 0xe3, 0x1d, 0x49, 0x3e, 0x71, 0xe6, 0xab, 0x11, 0x55, 0xb6,
-	0xba, 0x77, 0x2e, 0xe2, 0x63, 0x8c, 0x2e, 0x84, 0x5c, 0x8d, 0x80, 0x60, 0xc9, 0x9f, 0xdb, 0x48,
-	0xa8, 0x2f, 0x87, 0xd5, 0x0d, 0x74, 0x01, 0x1b, 0xc9, 0xdf, 0x33, 0x4b, 0x1b, 0x49, 0xa0, 0x38,
-	0x95, 0x65, 0xb9, 0xb0, 0x64, 0x7c, 0x14, 0x3a, 0xc3, 0x77, 0x4b, 0x37, 0x01, 0x4e, 0x49, 0xec,
-	0xf5, 0xc6, 0x3c, 0xb7, 0x57, 0xdf, 0xcf, 0x25, 0xe1, 0xee, 0xa3, 0x04, 0x83, 0x0d, 0xaa, 0x76,
-	0xeb, 0xb3, 0xcf, 0xd7, 0xaf, 0xfc, 0xec, 0xf3, 0xf5, 0x2b, 0x3f, 0xff, 0x7c, 0xfd, 0xca, 0x1f,
-	0x9f, 0xaf, 0x97, 0x3e, 0x3b, 0x5f, 0x2f, 0xfd, 0xec, 0x7c, 0xbd, 0xf4, 0xf3, 0xf3, 0xf5, 0xd2,
-	0x7f, 0x9c, 0xaf, 0x97, 0xfe, 0xe2, 0x17, 0xeb, 0x57, 0xbe, 0x5f, 0xd7, 0xf3, 0xff, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x7f, 0xaf, 0x25, 0xc2, 0x41, 0x00, 0x00,
+	// 4831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x8c, 0x24, 0xd7,
+	0x55, 0xee, 0xd7, 0x74, 0xf7, 0xe9, 0x19, 0xcf, 0xec, 0xf5, 0x23, 0xe5, 0x89, 0xbd, 0xb3, 0xaa,
+	0x40, 0x30, 0x51, 0xd2, 0x63, 0xaf, 0x6d, 0x18, 0x3b, 0x52, 0xe2, 0xee, 0x9e, 0xd9, 0xd7, 0xcc,
+	0xee, 0x4c, 0x4e, 0xcf, 0x7a, 0xa5, 0x60, 0x11, 0xd7, 0x54, 0xdf, 0xee, 0x2e, 0x4f, 0x77, 0x55,
+	0xa5, 0xaa, 0x7a, 0x76, 0x1b, 0x42, 0x82, 0x14, 0x40, 0x42, 0x44, 0x4a, 0x22, 0xcc, 0x27, 0x7f,
+	0x7c, 0xf0, 0x81, 0xf8, 0x47, 0x42, 0x42, 0x42, 0x20, 0x19, 0x89, 0x8f, 0x20, 0x21, 0x14, 0x09,
+	0x69, 0xc0, 0xc3, 0x07, 0x3f, 0x48, 0xf0, 0xbd, 0x5f, 0xe8, 0xbe, 0xaa, 0x6e, 0x55, 0xf7, 0xee,
+	0xf6, 0x6c, 0xad, 0x27, 0x48, 0xfc, 0x75, 0x9d, 0x73, 0xee, 0x39, 0x55, 0xe7, 0x9e, 0x7b, 0xcf,
+	0xe3, 0x9e, 0xdb, 0x70, 0x63, 0xe0, 0x44, 0xc3, 0xc9, 0x51, 0xd3, 0xf6, 0xc6, 0x9b, 0x56, 0x30,
+	0xf0, 0xfc, 0xc0, 0xfb, 0x98, 0xff, 0xf8, 0x1a, 0x3d, 0xa1, 0x6e, 0x14, 0x6e, 0xfa, 0xc7, 0x83,
+	0x4d, 0xcb, 0x77, 0xc2, 0xcd, 0x90, 0xba, 0xa1, 0x17, 0x6c, 0x9e, 0xbc, 0x69, 0x8d, 0xfc, 0xa1,
+	0xf5, 0xe6, 0xe6, 0x80, 0xba, 0x34, 0xb0, 0x22, 0xda, 0x6b, 0xfa, 0x81, 0x17, 0x79, 0x64, 0x2b,
+	0xe1, 0xd4, 0x54, 0x9c, 0xf8, 0x8f, 0xef, 0x08, 0x4e, 0x4d, 0xff, 0x78, 0xd0, 0x64, 0x9c, 0x9a,
+	0x82, 0x53, 0x53, 0x71, 0x5a, 0xff, 0xe6, 0xc2, 0xef, 0x60, 0x7b, 0xe3, 0xb1, 0xe7, 0x66, 0x45,
+	0xaf, 0x7f, 0x4d, 0x63, 0x30, 0xf0, 0x06, 0xde, 0x26, 0x07, 0x1f, 0x4d, 0xfa, 0xfc, 0x89, 0x3f,
+	0xf0, 0x5f, 0x92, 0xdc, 0x3c, 0xde, 0x0a, 0x9b, 0x8e, 0xc7, 0x58, 0x6e, 0xda, 0x5e, 0x40, 0x37,
+	0x4f, 0x66, 0xbe, 0x66, 0xfd, 0xed, 0x84, 0x66, 0x6c, 0xd9, 0x43, 0xc7, 0xa5, 0xc1, 0x34, 0x79,
+	0x8f, 0x31, 0x8d, 0xac, 0x79, 0xa3, 0x36, 0x1f, 0x35, 0x2a, 0x98, 0xb8, 0x91, 0x33, 0xa6, 0x33,
+	0x03, 0x7e, 0xed, 0x49, 0x03, 0x42, 0x7b, 0x48, 0xc7, 0x56, 0x76, 0x9c, 0xf9, 0x77, 0x65, 0x58,
+	0x6b, 0xdd, 0xeb, 0xee, 0x59, 0xe3, 0xa3, 0x9e, 0x75, 0x18, 0x38, 0x83, 0x01, 0x0d, 0xc8, 0x16,
+	0x2c, 0xf7, 0x27, 0xae, 0x1d, 0x39, 0x9e, 0x7b, 0xc7, 0x1a, 0x53, 0xa3, 0x70, 0xa5, 0xf0, 0x7a,
+	0xbd, 0xfd, 0xe2, 0xa7, 0xa7, 0x1b, 0xcf, 0x9d, 0x9d, 0x6e, 0x2c, 0x5f, 0xd3, 0x70, 0x98, 0xa2,
+	0x24, 0x08, 0x75, 0xcb, 0xb6, 0x69, 0x18, 0xee, 0xd2, 0xa9, 0x51, 0xbc, 0x52, 0x78, 0xbd, 0x71,
+	0xf5, 0x97, 0x9b, 0xe2, 0xd5, 0xd8, 0x94, 0x35, 0x99, 0x96, 0x9a, 0x27, 0x6f, 0x36, 0xbb, 0xd4,
+	0x0e, 0x68, 0xb4, 0x4b, 0xa7, 0x5d, 0x3a, 0xa2, 0x76, 0xe4, 0x05, 0xed, 0x95, 0xb3, 0xd3, 0x8d,
+	0x7a, 0x4b, 0x8d, 0xc5, 0x84, 0x0d, 0xe3, 0x19, 0x2a, 0x72, 0xa3, 0x74, 0x6e, 0x9e, 0x31, 0x18,
+	0x13, 0x36, 0x64, 0x13, 0xea, 0xae, 0x35, 0xa6, 0xa1, 0x6f, 0xd9, 0xd4, 0x28, 0xf3, 0xcf, 0xbb,
+	0x24, 0x3f, 0xaf, 0x7e, 0x47, 0x21, 0x30, 0xa1, 0x21, 0x5f, 0x86, 0xa5, 0x80, 0x0e, 0x1c, 0xcf,
+	0x35, 0x2a, 0x9c, 0xfa, 0x79, 0x49, 0xbd, 0x84, 0x1c, 0x8a, 0x12, 0x4b, 0x26, 0x50, 0xf5, 0xad,
+	0xe9, 0xc8, 0xb3, 0x7a, 0xc6, 0xd2, 0x95, 0xd2, 0xeb, 0x8d, 0xab, 0xb7, 0x9a, 0x4f, 0x6b, 0xce,
+	0x4d, 0x39, 0x1d, 0x07, 0x56, 0x60, 0x8d, 0x69, 0x44, 0x83, 0xf6, 0xaa, 0x14, 0x5a, 0x3d, 0x10,
+	0x22, 0x50, 0xc9, 0x22, 0xdf, 0x07, 0xf0, 0x15, 0x59, 0x68, 0x54, 0x9f, 0xb9, 0x64, 0x22, 0x25,
+	0x43, 0x0c, 0x0a, 0x51, 0x93, 0x68, 0xfe, 0x6b, 0x19, 0x5e, 0x68, 0x05, 0x03, 0xef, 0x9e, 0x17,
+	0x1c, 0xf7, 0x47, 0xde, 0x7d, 0x65, 0x49, 0x2e, 0x2c, 0x85, 0xde, 0x24, 0xb0, 0x85, 0x0d, 0xe5,
+	0x7a, 0xa7, 0x56, 0x10, 0x39, 0x7d, 0xcb, 0x8e, 0xf6, 0x3c, 0xdb, 0x62, 0xf6, 0xd6, 0x06, 0xa6,
+	0xfe, 0x2e, 0xe7, 0x8e, 0x52, 0x0a, 0xb9, 0x01, 0x75, 0xcf, 0x67, 0x06, 0xce, 0x66, 0xaa, 0xc8,
+	0x67, 0xea, 0x2b, 0x6a, 0x5e, 0xf7, 0x15, 0xe2, 0xe1, 0xe9, 0xc6, 0x4b, 0xfa, 0xcb, 0xc6, 0x08,
+	0x4c, 0x06, 0x67, 0x34, 0x5a, 0xba, 0x68, 0x8d, 0x92, 0x1f, 0x15, 0xe0, 0xc5, 0x41, 0xe0, 0x4d,
+	0xfc, 0x0f, 0x68, 0x10, 0xb2, 0x77, 0xa3, 0x52, 0x91, 0x65, 0xae, 0xc8, 0xf7, 0xb4, 0x15, 0x10,
+	0x2f, 0xf8, 0x44, 0x3c, 0xdb, 0x57, 0xd8, 0x9a, 0xb8, 0x3e, 0x87, 0x43, 0xfb, 0x55, 0x29, 0xfa,
+	0xc5, 0x79, 0x58, 0x9c, 0x2b, 0x95, 0xfc, 0x36, 0x40, 0x38, 0x39, 0x1a, 0x3b, 0xd1, 0xb5, 0xc0,
+	0x1b, 0xf3, 0x35, 0xd0, 0xb8, 0xba, 0xfb, 0xf4, 0xea, 0x50, 0xaa, 0x47, 0xda, 0xa7, 0x01, 0x75,
+	0x6d, 0xda, 0x7e, 0x9e, 0xe9, 0xa2, 0x1b, 0x8b, 0x40, 0x4d, 0x9c, 0xf9, 0x49, 0x05, 0xd6, 0xb2,
+	0xd3, 0x4f, 0xba, 0x50, 0x0c, 0xdf, 0x92, 0x66, 0xf5, 0xf5, 0xc5, 0xdf, 0x44, 0xec, 0xfc, 0xcd,
+	0xee, 0x5b, 0x8a, 0x61, 0x7b, 0xe9, 0xec, 0x74, 0xa3, 0xd8, 0x7d, 0x0b, 0x8b, 0xe1, 0x5b, 0xc4,
+	0x84, 0x25, 0xc7, 0x1d, 0x39, 0x2e, 0x95, 0xc6, 0xc3, 0x6d, 0xec, 0x26, 0x87, 0xa0, 0xc4, 0x90,
+	0x1e, 0x94, 0xfb, 0xce, 0x88, 0xca, 0xad, 0xe8, 0xda, 0xd3, 0x2b, 0xe1, 0x9a, 0x33, 0xa2, 0xf1,
+	0x5b, 0xd4, 0xce, 0x4e, 0x37, 0xca, 0x0c, 0x82, 0x9c, 0x3b, 0xf9, 0x08, 0x4a, 0x93, 0x60, 0x24,
+	0x67, 0x7b, 0xe7, 0xe9, 0x85, 0xdc, 0xc5, 0xbd, 0x58, 0x46, 0xf5, 0xec, 0x74, 0xa3, 0x74, 0x17,
+	0xf7, 0x90, 0xb1, 0x26, 0x0f, 0xa0, 0x6e, 0x7b, 0x6e, 0xdf, 0x19, 0x8c, 0x2d, 0x3f, 0xff, 0x8c,
+	0x76, 0x14, 0xab, 0x58, 0x1a, 0xdf, 0x7d, 0x63, 0x30, 0x26, 0xc2, 0xd8, 0xb7, 0x0d, 0x9c, 0xc8,
+	0x58, 0xca, 0xfb, 0x6d, 0xd7, 0x9d, 0x28, 0xfd, 0x6d, 0xd7, 0x9d, 0x08, 0x19, 0x6b, 0x62, 0x43,
+	0x2d, 0x50, 0x0b, 0xa6, 0xca, 0xc5, 0xbc, 0x7b, 0x6e, 0x13, 0x89, 0xd7, 0xcb, 0xf2, 0xd9, 0xe9,
+	0x46, 0x4d, 0x3d, 0x61, 0xcc, 0xd8, 0x3c, 0x2d, 0x40, 0xbd, 0x6d, 0x85, 0x8e, 0xdd, 0x9a, 0x44,
+	0x43, 0xb2, 0x0f, 0xb5, 0x49, 0x48, 0x03, 0x57, 0x39, 0xcc, 0x85, 0xbd, 0x14, 0x67, 0x7f, 0x57,
+	0x0e, 0xc5, 0x98, 0x09, 0x63, 0xe8, 0x5b, 0x61, 0x78, 0xdf, 0x0b, 0x7a, 0x46, 0xf1, 0xdc, 0x0c,
+	0x0f, 0xe4, 0x50, 0x8c, 0x99, 0xa4, 0x9d, 0x5e, 0xe9, 0xc9, 0x4e, 0xcf, 0xfc, 0x87, 0x02, 0xac,
+	0x75, 0xbc, 0xb1, 0x3f, 0xa2, 0x6c, 0xc5, 0x1d, 0x78, 0x23, 0xc7, 0x9e, 0x92, 0x6d, 0x58, 0x0b,
+	0x27, 0xdc, 0x39, 0x77, 0x3c, 0xb7, 0xe7, 0x30, 0x8c, 0x0c, 0x10, 0x0c, 0xc9, 0x6c, 0xad, 0x9b,
+	0xc1, 0xe3, 0xcc, 0x08, 0xc6, 0xa5, 0x6f, 0x39, 0xa3, 0x49, 0x40, 0x13, 0x2e, 0xc5, 0x34, 0x97,
+	0x6b, 0x19, 0x3c, 0xce, 0x8c, 0x20, 0xbf, 0x0a, 0x55, 0x16, 0xdc, 0x78, 0x93, 0x88, 0x7f, 0x4f,
+	0x29, 0xf1, 0x90, 0x87, 0x02, 0x8c, 0x0a, 0x6f, 0xfe, 0x7e, 0x01, 0x2e, 0xcd, 0xd8, 0x28, 0xb9,
+	0x02, 0x65, 0x37, 0x89, 0x70, 0x96, 0xe5, 0xe8, 0x32, 0x8f, 0x6c, 0x38, 0x26, 0xad, 0xb4, 0xe2,
+	0x02, 0x91, 0xc2, 0x6b, 0x50, 0x3a, 0x96, 0x81, 0x4a, 0xbd, 0xdd, 0x90, 0xa4, 0x25, 0x16, 0x7f,
+	0x30, 0xb8, 0xf9, 0xc7, 0x15, 0x58, 0xe9, 0x4c, 0xc2, 0xc8, 0x1b, 0x2b, 0x1f, 0xb9, 0xc9, 0xe2,
+	0x9b, 0xe0, 0x84, 0x06, 0x77, 0x71, 0xcf, 0x28, 0xa4, 0x25, 0x74, 0x15, 0x02, 0x13, 0x1a, 0x16,
+	0x8b, 0x84, 0xd4, 0x9e, 0x04, 0xe2, 0x7d, 0x6a, 0x49, 0x2c, 0xd2, 0xe5, 0x50, 0x94, 0x58, 0x16,
+	0xc6, 0xd9, 0x34, 0x88, 0xd8, 0xa6, 0x72, 0x60, 0x45, 0x43, 0xa3, 0x94, 0x0e, 0xe3, 0x3a, 0x1a,
+	0x0e, 0x53, 0x94, 0xe4, 0x16, 0x10, 0x21, 0x8e, 0x7d, 0xe1, 0xfe, 0x09, 0x0d, 0x02, 0xa7, 0xa7,
+	0xe2, 0xa4, 0x75, 0x39, 0x9e, 0x74, 0x67, 0x28, 0x70, 0xce, 0x28, 0x12, 0x42, 0x39, 0xf4, 0xa9,
+	0x6d, 0x54, 0xb8, 0x0b, 0xfd, 0x56, 0x8e, 0x1d, 0x46, 0xd7, 0x5a, 0xb3, 0xeb, 0x53, 0x7b, 0xc7,
+	0x8d, 0x82, 0x69, 0x32, 0x6b, 0x0c, 0x84, 0x5c, 0x58, 0xc6, 0x7b, 0x2f, 0x5d, 0xb8, 0xf7, 0xd6,
+	0xc2, 0xc0, 0xea, 0xc5, 0x85, 0x81, 0xeb, 0xbf, 0x0e, 0xf5, 0x58, 0x2f, 0x64, 0x4d, 0x18, 0x22,
+	0xb7, 0x28, 0x6e, 0x7b, 0xe4, 0x45, 0xa8, 0x9c, 0x58, 0xa3, 0x89, 0xb4, 0x63, 0x14, 0x0f, 0xef,
+	0x15, 0xb7, 0x0a, 0xe6, 0xdf, 0x14, 0x00, 0xb6, 0xad, 0xc8, 0xba, 0xe6, 0x8c, 0x22, 0x1a, 0xb0,
+	0x65, 0xe1, 0x33, 0x8b, 0xc9, 0x2c, 0x0b, 0x6e, 0x29, 0x1c, 0x43, 0xbe, 0x0a, 0xe5, 0x68, 0xea,
+	0xd3, 0xcc, 0x9a, 0x2d, 0x1f, 0x4e, 0x7d, 0xfa, 0xf0, 0x74, 0xa3, 0x76, 0xab, 0xbb, 0x7f, 0x87,
+	0xfd, 0x46, 0x4e, 0x45, 0x36, 0x94, 0x60, 0x16, 0x47, 0xd5, 0xdb, 0xf5, 0xb3, 0xd3, 0x8d, 0xca,
+	0x07, 0x0c, 0x20, 0xdf, 0x81, 0xbc, 0x0f, 0x60, 0x7b, 0x63, 0xa6, 0xc0, 0xc8, 0x0b, 0xa4, 0xa1,
+	0x5d, 0x51, 0x3a, 0xee, 0xc4, 0x98, 0x87, 0xa9, 0x27, 0xd4, 0xc6, 0x98, 0xff, 0x54, 0x80, 0xd5,
+	0x6d, 0xea, 0x53, 0xb7, 0x47, 0x5d, 0x7b, 0xca, 0x23, 0x9b, 0x05, 0x56, 0xf7, 0xdb, 0xb0, 0xdc,
+	0x53, 0x83, 0x1c, 0x1a, 0x1a, 0x45, 0xfe, 0x7e, 0x6b, 0x6c, 0x79, 0x6c, 0x6b, 0x70, 0x4c, 0x51,
+	0xb1, 0x05, 0x78, 0xdf, 0x71, 0x7b, 0xde, 0x7d, 0xb9, 0xeb, 0xc4, 0x0b, 0xf0, 0x1e, 0x87, 0xa2,
+	0xc4, 0x92, 0x6f, 0xc0, 0xf3, 0xb6, 0x17, 0x04, 0x74, 0xc4, 0x23, 0x16, 0x96, 0xbe, 0x88, 0x2f,
+	0x7b, 0x59, 0xd2, 0x3f, 0xdf, 0x49, 0x61, 0x31, 0x43, 0x6d, 0x7e, 0x52, 0x80, 0xca, 0x0e, 0xb3,
+	0x0e, 0x32, 0x86, 0xaa, 0xed, 0xb9, 0x11, 0x7d, 0x10, 0x19, 0x85, 0xbc, 0x61, 0x07, 0xe7, 0xd8,
+	0x11, 0xdc, 0xda, 0x0d, 0x66, 0x47, 0xf2, 0x01, 0x95, 0x0c, 0xf2, 0x2a, 0x94, 0x7b, 0x56, 0x64,
+	0xf1, 0xd9, 0x5d, 0x16, 0xa1, 0x09, 0xb3, 0x0e, 0xe4, 0x50, 0xf3, 0x3f, 0x8b, 0xb0, 0xac, 0x33,
+	0x21, 0xeb, 0x50, 0x74, 0x7a, 0x52, 0xcb, 0x20, 0xbf, 0xad, 0x78, 0x73, 0x1b, 0x8b, 0x4e, 0x8f,
+	0x6f, 0x56, 0xc2, 0x0f, 0x17, 0xd3, 0x89, 0x53, 0x26, 0x72, 0x7f, 0x07, 0x1a, 0x6c, 0xe5, 0x9e,
+	0x88, 0xb8, 0x53, 0xee, 0x55, 0x2f, 0x48, 0xe2, 0x06, 0xb3, 0x6a, 0x15, 0x92, 0xea, 0x74, 0x6c,
+	0x8a, 0xb9, 0x1d, 0x96, 0xd3, 0x53, 0xac, 0xd9, 0x5e, 0x0b, 0x56, 0xd9, 0x5b, 0xf3, 0x77, 0x75,
+	0x23, 0x86, 0x90, 0x29, 0xdc, 0x17, 0x24, 0xf1, 0xea, 0x76, 0x1a, 0x8d, 0x59, 0x7a, 0xe6, 0x66,
+	0xc2, 0xc9, 0xd1, 0xc7, 0xd4, 0x16, 0x31, 0x4b, 0x3d, 0x59, 0x81, 0x5d, 0x01, 0x46, 0x85, 0x27,
+	0x7b, 0x50, 0x66, 0x1e, 0x47, 0x06, 0x1d, 0x5f, 0x59, 0x2c, 0x4a, 0x67, 0xce, 0x4a, 0x7b, 0x77,
+	0x87, 0x99, 0x27, 0xe3, 0x62, 0xfe, 0x4b, 0x11, 0x56, 0xb9, 0xa6, 0x13, 0xcb, 0x5e, 0xc0, 0xa8,
+	0xdf, 0x81, 0xc6, 0xc0, 0x8a, 0xe8, 0x7d, 0x6b, 0xca, 0x80, 0x46, 0x31, 0xad, 0xca, 0xeb, 0x09,
+	0x0a, 0x75, 0x3a, 0xa6, 0x28, 0x6e, 0x3a, 0x62, 0x62, 0xf8, 0xd0, 0x52, 0x5a, 0x51, 0x3b, 0x69,
+	0x34, 0x66, 0xe9, 0x99, 0x2b, 0xe3, 0x20, 0x3e, 0x38, 0x93, 0x56, 0xef, 0x28, 0x04, 0x26, 0x34,
+	0xe4, 0x04, 0xaa, 0x7d, 0xbe, 0xe5, 0x84, 0x32, 0x02, 0xdd, 0xcf, 0x69, 0xd7, 0x89, 0xa2, 0xc4,
+	0x56, 0x26, 0x0c, 0x5c, 0xfc, 0x0e, 0x51, 0x09, 0x33, 0xff, 0xa4, 0x04, 0x2f, 0xcd, 0xa5, 0x5f,
+	0x40, 0xbd, 0x47, 0x72, 0x8a, 0x45, 0x4c, 0xb6, 0x9d, 0x63, 0x63, 0x77, 0xc6, 0x54, 0xbe, 0x65,
+	0x2d, 0x3d, 0xf1, 0xfa, 0x7a, 0x2f, 0x5d, 0xc0, 0x7a, 0xef, 0xcb, 0xf5, 0x5e, 0xbe, 0x52, 0xca,
+	0xf7, 0x49, 0x89, 0x0f, 0x49, 0x54, 0x97, 0xec, 0x1c, 0xcc, 0x0f, 0xd0, 0x07, 0x3e, 0x9f, 0xec,
+	0xd8, 0x0f, 0xec, 0x30, 0x00, 0x0a, 0xb8, 0xf9, 0x06, 0x2c, 0xeb, 0x59, 0xd1, 0x93, 0x1d, 0x91,
+	0xf9, 0x57, 0x65, 0x68, 0x68, 0x79, 0x00, 0x79, 0x4d, 0xe4, 0x4d, 0x85, 0x74, 0xf8, 0x15, 0x27,
+	0x3d, 0x6c, 0x4b, 0x1e, 0x79, 0x2e, 0xdd, 0x76, 0x02, 0x1e, 0x2c, 0x4f, 0x8d, 0x62, 0x66, 0x4b,
+	0x4e, 0x61, 0x31, 0x43, 0x4d, 0x6c, 0xa8, 0xd8, 0x01, 0xed, 0x85, 0x72, 0x5a, 0xda, 0xb9, 0x92,
+	0x97, 0x0e, 0xe3, 0x24, 0xb4, 0xc0, 0x7f, 0xa2, 0xe0, 0x7d, 0xfe, 0xea, 0xd4, 0x55, 0x80, 0x30,
+	0x1c, 0xee, 0xd2, 0x29, 0x8f, 0xf3, 0xc4, 0xf6, 0x16, 0x87, 0x28, 0xdd, 0xee, 0x0d, 0x89, 0x41,
+	0x8d, 0x8a, 0x7c, 0x15, 0x6a, 0x7d, 0x15, 0x19, 0x8a, 0x5d, 0x6d, 0x4d, 0x8e, 0xa8, 0xc5, 0x51,
+	0x61, 0x4c, 0xc1, 0xb6, 0xf1, 0xa3, 0xc0, 0x72, 0xed, 0xa1, 0x51, 0x4d, 0x6f, 0xe3, 0x6d, 0x0e,
+	0x45, 0x89, 0x65, 0xea, 0x8f, 0xac, 0x81, 0x51, 0x4b, 0xab, 0xff, 0xd0, 0x1a, 0x20, 0x83, 0x33,
+	0x74, 0x40, 0xfb, 0x46, 0x3d, 0x8d, 0x46, 0xda, 0x47, 0x06, 0x27, 0x63, 0x56, 0x65, 0x1b, 0x7b,
+	0x11, 0x35, 0x80, 0xab, 0xf7, 0x66, 0x2e, 0xf5, 0x22, 0x67, 0x25, 0x82, 0x7e, 0x91, 0xc9, 0x0b,
+	0x08, 0x4a, 0x21, 0xe6, 0x5f, 0x14, 0xa0, 0xa6, 0xa6, 0xe1, 0xff, 0x7e, 0xfe, 0x66, 0x7e, 0x0b,
+	0x56, 0x33, 0x5f, 0xb5, 0xc0, 0x6e, 0xf5, 0x2a, 0x94, 0x27, 0xc1, 0x48, 0x45, 0x36, 0x7c, 0x9f,
+	0xb9, 0x8b, 0x7b, 0x5d, 0xe4, 0x50, 0xf3, 0x6d, 0x58, 0xbb, 0x71, 0x78, 0x78, 0xd0, 0x9d, 0x1c,
+	0x85, 0x76, 0xe0, 0xf8, 0x91, 0x74, 0xa9, 0xbe, 0x17, 0x88, 0x40, 0xa3, 0xa2, 0xad, 0x39, 0x2f,
+	0x88, 0x90, 0x63, 0xcc, 0x1f, 0x2e, 0x41, 0x83, 0x0d, 0x53, 0x19, 0xcc, 0x13, 0xd6, 0x9c, 0x16,
+	0x0c, 0x17, 0x2f, 0xb0, 0x26, 0xfa, 0x9b, 0x50, 0x8a, 0x46, 0x6a, 0xa1, 0x76, 0x72, 0x88, 0xdc,
+	0xeb, 0x4a, 0x1b, 0xe2, 0x35, 0x86, 0xc3, 0xbd, 0x2e, 0x32, 0xc6, 0x6c, 0x49, 0x8c, 0x69, 0x34,
+	0xf4, 0x7a, 0x46, 0x39, 0xbd, 0x24, 0x6e, 0x73, 0x28, 0x4a, 0x6c, 0x26, 0x17, 0xa9, 0x5c, 0x78,
+	0x2e, 0xa2, 0x25, 0xc9, 0x4b, 0x8f, 0x4f, 0x92, 0x89, 0x0f, 0xf5, 0x23, 0x55, 0xd0, 0x30, 0xaa,
+	0x79, 0x15, 0x17, 0xd7, 0x46, 0x44, 0x29, 0x28, 0x7e, 0xc4, 0x44, 0x08, 0xf9, 0x1d, 0xa8, 0x0e,
+	0xa9, 0xd5, 0x63, 0x9a, 0xa9, 0x71, 0xcd, 0xe0, 0xd3, 0xcb, 0xd3, 0x4c, 0xb2, 0x79, 0x43, 0x30,
+	0x15, 0x19, 0x62, 0xfc, 0xc1, 0x12, 0x8a, 0x4a, 0xe6, 0xfa, 0x7b, 0xb0, 0xac, 0x53, 0x9e, 0x2b,
+	0x67, 0xf2, 0x61, 0x75, 0x77, 0xab, 0xdb, 0xf2, 0xfd, 0xd1, 0x74, 0x9f, 0xaf, 0x9c, 0x90, 0x1f,
+	0x9c, 0x38, 0x74, 0xd4, 0xbb, 0x6d, 0xb9, 0xd6, 0x80, 0x06, 0x33, 0x07, 0x27, 0x1a, 0x0e, 0x53,
+	0x94, 0xe4, 0x4b, 0x50, 0xe9, 0x7b, 0x2a, 0x4a, 0xae, 0xb5, 0x57, 0xe4, 0x90, 0xca, 0x35, 0x06,
+	0x44, 0x81, 0x33, 0xff, 0xb4, 0x08, 0x6b, 0xbb, 0x5b, 0xdd, 0x6d, 0x3a, 0xa2, 0x11, 0x55, 0x32,
+	0xbf, 0x0e, 0x2b, 0x23, 0xeb, 0x88, 0x8e, 0xd4, 0xf6, 0x21, 0x85, 0xbe, 0x24, 0x39, 0xac, 0xec,
+	0xe9, 0x48, 0x4c, 0xd3, 0x92, 0x1f, 0x16, 0xe0, 0x92, 0x1f, 0x78, 0xbe, 0x35, 0xb0, 0x92, 0x12,
+	0x8f, 0x74, 0x89, 0x77, 0x25, 0x87, 0x4b, 0x07, 0x59, 0x82, 0x87, 0xa7, 0x1b, 0x5b, 0x8b, 0x1c,
+	0x6b, 0x35, 0xf9, 0x9b, 0xb2, 0x61, 0x09, 0x07, 0x9c, 0x95, 0x47, 0xae, 0x01, 0x19, 0x04, 0x96,
+	0x4d, 0x0f, 0x68, 0xe0, 0x78, 0xbd, 0x2e, 0xb5, 0x3d, 0x57, 0x7a, 0xd8, 0x52, 0xfb, 0x65, 0x56,
+	0x6a, 0xb8, 0x3e, 0x83, 0xc5, 0x39, 0x23, 0xcc, 0x3f, 0x2c, 0xc1, 0xa5, 0xdd, 0xad, 0xae, 0x2a,
+	0xd5, 0x49, 0xee, 0x3f, 0x80, 0x25, 0xfe, 0xd1, 0xa1, 0x51, 0xe0, 0x16, 0x76, 0xef, 0xe9, 0x2d,
+	0x6c, 0x86, 0x79, 0x93, 0x6b, 0x57, 0x9a, 0x59, 0xbc, 0x01, 0x08, 0x20, 0x4a, 0xb1, 0xc4, 0x86,
+	0xea, 0x91, 0x65, 0x1f, 0x7b, 0xfd, 0xbe, 0xf4, 0x03, 0x5b, 0xe7, 0xae, 0x45, 0xb6, 0xc5, 0xf8,
+	0xc4, 0x92, 0x25, 0x00, 0x15, 0x67, 0xd2, 0x85, 0x97, 0x68, 0x10, 0x78, 0xc1, 0xbe, 0x2b, 0x51,
+	0x87, 0x5a, 0x61, 0xac, 0xd6, 0x7e, 0x4d, 0x0e, 0x7c, 0x69, 0x67, 0x1e, 0x11, 0xce, 0x1f, 0xbb,
+	0xfe, 0x2e, 0x34, 0xb4, 0x0f, 0x3c, 0xd7, 0xea, 0xf8, 0xfb, 0x0a, 0x2c, 0xef, 0x5a, 0xfd, 0x63,
+	0x6b, 0x41, 0x27, 0xf1, 0x25, 0xa8, 0x44, 0x9e, 0xef, 0xd8, 0xd2, 0xf8, 0xe2, 0x05, 0x70, 0xc8,
+	0x80, 0x28, 0x70, 0x2c, 0x30, 0xf2, 0xad, 0x20, 0x12, 0xe5, 0xc2, 0x12, 0xf7, 0x4f, 0x71, 0x60,
+	0x74, 0xa0, 0x10, 0x98, 0xd0, 0x64, 0xf6, 0xde, 0xf2, 0x85, 0xef, 0xbd, 0x5b, 0xb0, 0x1c, 0xd0,
+	0xef, 0x4e, 0x9c, 0x80, 0xf6, 0x5a, 0xf6, 0xb1, 0x48, 0x72, 0x2a, 0xc9, 0x86, 0x80, 0x1a, 0x0e,
+	0x53, 0x94, 0x2c, 0x3c, 0x63, 0xd5, 0x8d, 0x80, 0x86, 0x21, 0xdf, 0xb6, 0x6b, 0x49, 0x78, 0xd6,
+	0x91, 0x70, 0x8c, 0x29, 0x58, 0x58, 0xdb, 0x1f, 0x4d, 0xc2, 0xe1, 0x35, 0xc6, 0x83, 0x65, 0x33,
+	0x7c, 0xf7, 0xae, 0x24, 0x61, 0xed, 0xb5, 0x14, 0x16, 0x33, 0xd4, 0xca, 0x57, 0xd6, 0x3e, 0x2f,
+	0x5f, 0xa9, 0x85, 0x00, 0xf5, 0x0b, 0x0c, 0x01, 0x5a, 0xb0, 0x1a, 0xdb, 0x82, 0xe3, 0x0e, 0x58,
+	0x05, 0x06, 0xd2, 0x29, 0xed, 0x41, 0x1a, 0x8d, 0x59, 0x7a, 0xd3, 0x85, 0xb5, 0x3b, 0xad, 0xc3,
+	0x6e, 0x2a, 0x42, 0x3a, 0x77, 0xc5, 0x56, 0x2b, 0x20, 0x14, 0x1f, 0x5f, 0x40, 0x30, 0xff, 0xb2,
+	0x04, 0x0d, 0x26, 0x70, 0xc1, 0x65, 0xb3, 0x38, 0x67, 0x7d, 0x0e, 0x4a, 0xbf, 0xb0, 0xa3, 0xe9,
+	0x8b, 0x5f, 0x82, 0xd2, 0xb4, 0x2b, 0x9f, 0x93, 0x69, 0x9b, 0x3f, 0xad, 0x01, 0xdc, 0xf1, 0x7a,
+	0xb4, 0x1b, 0x59, 0xd1, 0x24, 0x7c, 0x6c, 0x2d, 0x4c, 0x45, 0xeb, 0xc5, 0xc7, 0x95, 0x6e, 0x7a,
+	0x4e, 0xe8, 0x8f, 0x64, 0xe9, 0x26, 0x53, 0x05, 0xdb, 0x4e, 0x50, 0xa8, 0xd3, 0xc5, 0xd5, 0xd8,
+	0xf2, 0xfc, 0x6a, 0x2c, 0x7b, 0x3d, 0xad, 0x22, 0xf6, 0x06, 0x54, 0xfc, 0xa1, 0x15, 0xaa, 0x3a,
+	0x98, 0x2a, 0xe8, 0x57, 0x0e, 0x18, 0xf0, 0x21, 0xcb, 0x31, 0xbd, 0x1e, 0xe5, 0x0f, 0x28, 0x08,
+	0xc9, 0x47, 0x50, 0x0f, 0x23, 0x2b, 0x88, 0x68, 0xaf, 0xa5, 0x8e, 0xed, 0x36, 0x17, 0x2b, 0x6d,
+	0xdd, 0x76, 0xec, 0xc0, 0xe3, 0xf5, 0xad, 0x64, 0x85, 0x28, 0x4e, 0x98, 0x30, 0x25, 0x7d, 0x68,
+	0xd8, 0xe2, 0xa4, 0x89, 0xcb, 0xa8, 0x3e, 0x9d, 0x8c, 0x58, 0x53, 0x9d, 0x84, 0x17, 0xea, 0x8c,
+	0xd9, 0x7a, 0x19, 0xd3, 0x30, 0xb4, 0x06, 0x54, 0xe6, 0xa8, 0xb1, 0xe1, 0xde, 0x16, 0x60, 0x54,
+	0x78, 0xf2, 0x11, 0x54, 0xb8, 0x4d, 0xf0, 0x6c, 0xb5, 0x71, 0xf5, 0x9b, 0x39, 0x2b, 0x30, 0xb2,
+	0xda, 0xc1, 0x7e, 0xa2, 0x60, 0xcc, 0xd4, 0x3a, 0xf1, 0x7b, 0x96, 0xf8, 0x64, 0xc8, 0xa9, 0xd6,
+	0xbb, 0x8a, 0x13, 0x26, 0x4c, 0x89, 0x0d, 0x10, 0xd0, 0xd0, 0x1b, 0x9d, 0x70, 0x11, 0x8d, 0xa7,
+	0x13, 0x11, 0xaf, 0x30, 0x8c, 0x59, 0xa1, 0xc6, 0x96, 0xb9, 0x2a, 0x2b, 0x8a, 0xe8, 0xd8, 0x8f,
+	0x42, 0x63, 0x99, 0xbb, 0x9d, 0xd8, 0x55, 0xb5, 0x24, 0x1c, 0x63, 0x0a, 0xf2, 0x21, 0xd4, 0xe9,
+	0x03, 0xdf, 0x09, 0x68, 0xd8, 0x8a, 0x8c, 0x95, 0xa7, 0x7b, 0x23, 0x9e, 0x4f, 0xec, 0x28, 0x2e,
+	0x98, 0x30, 0x64, 0xe7, 0x8a, 0x5a, 0x11, 0x9d, 0x9f, 0x31, 0x18, 0xcf, 0xa7, 0xcf, 0x15, 0x3b,
+	0x19, 0x3c, 0xce, 0x8c, 0x20, 0xdf, 0x80, 0xaa, 0x37, 0x89, 0x6c, 0x6f, 0x4c, 0x8d, 0x55, 0x3e,
+	0xf8, 0x97, 0x94, 0x95, 0xec, 0x0b, 0xf0, 0xc3, 0xd3, 0x8d, 0x4b, 0xc9, 0xc9, 0xa8, 0x04, 0xa2,
+	0x1a, 0x64, 0xfe, 0xb8, 0x0c, 0x6b, 0xfb, 0x3e, 0x75, 0xef, 0x0d, 0x9d, 0xf0, 0x58, 0xed, 0xe4,
+	0x57, 0xa0, 0x3c, 0xf4, 0xc2, 0x28, 0x9b, 0xab, 0xdf, 0xf0, 0xc2, 0x08, 0x39, 0x86, 0x19, 0xa7,
+	0xaa, 0x7f, 0x67, 0x36, 0x73, 0x55, 0xfb, 0x56, 0xf8, 0x73, 0x9f, 0xe5, 0xf2, 0xce, 0xac, 0x49,
+	0x34, 0x3c, 0xf4, 0x8e, 0xa9, 0x6b, 0x94, 0xcf, 0x53, 0x8e, 0x10, 0x9d, 0x59, 0x6a, 0x2c, 0x26,
+	0x6c, 0x58, 0xd9, 0xc9, 0x4a, 0xba, 0xc4, 0x32, 0x65, 0xa7, 0x56, 0x8c, 0x41, 0x8d, 0xea, 0xff,
+	0x6b, 0x83, 0xd4, 0x3f, 0x17, 0xa0, 0x8e, 0x56, 0x44, 0xf7, 0x9c, 0xb1, 0x13, 0x91, 0x37, 0xa1,
+	0x3c, 0x71, 0x1d, 0x65, 0x0a, 0x2a, 0x36, 0x2f, 0xdf, 0x75, 0x9d, 0xe8, 0xe1, 0xe9, 0xc6, 0x4a,
+	0x4c, 0xc8, 0x00, 0xc8, 0x49, 0x59, 0x28, 0xc3, 0xa3, 0xb5, 0x30, 0x0a, 0x0f, 0x68, 0xc0, 0x10,
+	0xdc, 0x46, 0x2a, 0x49, 0x28, 0x83, 0x69, 0x34, 0x66, 0xe9, 0x59, 0x88, 0x7d, 0x34, 0x09, 0xc2,
+	0x48, 0x46, 0xce, 0x71, 0x88, 0xdd, 0x66, 0x40, 0x14, 0x38, 0xb6, 0x98, 0x7b, 0xf4, 0xc8, 0x9b,
+	0xb8, 0xb2, 0xf4, 0x58, 0x4a, 0x16, 0xf3, 0xb6, 0x84, 0x63, 0x4c, 0x61, 0xfe, 0x6d, 0x11, 0x96,
+	0xba, 0x5c, 0x37, 0xe4, 0x23, 0xa8, 0xb1, 0x85, 0xca, 0xeb, 0xc8, 0xa2, 0x7e, 0xf6, 0xc6, 0x62,
+	0xcb, 0x7a, 0x9f, 0x87, 0x27, 0xb7, 0x69, 0x64, 0x25, 0x5a, 0x4c, 0x60, 0x18, 0x73, 0x65, 0x55,
+	0x6a, 0x7e, 0x92, 0x9c, 0xbb, 0xf0, 0x2e, 0xde, 0x98, 0x9d, 0x29, 0xcd, 0x3d, 0x3c, 0x66, 0x4d,
+	0x6b, 0xdc, 0x99, 0xe7, 0xaf, 0xbd, 0x4b, 0x49, 0x9c, 0x9b, 0x76, 0xf4, 0xc5, 0x9f, 0x51, 0x4a,
+	0x61, 0x47, 0x97, 0x20, 0x08, 0xf7, 0x9c, 0x30, 0x22, 0x1f, 0xce, 0x28, 0xb2, 0xb9, 0x98, 0x22,
+	0xd9, 0x68, 0xae, 0xc6, 0x78, 0xc6, 0x14, 0x44, 0x53, 0x22, 0x85, 0x8a, 0x13, 0xd1, 0x71, 0x28,
+	0x4b, 0x71, 0xef, 0xe7, 0xfd, 0xb6, 0xc4, 0x8c, 0x6e, 0x32, 0xb6, 0x28, 0xb8, 0x9b, 0xff, 0x58,
+	0x80, 0x55, 0x41, 0xa0, 0x12, 0xe6, 0x90, 0x7c, 0x04, 0xd0, 0xa3, 0xfe, 0xc8, 0x9b, 0x8e, 0x99,
+	0x57, 0x7d, 0x5a, 0x1b, 0xe1, 0x8d, 0x62, 0xdb, 0x31, 0x1f, 0xd4, 0x78, 0x92, 0x7b, 0x50, 0x65,
+	0x41, 0xb7, 0x63, 0xab, 0xd3, 0x99, 0xf3, 0xb3, 0xe7, 0x07, 0x24, 0x5d, 0xc1, 0x04, 0x15, 0x37,
+	0xf3, 0x27, 0x0d, 0x35, 0x45, 0xcc, 0x4e, 0x58, 0xd9, 0x24, 0x7d, 0x6e, 0x2c, 0x2a, 0x0b, 0x37,
+	0x9f, 0xd9, 0xe1, 0x55, 0x92, 0x22, 0x3e, 0xe6, 0x18, 0xda, 0x83, 0x5a, 0x24, 0xf6, 0x21, 0x35,
+	0x9b, 0xad, 0xdc, 0x3b, 0x5a, 0x62, 0x3b, 0x12, 0x10, 0x62, 0x2c, 0x84, 0xf8, 0x50, 0x63, 0x4e,
+	0x7c, 0x64, 0x45, 0x34, 0xff, 0xf9, 0xc7, 0xa1, 0xe4, 0xa4, 0x49, 0x94, 0x10, 0x8c, 0xa5, 0x90,
+	0xef, 0xc1, 0x72, 0xa8, 0x65, 0x5e, 0x46, 0x39, 0xf7, 0x82, 0xd4, 0xb8, 0x89, 0x73, 0x7e, 0x1d,
+	0x82, 0x29, 0x69, 0xcc, 0x1f, 0xdb, 0x4e, 0x60, 0x4f, 0x9c, 0x48, 0x3a, 0xb7, 0xd8, 0xbf, 0x74,
+	0x04, 0x18, 0x15, 0x9e, 0xfc, 0xb8, 0x00, 0x6b, 0xbd, 0x74, 0xfb, 0x81, 0xea, 0x3b, 0xc9, 0x61,
+	0x15, 0x99, 0x86, 0x86, 0x24, 0x86, 0xc9, 0x20, 0x42, 0x9c, 0x11, 0xce, 0x7a, 0x78, 0x64, 0x51,
+	0x87, 0x35, 0x52, 0xd1, 0x1e, 0x7a, 0x13, 0xb7, 0xc7, 0x03, 0xeb, 0x5a, 0xd2, 0xc3, 0xb3, 0x33,
+	0x43, 0x81, 0x73, 0x46, 0x91, 0x4f, 0x0a, 0xb0, 0x22, 0x97, 0x82, 0xa8, 0x07, 0x19, 0xb5, 0xbc,
+	0xa5, 0xb4, 0x64, 0x35, 0x35, 0xbb, 0x3a, 0x67, 0x51, 0x4a, 0x8b, 0xab, 0x97, 0x29, 0x1c, 0xa6,
+	0x5f, 0x82, 0xfc, 0x79, 0x41, 0xf4, 0x29, 0x39, 0x36, 0x6d, 0xb9, 0xae, 0x17, 0xf1, 0x08, 0x2e,
+	0x94, 0x15, 0x86, 0x0f, 0x9f, 0xe5, 0xbb, 0x69, 0xec, 0xc5, 0x0b, 0xa6, 0xba, 0xa0, 0xd2, 0x04,
+	0x38, 0xe7, 0x9d, 0x58, 0x21, 0x88, 0x4b, 0x6d, 0x4f, 0x42, 0x1e, 0x2c, 0x41, 0xba, 0x32, 0xbc,
+	0xa3, 0xe1, 0x30, 0x45, 0xc9, 0xe6, 0x51, 0x2e, 0xc0, 0x8e, 0xe7, 0xda, 0x93, 0x20, 0xe0, 0xe5,
+	0x9d, 0x06, 0x77, 0xe1, 0xf1, 0x5b, 0x1c, 0xce, 0x50, 0xe0, 0x9c, 0x51, 0xf1, 0x11, 0x7f, 0x7b,
+	0x12, 0xb6, 0xec, 0xe3, 0x7b, 0x96, 0x13, 0xf1, 0x80, 0xbd, 0x94, 0x39, 0xe2, 0x4f, 0xd0, 0x98,
+	0xa5, 0x5f, 0x7f, 0x1f, 0xc8, 0xec, 0x7c, 0x9d, 0xa7, 0x32, 0xb8, 0xbe, 0x03, 0x5f, 0x78, 0x84,
+	0x56, 0xcf, 0x55, 0x60, 0xfc, 0x9f, 0x1a, 0x2c, 0xeb, 0xee, 0x35, 0x49, 0x6b, 0x0b, 0x8b, 0xa6,
+	0xb5, 0xbf, 0xa1, 0xa7, 0xb5, 0xc5, 0x73, 0x77, 0x6c, 0x3c, 0x3e, 0xa3, 0xb5, 0xd2, 0x19, 0x6d,
+	0xe9, 0xdc, 0xec, 0xcf, 0x95, 0xcc, 0x96, 0x9f, 0x90, 0xcc, 0x9e, 0x40, 0xc5, 0xf5, 0x7a, 0x34,
+	0xcc, 0xdf, 0x86, 0xa7, 0xeb, 0xbc, 0xc9, 0x54, 0x2a, 0x57, 0x44, 0x1c, 0x07, 0x70, 0x18, 0x0a,
+	0x71, 0xe4, 0x3a, 0x5c, 0x52, 0x76, 0x38, 0xb5, 0x47, 0xb4, 0xe3, 0x4d, 0x5c, 0x51, 0x41, 0xa8,
+	0xb4, 0x5f, 0x51, 0xe7, 0x0b, 0x87, 0x59, 0x02, 0x9c, 0x1d, 0x43, 0xbe, 0x03, 0x44, 0x07, 0x0a,
+	0xf9, 0xf2, 0x30, 0x7a, 0x33, 0xbb, 0x0c, 0x12, 0x8a, 0x87, 0x19, 0xfe, 0x0c, 0x4a, 0x71, 0x0e,
+	0x2b, 0x32, 0x60, 0xe7, 0x28, 0x61, 0xc4, 0x41, 0x4c, 0xff, 0x46, 0xed, 0xdc, 0x33, 0xa6, 0x9d,
+	0xb9, 0x68, 0x8c, 0x30, 0xcd, 0x97, 0x9c, 0x40, 0x5d, 0xb5, 0x10, 0x87, 0xb2, 0xb6, 0x70, 0x33,
+	0xef, 0x74, 0xc4, 0x41, 0x96, 0xc8, 0xd6, 0xe2, 0x47, 0x4c, 0x44, 0x91, 0x0f, 0xc1, 0xe8, 0x05,
+	0x9e, 0xef, 0xd3, 0x9e, 0x54, 0xc8, 0xce, 0x03, 0x6a, 0x4f, 0xc4, 0x96, 0x09, 0x7c, 0x17, 0x50,
+	0x1d, 0x77, 0xc6, 0xf6, 0x23, 0xe8, 0xf0, 0x91, 0x1c, 0xc8, 0x11, 0xac, 0xdb, 0x9e, 0x35, 0xa2,
+	0xa1, 0x3d, 0x8f, 0x7f, 0x83, 0xf3, 0x37, 0x25, 0xff, 0xf5, 0xce, 0x23, 0x29, 0xf1, 0x31, 0x5c,
+	0xd6, 0xbf, 0x0f, 0x90, 0x18, 0xdc, 0x9c, 0xcd, 0xe2, 0xdb, 0xfa, 0x66, 0x91, 0x2b, 0x43, 0x48,
+	0x0a, 0x7a, 0xfa, 0x96, 0xf3, 0x5f, 0x45, 0x58, 0xee, 0x8e, 0x2c, 0x3b, 0x4e, 0xe9, 0xd3, 0x59,
+	0x65, 0xe1, 0xc2, 0x6b, 0x9b, 0x77, 0x01, 0x42, 0xfe, 0x3e, 0x3c, 0xab, 0x3f, 0x57, 0x93, 0x81,
+	0xb8, 0x6f, 0x11, 0x0f, 0x46, 0x8d, 0xd1, 0xf9, 0x8b, 0x0b, 0x2c, 0x50, 0x1a, 0x5a, 0xae, 0x4b,
+	0x47, 0xd9, 0x8d, 0xa8, 0x23, 0xc0, 0xa8, 0xf0, 0xfa, 0x9e, 0x55, 0x79, 0xfc, 0x9e, 0x65, 0xfe,
+	0x41, 0x15, 0x48, 0x37, 0xb2, 0xdc, 0x9e, 0x15, 0xf4, 0x76, 0xb7, 0xe2, 0x8a, 0xf8, 0x23, 0x6f,
+	0xc6, 0x14, 0x7e, 0x21, 0x37, 0x63, 0xdc, 0x54, 0x83, 0xe3, 0xe7, 0x7f, 0xc5, 0xe9, 0x8e, 0x7e,
+	0xc5, 0x49, 0x4c, 0xce, 0x1b, 0xf3, 0xae, 0x38, 0x7d, 0x71, 0x77, 0x72, 0x44, 0x03, 0x97, 0x46,
+	0x34, 0x54, 0xef, 0xba, 0xc0, 0x45, 0xa7, 0x8b, 0xaf, 0xcf, 0xf7, 0x61, 0xc5, 0xb7, 0x22, 0x7b,
+	0xd8, 0x8d, 0x02, 0x2b, 0xa2, 0x83, 0xa9, 0x34, 0x8b, 0xf7, 0xd5, 0x5e, 0x7a, 0xa0, 0x23, 0x1f,
+	0x9e, 0x6e, 0xfc, 0xca, 0xa3, 0x4e, 0x9e, 0x59, 0x6d, 0x3b, 0x6c, 0x72, 0x72, 0x5e, 0xec, 0x4e,
+	0xb3, 0x65, 0xc5, 0xaa, 0x91, 0x73, 0x42, 0xf7, 0x93, 0x3e, 0xce, 0x5a, 0xf2, 0x6e, 0x7b, 0x31,
+	0x06, 0x35, 0x2a, 0x96, 0xe7, 0xad, 0xf4, 0xf4, 0xd3, 0x76, 0x59, 0x98, 0xbe, 0x95, 0xeb, 0x08,
+	0x39, 0x75, 0x7e, 0xdf, 0xbe, 0xc4, 0x3e, 0x32, 0x05, 0xc2, 0xb4, 0x4c, 0xf2, 0x03, 0x58, 0xb6,
+	0xb4, 0x2e, 0x03, 0xa3, 0x96, 0xd7, 0x67, 0x64, 0xda, 0x16, 0x44, 0x1e, 0xa4, 0x43, 0x30, 0x25,
+	0xd0, 0xdc, 0x84, 0x65, 0xb1, 0x19, 0xca, 0x13, 0xf5, 0x0d, 0xa8, 0x58, 0xa3, 0x91, 0x77, 0x9f,
+	0xef, 0x78, 0x15, 0x51, 0xd8, 0x6e, 0x31, 0x00, 0x0a, 0xb8, 0x79, 0x56, 0x80, 0x54, 0x5e, 0x45,
+	0x86, 0x50, 0x1e, 0x46, 0x91, 0x9f, 0xff, 0x16, 0x60, 0xb6, 0x5b, 0x49, 0x74, 0x34, 0x31, 0x28,
+	0x72, 0x09, 0x4c, 0x92, 0x6b, 0x45, 0x61, 0xfe, 0xc5, 0x98, 0x3d, 0xf5, 0x13, 0x92, 0x18, 0x14,
+	0xb9, 0x04, 0xf3, 0xaf, 0x0b, 0x50, 0x8f, 0x0f, 0x85, 0x98, 0x79, 0xd9, 0x16, 0xbb, 0x52, 0x71,
+	0x90, 0xf4, 0x2b, 0xc6, 0xe6, 0xd5, 0x69, 0x29, 0x0c, 0x6a, 0x54, 0xa2, 0x19, 0xd1, 0x61, 0xdd,
+	0x99, 0x6a, 0xdc, 0x4c, 0x33, 0xa2, 0x8e, 0xc5, 0x0c, 0x35, 0x6b, 0xfd, 0x10, 0x10, 0xd5, 0xf9,
+	0x57, 0x4a, 0xb7, 0x7e, 0x74, 0x74, 0x24, 0xa6, 0x69, 0xcd, 0x3f, 0x2a, 0x41, 0x9c, 0x71, 0xab,
+	0x0b, 0x1f, 0x2c, 0x26, 0xb7, 0x6d, 0x16, 0x6f, 0x69, 0xf7, 0x7e, 0x67, 0x52, 0x9d, 0x84, 0x02,
+	0xe7, 0x8c, 0x22, 0xb7, 0xf8, 0xbd, 0xb2, 0xc8, 0x62, 0x2b, 0x53, 0x4e, 0xc3, 0x6b, 0xf3, 0x7c,
+	0x52, 0x47, 0x11, 0xc5, 0x37, 0xc5, 0xc4, 0x23, 0x26, 0xc3, 0xc9, 0x0e, 0x54, 0x4f, 0xbc, 0xd1,
+	0x64, 0x4c, 0xd5, 0x15, 0xcc, 0xf5, 0x79, 0x9c, 0x3e, 0xe0, 0x24, 0x5a, 0xb5, 0x5c, 0x0c, 0x41,
+	0x35, 0x96, 0x50, 0x58, 0xe5, 0x77, 0x62, 0x9c, 0x68, 0x2a, 0x7b, 0x5f, 0x65, 0x25, 0xe1, 0xcb,
+	0xf3, 0xd8, 0x1d, 0xf0, 0x8e, 0x12, 0x9d, 0xba, 0xfd, 0x02, 0xcb, 0x8d, 0x32, 0x40, 0xcc, 0xf2,
+	0x24, 0xef, 0xc6, 0x57, 0x5d, 0x18, 0xef, 0x2f, 0x3e, 0x8a, 0x37, 0xab, 0x3b, 0xd6, 0xd2, 0x35,
+	0x47, 0xb3, 0x0b, 0x90, 0xb4, 0x03, 0xb3, 0x4a, 0x2d, 0x4f, 0x24, 0xe4, 0x0c, 0xc4, 0xa1, 0x35,
+	0x4f, 0x34, 0x50, 0xe0, 0xd8, 0x79, 0x42, 0x18, 0x79, 0x7e, 0xf6, 0x34, 0xb1, 0x1b, 0x79, 0x3e,
+	0x72, 0x8c, 0xf9, 0x67, 0x4b, 0x50, 0x55, 0x5e, 0x33, 0xd4, 0x6a, 0x37, 0x85, 0xbc, 0x1b, 0x88,
+	0x64, 0x1a, 0x97, 0x70, 0x96, 0x1f, 0x51, 0xbe, 0x49, 0xfb, 0x96, 0xe2, 0x85, 0xfb, 0x96, 0x63,
+	0x58, 0xf2, 0x45, 0x4b, 0x93, 0x48, 0xbf, 0xae, 0xe7, 0x97, 0xcd, 0xd9, 0x09, 0xc7, 0x2c, 0x7e,
+	0xa3, 0x14, 0xc1, 0x22, 0x1b, 0x2f, 0xe8, 0xd1, 0x80, 0x8a, 0x86, 0xc0, 0x5a, 0x62, 0x8f, 0xfb,
+	0x02, 0x8c, 0x0a, 0xaf, 0xb7, 0xe4, 0x55, 0x9e, 0xd0, 0x92, 0xf7, 0x5d, 0x58, 0x09, 0x68, 0x14,
+	0x4c, 0x63, 0xf7, 0xb8, 0x94, 0xb3, 0x85, 0x88, 0xfb, 0x1b, 0xd4, 0x59, 0x62, 0x5a, 0x02, 0xeb,
+	0x02, 0x0c, 0xd4, 0x09, 0x44, 0xfe, 0x2e, 0xc0, 0xf8, 0x30, 0x43, 0xa6, 0x26, 0xea, 0x11, 0x13,
+	0x21, 0xe4, 0x7b, 0x50, 0x17, 0xf5, 0xab, 0x70, 0xdf, 0x95, 0xa5, 0xa5, 0xdd, 0xdc, 0x53, 0xa5,
+	0x55, 0x53, 0xe3, 0xe8, 0x75, 0x5b, 0x49, 0xc1, 0x44, 0xa0, 0xf9, 0x7b, 0x05, 0xb8, 0x34, 0x33,
+	0x66, 0x81, 0xd6, 0xda, 0xdb, 0x7c, 0xa3, 0x4b, 0x5d, 0x5e, 0x54, 0x99, 0x68, 0x3d, 0xbe, 0xa3,
+	0xf8, 0xf0, 0x74, 0x63, 0x7d, 0x86, 0x79, 0x8c, 0xc5, 0x84, 0x83, 0xf9, 0xdf, 0x05, 0x58, 0xcb,
+	0x5a, 0x38, 0x39, 0x86, 0x52, 0x18, 0xd8, 0x72, 0xc5, 0x1e, 0x3c, 0xbb, 0xa5, 0x23, 0x82, 0x4a,
+	0xd1, 0xca, 0xd0, 0x0d, 0x6c, 0x64, 0x52, 0xd8, 0x27, 0xf7, 0x68, 0x18, 0x65, 0x77, 0x94, 0x6d,
+	0xca, 0x4e, 0x28, 0x19, 0x86, 0xec, 0xcd, 0x06, 0x9f, 0xcd, 0x79, 0xc1, 0xe7, 0x2b, 0x59, 0x79,
+	0xf3, 0x42, 0x4f, 0xf3, 0x47, 0x25, 0x78, 0x79, 0xfe, 0x8b, 0x31, 0xd7, 0x98, 0x54, 0x34, 0x35,
+	0x67, 0x14, 0xbb, 0xc6, 0xed, 0x14, 0x16, 0x33, 0xd4, 0xdc, 0x1d, 0x8b, 0x5d, 0x59, 0xfd, 0x13,
+	0x85, 0xee, 0x8e, 0x63, 0x0c, 0x6a, 0x54, 0xac, 0x3a, 0x26, 0x9f, 0x0e, 0xf5, 0x2a, 0xb7, 0xd6,
+	0x2d, 0xd4, 0x49, 0xa3, 0x31, 0x4b, 0xcf, 0x16, 0x36, 0x3b, 0x65, 0x49, 0xae, 0x7a, 0xc5, 0x0b,
+	0x7b, 0x5b, 0x80, 0x51, 0xe1, 0x59, 0x45, 0x90, 0xfd, 0x8c, 0x45, 0x55, 0xd2, 0x15, 0xc1, 0x6d,
+	0x0d, 0x87, 0x29, 0xca, 0xe4, 0x36, 0x9d, 0x68, 0xdb, 0x9f, 0xbd, 0x4d, 0xf7, 0x0e, 0x34, 0x64,
+	0x81, 0x83, 0x6b, 0xae, 0x9a, 0xee, 0x22, 0x39, 0x4c, 0x50, 0xa8, 0xd3, 0x99, 0xff, 0x56, 0x84,
+	0x95, 0xd4, 0x36, 0x47, 0xfa, 0x50, 0x3a, 0xde, 0x0a, 0xa5, 0xf5, 0xed, 0x3e, 0xc3, 0xbe, 0x49,
+	0x61, 0x78, 0xbb, 0x5b, 0x21, 0x32, 0x01, 0xe4, 0xe3, 0xf8, 0xc4, 0xad, 0x98, 0xbb, 0xc0, 0xaf,
+	0x05, 0xaa, 0x32, 0x7f, 0x4a, 0x9d, 0xb6, 0x91, 0xdf, 0x12, 0x57, 0x0d, 0xc5, 0xc9, 0xbd, 0xf4,
+	0x0b, 0xb7, 0xf2, 0xdc, 0x7b, 0x4f, 0xdf, 0x8f, 0x16, 0x89, 0x75, 0x02, 0x45, 0x4d, 0x9a, 0xb9,
+	0x13, 0x2b, 0xb8, 0x7b, 0xdf, 0x89, 0xec, 0x21, 0x79, 0x05, 0x4a, 0x96, 0x3b, 0xe5, 0x71, 0x74,
+	0x5d, 0xe8, 0xa4, 0xe5, 0x4e, 0x91, 0xc1, 0x38, 0x6a, 0x34, 0x32, 0x8a, 0x1a, 0x6a, 0x34, 0x42,
+	0x06, 0x33, 0x7f, 0x5a, 0x87, 0xd5, 0x8c, 0x0b, 0x5e, 0x60, 0xbb, 0x3a, 0x86, 0xa5, 0x90, 0x4b,
+	0x35, 0x8a, 0xcf, 0xc8, 0x19, 0x8a, 0x8f, 0x90, 0x5a, 0xe6, 0xbf, 0x51, 0x8a, 0x20, 0x03, 0x61,
+	0x39, 0x42, 0xbd, 0x7b, 0xb9, 0xa6, 0x33, 0x93, 0xff, 0x67, 0x4c, 0x87, 0x1d, 0xc5, 0x59, 0xda,
+	0x9f, 0x79, 0xc8, 0xc0, 0xee, 0x76, 0x9e, 0x2c, 0x7c, 0xe6, 0x7f, 0x4c, 0x64, 0x86, 0xa4, 0x21,
+	0x30, 0x25, 0x94, 0xd8, 0x32, 0xbf, 0xa9, 0xe4, 0xfd, 0x4b, 0x03, 0xad, 0x87, 0x7d, 0x26, 0xb5,
+	0xb9, 0x0f, 0x75, 0xeb, 0x7e, 0x28, 0xfe, 0xaa, 0xc7, 0x58, 0xca, 0x6b, 0xb8, 0xd9, 0x7f, 0xfd,
+	0x91, 0x7d, 0x1e, 0x0a, 0x8a, 0x89, 0x2c, 0x12, 0xc0, 0x92, 0xcd, 0x2f, 0x5f, 0x1b, 0xd5, 0xbc,
+	0x96, 0x93, 0xba, 0xc4, 0x2d, 0x62, 0x91, 0x14, 0x08, 0xa5, 0x24, 0x32, 0x80, 0xca, 0x31, 0x6b,
+	0x1f, 0x36, 0x6a, 0x79, 0x77, 0x04, 0xbd, 0x0b, 0x59, 0x6c, 0x96, 0x1c, 0x82, 0x82, 0x3f, 0x9b,
+	0x3a, 0x9e, 0x30, 0xd6, 0xf3, 0x4e, 0x9d, 0xd6, 0xb5, 0x99, 0xcd, 0x15, 0xd9, 0xd7, 0xf0, 0xfa,
+	0x9a, 0x01, 0x79, 0xbf, 0x46, 0xaf, 0x3f, 0x8a, 0xaf, 0xe1, 0x10, 0x14, 0xfc, 0x99, 0x8d, 0x78,
+	0xaa, 0xf1, 0xc8, 0x68, 0xe4, 0xb5, 0x91, 0x6c, 0x0f, 0x93, 0xb0, 0x91, 0x18, 0x8a, 0x89, 0x2c,
+	0xd3, 0x86, 0x86, 0xf6, 0x57, 0x23, 0x0b, 0xdc, 0x20, 0xbf, 0x0a, 0x70, 0x42, 0x03, 0xa7, 0x3f,
+	0x65, 0xe9, 0xac, 0xbc, 0xf6, 0x10, 0x7b, 0xe8, 0x0f, 0x62, 0x0c, 0x6a, 0x54, 0xe6, 0x3d, 0xb8,
+	0x34, 0xf3, 0xcf, 0x31, 0x4c, 0xd4, 0xb1, 0xe3, 0xf6, 0xb2, 0xa2, 0x76, 0x1d, 0xb7, 0x87, 0x1c,
+	0xf3, 0xe4, 0xbe, 0xcb, 0x76, 0xf3, 0xd3, 0xcf, 0x2e, 0x3f, 0xf7, 0xb3, 0xcf, 0x2e, 0x3f, 0xf7,
+	0xf3, 0xcf, 0x2e, 0x3f, 0xf7, 0xbb, 0x67, 0x97, 0x0b, 0x9f, 0x9e, 0x5d, 0x2e, 0xfc, 0xec, 0xec,
+	0x72, 0xe1, 0xe7, 0x67, 0x97, 0x0b, 0xff, 0x7e, 0x76, 0xb9, 0xf0, 0x93, 0xff, 0xb8, 0xfc, 0xdc,
+	0xb7, 0x6b, 0x4a, 0x31, 0xff, 0x3b, 0x00, 0x59, 0x2d, 0xc9, 0xb6, 0xee, 0x4c, 0x00, 0x00,
 }
 
 func (m *AWSLambdaTrigger) Marshal() (dAtA []byte, err error) {
@@ -1675,6 +1900,18 @@ func (m *ArgoWorkflowTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SubmitFrom != nil {
+		{
+			size, err := m.SubmitFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.GroupVersionResource.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1873,6 +2110,42 @@ func (m *BasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CompletionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Timeout))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.FailureCondition)
+	copy(dAtA[i:], m.FailureCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ConfigmapArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2076,6 +2349,14 @@ func (m *DependencyGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CorrelationKey)
+	copy(dAtA[i:], m.CorrelationKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CorrelationKey)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Window))
+	i--
+	dAtA[i] = 0x18
 	if len(m.Dependencies) > 0 {
 		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Dependencies[iNdEx])
@@ -2273,6 +2554,15 @@ func (m *EventDependencyFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Exprs) > 0 {
+		for iNdEx := len(m.Exprs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exprs[iNdEx])
+			copy(dAtA[i:], m.Exprs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Exprs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2656,7 +2946,7 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
+func (m *K8SApplyOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2666,35 +2956,109 @@ func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *K8SResourcePolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *K8SApplyOptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *K8SResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *K8SApplyOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	i--
-	if m.ErrorOnBackoffTimeout {
+	if m.Force {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x18
-	{
-		size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x10
+	i -= len(m.FieldManager)
+	copy(dAtA[i:], m.FieldManager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManager)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *K8SDeleteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *K8SDeleteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *K8SDeleteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.GracePeriodSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
+	i -= len(m.PropagationPolicy)
+	copy(dAtA[i:], m.PropagationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PropagationPolicy)))
 	i--
 	dAtA[i] = 0x12
-	if len(m.Labels) > 0 {
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *K8SResourcePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *K8SResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.ErrorOnBackoffTimeout {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Labels) > 0 {
 		keysForLabels := make([]string, 0, len(m.Labels))
 		for k := range m.Labels {
 			keysForLabels = append(keysForLabels, string(k))
@@ -2942,6 +3306,31 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Outcome)
+	copy(dAtA[i:], m.Outcome)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Outcome)))
+	i--
+	dAtA[i] = 0x7a
+	i -= len(m.CorrelationValue)
+	copy(dAtA[i:], m.CorrelationValue)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CorrelationValue)))
+	i--
+	dAtA[i] = 0x72
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x60
 	{
 		size, err := m.ResolvedAt.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3110,6 +3499,43 @@ func (m *OpenWhiskTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Debounce))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestsPerUnit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Unit)
+	copy(dAtA[i:], m.Unit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Sensor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3277,6 +3703,17 @@ func (m *SensorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EventBusAckWait))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TriggerConcurrency))
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.EventBusName)
+	copy(dAtA[i:], m.EventBusName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventBusName)))
+	i--
+	dAtA[i] = 0x52
 	if len(m.ServiceAnnotations) > 0 {
 		keysForServiceAnnotations := make([]string, 0, len(m.ServiceAnnotations))
 		for k := range m.ServiceAnnotations {
@@ -3425,6 +3862,12 @@ func (m *SensorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CoalescedTriggerExecutions))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DroppedTriggerExecutions))
+	i--
+	dAtA[i] = 0x50
 	if m.Resources != nil {
 		{
 			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
@@ -3601,6 +4044,30 @@ func (m *StandardK8STrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ApplyOptions != nil {
+		{
+			size, err := m.ApplyOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DeleteOptions != nil {
+		{
+			size, err := m.DeleteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	i--
 	if m.LiveObject {
 		dAtA[i] = 1
@@ -3904,6 +4371,55 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Timeout))
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.Ordered {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
 	if m.Policy != nil {
 		{
 			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
@@ -3945,6 +4461,39 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggerDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TriggerParameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4010,6 +4559,11 @@ func (m *TriggerParameterSource) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TriggerName)
+	copy(dAtA[i:], m.TriggerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TriggerName)))
+	i--
+	dAtA[i] = 0x3a
 	if m.Value != nil {
 		i -= len(*m.Value)
 		copy(dAtA[i:], *m.Value)
@@ -4065,6 +4619,18 @@ func (m *TriggerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Completion != nil {
+		{
+			size, err := m.Completion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Status != nil {
 		{
 			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
@@ -4317,6 +4883,39 @@ func (m *URLArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -4383,6 +4982,10 @@ func (m *ArgoWorkflowTrigger) Size() (n int) {
 	}
 	l = m.GroupVersionResource.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SubmitFrom != nil {
+		l = m.SubmitFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4442,6 +5045,20 @@ func (m *BasicAuth) Size() (n int) {
 	return n
 }
 
+func (m *CompletionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailureCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Timeout))
+	return n
+}
+
 func (m *ConfigmapArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4528,6 +5145,9 @@ func (m *DependencyGroup) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 1 + sovGenerated(uint64(m.Window))
+	l = len(m.CorrelationKey)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4614,6 +5234,12 @@ func (m *EventDependencyFilter) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Exprs) > 0 {
+		for _, s := range m.Exprs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4747,6 +5373,34 @@ func (m *HTTPTrigger) Size() (n int) {
 	return n
 }
 
+func (m *K8SApplyOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FieldManager)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *K8SDeleteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PropagationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.GracePeriodSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.GracePeriodSeconds))
+	}
+	return n
+}
+
 func (m *K8SResourcePolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4874,8 +5528,17 @@ func (m *NodeStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.ResolvedAt.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.CorrelationValue)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Outcome)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
 
 func (m *OpenWhiskTrigger) Size() (n int) {
 	if m == nil {
@@ -4910,6 +5573,20 @@ func (m *OpenWhiskTrigger) Size() (n int) {
 	return n
 }
 
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Unit)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RequestsPerUnit))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	n += 1 + sovGenerated(uint64(m.Debounce))
+	return n
+}
+
 func (m *Sensor) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5008,6 +5685,10 @@ func (m *SensorSpec) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.EventBusName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.TriggerConcurrency))
+	n += 1 + sovGenerated(uint64(m.EventBusAckWait))
 	return n
 }
 
@@ -5043,6 +5724,8 @@ func (m *SensorStatus) Size() (n int) {
 		l = m.Resources.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.DroppedTriggerExecutions))
+	n += 1 + sovGenerated(uint64(m.CoalescedTriggerExecutions))
 	return n
 }
 
@@ -5094,6 +5777,14 @@ func (m *StandardK8STrigger) Size() (n int) {
 	l = len(m.PatchStrategy)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.DeleteOptions != nil {
+		l = m.DeleteOptions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ApplyOptions != nil {
+		l = m.ApplyOptions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -5205,6 +5896,35 @@ func (m *Trigger) Size() (n int) {
 		l = m.Policy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
+	n += 1 + sovGenerated(uint64(m.Timeout))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TriggerDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Condition)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5245,6 +5965,8 @@ func (m *TriggerParameterSource) Size() (n int) {
 		l = len(*m.Value)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.TriggerName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5262,6 +5984,10 @@ func (m *TriggerPolicy) Size() (n int) {
 		l = m.Status.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Completion != nil {
+		l = m.Completion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -5349,6 +6075,19 @@ func (m *URLArtifact) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5395,6 +6134,7 @@ func (this *ArgoWorkflowTrigger) String() string {
 		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`GroupVersionResource:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GroupVersionResource), "GroupVersionResource", "v11.GroupVersionResource", 1), `&`, ``, 1) + `,`,
+		`SubmitFrom:` + strings.Replace(this.SubmitFrom.String(), "WorkflowReference", "WorkflowReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5427,6 +6167,18 @@ func (this *BasicAuth) String() string {
 	}, "")
 	return s
 }
+func (this *CompletionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompletionPolicy{`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ConfigmapArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -5495,6 +6247,8 @@ func (this *DependencyGroup) String() string {
 	s := strings.Join([]string{`&DependencyGroup{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`CorrelationKey:` + fmt.Sprintf("%v", this.CorrelationKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5554,6 +6308,7 @@ func (this *EventDependencyFilter) String() string {
 		`Time:` + strings.Replace(this.Time.String(), "TimeFilter", "TimeFilter", 1) + `,`,
 		`Context:` + strings.Replace(this.Context.String(), "EventContext", "EventContext", 1) + `,`,
 		`Data:` + repeatedStringForData + `,`,
+		`Exprs:` + fmt.Sprintf("%v", this.Exprs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5656,6 +6411,29 @@ func (this *HTTPTrigger) String() string {
 	}, "")
 	return s
 }
+func (this *K8SApplyOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&K8SApplyOptions{`,
+		`FieldManager:` + fmt.Sprintf("%v", this.FieldManager) + `,`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *K8SDeleteOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&K8SDeleteOptions{`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`PropagationPolicy:` + fmt.Sprintf("%v", this.PropagationPolicy) + `,`,
+		`GracePeriodSeconds:` + valueToStringGenerated(this.GracePeriodSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *K8SResourcePolicy) String() string {
 	if this == nil {
 		return "nil"
@@ -5758,6 +6536,10 @@ func (this *NodeStatus) String() string {
 		`Event:` + strings.Replace(this.Event.String(), "Event", "Event", 1) + `,`,
 		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "MicroTime", "v11.MicroTime", 1), `&`, ``, 1) + `,`,
 		`ResolvedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ResolvedAt), "MicroTime", "v11.MicroTime", 1), `&`, ``, 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "MicroTime", "v11.MicroTime", 1) + `,`,
+		`CorrelationValue:` + fmt.Sprintf("%v", this.CorrelationValue) + `,`,
+		`Outcome:` + fmt.Sprintf("%v", this.Outcome) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5788,6 +6570,19 @@ func (this *OpenWhiskTrigger) String() string {
 	}, "")
 	return s
 }
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
+		`RequestsPerUnit:` + fmt.Sprintf("%v", this.RequestsPerUnit) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`Debounce:` + fmt.Sprintf("%v", this.Debounce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Sensor) String() string {
 	if this == nil {
 		return "nil"
@@ -5876,6 +6671,9 @@ func (this *SensorSpec) String() string {
 		`ErrorOnFailedRound:` + fmt.Sprintf("%v", this.ErrorOnFailedRound) + `,`,
 		`ServiceLabels:` + mapStringForServiceLabels + `,`,
 		`ServiceAnnotations:` + mapStringForServiceAnnotations + `,`,
+		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
+		`TriggerConcurrency:` + fmt.Sprintf("%v", this.TriggerConcurrency) + `,`,
+		`EventBusAckWait:` + fmt.Sprintf("%v", this.EventBusAckWait) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5904,6 +6702,8 @@ func (this *SensorStatus) String() string {
 		`TriggerCycleStatus:` + fmt.Sprintf("%v", this.TriggerCycleStatus) + `,`,
 		`LastCycleTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastCycleTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
 		`Resources:` + strings.Replace(this.Resources.String(), "SensorResources", "SensorResources", 1) + `,`,
+		`DroppedTriggerExecutions:` + fmt.Sprintf("%v", this.DroppedTriggerExecutions) + `,`,
+		`CoalescedTriggerExecutions:` + fmt.Sprintf("%v", this.CoalescedTriggerExecutions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5943,6 +6743,8 @@ func (this *StandardK8STrigger) String() string {
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`PatchStrategy:` + fmt.Sprintf("%v", this.PatchStrategy) + `,`,
 		`LiveObject:` + fmt.Sprintf("%v", this.LiveObject) + `,`,
+		`DeleteOptions:` + strings.Replace(this.DeleteOptions.String(), "K8SDeleteOptions", "K8SDeleteOptions", 1) + `,`,
+		`ApplyOptions:` + strings.Replace(this.ApplyOptions.String(), "K8SApplyOptions", "K8SApplyOptions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6019,10 +6821,31 @@ func (this *Trigger) String() string {
 		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForParameters += "}"
+	repeatedStringForDependsOn := "[]TriggerDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "TriggerDependency", "TriggerDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&Trigger{`,
 		`Template:` + strings.Replace(this.Template.String(), "TriggerTemplate", "TriggerTemplate", 1) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`Policy:` + strings.Replace(this.Policy.String(), "TriggerPolicy", "TriggerPolicy", 1) + `,`,
+		`Ordered:` + fmt.Sprintf("%v", this.Ordered) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6050,6 +6873,7 @@ func (this *TriggerParameterSource) String() string {
 		`DataKey:` + fmt.Sprintf("%v", this.DataKey) + `,`,
 		`DataTemplate:` + fmt.Sprintf("%v", this.DataTemplate) + `,`,
 		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`TriggerName:` + fmt.Sprintf("%v", this.TriggerName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6061,6 +6885,7 @@ func (this *TriggerPolicy) String() string {
 	s := strings.Join([]string{`&TriggerPolicy{`,
 		`K8s:` + strings.Replace(this.K8s.String(), "K8SResourcePolicy", "K8SResourcePolicy", 1) + `,`,
 		`Status:` + strings.Replace(this.Status.String(), "StatusPolicy", "StatusPolicy", 1) + `,`,
+		`Completion:` + strings.Replace(this.Completion.String(), "CompletionPolicy", "CompletionPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6107,6 +6932,17 @@ func (this *URLArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowReference{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -6568,6 +7404,42 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitFrom == nil {
+				m.SubmitFrom = &WorkflowReference{}
+			}
+			if err := m.SubmitFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7051,7 +7923,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
+func (m *CompletionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7074,15 +7946,15 @@ func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigmapArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompletionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigmapArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompletionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7110,11 +7982,11 @@ func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7142,13 +8014,13 @@ func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7158,24 +8030,11 @@ func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timeout |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7200,7 +8059,7 @@ func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
+func (m *ConfigmapArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7223,15 +8082,15 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigmapArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigmapArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7259,13 +8118,13 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7275,15 +8134,27 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Secure = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertFilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7311,11 +8182,64 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertFilePath = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerNameOverride", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7343,7 +8267,91 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerNameOverride = string(dAtA[iNdEx:postIndex])
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Secure = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertFilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertFilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerNameOverride", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerNameOverride = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -7838,6 +8846,57 @@ func (m *DependencyGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8647,6 +9706,38 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exprs = append(m.Exprs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9854,7 +10945,7 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
+func (m *K8SApplyOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9877,17 +10968,17 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: K8SResourcePolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: K8SApplyOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: K8SResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: K8SApplyOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9897,160 +10988,32 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnBackoffTimeout", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -10062,7 +11025,7 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ErrorOnBackoffTimeout = bool(v != 0)
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10087,7 +11050,7 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
+func (m *K8SDeleteOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10110,15 +11073,15 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: K8SDeleteOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: K8SDeleteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10146,11 +11109,11 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagationPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10178,13 +11141,13 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.PropagationPolicy = k8s_io_apimachinery_pkg_apis_meta_v1.DeletionPropagation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
 			}
-			m.Partition = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10194,14 +11157,68 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.GracePeriodSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: K8SResourcePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: K8SResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10228,35 +11245,109 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAcks", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			m.RequiredAcks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequiredAcks |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10266,12 +11357,254 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Compress = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnBackoffTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ErrorOnBackoffTimeout = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAcks", wireType)
+			}
+			m.RequiredAcks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredAcks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compress = bool(v != 0)
 		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FlushFrequency", wireType)
@@ -11144,19 +12477,138 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v11.MicroTime{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcome = CompletionOutcome(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -11453,6 +12905,148 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = RateLimitUnit(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerUnit", wireType)
+			}
+			m.RequestsPerUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsPerUnit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debounce", wireType)
+			}
+			m.Debounce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Debounce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Sensor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12356,17 +13950,87 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.ServiceAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBusName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerConcurrency", wireType)
+			}
+			m.TriggerConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerConcurrency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusAckWait", wireType)
+			}
+			m.EventBusAckWait = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventBusAckWait |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -12788,6 +14452,44 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedTriggerExecutions", wireType)
+			}
+			m.DroppedTriggerExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedTriggerExecutions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoalescedTriggerExecutions", wireType)
+			}
+			m.CoalescedTriggerExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoalescedTriggerExecutions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13247,6 +14949,78 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LiveObject = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteOptions == nil {
+				m.DeleteOptions = &K8SDeleteOptions{}
+			}
+			if err := m.DeleteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplyOptions == nil {
+				m.ApplyOptions = &K8SApplyOptions{}
+			}
+			if err := m.ApplyOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13410,28 +15184,404 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPSubscription{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NATS == nil {
+				m.NATS = &NATSSubscription{}
+			}
+			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACertPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCertPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Template) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &v1.Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13458,16 +15608,16 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPSubscription{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
 			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13494,10 +15644,10 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = &NATSSubscription{}
+			if m.Spec == nil {
+				m.Spec = &v1.PodSpec{}
 			}
-			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13525,7 +15675,7 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLSConfig) Unmarshal(dAtA []byte) error {
+func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13548,15 +15698,15 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TLSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13584,43 +15734,11 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CACertPath = string(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientCertPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13648,7 +15766,7 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKeyPath = string(dAtA[iNdEx:postIndex])
+			m.Stop = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13674,7 +15792,7 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Template) Unmarshal(dAtA []byte) error {
+func (m *Trigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13697,17 +15815,17 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13717,27 +15835,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			if m.Template == nil {
+				m.Template = &TriggerTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13764,16 +15886,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13800,16 +15920,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &TriggerPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13819,31 +15941,34 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+			m.Ordered = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13870,71 +15995,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &v1.PodSpec{}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TimeFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TimeFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13944,29 +16016,33 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13976,23 +16052,25 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stop = string(dAtA[iNdEx:postIndex])
+			m.DependsOn = append(m.DependsOn, TriggerDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14018,7 +16096,7 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trigger) Unmarshal(dAtA []byte) error {
+func (m *TriggerDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14041,17 +16119,17 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14061,67 +16139,29 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Template == nil {
-				m.Template = &TriggerTemplate{}
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14131,27 +16171,23 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &TriggerPolicy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Condition = TriggerDependencyCondition(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14552,6 +16588,38 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Value = &s
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14677,6 +16745,42 @@ func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Completion == nil {
+				m.Completion = &CompletionPolicy{}
+			}
+			if err := m.Completion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15368,6 +17472,123 @@ func (m *URLArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
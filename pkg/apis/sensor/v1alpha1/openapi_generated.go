@@ -33,6 +33,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger":    schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation":       schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.BasicAuth":              schema_pkg_apis_sensor_v1alpha1_BasicAuth(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CompletionPolicy":       schema_pkg_apis_sensor_v1alpha1_CompletionPolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConfigmapArtifact":      schema_pkg_apis_sensor_v1alpha1_ConfigmapArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger":          schema_pkg_apis_sensor_v1alpha1_CustomTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter":             schema_pkg_apis_sensor_v1alpha1_DataFilter(ref),
@@ -47,12 +48,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig":        schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPSubscription":       schema_pkg_apis_sensor_v1alpha1_HTTPSubscription(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger":            schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SApplyOptions":        schema_pkg_apis_sensor_v1alpha1_K8SApplyOptions(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SDeleteOptions":       schema_pkg_apis_sensor_v1alpha1_K8SDeleteOptions(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SResourcePolicy":      schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger":           schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSSubscription":       schema_pkg_apis_sensor_v1alpha1_NATSSubscription(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger":            schema_pkg_apis_sensor_v1alpha1_NATSTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NodeStatus":             schema_pkg_apis_sensor_v1alpha1_NodeStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger":       schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit":              schema_pkg_apis_sensor_v1alpha1_RateLimit(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor":                 schema_pkg_apis_sensor_v1alpha1_Sensor(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorList":             schema_pkg_apis_sensor_v1alpha1_SensorList(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorResources":        schema_pkg_apis_sensor_v1alpha1_SensorResources(ref),
@@ -66,12 +70,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template":               schema_pkg_apis_sensor_v1alpha1_Template(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter":             schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger":                schema_pkg_apis_sensor_v1alpha1_Trigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDependency":      schema_pkg_apis_sensor_v1alpha1_TriggerDependency(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter":       schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource": schema_pkg_apis_sensor_v1alpha1_TriggerParameterSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy":          schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerSwitch":          schema_pkg_apis_sensor_v1alpha1_TriggerSwitch(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate":        schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact":            schema_pkg_apis_sensor_v1alpha1_URLArtifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.WorkflowReference":      schema_pkg_apis_sensor_v1alpha1_WorkflowReference(ref),
 	}
 }
 
@@ -198,12 +204,18 @@ func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref common.ReferenceCal
 							Format: "",
 						},
 					},
+					"submitFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubmitFrom makes the submit operation create the workflow from the spec of a workflow template or a cron workflow, in the namespace of the workflow. The workflow of the source, if any, is laid over the spec, e.g. to set its name or arguments.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.WorkflowReference"),
+						},
+					},
 				},
 				Required: []string{"group", "version", "resource"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.WorkflowReference"},
 	}
 }
 
@@ -299,6 +311,41 @@ func schema_pkg_apis_sensor_v1alpha1_BasicAuth(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_CompletionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CompletionPolicy refers to the policy used to wait for the resource created by a K8s based trigger to complete. The resource is watched until either condition holds, or until the timeout is reached. The conditions are a JSONPath, an operator (==, != or in) and a value, for example `.status.phase == Succeeded` or `.status.phase in (Failed, Error)`. A condition without operator holds if the value at the JSONPath is neither empty nor false.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"successCondition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessCondition determines whether the resource completed successfully",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureCondition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureCondition determines whether the resource failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout in seconds to wait for the resource to complete. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"successCondition"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_ConfigmapArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -494,6 +541,20 @@ func schema_pkg_apis_sensor_v1alpha1_DependencyGroup(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the time window in seconds within which the events of the dependencies must be received for the group to be resolved. The event of a dependency expires once it is older than the window. Defaults to no window.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"correlationKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CorrelationKey is the path of a value in the event data, e.g. body.commit.sha. The group is resolved only by events holding the same value, the events holding a different value than the latest one are expired.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "dependencies"},
 			},
@@ -622,7 +683,7 @@ func schema_pkg_apis_sensor_v1alpha1_EventDependency(ref common.ReferenceCallbac
 					},
 					"eventName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EventName is the name of the event",
+							Description: "EventName is the name of the event The event source and event names may be globs, except with a NATS Streaming EventBus, which doesn't support wildcard subjects.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -681,6 +742,20 @@ func schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref common.ReferenceC
 							},
 						},
 					},
+					"exprs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exprs is a list of boolean expressions, all of which must be true for the event to pass the filter, e.g. data.body.action == \"opened\" && data.body.pull_request.base.ref matches \"^release/\". The event data is available as `data`, and the event context as `context`, e.g. context.source. See https://github.com/antonmedv/expr/blob/master/docs/Language-Definition.md for the syntax. The string functions lower, upper, trim, split, join, replace, hasPrefix and hasSuffix are available as well.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -962,6 +1037,67 @@ func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_K8SApplyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "K8SApplyOptions refers to the options of the apply operation of a Kubernetes trigger",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fieldManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldManager is the name of the manager of the fields set by the trigger. Defaults to \"argo-events\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Force takes the ownership of the fields managed by other managers, rather than failing on the conflicts.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_K8SDeleteOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "K8SDeleteOptions refers to the options of the delete operation of a Kubernetes trigger",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the resources to delete in the namespace of the resource artifact, rather than its name. It can be set from the events by the trigger parameters, with the destination \"k8s.deleteOptions.labelSelector\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"propagationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PropagationPolicy determines whether and how the dependents are garbage collected, one of \"Orphan\", \"Background\" or \"Foreground\". Defaults to the default policy of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracePeriodSeconds is the duration in seconds before the resources are deleted. Defaults to the default grace period of the resource.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1268,6 +1404,33 @@ func schema_pkg_apis_sensor_v1alpha1_NodeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"),
 						},
 					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of attempts of the last execution of a trigger.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresAt is the time at which the event of a dependency expires, according to the windows of its groups.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"),
+						},
+					},
+					"correlationValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CorrelationValue is the value of the correlation key shared by the events that resolved a dependency group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outcome": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outcome is the outcome of the resource created by the last execution of a trigger, if the trigger has a completion policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"id", "name", "displayName", "type", "phase"},
 			},
@@ -1351,6 +1514,47 @@ func schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RateLimit limits the rate of the executions of a trigger",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit is the unit of time of the rate limit, i.e. Second, Minute or Hour. Defaults to Second.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestsPerUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestsPerUnit is the number of executions of the trigger allowed per unit of time, the executions beyond the limit are dropped. Defaults to no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of executions allowed at once. Defaults to RequestsPerUnit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"debounce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Debounce is a window in seconds opened by a resolution of the dependencies, during which the following resolutions are coalesced. At the end of the window, the trigger is executed once with the events of the latest resolution. Defaults to no debounce.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_Sensor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1511,7 +1715,7 @@ func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 					},
 					"subscription": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subscription refers to the modes of events subscriptions for the sensor. At least one of the types of subscription must be defined in order for sensor to be meaningful.",
+							Description: "Subscription refers to the modes of events subscriptions for the sensor. If no subscription is defined, the sensor subscribes to the EventBus referred by EventBusName.",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Subscription"),
 						},
 					},
@@ -1537,7 +1741,7 @@ func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 					},
 					"errorOnFailedRound": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ErrorOnFailedRound if set to true, marks sensor state as `error` if the previous trigger round fails. Once sensor state is set to `error`, no further triggers will be processed.",
+							Description: "ErrorOnFailedRound if set to true, marks sensor state as `error` if the previous trigger round fails. Once sensor state is set to `error`, no further triggers will be processed. The eventbus messages of the events of a failed round, or received once the state is `error`, are not acknowledged, so that they are redelivered after the ack wait of the subscription.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -1572,6 +1776,27 @@ func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"eventBusName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusName references to a EventBus name. By default the value is \"default\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggerConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerConcurrency is the max number of triggers the sensor executes at the same time, across the resolutions of its dependencies. The triggers are started in the order of the spec, so with the default of 1 the triggers of a resolution are executed one at a time in that order. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"eventBusAckWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusAckWait is the time in seconds the streaming eventbus waits for the ack of an event before redelivering it. The events are acked once their triggers are executed, so it must be longer than the worst case of a trigger cycle, i.e. the sum, over the triggers, of the debounce window and of the timeout, or of the retry backoff and the policy waits if the trigger has no timeout. Defaults to that worst case, and to at least 5 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"dependencies", "triggers"},
 			},
@@ -1654,6 +1879,20 @@ func schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref common.ReferenceCallback)
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorResources"),
 						},
 					},
+					"droppedTriggerExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DroppedTriggerExecutions is the count of trigger executions dropped because of the rate limit of a trigger.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"coalescedTriggerExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoalescedTriggerExecutions is the count of trigger executions coalesced into a later one by the debounce of a trigger.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"phase", "triggerCycleStatus", "lastCycleTime"},
 			},
@@ -1781,12 +2020,24 @@ func schema_pkg_apis_sensor_v1alpha1_StandardK8STrigger(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"deleteOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteOptions configures the delete operation. The resource is deleted by the name of the resource artifact, or the resources are deleted by the label selector if one is specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SDeleteOptions"),
+						},
+					},
+					"applyOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplyOptions configures the apply operation.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SApplyOptions"),
+						},
+					},
 				},
 				Required: []string{"group", "version", "resource"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SApplyOptions", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SDeleteOptions", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
 	}
 }
 
@@ -1997,11 +2248,78 @@ func schema_pkg_apis_sensor_v1alpha1_Trigger(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy"),
 						},
 					},
+					"ordered": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ordered makes the executions of the trigger for successive resolutions of the dependencies run one at a time and in the order of the resolutions.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout in seconds of an execution of the trigger. Once it's reached, the execution is cancelled and marked as failed. Defaults to no timeout.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy is the backoff to retry the execution of the trigger when it fails. The executions failing with a client error, e.g. a 4xx status or an already existing resource, aren't retried. The policy of the trigger is applied once, after the last attempt. Defaults to a single attempt.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the executions of the trigger.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn is the list of the triggers which must be executed before the trigger. The triggers of the sensor form a DAG, the triggers without dependencies are executed first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDependency"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerDependency is a dependency of a trigger on the execution of another trigger of the sensor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the trigger",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Condition on the execution of the trigger for the dependent trigger to be executed, i.e. Succeeded, Failed or Completed. Defaults to Succeeded. If the condition doesn't hold, or if the trigger is skipped, the dependent trigger is skipped.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
 	}
 }
 
@@ -2090,8 +2408,14 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerParameterSource(ref common.Reference
 							Format:      "",
 						},
 					},
+					"triggerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerName refers to the name of a trigger the trigger depends on, in place of a dependency. The result of its execution is used as the data of the event, i.e. the created resource for the K8s and Argo Workflow triggers, the response body for the HTTP trigger and the payload of the response for the AWS Lambda and custom triggers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"dependencyName"},
 			},
 		},
 	}
@@ -2116,11 +2440,17 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref common.ReferenceCallback)
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StatusPolicy"),
 						},
 					},
+					"completion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Completion refers to the policy used to wait for the resource created by a K8s based trigger to complete",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CompletionPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SResourcePolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StatusPolicy"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CompletionPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SResourcePolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StatusPolicy"},
 	}
 }
 
@@ -2275,3 +2605,31 @@ func schema_pkg_apis_sensor_v1alpha1_URLArtifact(ref common.ReferenceCallback) c
 		},
 	}
 }
+
+func schema_pkg_apis_sensor_v1alpha1_WorkflowReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkflowReference refers to a workflow template or a cron workflow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the resource, either \"WorkflowTemplate\" or \"CronWorkflow\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
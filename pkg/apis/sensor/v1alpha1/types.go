@@ -78,6 +78,8 @@ const (
 	Create KubernetesResourceOperation = "create" // create the resource
 	Update KubernetesResourceOperation = "update" // updates the resource
 	Patch  KubernetesResourceOperation = "patch"  // patch resource
+	Delete KubernetesResourceOperation = "delete" // deletes the resource
+	Apply  KubernetesResourceOperation = "apply"  // server-side applies the resource
 )
 
 // ArgoWorkflowOperation refers to the type of the operation performed on the Argo Workflow
@@ -85,11 +87,13 @@ type ArgoWorkflowOperation string
 
 // possible values for ArgoWorkflowOperation
 const (
-	Submit   ArgoWorkflowOperation = "submit"   // submit a workflow
-	Suspend  ArgoWorkflowOperation = "suspend"  // suspends a workflow
-	Resubmit ArgoWorkflowOperation = "resubmit" // resubmit a workflow
-	Retry    ArgoWorkflowOperation = "retry"    // retry a workflow
-	Resume   ArgoWorkflowOperation = "resume"   // resume a workflow
+	Submit    ArgoWorkflowOperation = "submit"    // submit a workflow
+	Suspend   ArgoWorkflowOperation = "suspend"   // suspends a workflow
+	Resubmit  ArgoWorkflowOperation = "resubmit"  // resubmit a workflow
+	Retry     ArgoWorkflowOperation = "retry"     // retry a workflow
+	Resume    ArgoWorkflowOperation = "resume"    // resume a workflow
+	Terminate ArgoWorkflowOperation = "terminate" // terminates a workflow without running its exit handlers
+	Stop      ArgoWorkflowOperation = "stop"      // stops a workflow, running its exit handlers
 )
 
 // Comparator refers to the comparator operator for a data filter
@@ -104,6 +108,16 @@ const (
 	EmptyComparator                 = ""   // Equal to value provided in data filter
 )
 
+// RateLimitUnit is the unit of time of a rate limit
+type RateLimitUnit string
+
+// possible values for RateLimitUnit
+const (
+	Second RateLimitUnit = "Second" // executions per second
+	Minute RateLimitUnit = "Minute" // executions per minute
+	Hour   RateLimitUnit = "Hour"   // executions per hour
+)
+
 // Sensor is the definition of a sensor resource
 // +genclient
 // +genclient:noStatus
@@ -138,7 +152,8 @@ type SensorSpec struct {
 	// +optional
 	Template Template `json:"template,omitempty" protobuf:"bytes,3,opt,name=template"`
 	// Subscription refers to the modes of events subscriptions for the sensor.
-	// At least one of the types of subscription must be defined in order for sensor to be meaningful.
+	// If no subscription is defined, the sensor subscribes to the EventBus referred by EventBusName.
+	// +optional
 	Subscription *Subscription `json:"subscription,omitempty" protobuf:"bytes,4,opt,name=subscription"`
 	// Circuit is a boolean expression of dependency groups
 	Circuit string `json:"circuit,omitempty" protobuf:"bytes,5,opt,name=circuit"`
@@ -147,12 +162,28 @@ type SensorSpec struct {
 	DependencyGroups []DependencyGroup `json:"dependencyGroups,omitempty" protobuf:"bytes,6,rep,name=dependencyGroups"`
 	// ErrorOnFailedRound if set to true, marks sensor state as `error` if the previous trigger round fails.
 	// Once sensor state is set to `error`, no further triggers will be processed.
+	// The eventbus messages of the events of a failed round, or received once the state is `error`, are not
+	// acknowledged, so that they are redelivered after the ack wait of the subscription.
 	ErrorOnFailedRound bool `json:"errorOnFailedRound,omitempty" protobuf:"varint,7,opt,name=errorOnFailedRound"`
 	// ServiceLabels to be set for the service generated
 	ServiceLabels map[string]string `json:"serviceLabels,omitempty" protobuf:"bytes,8,rep,name=serviceLabels"`
 	// ServiceAnnotations refers to annotations to be set
 	// for the service generated
 	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty" protobuf:"bytes,9,rep,name=serviceAnnotations"`
+	// EventBusName references to a EventBus name. By default the value is "default"
+	// +optional
+	EventBusName string `json:"eventBusName,omitempty" protobuf:"bytes,10,opt,name=eventBusName"`
+	// TriggerConcurrency is the max number of triggers the sensor executes at the same time,
+	// across the resolutions of its dependencies. The triggers are started in the order of the spec, so with
+	// the default of 1 the triggers of a resolution are executed one at a time in that order. Defaults to 1.
+	// +optional
+	TriggerConcurrency int32 `json:"triggerConcurrency,omitempty" protobuf:"varint,11,opt,name=triggerConcurrency"`
+	// EventBusAckWait is the time in seconds the streaming eventbus waits for the ack of an event before redelivering it.
+	// The events are acked once their triggers are executed, so it must be longer than the worst case of a trigger cycle,
+	// i.e. the sum, over the triggers, of the debounce window and of the timeout, or of the retry backoff and the policy
+	// waits if the trigger has no timeout. Defaults to that worst case, and to at least 5 minutes.
+	// +optional
+	EventBusAckWait int64 `json:"eventBusAckWait,omitempty" protobuf:"varint,12,opt,name=eventBusAckWait"`
 }
 
 // Template holds the information of a sensor deployment template
@@ -212,6 +243,8 @@ type EventDependency struct {
 	// EventSourceName is the name of EventSource that Sensor depends on
 	EventSourceName string `json:"eventSourceName" protobuf:"bytes,3,name=eventSourceName"`
 	// EventName is the name of the event
+	// The event source and event names may be globs, except with a NATS Streaming EventBus, which doesn't support
+	// wildcard subjects.
 	EventName string `json:"eventName" protobuf:"bytes,4,name=eventName"`
 	// Filters and rules governing toleration of success and constraints on the context and data of an event
 	Filters *EventDependencyFilter `json:"filters,omitempty" protobuf:"bytes,5,opt,name=filters"`
@@ -224,6 +257,18 @@ type DependencyGroup struct {
 
 	// Dependencies of events
 	Dependencies []string `json:"dependencies" protobuf:"bytes,2,rep,name=dependencies"`
+
+	// Window is the time window in seconds within which the events of the dependencies must be received
+	// for the group to be resolved. The event of a dependency expires once it is older than the window.
+	// Defaults to no window.
+	// +optional
+	Window int64 `json:"window,omitempty" protobuf:"varint,3,opt,name=window"`
+
+	// CorrelationKey is the path of a value in the event data, e.g. body.commit.sha.
+	// The group is resolved only by events holding the same value, the events holding
+	// a different value than the latest one are expired.
+	// +optional
+	CorrelationKey string `json:"correlationKey,omitempty" protobuf:"bytes,4,opt,name=correlationKey"`
 }
 
 // EventDependencyFilter defines filters and constraints for a event.
@@ -237,6 +282,14 @@ type EventDependencyFilter struct {
 
 	// Data filter constraints with escalation
 	Data []DataFilter `json:"data,omitempty" protobuf:"bytes,4,rep,name=data"`
+
+	// Exprs is a list of boolean expressions, all of which must be true for the event to pass the filter,
+	// e.g. data.body.action == "opened" && data.body.pull_request.base.ref matches "^release/".
+	// The event data is available as `data`, and the event context as `context`, e.g. context.source.
+	// See https://github.com/antonmedv/expr/blob/master/docs/Language-Definition.md for the syntax.
+	// The string functions lower, upper, trim, split, join, replace, hasPrefix and hasSuffix are available as well.
+	// +optional
+	Exprs []string `json:"exprs,omitempty" protobuf:"bytes,5,rep,name=exprs"`
 }
 
 // TimeFilter describes a window in time.
@@ -297,6 +350,68 @@ type Trigger struct {
 	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,2,rep,name=parameters"`
 	// Policy to configure backoff and execution criteria for the trigger
 	Policy *TriggerPolicy `json:"policy,omitempty" protobuf:"bytes,3,opt,name=policy"`
+	// Ordered makes the executions of the trigger for successive resolutions of the dependencies
+	// run one at a time and in the order of the resolutions.
+	// +optional
+	Ordered bool `json:"ordered,omitempty" protobuf:"varint,4,opt,name=ordered"`
+	// Timeout in seconds of an execution of the trigger. Once it's reached, the execution is cancelled
+	// and marked as failed. Defaults to no timeout.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,5,opt,name=timeout"`
+	// RetryStrategy is the backoff to retry the execution of the trigger when it fails.
+	// The executions failing with a client error, e.g. a 4xx status or an already existing resource, aren't retried.
+	// The policy of the trigger is applied once, after the last attempt. Defaults to a single attempt.
+	// +optional
+	RetryStrategy *apicommon.Backoff `json:"retryStrategy,omitempty" protobuf:"bytes,6,opt,name=retryStrategy"`
+	// RateLimit limits the rate of the executions of the trigger.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,7,opt,name=rateLimit"`
+	// DependsOn is the list of the triggers which must be executed before the trigger.
+	// The triggers of the sensor form a DAG, the triggers without dependencies are executed first.
+	// +optional
+	DependsOn []TriggerDependency `json:"dependsOn,omitempty" protobuf:"bytes,8,rep,name=dependsOn"`
+}
+
+// TriggerDependency is a dependency of a trigger on the execution of another trigger of the sensor
+type TriggerDependency struct {
+	// Name of the trigger
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Condition on the execution of the trigger for the dependent trigger to be executed, i.e. Succeeded, Failed or Completed.
+	// Defaults to Succeeded. If the condition doesn't hold, or if the trigger is skipped, the dependent trigger is skipped.
+	// +optional
+	Condition TriggerDependencyCondition `json:"condition,omitempty" protobuf:"bytes,2,opt,name=condition,casttype=TriggerDependencyCondition"`
+}
+
+// TriggerDependencyCondition is the condition on the execution of a trigger for its dependent triggers to be executed
+type TriggerDependencyCondition string
+
+// possible conditions of the trigger dependencies
+const (
+	// the trigger is executed successfully
+	TriggerSucceeded TriggerDependencyCondition = "Succeeded"
+	// the execution of the trigger failed
+	TriggerFailed TriggerDependencyCondition = "Failed"
+	// the trigger is executed, successfully or not
+	TriggerCompleted TriggerDependencyCondition = "Completed"
+)
+
+// RateLimit limits the rate of the executions of a trigger
+type RateLimit struct {
+	// Unit is the unit of time of the rate limit, i.e. Second, Minute or Hour. Defaults to Second.
+	// +optional
+	Unit RateLimitUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit,casttype=RateLimitUnit"`
+	// RequestsPerUnit is the number of executions of the trigger allowed per unit of time,
+	// the executions beyond the limit are dropped. Defaults to no limit.
+	// +optional
+	RequestsPerUnit int32 `json:"requestsPerUnit,omitempty" protobuf:"varint,2,opt,name=requestsPerUnit"`
+	// Burst is the number of executions allowed at once. Defaults to RequestsPerUnit.
+	// +optional
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
+	// Debounce is a window in seconds opened by a resolution of the dependencies, during which the following resolutions
+	// are coalesced. At the end of the window, the trigger is executed once with the events of the latest resolution.
+	// Defaults to no debounce.
+	// +optional
+	Debounce int64 `json:"debounce,omitempty" protobuf:"varint,4,opt,name=debounce"`
 }
 
 // TriggerTemplate is the template that describes trigger specification.
@@ -376,6 +491,39 @@ type StandardK8STrigger struct {
 	// Only valid for operation type `update`
 	// +optional
 	LiveObject bool `json:"liveObject,omitempty" protobuf:"varint,6,opt,name=liveObject"`
+	// DeleteOptions configures the delete operation. The resource is deleted by the name of the resource artifact,
+	// or the resources are deleted by the label selector if one is specified.
+	// +optional
+	DeleteOptions *K8SDeleteOptions `json:"deleteOptions,omitempty" protobuf:"bytes,7,opt,name=deleteOptions"`
+	// ApplyOptions configures the apply operation.
+	// +optional
+	ApplyOptions *K8SApplyOptions `json:"applyOptions,omitempty" protobuf:"bytes,8,opt,name=applyOptions"`
+}
+
+// K8SDeleteOptions refers to the options of the delete operation of a Kubernetes trigger
+type K8SDeleteOptions struct {
+	// LabelSelector selects the resources to delete in the namespace of the resource artifact, rather than its name.
+	// It can be set from the events by the trigger parameters, with the destination "k8s.deleteOptions.labelSelector".
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,1,opt,name=labelSelector"`
+	// PropagationPolicy determines whether and how the dependents are garbage collected,
+	// one of "Orphan", "Background" or "Foreground". Defaults to the default policy of the resource.
+	// +optional
+	PropagationPolicy metav1.DeletionPropagation `json:"propagationPolicy,omitempty" protobuf:"bytes,2,opt,name=propagationPolicy,casttype=k8s.io/apimachinery/pkg/apis/meta/v1.DeletionPropagation"`
+	// GracePeriodSeconds is the duration in seconds before the resources are deleted.
+	// Defaults to the default grace period of the resource.
+	// +optional
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty" protobuf:"varint,3,opt,name=gracePeriodSeconds"`
+}
+
+// K8SApplyOptions refers to the options of the apply operation of a Kubernetes trigger
+type K8SApplyOptions struct {
+	// FieldManager is the name of the manager of the fields set by the trigger. Defaults to "argo-events".
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty" protobuf:"bytes,1,opt,name=fieldManager"`
+	// Force takes the ownership of the fields managed by other managers, rather than failing on the conflicts.
+	// +optional
+	Force bool `json:"force,omitempty" protobuf:"varint,2,opt,name=force"`
 }
 
 // ArgoWorkflowTrigger is the trigger for the Argo Workflow
@@ -391,6 +539,19 @@ type ArgoWorkflowTrigger struct {
 	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,3,rep,name=parameters"`
 	// The unambiguous kind of this object - used in order to retrieve the appropriate kubernetes api client for this resource
 	metav1.GroupVersionResource `json:",inline" protobuf:"bytes,4,opt,name=groupVersionResource"`
+	// SubmitFrom makes the submit operation create the workflow from the spec of a workflow template or a cron workflow,
+	// in the namespace of the workflow. The workflow of the source, if any, is laid over the spec, e.g. to set its
+	// name or arguments.
+	// +optional
+	SubmitFrom *WorkflowReference `json:"submitFrom,omitempty" protobuf:"bytes,5,opt,name=submitFrom"`
+}
+
+// WorkflowReference refers to a workflow template or a cron workflow
+type WorkflowReference struct {
+	// Kind of the resource, either "WorkflowTemplate" or "CronWorkflow"
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name of the resource
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
 }
 
 // HTTPTrigger is the trigger for the HTTP request
@@ -625,7 +786,7 @@ type TriggerParameter struct {
 type TriggerParameterSource struct {
 	// DependencyName refers to the name of the dependency. The event which is stored for this dependency is used as payload
 	// for the parameterization. Make sure to refer to one of the dependencies you have defined under Dependencies list.
-	DependencyName string `json:"dependencyName" protobuf:"bytes,1,opt,name=dependencyName"`
+	DependencyName string `json:"dependencyName,omitempty" protobuf:"bytes,1,opt,name=dependencyName"`
 	// ContextKey is the JSONPath of the event's (JSON decoded) context key
 	// ContextKey is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'.
 	// To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\'.
@@ -650,6 +811,12 @@ type TriggerParameterSource struct {
 	// This is only used if the DataKey is invalid.
 	// If the DataKey is invalid and this is not defined, this param source will produce an error.
 	Value *string `json:"value,omitempty" protobuf:"bytes,6,opt,name=value"`
+	// TriggerName refers to the name of a trigger the trigger depends on, in place of a dependency.
+	// The result of its execution is used as the data of the event, i.e. the created resource for the K8s and
+	// Argo Workflow triggers, the response body for the HTTP trigger and the payload of the response for the
+	// AWS Lambda and custom triggers.
+	// +optional
+	TriggerName string `json:"triggerName,omitempty" protobuf:"bytes,7,opt,name=triggerName"`
 }
 
 // TriggerPolicy dictates the policy for the trigger retries
@@ -658,6 +825,9 @@ type TriggerPolicy struct {
 	K8s *K8SResourcePolicy `json:"k8s,omitempty" protobuf:"bytes,1,opt,name=k8s"`
 	// Status refers to the policy used to check the state of the trigger using response status
 	Status *StatusPolicy `json:"status,omitempty" protobuf:"bytes,2,opt,name=status"`
+	// Completion refers to the policy used to wait for the resource created by a K8s based trigger to complete
+	// +optional
+	Completion *CompletionPolicy `json:"completion,omitempty" protobuf:"bytes,3,opt,name=completion"`
 }
 
 // K8SResourcePolicy refers to the policy used to check the state of K8s based triggers using using labels
@@ -671,6 +841,32 @@ type K8SResourcePolicy struct {
 	ErrorOnBackoffTimeout bool `json:"errorOnBackoffTimeout" protobuf:"varint,3,opt,name=errorOnBackoffTimeout"`
 }
 
+// CompletionPolicy refers to the policy used to wait for the resource created by a K8s based trigger to complete.
+// The resource is watched until either condition holds, or until the timeout is reached.
+// The conditions are a JSONPath, an operator (==, != or in) and a value, for example `.status.phase == Succeeded`
+// or `.status.phase in (Failed, Error)`.
+// A condition without operator holds if the value at the JSONPath is neither empty nor false.
+type CompletionPolicy struct {
+	// SuccessCondition determines whether the resource completed successfully
+	SuccessCondition string `json:"successCondition" protobuf:"bytes,1,opt,name=successCondition"`
+	// FailureCondition determines whether the resource failed
+	// +optional
+	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,2,opt,name=failureCondition"`
+	// Timeout in seconds to wait for the resource to complete. Defaults to 1 hour.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,3,opt,name=timeout"`
+}
+
+// CompletionOutcome is the outcome of the resource created by a trigger, as determined by its completion policy
+type CompletionOutcome string
+
+// possible outcomes of the completion policy
+const (
+	CompletionSucceeded CompletionOutcome = "Succeeded"
+	CompletionFailed    CompletionOutcome = "Failed"
+	CompletionTimedOut  CompletionOutcome = "TimedOut"
+)
+
 // StatusPolicy refers to the policy used to check the state of the trigger using response status
 type StatusPolicy struct {
 	// Allow refers to the list of allowed response statuses. If the response status of the the trigger is within the list,
@@ -717,6 +913,12 @@ type SensorStatus struct {
 	LastCycleTime metav1.Time `json:"lastCycleTime" protobuf:"bytes,8,opt,name=lastCycleTime"`
 	// Resources refers to metadata of the resources created for the sensor
 	Resources *SensorResources `json:"resources,omitempty" protobuf:"bytes,9,opt,name=resources"`
+	// DroppedTriggerExecutions is the count of trigger executions dropped because of the rate limit of a trigger.
+	// +optional
+	DroppedTriggerExecutions int64 `json:"droppedTriggerExecutions,omitempty" protobuf:"varint,10,opt,name=droppedTriggerExecutions"`
+	// CoalescedTriggerExecutions is the count of trigger executions coalesced into a later one by the debounce of a trigger.
+	// +optional
+	CoalescedTriggerExecutions int64 `json:"coalescedTriggerExecutions,omitempty" protobuf:"varint,11,opt,name=coalescedTriggerExecutions"`
 }
 
 // NodeStatus describes the status for an individual node in the sensor's FSM.
@@ -745,6 +947,18 @@ type NodeStatus struct {
 	UpdatedAt metav1.MicroTime `json:"updatedAt,omitempty" protobuf:"bytes,10,opt,name=updatedAt"`
 	// ResolvedAt refers to the time at which the node was resolved.
 	ResolvedAt metav1.MicroTime `json:"resolvedAt,omitempty" protobuf:"bytes,11,opt,name=resolvedAt"`
+	// Attempts is the number of attempts of the last execution of a trigger.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,12,opt,name=attempts"`
+	// ExpiresAt is the time at which the event of a dependency expires, according to the windows of its groups.
+	// +optional
+	ExpiresAt *metav1.MicroTime `json:"expiresAt,omitempty" protobuf:"bytes,13,opt,name=expiresAt"`
+	// CorrelationValue is the value of the correlation key shared by the events that resolved a dependency group.
+	// +optional
+	CorrelationValue string `json:"correlationValue,omitempty" protobuf:"bytes,14,opt,name=correlationValue"`
+	// Outcome is the outcome of the resource created by the last execution of a trigger, if the trigger has a completion policy.
+	// +optional
+	Outcome CompletionOutcome `json:"outcome,omitempty" protobuf:"bytes,15,opt,name=outcome,casttype=CompletionOutcome"`
 }
 
 // ArtifactLocation describes the source location for an external artifact
@@ -83,6 +83,11 @@ func (in *ArgoWorkflowTrigger) DeepCopyInto(out *ArgoWorkflowTrigger) {
 		}
 	}
 	out.GroupVersionResource = in.GroupVersionResource
+	if in.SubmitFrom != nil {
+		in, out := &in.SubmitFrom, &out.SubmitFrom
+		*out = new(WorkflowReference)
+		**out = **in
+	}
 	return
 }
 
@@ -173,6 +178,22 @@ func (in *BasicAuth) DeepCopy() *BasicAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompletionPolicy) DeepCopyInto(out *CompletionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompletionPolicy.
+func (in *CompletionPolicy) DeepCopy() *CompletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CompletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigmapArtifact) DeepCopyInto(out *ConfigmapArtifact) {
 	*out = *in
@@ -352,6 +373,11 @@ func (in *EventDependencyFilter) DeepCopyInto(out *EventDependencyFilter) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Exprs != nil {
+		in, out := &in.Exprs, &out.Exprs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -517,6 +543,43 @@ func (in *HTTPTrigger) DeepCopy() *HTTPTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8SApplyOptions) DeepCopyInto(out *K8SApplyOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SApplyOptions.
+func (in *K8SApplyOptions) DeepCopy() *K8SApplyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(K8SApplyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8SDeleteOptions) DeepCopyInto(out *K8SDeleteOptions) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SDeleteOptions.
+func (in *K8SDeleteOptions) DeepCopy() *K8SDeleteOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(K8SDeleteOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
 	*out = *in
@@ -639,6 +702,10 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	}
 	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
 	in.ResolvedAt.DeepCopyInto(&out.ResolvedAt)
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -687,6 +754,22 @@ func (in *OpenWhiskTrigger) DeepCopy() *OpenWhiskTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sensor) DeepCopyInto(out *Sensor) {
 	*out = *in
@@ -906,6 +989,16 @@ func (in *StandardK8STrigger) DeepCopyInto(out *StandardK8STrigger) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DeleteOptions != nil {
+		in, out := &in.DeleteOptions, &out.DeleteOptions
+		*out = new(K8SDeleteOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyOptions != nil {
+		in, out := &in.ApplyOptions, &out.ApplyOptions
+		*out = new(K8SApplyOptions)
+		**out = **in
+	}
 	return
 }
 
@@ -1056,6 +1149,21 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(TriggerPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]TriggerDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1069,6 +1177,22 @@ func (in *Trigger) DeepCopy() *Trigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerDependency) DeepCopyInto(out *TriggerDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerDependency.
+func (in *TriggerDependency) DeepCopy() *TriggerDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerParameter) DeepCopyInto(out *TriggerParameter) {
 	*out = *in
@@ -1124,6 +1248,11 @@ func (in *TriggerPolicy) DeepCopyInto(out *TriggerPolicy) {
 		*out = new(StatusPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Completion != nil {
+		in, out := &in.Completion, &out.Completion
+		*out = new(CompletionPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -1244,3 +1373,19 @@ func (in *URLArtifact) DeepCopy() *URLArtifact {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowReference) DeepCopyInto(out *WorkflowReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowReference.
+func (in *WorkflowReference) DeepCopy() *WorkflowReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowReference)
+	in.DeepCopyInto(out)
+	return out
+}
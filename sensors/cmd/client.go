@@ -21,8 +21,10 @@ import (
 	"os"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/metrics"
 	sv1 "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned"
 	"github.com/argoproj/argo-events/sensors"
+	"github.com/argoproj/argo-events/tracing"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
@@ -60,8 +62,19 @@ func main() {
 
 	dynamicClient := dynamic.NewForConfigOrDie(restConfig)
 
+	if err := tracing.Init("sensor"); err != nil {
+		panic(err)
+	}
+
 	// wait for sensor http server to shutdown
 	sensorExecutionCtx := sensors.NewSensorContext(sensorClient, kubeClient, dynamicClient, sensor, controllerInstanceID)
+
+	go func() {
+		if err := metrics.Serve(metrics.Port(common.DefaultMetricsPort)); err != nil {
+			sensorExecutionCtx.Logger.WithError(err).Errorln("failed to serve the metrics")
+		}
+	}()
+
 	if err := sensorExecutionCtx.ListenEvents(); err != nil {
 		sensorExecutionCtx.Logger.WithError(err).Errorln("failed to listen to events")
 		os.Exit(-1)
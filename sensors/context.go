@@ -16,21 +16,16 @@ limitations under the License.
 package sensors
 
 import (
-	"net/http"
-	"time"
+	"sync"
 
-	"github.com/Shopify/sarama"
-	"github.com/apache/openwhisk-client-go/whisk"
-	"github.com/aws/aws-sdk-go/service/lambda"
-	natslib "github.com/nats-io/go-nats"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	sensorclientset "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned"
+	"github.com/argoproj/argo-events/sensors/triggers"
 	"github.com/argoproj/argo-events/sensors/types"
 )
 
@@ -52,20 +47,21 @@ type SensorContext struct {
 	ControllerInstanceID string
 	// Updated indicates update to Sensor resource
 	Updated bool
-	// httpClients holds the reference to HTTP clients for HTTP triggers.
-	httpClients map[string]*http.Client
-	// customTriggerClients holds the references to the gRPC clients for the custom trigger servers
-	customTriggerClients map[string]*grpc.ClientConn
-	// http client to send slack messages.
-	slackHttpClient *http.Client
-	// kafkaProducers holds references to the active kafka producers
-	kafkaProducers map[string]sarama.AsyncProducer
-	// natsConnections holds the references to the active nats connections.
-	natsConnections map[string]*natslib.Conn
-	// awsLambdaClients holds the references to active AWS Lambda clients.
-	awsLambdaClients map[string]*lambda.Lambda
-	// openwhiskClients holds the references to active OpenWhisk clients.
-	openwhiskClients map[string]*whisk.Client
+	// triggerClients holds the clients shared by the triggers
+	triggerClients *triggers.Clients
+	// lock protects the Sensor object, which is updated both by the processing of the notifications
+	// and by the trigger cycles completing in the background.
+	lock sync.Mutex
+	// triggerLock protects the stores of the trigger clients, which are filled in when the triggers are resolved
+	triggerLock sync.Mutex
+	// triggerPool executes the triggers once the dependencies are resolved
+	triggerPool *triggerPool
+	// triggerLimiters holds the limiters of the rate limited triggers
+	triggerLimiters map[string]*triggerLimiter
+	// skippedExecutions counts the trigger executions skipped because of the rate limits
+	skippedExecutions skippedExecutions
+	// eventBus is the subscription to the eventbus, if any. It's protected by the sensor lock.
+	eventBus *eventBusSubscription
 }
 
 // NewSensorContext returns a new sensor execution context.
@@ -78,14 +74,8 @@ func NewSensorContext(sensorClient sensorclientset.Interface, kubeClient kuberne
 		Logger:               common.NewArgoEventsLogger().WithField(common.LabelSensorName, sensor.Name).Logger,
 		NotificationQueue:    make(chan *types.Notification),
 		ControllerInstanceID: controllerInstanceID,
-		httpClients:          make(map[string]*http.Client),
-		customTriggerClients: make(map[string]*grpc.ClientConn),
-		slackHttpClient: &http.Client{
-			Timeout: time.Minute * 5,
-		},
-		kafkaProducers:   make(map[string]sarama.AsyncProducer),
-		natsConnections:  make(map[string]*natslib.Conn),
-		awsLambdaClients: make(map[string]*lambda.Lambda),
-		openwhiskClients: make(map[string]*whisk.Client),
+		triggerClients:       triggers.NewClients(kubeClient, dynamicClient),
+		triggerPool:          newTriggerPool(sensor.Spec.TriggerConcurrency),
+		triggerLimiters:      make(map[string]*triggerLimiter),
 	}
 }
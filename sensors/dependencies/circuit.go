@@ -17,9 +17,13 @@ limitations under the License.
 package dependencies
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/Knetic/govaluate"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
 
 	snctrl "github.com/argoproj/argo-events/controllers/sensor"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
@@ -28,6 +32,8 @@ import (
 // ResolveCircuit resolves a circuit
 func ResolveCircuit(sensor *v1alpha1.Sensor, logger *logrus.Logger) (bool, []string, error) {
 	if sensor.Spec.DependencyGroups != nil {
+		expireDependencies(sensor, time.Now().UTC(), logger)
+
 		groups := make(map[string]interface{}, len(sensor.Spec.DependencyGroups))
 	group:
 		for _, group := range sensor.Spec.DependencyGroups {
@@ -37,6 +43,14 @@ func ResolveCircuit(sensor *v1alpha1.Sensor, logger *logrus.Logger) (bool, []str
 					continue group
 				}
 			}
+			if group.CorrelationKey != "" {
+				value, ok := correlate(sensor, group, logger)
+				if !ok {
+					groups[group.Name] = false
+					continue group
+				}
+				snctrl.MarkCorrelationValue(sensor, group.Name, value)
+			}
 			snctrl.MarkNodePhase(sensor, group.Name, v1alpha1.NodeTypeDependencyGroup, v1alpha1.NodePhaseComplete, nil, logger, "dependency group is complete")
 			groups[group.Name] = true
 		}
@@ -69,3 +83,80 @@ func ResolveCircuit(sensor *v1alpha1.Sensor, logger *logrus.Logger) (bool, []str
 	}
 	return false, nil, nil
 }
+
+// expireDependencies expires the events of the dependencies that are older than the windows of their groups.
+// A dependency shared by several groups expires with the shortest window.
+func expireDependencies(sensor *v1alpha1.Sensor, now time.Time, logger *logrus.Logger) {
+	windows := make(map[string]time.Duration)
+	for _, group := range sensor.Spec.DependencyGroups {
+		if group.Window <= 0 {
+			continue
+		}
+		window := time.Duration(group.Window) * time.Second
+		for _, dependency := range group.Dependencies {
+			if w, ok := windows[dependency]; !ok || window < w {
+				windows[dependency] = window
+			}
+		}
+	}
+
+	for dependency, window := range windows {
+		node := snctrl.GetNodeByName(sensor, dependency)
+		if node == nil || node.Phase != v1alpha1.NodePhaseComplete {
+			continue
+		}
+		expiresAt := node.CompletedAt.Add(window)
+		if now.After(expiresAt) {
+			snctrl.ExpireNode(sensor, dependency, logger, fmt.Sprintf("event expired at %s", expiresAt.UTC().Format(time.RFC3339)))
+			continue
+		}
+		snctrl.MarkExpiresAt(sensor, dependency, expiresAt)
+	}
+}
+
+// correlate returns the value of the correlation key of the group if the events of its dependencies hold the same one.
+// Otherwise, the events that don't hold the value of the latest event are expired.
+func correlate(sensor *v1alpha1.Sensor, group v1alpha1.DependencyGroup, logger *logrus.Logger) (string, bool) {
+	values := make(map[string]string, len(group.Dependencies))
+	// latest is the latest event holding a value of the correlation key
+	var latest *v1alpha1.NodeStatus
+	for _, dependency := range group.Dependencies {
+		node := snctrl.GetNodeByName(sensor, dependency)
+		if node == nil {
+			return "", false
+		}
+		value, ok := correlationValue(node.Event, group.CorrelationKey)
+		if !ok {
+			continue
+		}
+		values[dependency] = value
+		if latest == nil || node.CompletedAt.After(latest.CompletedAt.Time) {
+			latest = node
+		}
+	}
+
+	var value string
+	if latest != nil {
+		value = values[latest.Name]
+	}
+	correlated := true
+	for _, dependency := range group.Dependencies {
+		if v, ok := values[dependency]; !ok || v != value {
+			snctrl.ExpireNode(sensor, dependency, logger, fmt.Sprintf("event doesn't match the correlation key %s of the group %s", group.CorrelationKey, group.Name))
+			correlated = false
+		}
+	}
+	return value, correlated
+}
+
+// correlationValue returns the value at the path of the correlation key in the event data
+func correlationValue(event *v1alpha1.Event, key string) (string, bool) {
+	if event == nil || event.Data == nil {
+		return "", false
+	}
+	result := gjson.GetBytes(event.Data, key)
+	if !result.Exists() {
+		return "", false
+	}
+	return result.String(), true
+}
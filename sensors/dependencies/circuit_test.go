@@ -18,6 +18,7 @@ package dependencies
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -168,3 +169,99 @@ func TestResolveCircuit(t *testing.T) {
 		})
 	}
 }
+
+// markEvent marks the dependency as complete with an event received at the given time
+func markEvent(obj *v1alpha1.Sensor, dependency string, data string, receivedAt time.Time) {
+	logger := common.NewArgoEventsLogger()
+	event := &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{},
+		Data:    []byte(data),
+	}
+	snctrl.MarkNodePhase(obj, dependency, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, event, logger, "dependency is complete")
+	snctrl.MarkUpdatedAt(obj, dependency)
+	node := snctrl.GetNodeByName(obj, dependency)
+	node.CompletedAt = metav1.MicroTime{Time: receivedAt}
+	obj.Status.Nodes[node.ID] = *node
+}
+
+func TestResolveCircuitWithWindow(t *testing.T) {
+	logger := common.NewArgoEventsLogger()
+	obj := sensorObj.DeepCopy()
+	obj.Spec.DependencyGroups = []v1alpha1.DependencyGroup{
+		{
+			Name:         "group1",
+			Dependencies: []string{"dep-1", "dep-2"},
+			Window:       600,
+		},
+	}
+	obj.Spec.Circuit = "group1"
+	for _, dependency := range obj.Spec.Dependencies {
+		snctrl.InitializeNode(obj, dependency.Name, v1alpha1.NodeTypeEventDependency, logger)
+	}
+	snctrl.InitializeNode(obj, "group1", v1alpha1.NodeTypeDependencyGroup, logger)
+
+	// the event of dep-1 is older than the window
+	now := time.Now().UTC()
+	markEvent(obj, "dep-1", "{}", now.Add(-20*time.Minute))
+	markEvent(obj, "dep-2", "{}", now)
+	ok, snapshot, err := ResolveCircuit(obj, logger)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, snapshot)
+	node := snctrl.GetNodeByName(obj, "dep-1")
+	assert.Equal(t, v1alpha1.NodePhaseActive, node.Phase)
+	assert.Contains(t, node.Message, "expired")
+	assert.False(t, snctrl.IsDependencyResolved(obj, "dep-1"))
+
+	// both events are within the window
+	markEvent(obj, "dep-1", "{}", now.Add(-5*time.Minute))
+	ok, snapshot, err = ResolveCircuit(obj, logger)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{"dep-1", "dep-2"}, snapshot)
+	node = snctrl.GetNodeByName(obj, "dep-1")
+	assert.NotNil(t, node.ExpiresAt)
+	assert.Equal(t, now.Add(5*time.Minute).Unix(), node.ExpiresAt.Unix())
+}
+
+func TestResolveCircuitWithCorrelationKey(t *testing.T) {
+	logger := common.NewArgoEventsLogger()
+	obj := sensorObj.DeepCopy()
+	obj.Spec.DependencyGroups = []v1alpha1.DependencyGroup{
+		{
+			Name:           "group1",
+			Dependencies:   []string{"dep-1", "dep-2"},
+			CorrelationKey: "body.commit.sha",
+		},
+	}
+	obj.Spec.Circuit = "group1"
+	for _, dependency := range obj.Spec.Dependencies {
+		snctrl.InitializeNode(obj, dependency.Name, v1alpha1.NodeTypeEventDependency, logger)
+	}
+	snctrl.InitializeNode(obj, "group1", v1alpha1.NodeTypeDependencyGroup, logger)
+
+	// the events are for different commits, the older one is expired
+	now := time.Now().UTC()
+	markEvent(obj, "dep-1", `{"body": {"commit": {"sha": "abc"}}}`, now.Add(-time.Minute))
+	markEvent(obj, "dep-2", `{"body": {"commit": {"sha": "def"}}}`, now)
+	ok, _, err := ResolveCircuit(obj, logger)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, v1alpha1.NodePhaseActive, snctrl.GetNodeByName(obj, "dep-1").Phase)
+	assert.Equal(t, v1alpha1.NodePhaseComplete, snctrl.GetNodeByName(obj, "dep-2").Phase)
+
+	// an event without the correlation key is expired
+	markEvent(obj, "dep-1", `{"body": {}}`, now)
+	ok, _, err = ResolveCircuit(obj, logger)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, v1alpha1.NodePhaseActive, snctrl.GetNodeByName(obj, "dep-1").Phase)
+
+	// the events are for the same commit
+	markEvent(obj, "dep-1", `{"body": {"commit": {"sha": "def"}}}`, now.Add(time.Second))
+	ok, snapshot, err := ResolveCircuit(obj, logger)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{"dep-1", "dep-2"}, snapshot)
+	assert.Equal(t, "def", snctrl.GetNodeByName(obj, "group1").CorrelationValue)
+}
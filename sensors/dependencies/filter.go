@@ -56,8 +56,12 @@ func filterEvent(filter *v1alpha1.EventDependencyFilter, event *v1alpha1.Event)
 		return false, err
 	}
 	ctxFilter := filterContext(filter.Context, event.Context)
+	exprFilter, err := filterExprs(filter.Exprs, event)
+	if err != nil {
+		return false, err
+	}
 
-	return timeFilter && ctxFilter && dataFilter, err
+	return timeFilter && ctxFilter && dataFilter && exprFilter, err
 }
 
 // applyTimeFilter checks the eventTime against the timeFilter:
@@ -226,3 +230,53 @@ filter:
 	}
 	return true, nil
 }
+
+// filterExprs evaluates the expressions over the event context and data
+// returns (true, nil) when all the expressions are true, false otherwise
+func filterExprs(exprs []string, event *v1alpha1.Event) (bool, error) {
+	if exprs == nil {
+		return true, nil
+	}
+	if event == nil {
+		return false, fmt.Errorf("nil Event")
+	}
+	variables, err := exprVariables(event)
+	if err != nil {
+		return false, err
+	}
+	for _, expression := range exprs {
+		ok, err := common.EvalExpr(expression, variables)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// exprVariables returns the variables of the filter expressions, i.e. the event data as `data` and the event context
+// as `context`
+func exprVariables(event *v1alpha1.Event) (map[string]interface{}, error) {
+	variables := make(map[string]interface{})
+	if len(event.Data) > 0 {
+		var data interface{}
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the event data, the filter expressions only apply to JSON data")
+		}
+		variables["data"] = data
+	}
+	if event.Context != nil {
+		ctxBytes, err := json.Marshal(event.Context)
+		if err != nil {
+			return nil, err
+		}
+		var ctx map[string]interface{}
+		if err := json.Unmarshal(ctxBytes, &ctx); err != nil {
+			return nil, err
+		}
+		variables["context"] = ctx
+	}
+	return variables, nil
+}
@@ -381,3 +381,47 @@ func TestFilterEvent(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, valid, true)
 }
+
+func TestFilterExprs(t *testing.T) {
+	event := &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			Type:            "webhook",
+			SpecVersion:     "0.3",
+			Source:          "webhook-gateway",
+			ID:              "1",
+			Time:            metav1.Time{Time: time.Now().UTC()},
+			DataContentType: "application/json",
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"body": {"action": "opened", "number": 42, "labels": ["bug", "urgent"], "pull_request": {"base": {"ref": "release/v1.0"}}}}`),
+	}
+
+	tests := []struct {
+		exprs  []string
+		result bool
+	}{
+		{nil, true},
+		{[]string{`data.body.action == "opened" && (data.body.pull_request.base.ref matches "^release/")`}, true},
+		{[]string{`data.body.action == "closed" || data.body.number > 40`}, true},
+		{[]string{`!(data.body.action == "opened")`}, false},
+		{[]string{`"urgent" in data.body.labels`, `data.body.action in ["opened", "reopened"]`}, true},
+		{[]string{`"urgent" in data.body.labels`, `data.body.number < 10`}, false},
+		{[]string{`context.source == "webhook-gateway" && context.subject == "example-1"`}, true},
+		{[]string{`upper(data.body.action) == "OPENED" && hasPrefix(data.body.pull_request.base.ref, "release/")`}, true},
+		{[]string{`data.body.missing == "value"`}, false},
+	}
+	for _, test := range tests {
+		ok, err := filterExprs(test.exprs, event)
+		assert.Nil(t, err)
+		assert.Equal(t, test.result, ok, "%v", test.exprs)
+	}
+
+	_, err := filterExprs([]string{`data.body.missing.field == "value"`}, event)
+	assert.NotNil(t, err)
+
+	// a field of the data named after the context doesn't hide the event context
+	event.Data = []byte(`{"context": "pull_request"}`)
+	ok, err := filterExprs([]string{`data.context == "pull_request" && context.source == "webhook-gateway"`}, event)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
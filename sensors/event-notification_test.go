@@ -17,9 +17,14 @@ limitations under the License.
 package sensors
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -33,6 +38,8 @@ import (
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	sensorFake "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/policy"
+	"github.com/argoproj/argo-events/sensors/triggers"
 	"github.com/argoproj/argo-events/sensors/types"
 )
 
@@ -312,13 +319,15 @@ func TestOperateEventNotification(t *testing.T) {
 		Resource: &artifact,
 	}
 
-	err := sensorCtx.operateEventNotification(&types.Notification{
+	dispatched, err := sensorCtx.operateEventNotification(&types.Notification{
 		Event:            event,
 		EventDependency:  &obj.Spec.Dependencies[0],
 		Sensor:           obj,
 		NotificationType: v1alpha1.EventNotification,
 	})
 	assert.Nil(t, err)
+	assert.True(t, dispatched)
+	sensorCtx.triggerPool.wait()
 
 	assert.Equal(t, v1alpha1.NodePhaseActive, obj.Status.Nodes[dep1].Phase)
 
@@ -358,12 +367,320 @@ func TestOperateEventNotification(t *testing.T) {
 		},
 	}
 
-	err = sensorCtx.operateEventNotification(&types.Notification{
+	dispatched, err = sensorCtx.operateEventNotification(&types.Notification{
 		Event:            event,
 		EventDependency:  &obj.Spec.Dependencies[0],
 		Sensor:           obj,
 		NotificationType: v1alpha1.EventNotification,
 	})
 	assert.NotNil(t, err)
+	assert.False(t, dispatched)
 	assert.Equal(t, v1alpha1.NodePhaseError, obj.Status.Nodes[dep1].Phase)
 }
+
+func TestRetryTrigger(t *testing.T) {
+	attempts, err := retryTrigger(context.Background(), nil, func(attempt int32) error {
+		return errors.New("failed")
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), attempts)
+
+	attempts, err = retryTrigger(context.Background(), &apicommon.Backoff{
+		Duration: time.Millisecond,
+		Factor:   apicommon.NewAmount("1"),
+		Steps:    5,
+	}, func(attempt int32) error {
+		if attempt < 3 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), attempts)
+}
+
+func TestRetryTriggerNonRetryable(t *testing.T) {
+	attempts, err := retryTrigger(context.Background(), &apicommon.Backoff{
+		Duration: time.Millisecond,
+		Factor:   apicommon.NewAmount("1"),
+		Steps:    5,
+	}, func(attempt int32) error {
+		return triggers.NonRetryable(errors.New("failed"))
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), attempts)
+
+	attempts, err = retryTrigger(context.Background(), &apicommon.Backoff{
+		Duration: time.Millisecond,
+		Factor:   apicommon.NewAmount("1"),
+		Steps:    5,
+	}, func(attempt int32) error {
+		return errors.Wrap(&policy.CompletionError{Outcome: v1alpha1.CompletionFailed, Message: "failed"}, "failed")
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), attempts)
+}
+
+func TestExecuteTriggerWithRetry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-http-trigger",
+				HTTP: &v1alpha1.HTTPTrigger{
+					URL:    server.URL,
+					Method: http.MethodGet,
+				},
+			},
+			Policy: &v1alpha1.TriggerPolicy{
+				Status: &v1alpha1.StatusPolicy{
+					Allow: []int32{http.StatusOK},
+				},
+			},
+		},
+	}
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(), fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj, "1")
+	snctrl.InitializeNode(obj, "fake-http-trigger", v1alpha1.NodeTypeTrigger, sensorCtx.Logger)
+
+	// without a retry strategy, the trigger fails on the first error
+	_, err := sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	node := snctrl.GetNodeByName(obj, "fake-http-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseError, node.Phase)
+	assert.Equal(t, int32(1), node.Attempts)
+
+	obj.Spec.Triggers[0].RetryStrategy = &apicommon.Backoff{
+		Duration: time.Millisecond,
+		Factor:   apicommon.NewAmount("2"),
+		Steps:    3,
+	}
+	_, err = sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.Nil(t, err)
+	node = snctrl.GetNodeByName(obj, "fake-http-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseComplete, node.Phase)
+	assert.Equal(t, int32(2), node.Attempts)
+}
+
+func TestExecuteTriggerWithClientError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-http-trigger",
+				HTTP: &v1alpha1.HTTPTrigger{
+					URL:    server.URL,
+					Method: http.MethodGet,
+				},
+			},
+			Policy: &v1alpha1.TriggerPolicy{
+				Status: &v1alpha1.StatusPolicy{
+					Allow: []int32{http.StatusOK},
+				},
+			},
+			RetryStrategy: &apicommon.Backoff{
+				Duration: time.Millisecond,
+				Factor:   apicommon.NewAmount("1"),
+				Steps:    3,
+			},
+		},
+	}
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(), fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj, "1")
+	snctrl.InitializeNode(obj, "fake-http-trigger", v1alpha1.NodeTypeTrigger, sensorCtx.Logger)
+
+	// the request would be answered with the same status, it isn't retried
+	_, err := sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	node := snctrl.GetNodeByName(obj, "fake-http-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseError, node.Phase)
+	assert.Equal(t, int32(1), node.Attempts)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestExecuteTriggerPolicyNotRetried(t *testing.T) {
+	configMap := newUnstructured("v1", "ConfigMap", "fake", "fake-config")
+	resource := apicommon.NewResource(configMap)
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-k8s-trigger",
+				K8s: &v1alpha1.StandardK8STrigger{
+					GroupVersionResource: metav1.GroupVersionResource{
+						Version:  "v1",
+						Resource: "configmaps",
+					},
+					Source: &v1alpha1.ArtifactLocation{
+						Resource: &resource,
+					},
+					Operation: v1alpha1.Create,
+				},
+			},
+			Policy: &v1alpha1.TriggerPolicy{
+				K8s: &v1alpha1.K8SResourcePolicy{
+					Labels: map[string]string{"complete": "true"},
+					Backoff: apicommon.Backoff{
+						Duration: time.Millisecond,
+						Factor:   apicommon.NewAmount("1"),
+						Steps:    2,
+					},
+					ErrorOnBackoffTimeout: true,
+				},
+			},
+			RetryStrategy: &apicommon.Backoff{
+				Duration: time.Millisecond,
+				Factor:   apicommon.NewAmount("1"),
+				Steps:    3,
+			},
+		},
+	}
+	dynamicClient := dfake.NewSimpleDynamicClient(runtime.NewScheme())
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(), fake.NewSimpleClientset(), dynamicClient, obj, "1")
+	snctrl.InitializeNode(obj, "fake-k8s-trigger", v1alpha1.NodeTypeTrigger, sensorCtx.Logger)
+
+	// the policy fails, the config map isn't created again
+	_, err := sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	node := snctrl.GetNodeByName(obj, "fake-k8s-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseError, node.Phase)
+	assert.Equal(t, int32(1), node.Attempts)
+	assert.Equal(t, 1, countActions(dynamicClient, "create"))
+}
+
+// countActions returns the number of requests of the verb made with the fake dynamic client
+func countActions(client *dfake.FakeDynamicClient, verb string) int {
+	count := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == verb {
+			count++
+		}
+	}
+	return count
+}
+
+func TestExecuteTriggerWithCompletion(t *testing.T) {
+	wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+	wf.Object["status"] = map[string]interface{}{"phase": "Failed"}
+	resource := apicommon.NewResource(wf)
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-k8s-trigger",
+				K8s: &v1alpha1.StandardK8STrigger{
+					GroupVersionResource: metav1.GroupVersionResource{
+						Group:    "argoproj.io",
+						Version:  "v1alpha1",
+						Resource: "workflows",
+					},
+					Source: &v1alpha1.ArtifactLocation{
+						Resource: &resource,
+					},
+					Operation: v1alpha1.Create,
+				},
+			},
+			Policy: &v1alpha1.TriggerPolicy{
+				Completion: &v1alpha1.CompletionPolicy{
+					SuccessCondition: ".status.phase == Succeeded",
+					FailureCondition: ".status.phase in (Failed, Error)",
+					Timeout:          1,
+				},
+			},
+		},
+	}
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(), fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj, "1")
+	snctrl.InitializeNode(obj, "fake-k8s-trigger", v1alpha1.NodeTypeTrigger, sensorCtx.Logger)
+
+	_, err := sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	node := snctrl.GetNodeByName(obj, "fake-k8s-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseError, node.Phase)
+	assert.Equal(t, v1alpha1.CompletionFailed, node.Outcome)
+
+	// the created workflow completed successfully
+	wf.Object["status"] = map[string]interface{}{"phase": "Succeeded"}
+	wf.SetName("succeeded")
+	resource = apicommon.NewResource(wf)
+	_, err = sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.Nil(t, err)
+	node = snctrl.GetNodeByName(obj, "fake-k8s-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseComplete, node.Phase)
+	assert.Equal(t, v1alpha1.CompletionSucceeded, node.Outcome)
+
+	// the created workflow never completes
+	delete(wf.Object, "status")
+	wf.SetName("running")
+	resource = apicommon.NewResource(wf)
+	_, err = sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	node = snctrl.GetNodeByName(obj, "fake-k8s-trigger")
+	assert.Equal(t, v1alpha1.CompletionTimedOut, node.Outcome)
+}
+
+func TestExecuteTriggerWithCompletionAndRetry(t *testing.T) {
+	wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+	wf.Object["status"] = map[string]interface{}{"phase": "Failed"}
+	resource := apicommon.NewResource(wf)
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-k8s-trigger",
+				K8s: &v1alpha1.StandardK8STrigger{
+					GroupVersionResource: metav1.GroupVersionResource{
+						Group:    "argoproj.io",
+						Version:  "v1alpha1",
+						Resource: "workflows",
+					},
+					Source: &v1alpha1.ArtifactLocation{
+						Resource: &resource,
+					},
+					Operation: v1alpha1.Create,
+				},
+			},
+			Policy: &v1alpha1.TriggerPolicy{
+				Completion: &v1alpha1.CompletionPolicy{
+					SuccessCondition: ".status.phase == Succeeded",
+					FailureCondition: ".status.phase in (Failed, Error)",
+					Timeout:          1,
+				},
+			},
+			RetryStrategy: &apicommon.Backoff{
+				Duration: time.Millisecond,
+				Factor:   apicommon.NewAmount("1"),
+				Steps:    3,
+			},
+		},
+	}
+	dynamicClient := dfake.NewSimpleDynamicClient(runtime.NewScheme())
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(), fake.NewSimpleClientset(), dynamicClient, obj, "1")
+	snctrl.InitializeNode(obj, "fake-k8s-trigger", v1alpha1.NodeTypeTrigger, sensorCtx.Logger)
+
+	// the workflow failed, it isn't submitted again
+	_, err := sensorCtx.executeTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	node := snctrl.GetNodeByName(obj, "fake-k8s-trigger")
+	assert.Equal(t, v1alpha1.NodePhaseError, node.Phase)
+	assert.Equal(t, v1alpha1.CompletionFailed, node.Outcome)
+	assert.Equal(t, int32(1), node.Attempts)
+	assert.Equal(t, 1, countActions(dynamicClient, "create"))
+}
@@ -17,15 +17,26 @@ limitations under the License.
 package sensors
 
 import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/argoproj/argo-events/common"
 	snctrl "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sensors/dependencies"
+	"github.com/argoproj/argo-events/sensors/policy"
 	"github.com/argoproj/argo-events/sensors/triggers"
 	"github.com/argoproj/argo-events/sensors/types"
+	"github.com/argoproj/argo-events/tracing"
 )
 
 // isEligibleForExecution determines whether the dependencies are met and triggers are eligible for execution
@@ -46,11 +57,15 @@ func isEligibleForExecution(sensor *v1alpha1.Sensor, logger *logrus.Logger) (boo
 	return false, nil, nil
 }
 
-// OperateEventNotifications operates on an event notification
-func (sensorCtx *SensorContext) operateEventNotification(notification *types.Notification) error {
+// OperateEventNotifications operates on an event notification.
+// It returns true if the dependencies are resolved and the triggers are dispatched.
+func (sensorCtx *SensorContext) operateEventNotification(notification *types.Notification) (bool, error) {
 	nodeName := notification.EventDependency.Name
-	logger := sensorCtx.Logger.WithField(common.LabelEventSource, notification.Event.Context.Source)
+	// the subject of the event is the name of the event within its event source, as labelled by the gateways
+	eventName := notification.Event.Context.Subject
+	logger := sensorCtx.Logger.WithField(common.LabelEventSource, eventName)
 	logger.Info("received an event notification")
+	metrics.SensorEventReceived(sensorCtx.Sensor.Name, eventName, nodeName)
 
 	snctrl.MarkNodePhase(sensorCtx.Sensor, nodeName, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseComplete, notification.Event, sensorCtx.Logger, "event is received")
 	snctrl.MarkUpdatedAt(sensorCtx.Sensor, nodeName)
@@ -58,87 +73,290 @@ func (sensorCtx *SensorContext) operateEventNotification(notification *types.Not
 	// Apply filters
 	logger.Infoln("applying filters on event notifications if any")
 	if err := dependencies.ApplyFilter(notification); err != nil {
+		metrics.SensorFilterRejected(sensorCtx.Sensor.Name, eventName, nodeName)
 		snctrl.MarkNodePhase(sensorCtx.Sensor, nodeName, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseError, nil, sensorCtx.Logger, err.Error())
-		return err
+		return false, err
 	}
 
 	// Apply Circuit if any or check if all dependencies are resolved
 	logger.Infoln("applying circuit logic if any or checking if all dependencies are resolved")
 	ok, snapshot, err := isEligibleForExecution(sensorCtx.Sensor, sensorCtx.Logger)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if !ok {
 		sensorCtx.Logger.Infoln("dependencies are not yet resolved, won't execute triggers")
-		return nil
+		if notification.Span != nil {
+			notification.Span.Annotate(nil, "dependencies are not yet resolved")
+		}
+		return false, nil
+	}
+	metrics.SensorDependenciesResolved(sensorCtx.Sensor.Name)
+
+	// the triggers are executed against a copy of the sensor holding the events of this resolution,
+	// so that the next events can be processed while they are executed.
+	sensor := sensorCtx.Sensor.DeepCopy()
+
+	// process snapshot dependencies
+	for _, dependency := range snapshot {
+		// resolve dependencies
+		snctrl.MarkResolvedAt(sensorCtx.Sensor, dependency)
+		// Mark snapshot dependency nodes as active
+		snctrl.MarkNodePhase(sensorCtx.Sensor, dependency, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, sensorCtx.Logger, "dependency is re-activated")
+	}
+	// Mark all dependency groups as active
+	for _, group := range sensorCtx.Sensor.Spec.DependencyGroups {
+		snctrl.MarkNodePhase(sensorCtx.Sensor, group.Name, v1alpha1.NodeTypeDependencyGroup, v1alpha1.NodePhaseActive, nil, sensorCtx.Logger, "dependency group is re-activated")
 	}
 
 	logger.Infoln("executing triggers")
-	// Iterate over each trigger,
-	// 1. Apply template level parameters
-	// 2. Check if switches are resolved
-	// 3. Fetch the resource
-	// 4. Apply resource level parameters
-	// 5. Execute the trigger
-	// 6. If any policy is set, apply it
-	for _, trigger := range sensorCtx.Sensor.Spec.Triggers {
-		if err := triggers.ApplyTemplateParameters(sensorCtx.Sensor, &trigger); err != nil {
-			return err
+	sensorCtx.dispatchTriggers(sensor, notification)
+	return true, nil
+}
+
+// dispatchTriggers executes the triggers of the sensor snapshot on the trigger pool, subject to their rate limits.
+// The triggers without dependencies are submitted in the order of the spec, so the pool executes them in that order.
+// The triggers depending on other triggers are executed once those are done, if the conditions of their dependencies hold.
+// Once all of them are executed, or skipped, the trigger cycle is recorded and the notification completed.
+func (sensorCtx *SensorContext) dispatchTriggers(sensor *v1alpha1.Sensor, notification *types.Notification) {
+	ctx := context.Background()
+	if notification.Span != nil {
+		ctx = trace.NewContext(ctx, notification.Span)
+	}
+
+	sensorCtx.triggerPool.cycles.Add(1)
+	var wg sync.WaitGroup
+	errs := make([]error, len(sensor.Spec.Triggers))
+	dag := newTriggerDAG(sensor, sensorCtx.Logger)
+	for i, trigger := range sensor.Spec.Triggers {
+		i, trigger := i, trigger
+		wg.Add(1)
+		skip := func() {
+			defer wg.Done()
+			dag.complete(i, triggerSkipped, nil)
 		}
-		if ok := triggers.ApplySwitches(sensorCtx.Sensor, &trigger); !ok {
-			logger.Infoln("switches/group level when conditions were not resolved, won't execute the trigger")
-			continue
+		execute := func() {
+			sensorCtx.triggerPool.submit(trigger.Template.Name, trigger.Ordered, func() {
+				defer wg.Done()
+				result, err := sensorCtx.runTrigger(ctx, dag.sensorWithResults(&trigger), trigger)
+				errs[i] = err
+				switch {
+				case err != nil:
+					dag.complete(i, triggerFailed, nil)
+				case result == nil:
+					dag.complete(i, triggerSkipped, nil)
+				default:
+					dag.complete(i, triggerSucceeded, result)
+				}
+			})
 		}
-
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("resolving the trigger implementation")
-		triggerImpl := sensorCtx.GetTrigger(&trigger)
-		if triggerImpl == nil {
-			logger.WithField("trigger-name", trigger.Template.Name).Errorln("failed to get the specific trigger implementation. continuing to next trigger if any")
+		// the limiter is resolved here, the limiters of the sensor context are not safe for concurrent use
+		limiter := sensorCtx.getTriggerLimiter(&trigger)
+		schedule := func() {
+			if limiter != nil {
+				limiter.schedule(execute, skip)
+				return
+			}
+			execute()
+		}
+		if len(trigger.DependsOn) == 0 {
+			schedule()
 			continue
 		}
+		go func() {
+			if reason := dag.wait(&trigger); reason != "" {
+				sensorCtx.Logger.WithField("trigger-name", trigger.Template.Name).Infof("won't execute the trigger, %s", reason)
+				skip()
+				return
+			}
+			schedule()
+		}()
+	}
 
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("fetching trigger resource if any")
-		obj, err := triggerImpl.FetchResource()
-		if err != nil {
-			return err
+	go func() {
+		defer sensorCtx.triggerPool.cycles.Done()
+		wg.Wait()
+
+		var failed []string
+		for i, err := range errs {
+			if err != nil {
+				sensorCtx.Logger.WithError(err).WithField("trigger-name", sensor.Spec.Triggers[i].Template.Name).Errorln("failed to execute the trigger")
+				failed = append(failed, sensor.Spec.Triggers[i].Template.Name)
+			}
 		}
-		if obj == nil {
-			logger.WithField("trigger-name", trigger.Template.Name).Warnln("trigger resource is empty")
-			continue
+		var err error
+		if len(failed) > 0 {
+			err = errors.Errorf("failed to execute the triggers %s", strings.Join(failed, ", "))
 		}
 
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("applying resource parameters if any")
-		updatedObj, err := triggerImpl.ApplyResourceParameters(sensorCtx.Sensor, obj)
-		if err != nil {
-			return err
+		sensorCtx.lock.Lock()
+		sensorCtx.recordTriggerCycle(err)
+		sensorCtx.lock.Unlock()
+		completeNotification(notification, err)
+	}()
+}
+
+// runTrigger executes the trigger, cancelling the execution if it doesn't complete within the timeout of the trigger.
+// The execution is waited for even once cancelled, so that it holds its slot of the trigger pool until it returns.
+func (sensorCtx *SensorContext) runTrigger(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger) (*v1alpha1.Event, error) {
+	if trigger.Timeout <= 0 {
+		return sensorCtx.executeTrigger(ctx, sensor, trigger)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(trigger.Timeout)*time.Second)
+	defer cancel()
+	result, err := sensorCtx.executeTrigger(ctx, sensor, trigger)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, errors.Wrapf(err, "trigger %s timed out after %d seconds", trigger.Template.Name, trigger.Timeout)
+	}
+	return result, err
+}
+
+// executeTrigger executes a trigger, if its switches are resolved, and records the metrics and the span of the execution
+// 1. Apply template level parameters
+// 2. Check if switches are resolved
+// 3. Fetch the resource
+// 4. Apply resource level parameters
+// 5. Execute the trigger
+// 6. If any policy is set, apply it
+// Steps 3 to 5 are retried with the retry strategy of the trigger, if any, unless the trigger fails with a non-retryable
+// error. The policy is applied once, on the resource of the last attempt, so that it doesn't execute the trigger again.
+// It returns the result of the execution as an event, or nil if the trigger isn't executed as its switches are not resolved.
+func (sensorCtx *SensorContext) executeTrigger(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger) (result *v1alpha1.Event, err error) {
+	if err := triggers.ApplyTemplateParameters(sensor, &trigger); err != nil {
+		return nil, err
+	}
+	logger := sensorCtx.Logger.WithField("trigger-name", trigger.Template.Name)
+	if ok := triggers.ApplySwitches(sensor, &trigger); !ok {
+		logger.Infoln("switches/group level when conditions were not resolved, won't execute the trigger")
+		if span := trace.FromContext(ctx); span != nil {
+			span.Annotate([]trace.Attribute{trace.StringAttribute("trigger-name", trigger.Template.Name)}, "switches were not resolved, won't execute the trigger")
 		}
+		return nil, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sensor.execute-trigger")
+	span.AddAttributes(
+		trace.StringAttribute("trigger-name", trigger.Template.Name),
+		trace.StringAttribute("trigger-type", triggerType(&trigger)),
+	)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	start := time.Now()
+	logger.Infoln("resolving the trigger implementation")
+	sensorCtx.triggerLock.Lock()
+	triggerImpl, err := sensorCtx.GetTrigger(sensor, &trigger)
+	sensorCtx.triggerLock.Unlock()
+	if err != nil {
+		sensorCtx.markTriggerNode(trigger.Template.Name, 0, "", err)
+		return nil, errors.Wrap(err, "failed to resolve the trigger implementation")
+	}
+	defer func() {
+		metrics.SensorTriggerExecuted(sensor.Name, trigger.Template.Name, triggerType(&trigger), start, err)
+	}()
 
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("executing the trigger resource")
-		newObj, err := triggerImpl.Execute(updatedObj)
+	var resource interface{}
+	var executed bool
+	attempts, err := retryTrigger(ctx, trigger.RetryStrategy, func(attempt int32) error {
+		var err error
+		resource, executed, err = processTrigger(ctx, logger, sensor, triggerImpl)
 		if err != nil {
-			return err
+			logger.WithError(err).WithField("attempt", attempt).Warnln("failed to execute the trigger")
 		}
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("trigger resource successfully executed")
+		return err
+	})
+	if err == nil && executed {
+		logger.Infoln("applying trigger policy")
+		err = triggerImpl.ApplyPolicy(ctx, resource)
+	}
+	span.AddAttributes(trace.Int64Attribute("attempts", int64(attempts)))
+	sensorCtx.markTriggerNode(trigger.Template.Name, attempts, completionOutcome(&trigger, err), err)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to execute the trigger after %d attempt(s)", attempts)
+	}
 
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("applying trigger policy")
-		if err := triggerImpl.ApplyPolicy(newObj); err != nil {
-			return err
+	logger.WithField("attempts", attempts).Infoln("successfully processed the trigger")
+	result, resultErr := triggers.ResultEvent(&trigger, resource)
+	if resultErr != nil {
+		// the triggers depending on this one can't use its result, but it's executed nevertheless
+		logger.WithError(resultErr).Warnln("failed to read the result of the trigger")
+		result, _ = triggers.ResultEvent(&trigger, nil)
+	}
+	return result, nil
+}
+
+// retryTrigger executes the trigger with the retry strategy, it returns the number of attempts and the error of the last one.
+// The trigger isn't retried once the context is done, or once it fails with a non-retryable error.
+func retryTrigger(ctx context.Context, retryStrategy *apicommon.Backoff, execute func(attempt int32) error) (int32, error) {
+	backoff := wait.Backoff{Steps: 1}
+	if retryStrategy != nil {
+		backoff = *common.GetConnectionBackoff(retryStrategy)
+	}
+	var attempts int32
+	var lastErr error
+	_ = common.ExponentialBackoff(ctx, backoff, func() (bool, error) {
+		attempts++
+		lastErr = execute(attempts)
+		if lastErr != nil && !triggers.IsRetryable(lastErr) {
+			return false, lastErr
 		}
+		return lastErr == nil, nil
+	})
+	return attempts, lastErr
+}
 
-		logger.WithField("trigger-name", trigger.Template.Name).Infoln("successfully processed the trigger")
+// processTrigger fetches the trigger resource, applies the resource parameters and executes the trigger.
+// It returns the result of the execution, and whether the trigger is executed, i.e. its resource isn't empty.
+func processTrigger(ctx context.Context, logger *logrus.Entry, sensor *v1alpha1.Sensor, triggerImpl triggers.Trigger) (interface{}, bool, error) {
+	logger.Infoln("fetching trigger resource if any")
+	obj, err := triggerImpl.FetchResource()
+	if err != nil {
+		return nil, false, err
+	}
+	if obj == nil {
+		logger.Warnln("trigger resource is empty")
+		return nil, false, nil
 	}
 
-	// process snapshot dependencies
-	for _, dependency := range snapshot {
-		// resolve dependencies
-		snctrl.MarkResolvedAt(sensorCtx.Sensor, dependency)
-		// Mark snapshot dependency nodes as active
-		snctrl.MarkNodePhase(sensorCtx.Sensor, dependency, v1alpha1.NodeTypeEventDependency, v1alpha1.NodePhaseActive, nil, sensorCtx.Logger, "dependency is re-activated")
+	logger.Infoln("applying resource parameters if any")
+	updatedObj, err := triggerImpl.ApplyResourceParameters(sensor, obj)
+	if err != nil {
+		return nil, false, err
 	}
-	// Mark all dependency groups as active
-	for _, group := range sensorCtx.Sensor.Spec.DependencyGroups {
-		snctrl.MarkNodePhase(sensorCtx.Sensor, group.Name, v1alpha1.NodeTypeDependencyGroup, v1alpha1.NodePhaseActive, nil, sensorCtx.Logger, "dependency group is re-activated")
+
+	logger.Infoln("executing the trigger resource")
+	newObj, err := triggerImpl.Execute(ctx, updatedObj)
+	if err != nil {
+		return nil, false, err
 	}
+	logger.Infoln("trigger resource successfully executed")
+	return newObj, true, nil
+}
 
-	return nil
+// completionOutcome returns the outcome of the resource created by the trigger, if the trigger has a completion policy
+func completionOutcome(trigger *v1alpha1.Trigger, err error) v1alpha1.CompletionOutcome {
+	if trigger.Policy == nil || trigger.Policy.Completion == nil {
+		return ""
+	}
+	if err == nil {
+		return v1alpha1.CompletionSucceeded
+	}
+	if completionErr, ok := errors.Cause(err).(*policy.CompletionError); ok {
+		return completionErr.Outcome
+	}
+	return ""
+}
+
+// markTriggerNode records the outcome and the attempts of the execution of a trigger in its status node
+func (sensorCtx *SensorContext) markTriggerNode(name string, attempts int32, outcome v1alpha1.CompletionOutcome, err error) {
+	sensorCtx.lock.Lock()
+	defer sensorCtx.lock.Unlock()
+	if err != nil {
+		snctrl.MarkNodePhase(sensorCtx.Sensor, name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseError, nil, sensorCtx.Logger, err.Error())
+	} else {
+		snctrl.MarkNodePhase(sensorCtx.Sensor, name, v1alpha1.NodeTypeTrigger, v1alpha1.NodePhaseComplete, nil, sensorCtx.Logger, "trigger is executed")
+	}
+	snctrl.MarkAttempts(sensorCtx.Sensor, name, attempts)
+	snctrl.MarkOutcome(sensorCtx.Sensor, name, outcome)
 }
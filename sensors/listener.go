@@ -22,21 +22,37 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go"
 	"github.com/nats-io/go-nats"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/common"
+	snctrl "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sensors/dependencies"
+	"github.com/argoproj/argo-events/sensors/triggers"
 	"github.com/argoproj/argo-events/sensors/types"
+	"github.com/argoproj/argo-events/tracing"
 )
 
+// eventBusReconnectInterval is the interval between attempts to reconnect to the eventbus
+const eventBusReconnectInterval = 5 * time.Second
+
 // ListenEvents watches and handles events received from the gateway.
 func (sensorCtx *SensorContext) ListenEvents() error {
+	// every trigger of the api must be implemented, rather than silently ignored
+	if err := triggers.CheckRegistry(); err != nil {
+		return err
+	}
+
 	// start processing the update Notification NotificationQueue
 	go func() {
 		for e := range sensorCtx.NotificationQueue {
@@ -49,8 +65,26 @@ func (sensorCtx *SensorContext) ListenEvents() error {
 
 	errCh := make(chan error)
 
+	busConfig, subjectPrefix, auth, err := eventbus.GetConfigFromEnv()
+	if err != nil {
+		return errors.Wrap(err, "failed to read the eventbus configuration")
+	}
+	subscription := sensorCtx.Sensor.Spec.Subscription
+	if busConfig == nil && subscription == nil {
+		return errors.New("neither an eventbus nor a subscription is configured for the sensor")
+	}
+
+	// listen events over eventbus
+	if busConfig != nil {
+		go func() {
+			if err := sensorCtx.listenEventsOverEventBus(busConfig, subjectPrefix, auth); err != nil {
+				errCh <- errors.Wrap(err, "failed to listen events over eventbus")
+			}
+		}()
+	}
+
 	// listen events over http
-	if sensorCtx.Sensor.Spec.Subscription.HTTP != nil {
+	if subscription != nil && subscription.HTTP != nil {
 		go func() {
 			if err := sensorCtx.listenEventsOverHTTP(); err != nil {
 				errCh <- errors.Wrap(err, "failed to listen events over HTTP subscription")
@@ -59,7 +93,7 @@ func (sensorCtx *SensorContext) ListenEvents() error {
 	}
 
 	// listen events over nats
-	if sensorCtx.Sensor.Spec.Subscription.NATS != nil {
+	if subscription != nil && subscription.NATS != nil {
 		go func() {
 			if err := sensorCtx.listenEventsOverNATS(); err != nil {
 				errCh <- errors.Wrap(err, "failed to listen events over NATS subscription")
@@ -67,7 +101,7 @@ func (sensorCtx *SensorContext) ListenEvents() error {
 		}()
 	}
 
-	err := <-errCh
+	err = <-errCh
 	sensorCtx.Logger.WithError(err).Errorln("subscription failure. stopping sensor operations")
 
 	return nil
@@ -134,6 +168,98 @@ func (sensorCtx *SensorContext) listenEventsOverNATS() error {
 	return nil
 }
 
+// eventBusSubscription is the subscription of the sensor to the eventbus
+type eventBusSubscription struct {
+	busConfig     *eventbusv1alpha1.BusConfig
+	subjectPrefix string
+	subscription  eventbus.Subscription
+	// resubscribe is closed to subscribe again, once the subscription changes
+	resubscribe chan struct{}
+}
+
+// listenEventsOverEventBus listens to events published by the gateways on the eventbus, on the subjects of the
+// dependencies of the sensor. The subscription is renewed whenever the dependencies change,
+// and re-established whenever the connection to the eventbus is lost.
+func (sensorCtx *SensorContext) listenEventsOverEventBus(busConfig *eventbusv1alpha1.BusConfig, subjectPrefix string, auth *eventbus.Auth) error {
+	sensorCtx.lock.Lock()
+	sensorCtx.eventBus = &eventBusSubscription{
+		busConfig:     busConfig,
+		subjectPrefix: subjectPrefix,
+		resubscribe:   make(chan struct{}),
+	}
+	subscription, err := sensorCtx.eventBusSubscription()
+	sensorCtx.eventBus.subscription = subscription
+	sensorName := sensorCtx.Sensor.Name
+	sensorCtx.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	driver, err := eventbus.GetDriver(busConfig, eventbus.ClientID("sensor", sensorName), auth, sensorCtx.Logger)
+	if err != nil {
+		return err
+	}
+
+	logger := sensorCtx.Logger.WithField("subjectPrefix", subjectPrefix)
+	logger.Infoln("starting eventbus subscriber")
+	for {
+		conn, err := driver.Connect()
+		if err != nil {
+			logger.WithError(err).Errorln("failed to connect to the eventbus, retrying")
+			time.Sleep(eventBusReconnectInterval)
+			continue
+		}
+		// the subscription lasts until it changes, it's then renewed on the same connection
+		for !conn.IsClosed() {
+			sensorCtx.lock.Lock()
+			subscription, resubscribe := sensorCtx.eventBus.subscription, sensorCtx.eventBus.resubscribe
+			sensorCtx.lock.Unlock()
+			if err = driver.Subscribe(conn, resubscribe, subscription, sensorCtx.handleEventAndAck); err != nil {
+				break
+			}
+			logger.Infoln("eventbus subscription changed, subscribing again")
+		}
+		_ = conn.Close()
+		logger.WithError(err).Errorln("eventbus subscription is closed, reconnecting")
+		time.Sleep(eventBusReconnectInterval)
+	}
+}
+
+// eventBusSubscription returns the subscription of the sensor to the eventbus, i.e. to the subjects of its dependencies.
+// It must be called with the sensor lock held.
+func (sensorCtx *SensorContext) eventBusSubscription() (eventbus.Subscription, error) {
+	subjects, err := eventbus.DependencySubjects(sensorCtx.eventBus.busConfig, sensorCtx.eventBus.subjectPrefix, sensorCtx.Sensor.Spec.Dependencies)
+	if err != nil {
+		return eventbus.Subscription{}, errors.Wrap(err, "failed to get the eventbus subjects of the dependencies")
+	}
+	// the durable subscriptions are shared by the replicas of the sensor, so are named after it.
+	// The events are acked once their triggers are executed, so the ack wait must cover a trigger cycle.
+	return eventbus.Subscription{
+		Subjects: subjects,
+		Group:    fmt.Sprintf("sensor-%s", sensorCtx.Sensor.Name),
+		AckWait:  snctrl.EventBusAckWait(sensorCtx.Sensor),
+	}, nil
+}
+
+// updateEventBusSubscription makes the sensor subscribe again to the eventbus if its subscription changed,
+// e.g. once its dependencies are updated. It must be called with the sensor lock held.
+func (sensorCtx *SensorContext) updateEventBusSubscription() {
+	if sensorCtx.eventBus == nil {
+		return
+	}
+	subscription, err := sensorCtx.eventBusSubscription()
+	if err != nil {
+		sensorCtx.Logger.WithError(err).Errorln("failed to update the eventbus subscription, keeping the current one")
+		return
+	}
+	if reflect.DeepEqual(subscription, sensorCtx.eventBus.subscription) {
+		return
+	}
+	sensorCtx.eventBus.subscription = subscription
+	close(sensorCtx.eventBus.resubscribe)
+	sensorCtx.eventBus.resubscribe = make(chan struct{})
+}
+
 func cloudEventConverter(event *cloudevents.Event) (*v1alpha1.Event, error) {
 	data, err := event.DataBytes()
 	if err != nil {
@@ -155,6 +281,46 @@ func cloudEventConverter(event *cloudevents.Event) (*v1alpha1.Event, error) {
 
 // handleEvent handles a cloudevent, validates and sends it over internal event notification queue
 func (sensorCtx *SensorContext) handleEvent(eventBody []byte) error {
+	return sensorCtx.queueEvent(eventBody, nil)
+}
+
+// handleEventAndAck handles a cloudevent and acks the eventbus message once the notification is processed,
+// i.e. after the triggers for it have been executed. It returns as soon as the event is queued,
+// so that the next events are handled while the triggers are executed.
+// If the sensor errors on failed rounds, the message isn't acked if the round fails, so that it's redelivered.
+func (sensorCtx *SensorContext) handleEventAndAck(eventBody []byte, ack func()) error {
+	done := make(chan error, 1)
+	if err := sensorCtx.queueEvent(eventBody, done); err != nil {
+		// redelivering a malformed event won't help, so it is acked and discarded
+		sensorCtx.Logger.WithError(err).Errorln("discarding the event")
+		ack()
+		return nil
+	}
+	go func() {
+		if err := <-done; err != nil {
+			sensorCtx.lock.Lock()
+			errorOnFailedRound := sensorCtx.Sensor.Spec.ErrorOnFailedRound
+			sensorCtx.lock.Unlock()
+			if errorOnFailedRound {
+				sensorCtx.Logger.WithError(err).Warnln("the trigger round failed, not acknowledging the event so that it is redelivered")
+				return
+			}
+		}
+		ack()
+	}()
+	return nil
+}
+
+// queueEvent validates a cloudevent and sends it over internal event notification queue.
+// The done channel is closed once the notification is processed or if the event is not queued.
+func (sensorCtx *SensorContext) queueEvent(eventBody []byte, done chan error) error {
+	queued := false
+	defer func() {
+		if done != nil && !queued {
+			close(done)
+		}
+	}()
+
 	var event *cloudevents.Event
 	if err := json.Unmarshal(eventBody, &event); err != nil {
 		return err
@@ -170,14 +336,38 @@ func (sensorCtx *SensorContext) handleEvent(eventBody []byte) error {
 		"subject": event.Context.GetSubject(),
 	}).Infoln("received event")
 
+	// the sensor object is replaced as the notifications are processed
+	sensorCtx.lock.Lock()
+	sensorName := sensorCtx.Sensor.Name
+	eventDependencies := sensorCtx.Sensor.Spec.Dependencies
+	sensorCtx.lock.Unlock()
+
+	// continue the trace propagated by the gateway, if any
+	var traceParent string
+	_ = event.ExtensionAs(tracing.TraceParent, &traceParent)
+	_, span := tracing.StartSpan(context.Background(), "sensor.handle-event", traceParent, trace.WithSpanKind(trace.SpanKindServer))
+	span.AddAttributes(
+		trace.StringAttribute(common.LabelSensorName, sensorName),
+		trace.StringAttribute(common.LabelEventSource, event.Context.GetSource()),
+		trace.StringAttribute("event-name", event.Context.GetSubject()),
+	)
+
 	// Resolve Dependency
 	// validate whether the event is from gateway that this sensor is watching
-	if eventDependency := dependencies.ResolveDependency(sensorCtx.Sensor.Spec.Dependencies, internalEvent, sensorCtx.Logger); eventDependency != nil {
-		sensorCtx.NotificationQueue <- &types.Notification{
-			Event:            internalEvent,
-			EventDependency:  eventDependency,
-			NotificationType: v1alpha1.EventNotification,
-		}
+	eventDependency := dependencies.ResolveDependency(eventDependencies, internalEvent, sensorCtx.Logger)
+	if eventDependency == nil {
+		span.Annotate(nil, "event doesn't match any dependency")
+		span.End()
+		return nil
+	}
+	span.AddAttributes(trace.StringAttribute("dependency", eventDependency.Name))
+	sensorCtx.NotificationQueue <- &types.Notification{
+		Event:            internalEvent,
+		EventDependency:  eventDependency,
+		NotificationType: v1alpha1.EventNotification,
+		Done:             done,
+		Span:             span,
 	}
+	queued = true
 	return nil
 }
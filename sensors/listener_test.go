@@ -22,11 +22,15 @@ import (
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sensors/types"
+	"github.com/argoproj/argo-events/tracing"
 )
 
 func TestHandleEvent(t *testing.T) {
@@ -91,3 +95,172 @@ func TestHandleEvent(t *testing.T) {
 
 	done <- struct{}{}
 }
+
+func TestHandleEventAndAck(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = []v1alpha1.EventDependency{
+		{
+			Name:        "dep1",
+			GatewayName: "webhook-gateway",
+			EventName:   "example-1",
+		},
+	}
+
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetID("1")
+	event.SetSource("webhook-gateway")
+	event.SetSubject("example-1")
+	event.SetType("webhook")
+	event.SetDataContentType(common.MediaTypeJSON)
+	event.SetTime(time.Now())
+
+	queue := make(chan *types.Notification)
+	notifications := make(chan *types.Notification, 1)
+	go func() {
+		notifications <- <-queue
+	}()
+
+	sensorCtx := &SensorContext{
+		Sensor:            obj,
+		NotificationQueue: queue,
+		Logger:            common.NewArgoEventsLogger(),
+	}
+
+	eventBody, err := json.Marshal(&event)
+	assert.Nil(t, err)
+	acked := make(chan struct{})
+	err = sensorCtx.handleEventAndAck(eventBody, func() {
+		close(acked)
+	})
+	assert.Nil(t, err)
+
+	// the event is acknowledged only once the notification is processed
+	notification := <-notifications
+	select {
+	case <-acked:
+		assert.Fail(t, "acknowledged before the notification was processed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(notification.Done)
+	select {
+	case <-acked:
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "not acknowledged after the notification was processed")
+	}
+
+	// the events of the failed rounds aren't acknowledged if the sensor errors on failed rounds
+	sensorCtx.Sensor.Spec.ErrorOnFailedRound = true
+	go func() {
+		notifications <- <-queue
+	}()
+	acked = make(chan struct{})
+	err = sensorCtx.handleEventAndAck(eventBody, func() {
+		close(acked)
+	})
+	assert.Nil(t, err)
+	notification = <-notifications
+	completeNotification(notification, errors.New("failed to execute the triggers"))
+	select {
+	case <-acked:
+		assert.Fail(t, "acknowledged although the round failed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// malformed events are discarded and acknowledged right away
+	discarded := false
+	err = sensorCtx.handleEventAndAck([]byte("not an event"), func() {
+		discarded = true
+	})
+	assert.Nil(t, err)
+	assert.True(t, discarded)
+}
+
+func TestHandleEventWithTraceParent(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = []v1alpha1.EventDependency{
+		{
+			Name:        "dep1",
+			GatewayName: "webhook-gateway",
+			EventName:   "example-1",
+		},
+	}
+
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetID("1")
+	event.SetSource("webhook-gateway")
+	event.SetSubject("example-1")
+	event.SetType("webhook")
+	event.SetDataContentType(common.MediaTypeJSON)
+	event.SetTime(time.Now())
+	event.SetExtension(tracing.TraceParent, traceParent)
+
+	queue := make(chan *types.Notification, 1)
+	sensorCtx := &SensorContext{
+		Sensor:            obj,
+		NotificationQueue: queue,
+		Logger:            common.NewArgoEventsLogger(),
+	}
+
+	eventBody, err := json.Marshal(&event)
+	assert.Nil(t, err)
+	err = sensorCtx.handleEvent(eventBody)
+	assert.Nil(t, err)
+
+	notification := <-queue
+	assert.NotNil(t, notification.Span)
+	parent, _ := tracing.ParseTraceParent(traceParent)
+	assert.Equal(t, parent.TraceID, notification.Span.SpanContext().TraceID)
+	notification.Span.End()
+}
+
+func TestUpdateEventBusSubscription(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = []v1alpha1.EventDependency{
+		{
+			Name:            "dep1",
+			EventSourceName: "webhook",
+			EventName:       "example-1",
+		},
+	}
+	sensorCtx := &SensorContext{
+		Sensor: obj,
+		Logger: common.NewArgoEventsLogger(),
+		eventBus: &eventBusSubscription{
+			busConfig: &eventbusv1alpha1.BusConfig{
+				NATS: &eventbusv1alpha1.NATSConfig{
+					URL: "nats://localhost:4222",
+				},
+			},
+			subjectPrefix: eventbus.SubjectPrefix("argo-events"),
+			resubscribe:   make(chan struct{}),
+		},
+	}
+	subscription, err := sensorCtx.eventBusSubscription()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"eventbus-argo-events.webhook.example-1"}, subscription.Subjects)
+	assert.Equal(t, "sensor-"+obj.Name, subscription.Group)
+	sensorCtx.eventBus.subscription = subscription
+
+	// the subscription is kept as long as the subjects don't change
+	resubscribe := sensorCtx.eventBus.resubscribe
+	sensorCtx.updateEventBusSubscription()
+	select {
+	case <-resubscribe:
+		assert.Fail(t, "subscribed again although the subscription didn't change")
+	default:
+	}
+
+	sensorCtx.Sensor.Spec.Dependencies = append(sensorCtx.Sensor.Spec.Dependencies, v1alpha1.EventDependency{
+		Name:            "dep2",
+		EventSourceName: "webhook",
+		EventName:       "example-2",
+	})
+	sensorCtx.updateEventBusSubscription()
+	select {
+	case <-resubscribe:
+	default:
+		assert.Fail(t, "didn't subscribe again although a dependency was added")
+	}
+	assert.Equal(t, []string{"eventbus-argo-events.webhook.example-1", "eventbus-argo-events.webhook.example-2"}, sensorCtx.eventBus.subscription.Subjects)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// rewatchDelay is the delay before watching the resource again, once the watch is closed by the server
+	rewatchDelay = time.Second
+)
+
+// CompletionError is the error of the completion policy, when the resource failed or didn't complete in time
+type CompletionError struct {
+	Outcome v1alpha1.CompletionOutcome
+	Message string
+}
+
+func (e *CompletionError) Error() string {
+	return e.Message
+}
+
+// Retryable returns false, the outcome of the resource isn't changed by executing the trigger again
+func (e *CompletionError) Retryable() bool {
+	return false
+}
+
+// Completion implements the trigger policy which waits for the resource to complete
+type Completion struct {
+	Trigger *v1alpha1.Trigger
+	Client  dynamic.NamespaceableResourceInterface
+	Obj     *unstructured.Unstructured
+}
+
+// NewCompletion returns a new completion policy for the resource created by the trigger
+func NewCompletion(trigger *v1alpha1.Trigger, client dynamic.NamespaceableResourceInterface, obj *unstructured.Unstructured) *Completion {
+	return &Completion{
+		Trigger: trigger,
+		Client:  client,
+		Obj:     obj,
+	}
+}
+
+// ApplyPolicy watches the resource until its success or failure condition holds.
+// It returns a CompletionError if the resource failed, was deleted or didn't complete within the timeout,
+// and the error of the context if it's done first.
+func (c *Completion) ApplyPolicy(ctx context.Context) error {
+	if c.Trigger.Policy == nil || c.Trigger.Policy.Completion == nil {
+		return nil
+	}
+	policy := c.Trigger.Policy.Completion
+
+	success, err := common.ParseCondition(policy.SuccessCondition)
+	if err != nil {
+		return err
+	}
+	var failure *common.Condition
+	if policy.FailureCondition != "" {
+		if failure, err = common.ParseCondition(policy.FailureCondition); err != nil {
+			return err
+		}
+	}
+	timeout := common.DefaultCompletionTimeout
+	if policy.Timeout > 0 {
+		timeout = time.Duration(policy.Timeout) * time.Second
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	name := c.Obj.GetName()
+	client := c.Client.Namespace(c.Obj.GetNamespace())
+	timedOut := &CompletionError{
+		Outcome: v1alpha1.CompletionTimedOut,
+		Message: fmt.Sprintf("resource %s didn't complete within %s", name, timeout),
+	}
+
+	for {
+		obj, err := client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the resource %s", name)
+		}
+		if done, err := c.completed(obj, success, failure); done || err != nil {
+			return err
+		}
+
+		watcher, err := client.Watch(metav1.ListOptions{
+			FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+			ResourceVersion: obj.GetResourceVersion(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to watch the resource %s", name)
+		}
+		done, err := c.watch(ctx, watcher, deadline.C, timedOut, success, failure)
+		watcher.Stop()
+		if done {
+			return err
+		}
+
+		// the watch is closed, the resource is checked again before it's watched anew
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return timedOut
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+// watch checks the resource on each of its updates, until it's completed or the watch is closed
+func (c *Completion) watch(ctx context.Context, watcher watch.Interface, deadline <-chan time.Time, timedOut error, success, failure *common.Condition) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case <-deadline:
+			return true, timedOut
+		case event, ok := <-watcher.ResultChan():
+			if !ok || event.Type == watch.Error {
+				return false, nil
+			}
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok || obj.GetName() != c.Obj.GetName() {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				return true, &CompletionError{
+					Outcome: v1alpha1.CompletionFailed,
+					Message: fmt.Sprintf("resource %s is deleted before completing", obj.GetName()),
+				}
+			}
+			if done, err := c.completed(obj, success, failure); done || err != nil {
+				return true, err
+			}
+		}
+	}
+}
+
+// completed checks the conditions on the resource, the failure condition first
+func (c *Completion) completed(obj *unstructured.Unstructured, success, failure *common.Condition) (bool, error) {
+	if failure != nil {
+		failed, err := failure.Holds(obj.Object)
+		if err != nil {
+			return true, err
+		}
+		if failed {
+			return true, &CompletionError{
+				Outcome: v1alpha1.CompletionFailed,
+				Message: fmt.Sprintf("resource %s failed, the condition %q holds", obj.GetName(), failure),
+			}
+		}
+	}
+	return success.Holds(obj.Object)
+}
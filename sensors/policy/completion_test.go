@@ -0,0 +1,111 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func newCompletion(timeout int64, objects ...runtime.Object) (*Completion, *fake.FakeDynamicClient) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	trigger := &v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "fake-trigger",
+		},
+		Policy: &v1alpha1.TriggerPolicy{
+			Completion: &v1alpha1.CompletionPolicy{
+				SuccessCondition: ".status.phase == Succeeded",
+				FailureCondition: ".status.phase == Failed",
+				Timeout:          timeout,
+			},
+		},
+	}
+	resource := client.Resource(schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"})
+	return NewCompletion(trigger, resource, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")), client
+}
+
+// setPhase sets the phase of the workflow once it's watched
+func setPhase(t *testing.T, completion *Completion, client *fake.FakeDynamicClient, phase string) {
+	for {
+		watched := false
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "watch" {
+				watched = true
+			}
+		}
+		if watched {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	wf, err := completion.Client.Namespace("fake").Get("test", metav1.GetOptions{})
+	assert.Nil(t, err)
+	wf.Object["status"] = map[string]interface{}{"phase": phase}
+	_, err = completion.Client.Namespace("fake").Update(wf, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+}
+
+func TestCompletion_ApplyPolicy(t *testing.T) {
+	wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+	wf.Object["status"] = map[string]interface{}{"phase": "Succeeded"}
+	completion, _ := newCompletion(0, wf)
+	assert.Nil(t, completion.ApplyPolicy(context.Background()))
+
+	completion, client := newCompletion(0, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+	go setPhase(t, completion, client, "Succeeded")
+	assert.Nil(t, completion.ApplyPolicy(context.Background()))
+
+	completion, client = newCompletion(0, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+	go setPhase(t, completion, client, "Failed")
+	err := completion.ApplyPolicy(context.Background())
+	assert.NotNil(t, err)
+	completionErr, ok := err.(*CompletionError)
+	assert.True(t, ok)
+	assert.Equal(t, v1alpha1.CompletionFailed, completionErr.Outcome)
+	assert.False(t, completionErr.Retryable())
+
+	completion, _ = newCompletion(1, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+	err = completion.ApplyPolicy(context.Background())
+	assert.NotNil(t, err)
+	completionErr, ok = err.(*CompletionError)
+	assert.True(t, ok)
+	assert.Equal(t, v1alpha1.CompletionTimedOut, completionErr.Outcome)
+
+	// the context is done before the resource completes
+	completion, _ = newCompletion(0, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = completion.ApplyPolicy(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// the resource doesn't exist
+	completion, _ = newCompletion(1)
+	err = completion.ApplyPolicy(context.Background())
+	assert.NotNil(t, err)
+	_, ok = err.(*CompletionError)
+	assert.False(t, ok)
+}
@@ -17,11 +17,14 @@ limitations under the License.
 package policy
 
 import (
+	"context"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
@@ -32,7 +35,8 @@ type ResourceLabels struct {
 	Obj     *unstructured.Unstructured
 }
 
-func (rl *ResourceLabels) ApplyPolicy() error {
+// ApplyPolicy waits for the labels to be set on the resource, until the backoff is exhausted or the context is done
+func (rl *ResourceLabels) ApplyPolicy(ctx context.Context) error {
 	from := rl.Trigger.Policy.K8s.Backoff
 	if rl.Trigger.Policy.K8s == nil || rl.Trigger.Policy.K8s.Labels == nil || &from == nil {
 		return nil
@@ -50,7 +54,7 @@ func (rl *ResourceLabels) ApplyPolicy() error {
 		jitter, _ := from.Jitter.Float64()
 		backoff.Jitter = jitter
 	}
-	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+	err := common.ExponentialBackoff(ctx, backoff, func() (bool, error) {
 		obj, err := rl.Client.Namespace(rl.Obj.GetNamespace()).Get(rl.Obj.GetName(), metav1.GetOptions{})
 		if err != nil {
 			return false, err
@@ -17,6 +17,7 @@ limitations under the License.
 package policy
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -139,7 +140,7 @@ func TestResourceLabels_ApplyPolicy(t *testing.T) {
 			var err error
 			uObj, err = test.updateFunc(uObj)
 			assert.Nil(t, err)
-			err = resourceLabelsPolicy.ApplyPolicy()
+			err = resourceLabelsPolicy.ApplyPolicy(context.Background())
 			test.testFunc(err)
 		})
 	}
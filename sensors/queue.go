@@ -17,49 +17,101 @@ limitations under the License.
 package sensors
 
 import (
+	"sync/atomic"
+
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	snctrl "github.com/argoproj/argo-events/controllers/sensor"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sensors/types"
+	"github.com/argoproj/argo-events/tracing"
 )
 
 // processQueue processes events received on internal queue and updates the state of the node representing the event dependency
 func (sensorCtx *SensorContext) processQueue(notification *types.Notification) {
 	switch notification.NotificationType {
 	case v1alpha1.EventNotification:
-		if sensorCtx.Sensor.Status.TriggerCycleStatus == v1alpha1.TriggerCycleFailure && sensorCtx.Sensor.Spec.ErrorOnFailedRound {
-			sensorCtx.Logger.Errorln("sensor policy is error on failed trigger, won't activate the dependencies")
-			return
-		}
-
-		err := sensorCtx.operateEventNotification(notification)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).Errorln("failed to operate on the event notification")
-			sensorCtx.Sensor.Status.TriggerCycleStatus = v1alpha1.TriggerCycleFailure
-		} else {
-			sensorCtx.Sensor.Status.TriggerCycleStatus = v1alpha1.TriggerCycleSuccess
-		}
-
-		// increment completion counter
-		sensorCtx.Sensor.Status.TriggerCycleCount++
-
-		// set completion time
-		sensorCtx.Sensor.Status.LastCycleTime = metav1.Now()
-
-		sensorCtx.Logger.Infoln("persisting the sensor state")
-		updatedSensor, err := snctrl.PersistUpdates(sensorCtx.SensorClient, sensorCtx.Sensor, sensorCtx.Logger)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).Error("failed to persist sensor update")
-			return
-		}
-		// update Sensor ref. in case of failure to persist updates, this is a deep copy of old Sensor resource
-		sensorCtx.Sensor = updatedSensor
+		sensorCtx.processEventNotification(notification)
 
 	case v1alpha1.ResourceUpdateNotification:
+		sensorCtx.lock.Lock()
 		sensorCtx.operateResourceUpdateNotification(notification)
+		sensorCtx.lock.Unlock()
 
 	default:
 		sensorCtx.Logger.WithField("Notification-type", string(notification.NotificationType)).Error("unknown Notification type")
+		completeNotification(notification, nil)
+	}
+}
+
+// processEventNotification updates the event dependencies and dispatches the triggers if they are resolved.
+// If the triggers are dispatched, the trigger cycle is recorded and the notification completed once they are executed.
+func (sensorCtx *SensorContext) processEventNotification(notification *types.Notification) {
+	sensorCtx.lock.Lock()
+	errorOnFailedRound := sensorCtx.Sensor.Spec.ErrorOnFailedRound
+	sensorCtx.lock.Unlock()
+	if errorOnFailedRound {
+		// whether the dependencies can be activated depends on the outcome of the trigger cycles in flight
+		sensorCtx.triggerPool.wait()
+	}
+
+	sensorCtx.lock.Lock()
+	defer sensorCtx.lock.Unlock()
+
+	if sensorCtx.Sensor.Status.TriggerCycleStatus == v1alpha1.TriggerCycleFailure && sensorCtx.Sensor.Spec.ErrorOnFailedRound {
+		sensorCtx.Logger.Errorln("sensor policy is error on failed trigger, won't activate the dependencies")
+		completeNotification(notification, errors.New("sensor policy is error on failed trigger"))
+		return
+	}
+
+	dispatched, err := sensorCtx.operateEventNotification(notification)
+	if err != nil {
+		sensorCtx.Logger.WithError(err).Errorln("failed to operate on the event notification")
+	}
+	if dispatched {
+		return
+	}
+	sensorCtx.recordTriggerCycle(err)
+	completeNotification(notification, err)
+}
+
+// recordTriggerCycle records the outcome of a trigger cycle in the sensor status and persists it.
+// It must be called with the sensor lock held.
+func (sensorCtx *SensorContext) recordTriggerCycle(err error) {
+	if err != nil {
+		sensorCtx.Sensor.Status.TriggerCycleStatus = v1alpha1.TriggerCycleFailure
+	} else {
+		sensorCtx.Sensor.Status.TriggerCycleStatus = v1alpha1.TriggerCycleSuccess
+	}
+
+	// increment completion counter
+	sensorCtx.Sensor.Status.TriggerCycleCount++
+
+	// set completion time
+	sensorCtx.Sensor.Status.LastCycleTime = metav1.Now()
+
+	// record the executions skipped because of the rate limits since the last cycle
+	sensorCtx.Sensor.Status.DroppedTriggerExecutions += atomic.SwapInt64(&sensorCtx.skippedExecutions.dropped, 0)
+	sensorCtx.Sensor.Status.CoalescedTriggerExecutions += atomic.SwapInt64(&sensorCtx.skippedExecutions.coalesced, 0)
+
+	sensorCtx.Logger.Infoln("persisting the sensor state")
+	updatedSensor, err := snctrl.PersistUpdates(sensorCtx.SensorClient, sensorCtx.Sensor, sensorCtx.Logger)
+	if err != nil {
+		sensorCtx.Logger.WithError(err).Error("failed to persist sensor update")
+		return
+	}
+	// update Sensor ref. in case of failure to persist updates, this is a deep copy of old Sensor resource
+	sensorCtx.Sensor = updatedSensor
+}
+
+// completeNotification ends the span of the notification and signals that it has been processed
+func completeNotification(notification *types.Notification, err error) {
+	if notification.Span != nil {
+		tracing.EndSpan(notification.Span, err)
+	}
+	if notification.Done != nil {
+		notification.Done <- err
+		close(notification.Done)
 	}
 }
@@ -104,6 +104,7 @@ func TestProcessQueue(t *testing.T) {
 		Sensor:           obj,
 		NotificationType: v1alpha1.EventNotification,
 	})
+	sensorCtx.triggerPool.wait()
 
 	assert.Equal(t, sensorCtx.Sensor.Status.TriggerCycleStatus, v1alpha1.TriggerCycleSuccess)
 	assert.Equal(t, int32(1), sensorCtx.Sensor.Status.TriggerCycleCount)
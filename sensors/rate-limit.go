@@ -0,0 +1,140 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// skippedExecutions counts the trigger executions skipped because of the rate limits,
+// since they were last recorded in the sensor status
+type skippedExecutions struct {
+	dropped   int64
+	coalesced int64
+}
+
+// triggerLimiter applies the rate limit of a trigger
+type triggerLimiter struct {
+	rateLimit v1alpha1.RateLimit
+	// limiter is the token bucket of the rate limit, nil if the executions are not limited
+	limiter *rate.Limiter
+	// window is the debounce window, zero if the executions are not debounced
+	window  time.Duration
+	skipped *skippedExecutions
+	logger  *logrus.Entry
+	// lock protects pending
+	lock sync.Mutex
+	// pending is the execution scheduled at the end of the debounce window, along with its skip func
+	pending *pendingExecution
+}
+
+type pendingExecution struct {
+	execute func()
+	skip    func()
+}
+
+// newTriggerLimiter returns the limiter for the rate limit of a trigger
+func newTriggerLimiter(rateLimit v1alpha1.RateLimit, skipped *skippedExecutions, logger *logrus.Entry) *triggerLimiter {
+	l := &triggerLimiter{
+		rateLimit: rateLimit,
+		window:    time.Duration(rateLimit.Debounce) * time.Second,
+		skipped:   skipped,
+		logger:    logger,
+	}
+	if rateLimit.RequestsPerUnit > 0 {
+		unit := time.Second
+		switch rateLimit.Unit {
+		case v1alpha1.Minute:
+			unit = time.Minute
+		case v1alpha1.Hour:
+			unit = time.Hour
+		}
+		burst := int(rateLimit.Burst)
+		if burst <= 0 {
+			burst = int(rateLimit.RequestsPerUnit)
+		}
+		l.limiter = rate.NewLimiter(rate.Limit(float64(rateLimit.RequestsPerUnit)/unit.Seconds()), burst)
+	}
+	return l
+}
+
+// schedule executes the trigger according to the rate limit. If the execution is dropped, or coalesced
+// into a later one, skip is called instead of execute.
+func (l *triggerLimiter) schedule(execute, skip func()) {
+	if l.window <= 0 {
+		l.allow(execute, skip)
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.pending != nil {
+		// the latest events win, the execution scheduled earlier in the window is coalesced into this one
+		l.pending.skip()
+		atomic.AddInt64(&l.skipped.coalesced, 1)
+		l.logger.Infoln("coalescing the trigger execution into a later one")
+		l.pending = &pendingExecution{execute: execute, skip: skip}
+		return
+	}
+	l.pending = &pendingExecution{execute: execute, skip: skip}
+	time.AfterFunc(l.window, func() {
+		l.lock.Lock()
+		pending := l.pending
+		l.pending = nil
+		l.lock.Unlock()
+		l.allow(pending.execute, pending.skip)
+	})
+}
+
+// allow executes the trigger if the token bucket allows it, otherwise the execution is dropped
+func (l *triggerLimiter) allow(execute, skip func()) {
+	if l.limiter != nil && !l.limiter.Allow() {
+		atomic.AddInt64(&l.skipped.dropped, 1)
+		l.logger.Warnln("rate limit of the trigger is exceeded, dropping the execution")
+		skip()
+		return
+	}
+	execute()
+}
+
+// getTriggerLimiter returns the limiter for the rate limit of the trigger, or nil if it's not rate limited.
+// It must be called with the sensor lock held.
+func (sensorCtx *SensorContext) getTriggerLimiter(trigger *v1alpha1.Trigger) *triggerLimiter {
+	name := trigger.Template.Name
+	if trigger.RateLimit == nil {
+		delete(sensorCtx.triggerLimiters, name)
+		return nil
+	}
+	if sensorCtx.triggerLimiters == nil {
+		sensorCtx.triggerLimiters = make(map[string]*triggerLimiter)
+	}
+	// the limiter is reset when the rate limit is updated
+	limiter, ok := sensorCtx.triggerLimiters[name]
+	if !ok || !reflect.DeepEqual(limiter.rateLimit, *trigger.RateLimit) {
+		limiter = newTriggerLimiter(*trigger.RateLimit, &sensorCtx.skippedExecutions, sensorCtx.Logger.WithField("trigger-name", name))
+		sensorCtx.triggerLimiters[name] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorFake "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/types"
+)
+
+func TestTriggerLimiterTokenBucket(t *testing.T) {
+	skipped := &skippedExecutions{}
+	limiter := newTriggerLimiter(v1alpha1.RateLimit{
+		Unit:            v1alpha1.Minute,
+		RequestsPerUnit: 2,
+	}, skipped, common.NewArgoEventsLogger().WithField("trigger-name", "fake-trigger"))
+
+	executed, dropped := 0, 0
+	for i := 0; i < 5; i++ {
+		limiter.schedule(func() {
+			executed++
+		}, func() {
+			dropped++
+		})
+	}
+	assert.Equal(t, 2, executed)
+	assert.Equal(t, 3, dropped)
+	assert.Equal(t, int64(3), skipped.dropped)
+	assert.Equal(t, int64(0), skipped.coalesced)
+}
+
+func TestTriggerLimiterDebounce(t *testing.T) {
+	skipped := &skippedExecutions{}
+	limiter := newTriggerLimiter(v1alpha1.RateLimit{
+		Debounce: 1,
+	}, skipped, common.NewArgoEventsLogger().WithField("trigger-name", "fake-trigger"))
+
+	var lock sync.Mutex
+	var executions, skips []int
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		i := i
+		wg.Add(1)
+		limiter.schedule(func() {
+			defer wg.Done()
+			lock.Lock()
+			defer lock.Unlock()
+			executions = append(executions, i)
+		}, func() {
+			defer wg.Done()
+			lock.Lock()
+			defer lock.Unlock()
+			skips = append(skips, i)
+		})
+	}
+
+	// the earlier executions are coalesced right away, the latest one is executed at the end of the window
+	lock.Lock()
+	assert.Equal(t, []int{0, 1}, skips)
+	assert.Empty(t, executions)
+	lock.Unlock()
+
+	wg.Wait()
+	assert.Equal(t, []int{2}, executions)
+	assert.Equal(t, int64(2), skipped.coalesced)
+	assert.Equal(t, int64(0), skipped.dropped)
+}
+
+func TestGetTriggerLimiter(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	sensorCtx := &SensorContext{
+		Sensor: obj,
+		Logger: common.NewArgoEventsLogger(),
+	}
+	trigger := obj.Spec.Triggers[0].DeepCopy()
+	assert.Nil(t, sensorCtx.getTriggerLimiter(trigger))
+
+	trigger.RateLimit = &v1alpha1.RateLimit{
+		RequestsPerUnit: 1,
+	}
+	limiter := sensorCtx.getTriggerLimiter(trigger)
+	assert.NotNil(t, limiter)
+	assert.Equal(t, limiter, sensorCtx.getTriggerLimiter(trigger))
+
+	// the limiter is reset when the rate limit is updated
+	trigger.RateLimit.RequestsPerUnit = 2
+	newLimiter := sensorCtx.getTriggerLimiter(trigger)
+	assert.True(t, limiter != newLimiter)
+
+	trigger.RateLimit = nil
+	assert.Nil(t, sensorCtx.getTriggerLimiter(trigger))
+	assert.Empty(t, sensorCtx.triggerLimiters)
+}
+
+func TestDispatchTriggersWithRateLimit(t *testing.T) {
+	sensorClient := sensorFake.NewSimpleClientset()
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = []v1alpha1.EventDependency{
+		{
+			Name:        "dep1",
+			GatewayName: "webhook-gateway",
+			EventName:   "example-1",
+		},
+	}
+	obj.Spec.Triggers[0].RateLimit = &v1alpha1.RateLimit{
+		Unit:            v1alpha1.Hour,
+		RequestsPerUnit: 1,
+	}
+	obj, err := sensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Create(obj)
+	assert.Nil(t, err)
+	sensorCtx := NewSensorContext(sensorClient, fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj.DeepCopy(), "1")
+
+	event := &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Source:          "webhook-gateway",
+			Type:            "webhook",
+			DataContentType: common.MediaTypeJSON,
+			Subject:         "example-1",
+			Time:            metav1.Time{Time: time.Now().UTC()},
+		},
+		Data: []byte("{}"),
+	}
+	for i := 0; i < 3; i++ {
+		sensorCtx.processQueue(&types.Notification{
+			Event:            event,
+			EventDependency:  &obj.Spec.Dependencies[0],
+			NotificationType: v1alpha1.EventNotification,
+		})
+		sensorCtx.triggerPool.wait()
+	}
+
+	assert.Equal(t, int32(3), sensorCtx.Sensor.Status.TriggerCycleCount)
+	assert.Equal(t, int64(2), sensorCtx.Sensor.Status.DroppedTriggerExecutions)
+	assert.Equal(t, int64(0), sensorCtx.Sensor.Status.CoalescedTriggerExecutions)
+}
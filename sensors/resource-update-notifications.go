@@ -27,6 +27,8 @@ func (sensorCtx *SensorContext) operateResourceUpdateNotification(notification *
 	sensorCtx.Logger.Info("sensor resource update")
 	// update Sensor resource
 	sensorCtx.Sensor = notification.Sensor.DeepCopy()
+	sensorCtx.triggerPool.resize(sensorCtx.Sensor.Spec.TriggerConcurrency)
+	sensorCtx.updateEventBusSubscription()
 
 	// initialize new dependencies
 	for _, dependency := range sensorCtx.Sensor.Spec.Dependencies {
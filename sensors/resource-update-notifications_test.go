@@ -59,8 +59,9 @@ func TestOperateResourceUpdateNotification(t *testing.T) {
 	}
 
 	sensorCtx := &SensorContext{
-		Sensor: obj.DeepCopy(),
-		Logger: common.NewArgoEventsLogger(),
+		Sensor:      obj.DeepCopy(),
+		Logger:      common.NewArgoEventsLogger(),
+		triggerPool: newTriggerPool(obj.Spec.TriggerConcurrency),
 	}
 
 	tests := []struct {
@@ -90,6 +91,15 @@ func TestOperateResourceUpdateNotification(t *testing.T) {
 				assert.NotEmpty(t, sensorCtx.Sensor.Status.Nodes[dep2])
 			},
 		},
+		{
+			name: "the trigger concurrency is updated",
+			updateFunc: func() {
+				obj.Spec.TriggerConcurrency = 4
+			},
+			testFunc: func() {
+				assert.Equal(t, 4, sensorCtx.triggerPool.size)
+			},
+		},
 	}
 
 	for _, test := range tests {
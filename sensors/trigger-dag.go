@@ -0,0 +1,137 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	snctrl "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// triggerState is the state of a trigger in a trigger cycle
+type triggerState int
+
+const (
+	triggerPending triggerState = iota
+	triggerSucceeded
+	triggerFailed
+	triggerSkipped
+)
+
+// triggerDAG tracks the executions of the triggers of a trigger cycle, so that each trigger is executed
+// once the triggers it depends on are done
+type triggerDAG struct {
+	sensor *v1alpha1.Sensor
+	logger *logrus.Logger
+	index  map[string]int
+	// done are closed once the triggers are done, after their states and results are recorded
+	done    []chan struct{}
+	states  []triggerState
+	results []*v1alpha1.Event
+}
+
+// newTriggerDAG returns the DAG of the triggers of the sensor snapshot
+func newTriggerDAG(sensor *v1alpha1.Sensor, logger *logrus.Logger) *triggerDAG {
+	dag := &triggerDAG{
+		sensor:  sensor,
+		logger:  logger,
+		index:   make(map[string]int),
+		done:    make([]chan struct{}, len(sensor.Spec.Triggers)),
+		states:  make([]triggerState, len(sensor.Spec.Triggers)),
+		results: make([]*v1alpha1.Event, len(sensor.Spec.Triggers)),
+	}
+	for i, trigger := range sensor.Spec.Triggers {
+		dag.index[trigger.Template.Name] = i
+		dag.done[i] = make(chan struct{})
+	}
+	return dag
+}
+
+// complete records the state and the result of the trigger, and releases the triggers depending on it
+func (dag *triggerDAG) complete(i int, state triggerState, result *v1alpha1.Event) {
+	dag.states[i] = state
+	dag.results[i] = result
+	close(dag.done[i])
+}
+
+// wait waits for the triggers the trigger depends on to be done.
+// It returns the reason to skip the trigger if the condition of any of its dependencies doesn't hold.
+func (dag *triggerDAG) wait(trigger *v1alpha1.Trigger) string {
+	reason := ""
+	for _, dependency := range trigger.DependsOn {
+		i, ok := dag.index[dependency.Name]
+		if !ok {
+			return fmt.Sprintf("trigger %s doesn't exist", dependency.Name)
+		}
+		<-dag.done[i]
+		if reason != "" {
+			continue
+		}
+		if !dependencyHolds(dependency.Condition, dag.states[i]) {
+			reason = fmt.Sprintf("condition %s of trigger %s doesn't hold", dependencyCondition(dependency.Condition), dependency.Name)
+		}
+	}
+	return reason
+}
+
+// dependencyCondition returns the condition of a trigger dependency, which defaults to Succeeded
+func dependencyCondition(condition v1alpha1.TriggerDependencyCondition) v1alpha1.TriggerDependencyCondition {
+	if condition == "" {
+		return v1alpha1.TriggerSucceeded
+	}
+	return condition
+}
+
+// dependencyHolds checks the condition of a trigger dependency holds for the state of the trigger
+func dependencyHolds(condition v1alpha1.TriggerDependencyCondition, state triggerState) bool {
+	switch dependencyCondition(condition) {
+	case v1alpha1.TriggerSucceeded:
+		return state == triggerSucceeded
+	case v1alpha1.TriggerFailed:
+		return state == triggerFailed
+	case v1alpha1.TriggerCompleted:
+		return state == triggerSucceeded || state == triggerFailed
+	default:
+		return false
+	}
+}
+
+// sensorWithResults returns the sensor snapshot the trigger is executed against. If the trigger depends on other triggers,
+// it's a copy of the snapshot holding the results of their executions in their nodes, so that the parameters
+// of the trigger can be resolved from them.
+func (dag *triggerDAG) sensorWithResults(trigger *v1alpha1.Trigger) *v1alpha1.Sensor {
+	if len(trigger.DependsOn) == 0 {
+		return dag.sensor
+	}
+	sensor := dag.sensor.DeepCopy()
+	for _, dependency := range trigger.DependsOn {
+		i, ok := dag.index[dependency.Name]
+		if !ok || dag.results[i] == nil {
+			continue
+		}
+		node := snctrl.GetNodeByName(sensor, dependency.Name)
+		if node == nil {
+			node = snctrl.InitializeNode(sensor, dependency.Name, v1alpha1.NodeTypeTrigger, dag.logger)
+		}
+		node.Event = dag.results[i]
+		sensor.Status.Nodes[node.ID] = *node
+	}
+	return sensor
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common"
+	snctrl "github.com/argoproj/argo-events/controllers/sensor"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorFake "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/types"
+)
+
+func TestDependencyHolds(t *testing.T) {
+	assert.True(t, dependencyHolds("", triggerSucceeded))
+	assert.False(t, dependencyHolds("", triggerFailed))
+	assert.False(t, dependencyHolds(v1alpha1.TriggerSucceeded, triggerSkipped))
+	assert.True(t, dependencyHolds(v1alpha1.TriggerFailed, triggerFailed))
+	assert.False(t, dependencyHolds(v1alpha1.TriggerFailed, triggerSucceeded))
+	assert.True(t, dependencyHolds(v1alpha1.TriggerCompleted, triggerFailed))
+	assert.True(t, dependencyHolds(v1alpha1.TriggerCompleted, triggerSucceeded))
+	assert.False(t, dependencyHolds(v1alpha1.TriggerCompleted, triggerSkipped))
+}
+
+func TestDispatchTriggersDAG(t *testing.T) {
+	var lock sync.Mutex
+	requests := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := ioutil.ReadAll(request.Body)
+		lock.Lock()
+		requests[request.URL.Path] = string(body)
+		lock.Unlock()
+		_, _ = writer.Write([]byte(`{"name": "fake-config"}`))
+	}))
+	defer server.Close()
+
+	configMap := newUnstructured("v1", "ConfigMap", "fake", "THIS_WILL_BE_REPLACED")
+	resource := apicommon.NewResource(configMap)
+	httpTrigger := func(name, path string, dependsOn ...v1alpha1.TriggerDependency) v1alpha1.Trigger {
+		return v1alpha1.Trigger{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: name,
+				HTTP: &v1alpha1.HTTPTrigger{
+					URL:    server.URL + path,
+					Method: http.MethodPost,
+					Payload: []v1alpha1.TriggerParameter{
+						{
+							Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep1", DataKey: "message"},
+							Dest: "message",
+						},
+					},
+				},
+			},
+			DependsOn: dependsOn,
+		}
+	}
+
+	sensorClient := sensorFake.NewSimpleClientset()
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = []v1alpha1.EventDependency{
+		{
+			Name:        "dep1",
+			GatewayName: "webhook-gateway",
+			EventName:   "example-1",
+		},
+	}
+	deploy := httpTrigger("deploy", "/deploy", v1alpha1.TriggerDependency{Name: "create-config"})
+	deploy.Template.HTTP.Payload = append(deploy.Template.HTTP.Payload, v1alpha1.TriggerParameter{
+		Src:  &v1alpha1.TriggerParameterSource{TriggerName: "create-config", DataKey: "metadata.name"},
+		Dest: "config",
+	})
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		deploy,
+		httpTrigger("generate-name", "/generate"),
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "create-config",
+				K8s: &v1alpha1.StandardK8STrigger{
+					GroupVersionResource: metav1.GroupVersionResource{
+						Version:  "v1",
+						Resource: "configmaps",
+					},
+					Source: &v1alpha1.ArtifactLocation{
+						Resource: &resource,
+					},
+					Operation: v1alpha1.Create,
+					Parameters: []v1alpha1.TriggerParameter{
+						{
+							Src:  &v1alpha1.TriggerParameterSource{TriggerName: "generate-name", DataKey: "name"},
+							Dest: "metadata.name",
+						},
+					},
+				},
+			},
+			DependsOn: []v1alpha1.TriggerDependency{{Name: "generate-name"}},
+		},
+		httpTrigger("on-failure", "/failure", v1alpha1.TriggerDependency{Name: "generate-name", Condition: v1alpha1.TriggerFailed}),
+		httpTrigger("on-completion", "/completion", v1alpha1.TriggerDependency{Name: "generate-name", Condition: v1alpha1.TriggerCompleted}),
+	}
+	snctrl.InitializeNode(obj, "dep1", v1alpha1.NodeTypeEventDependency, common.NewArgoEventsLogger())
+	obj, err := sensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Create(obj)
+	assert.Nil(t, err)
+	dynamicClient := dfake.NewSimpleDynamicClient(runtime.NewScheme())
+	sensorCtx := NewSensorContext(sensorClient, fake.NewSimpleClientset(), dynamicClient, obj.DeepCopy(), "1")
+
+	sensorCtx.processQueue(&types.Notification{
+		Event: &v1alpha1.Event{
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Source:          "webhook-gateway",
+				Type:            "webhook",
+				DataContentType: common.MediaTypeJSON,
+				Subject:         "example-1",
+				Time:            metav1.Time{Time: time.Now().UTC()},
+			},
+			Data: []byte(`{"message": "hello"}`),
+		},
+		EventDependency:  &obj.Spec.Dependencies[0],
+		NotificationType: v1alpha1.EventNotification,
+	})
+	sensorCtx.triggerPool.wait()
+
+	// the config map is created with the name generated by the first trigger, then the deployment refers to it
+	_, err = dynamicClient.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("fake").Get("fake-config", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"message":"hello"}`, requests["/generate"])
+	assert.Equal(t, `{"message":"hello","config":"fake-config"}`, requests["/deploy"])
+	assert.Contains(t, requests, "/completion")
+	assert.NotContains(t, requests, "/failure")
+	assert.Equal(t, v1alpha1.TriggerCycleSuccess, sensorCtx.Sensor.Status.TriggerCycleStatus)
+}
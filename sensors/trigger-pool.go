@@ -0,0 +1,126 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"sync"
+)
+
+// defaultTriggerConcurrency is the number of triggers executed at the same time if the sensor doesn't set it
+const defaultTriggerConcurrency = 1
+
+// triggerPool executes the triggers of a sensor with a bounded concurrency
+type triggerPool struct {
+	// size is the max number of triggers executed at the same time
+	size int
+	// lock protects running, queue and last
+	lock sync.Mutex
+	// running is the number of slots in use
+	running int
+	// queue holds the executions waiting for a slot, in the order of submission
+	queue []func()
+	// last holds, for each ordered trigger, a channel closed once the latest execution submitted for it completes
+	last map[string]chan struct{}
+	// cycles tracks the trigger cycles in flight
+	cycles sync.WaitGroup
+}
+
+// newTriggerPool returns a pool executing up to concurrency triggers at the same time
+func newTriggerPool(concurrency int32) *triggerPool {
+	if concurrency <= 0 {
+		concurrency = defaultTriggerConcurrency
+	}
+	return &triggerPool{
+		size: int(concurrency),
+		last: make(map[string]chan struct{}),
+	}
+}
+
+// submit executes fn for the trigger once a slot is free. The slots are granted in the order of submission, so with
+// a concurrency of 1 the triggers are executed one at a time in the order they are submitted. If the trigger is
+// ordered, fn is executed only after the executions previously submitted for the trigger complete.
+func (pool *triggerPool) submit(trigger string, ordered bool, fn func()) {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	var previous, current chan struct{}
+	if ordered {
+		current = make(chan struct{})
+		previous = pool.last[trigger]
+		pool.last[trigger] = current
+	}
+
+	execution := func() {
+		if current != nil {
+			defer close(current)
+		}
+		// the previous execution was granted its slot earlier, so waiting for it while holding a slot can't deadlock
+		if previous != nil {
+			<-previous
+		}
+		fn()
+	}
+
+	if pool.running < pool.size {
+		pool.running++
+		go pool.run(execution)
+		return
+	}
+	pool.queue = append(pool.queue, execution)
+}
+
+// run executes the execution, then the queued executions in order, and releases its slot once the queue is empty
+func (pool *triggerPool) run(execution func()) {
+	for execution != nil {
+		execution()
+
+		pool.lock.Lock()
+		execution = nil
+		// the slot is released rather than reused if the pool shrank meanwhile
+		if len(pool.queue) > 0 && pool.running <= pool.size {
+			execution = pool.queue[0]
+			pool.queue[0] = nil
+			pool.queue = pool.queue[1:]
+		} else {
+			pool.running--
+		}
+		pool.lock.Unlock()
+	}
+}
+
+// resize changes the max number of triggers executed at the same time. The queued executions are started right away
+// if the pool grows, while the executions in flight complete before the slots are released if it shrinks.
+func (pool *triggerPool) resize(concurrency int32) {
+	if concurrency <= 0 {
+		concurrency = defaultTriggerConcurrency
+	}
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+	pool.size = int(concurrency)
+	for pool.running < pool.size && len(pool.queue) > 0 {
+		execution := pool.queue[0]
+		pool.queue[0] = nil
+		pool.queue = pool.queue[1:]
+		pool.running++
+		go pool.run(execution)
+	}
+}
+
+// wait blocks until the trigger cycles in flight complete
+func (pool *triggerPool) wait() {
+	pool.cycles.Wait()
+}
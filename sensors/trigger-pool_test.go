@@ -0,0 +1,249 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common"
+	snctrl "github.com/argoproj/argo-events/controllers/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorFake "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/types"
+)
+
+func TestTriggerPoolConcurrency(t *testing.T) {
+	pool := newTriggerPool(2)
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		pool.submit("fake-trigger", false, func() {
+			defer wg.Done()
+			current := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), maxRunning)
+}
+
+func TestTriggerPoolResize(t *testing.T) {
+	pool := newTriggerPool(1)
+	release := make(chan struct{})
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	execute := func() {
+		defer wg.Done()
+		current := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		<-release
+		atomic.AddInt32(&running, -1)
+	}
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		pool.submit("fake-trigger", false, execute)
+	}
+
+	// the queued executions are started once the pool grows
+	pool.resize(3)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&running) == 3
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// the slots are released once the pool shrinks
+	pool.resize(1)
+	atomic.StoreInt32(&maxRunning, 0)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		pool.submit("fake-trigger", false, execute)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), maxRunning)
+}
+
+func TestTriggerPoolOrdered(t *testing.T) {
+	pool := newTriggerPool(5)
+	var lock sync.Mutex
+	var executions []int
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(1)
+		pool.submit("fake-trigger", true, func() {
+			defer wg.Done()
+			// the earlier executions take longer, so they would complete last if they weren't ordered
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			lock.Lock()
+			executions = append(executions, i)
+			lock.Unlock()
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, executions)
+}
+
+func TestTriggerPoolSubmissionOrder(t *testing.T) {
+	pool := newTriggerPool(1)
+	var lock sync.Mutex
+	var executions []int
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(1)
+		pool.submit("fake-trigger-"+strconv.Itoa(i), false, func() {
+			defer wg.Done()
+			time.Sleep(time.Millisecond)
+			lock.Lock()
+			executions = append(executions, i)
+			lock.Unlock()
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, executions)
+}
+
+func TestDispatchTriggersInSpecOrder(t *testing.T) {
+	var lock sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		requests = append(requests, request.URL.Path)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = []v1alpha1.EventDependency{
+		{
+			Name:        "dep1",
+			GatewayName: "webhook-gateway",
+			EventName:   "example-1",
+		},
+	}
+	var paths []string
+	obj.Spec.Triggers = nil
+	for i := 0; i < 10; i++ {
+		path := "/" + strconv.Itoa(i)
+		paths = append(paths, path)
+		obj.Spec.Triggers = append(obj.Spec.Triggers, v1alpha1.Trigger{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-http-trigger-" + strconv.Itoa(i),
+				HTTP: &v1alpha1.HTTPTrigger{
+					URL:    server.URL + path,
+					Method: http.MethodGet,
+				},
+			},
+		})
+	}
+	snctrl.InitializeNode(obj, "dep1", v1alpha1.NodeTypeEventDependency, common.NewArgoEventsLogger())
+	sensorClient := sensorFake.NewSimpleClientset()
+	obj, err := sensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Create(obj)
+	assert.Nil(t, err)
+	sensorCtx := NewSensorContext(sensorClient, fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj.DeepCopy(), "1")
+
+	sensorCtx.processQueue(&types.Notification{
+		Event: &v1alpha1.Event{
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Source:          "webhook-gateway",
+				Type:            "webhook",
+				DataContentType: common.MediaTypeJSON,
+				Subject:         "example-1",
+				Time:            metav1.Time{Time: time.Now().UTC()},
+			},
+			Data: []byte(`{"message": "hello"}`),
+		},
+		EventDependency:  &obj.Spec.Dependencies[0],
+		NotificationType: v1alpha1.EventNotification,
+	})
+	sensorCtx.triggerPool.wait()
+
+	// with the default concurrency, the triggers are executed one at a time in the order of the spec
+	assert.Equal(t, paths, requests)
+}
+
+func TestRunTriggerTimeout(t *testing.T) {
+	release := make(chan struct{})
+	cancelled := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		select {
+		case <-request.Context().Done():
+			cancelled <- struct{}{}
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{
+		{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "fake-http-trigger",
+				HTTP: &v1alpha1.HTTPTrigger{
+					URL:    server.URL,
+					Method: http.MethodGet,
+				},
+			},
+			Timeout: 1,
+		},
+	}
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(), fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj, "1")
+	snctrl.InitializeNode(obj, "fake-http-trigger", v1alpha1.NodeTypeTrigger, sensorCtx.Logger)
+
+	start := time.Now()
+	_, err := sensorCtx.runTrigger(context.Background(), obj.DeepCopy(), obj.Spec.Triggers[0])
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	assert.True(t, time.Since(start) < 5*time.Second)
+
+	// the request is cancelled, and the execution is recorded before the trigger returns
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the request of the trigger isn't cancelled")
+	}
+	assert.Equal(t, v1alpha1.NodePhaseError, snctrl.GetNodeByName(obj, "fake-http-trigger").Phase)
+}
@@ -17,90 +17,28 @@ package sensors
 
 import (
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
-	argoworkflow "github.com/argoproj/argo-events/sensors/triggers/argo-workflow"
-	awslambda "github.com/argoproj/argo-events/sensors/triggers/aws-lambda"
-	customtrigger "github.com/argoproj/argo-events/sensors/triggers/custom-trigger"
-	"github.com/argoproj/argo-events/sensors/triggers/http"
-	"github.com/argoproj/argo-events/sensors/triggers/kafka"
-	"github.com/argoproj/argo-events/sensors/triggers/nats"
-	"github.com/argoproj/argo-events/sensors/triggers/slack"
-	standardk8s "github.com/argoproj/argo-events/sensors/triggers/standard-k8s"
+	"github.com/argoproj/argo-events/sensors/triggers"
+	// the trigger packages register their implementations
+	_ "github.com/argoproj/argo-events/sensors/triggers/apache-openwhisk"
+	_ "github.com/argoproj/argo-events/sensors/triggers/argo-workflow"
+	_ "github.com/argoproj/argo-events/sensors/triggers/aws-lambda"
+	_ "github.com/argoproj/argo-events/sensors/triggers/custom-trigger"
+	_ "github.com/argoproj/argo-events/sensors/triggers/http"
+	_ "github.com/argoproj/argo-events/sensors/triggers/kafka"
+	_ "github.com/argoproj/argo-events/sensors/triggers/nats"
+	_ "github.com/argoproj/argo-events/sensors/triggers/slack"
+	_ "github.com/argoproj/argo-events/sensors/triggers/standard-k8s"
 )
 
-// Trigger interface
-type Trigger interface {
-	// FetchResource fetches the trigger resource from external source
-	FetchResource() (interface{}, error)
-	// ApplyResourceParameters applies parameters to the trigger resource
-	ApplyResourceParameters(sensor *v1alpha1.Sensor, resource interface{}) (interface{}, error)
-	// Execute executes the trigger
-	Execute(resource interface{}) (interface{}, error)
-	// ApplyPolicy applies the policy on the trigger
-	ApplyPolicy(resource interface{}) error
-}
-
-// GetTrigger returns a trigger
-func (sensorCtx *SensorContext) GetTrigger(trigger *v1alpha1.Trigger) Trigger {
-	if trigger.Template.K8s != nil {
-		return standardk8s.NewStandardK8sTrigger(sensorCtx.KubeClient, sensorCtx.DynamicClient, sensorCtx.Sensor, trigger, sensorCtx.Logger)
-	}
-
-	if trigger.Template.ArgoWorkflow != nil {
-		return argoworkflow.NewArgoWorkflowTrigger(sensorCtx.KubeClient, sensorCtx.DynamicClient, sensorCtx.Sensor, trigger, sensorCtx.Logger)
-	}
-
-	if trigger.Template.HTTP != nil {
-		result, err := http.NewHTTPTrigger(sensorCtx.httpClients, sensorCtx.KubeClient, sensorCtx.Sensor, trigger, sensorCtx.Logger)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).WithField("trigger", trigger.Template.Name).Errorln("failed to invoke the trigger")
-			return nil
-		}
-		return result
-	}
-
-	if trigger.Template.AWSLambda != nil {
-		result, err := awslambda.NewAWSLambdaTrigger(sensorCtx.awsLambdaClients, sensorCtx.KubeClient, sensorCtx.Sensor, trigger, sensorCtx.Logger)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).WithField("trigger", trigger.Template.Name).Errorln("failed to invoke the trigger")
-			return nil
-		}
-		return result
-	}
-
-	if trigger.Template.Kafka != nil {
-		result, err := kafka.NewKafkaTrigger(sensorCtx.Sensor, trigger, sensorCtx.kafkaProducers, sensorCtx.Logger)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).WithField("trigger", trigger.Template.Name).Errorln("failed to invoke the trigger")
-			return nil
-		}
-		return result
-	}
-
-	if trigger.Template.NATS != nil {
-		result, err := nats.NewNATSTrigger(sensorCtx.Sensor, trigger, sensorCtx.natsConnections, sensorCtx.Logger)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).WithField("trigger", trigger.Template.Name).Errorln("failed to invoke the trigger")
-			return nil
-		}
-		return result
-	}
-
-	if trigger.Template.Slack != nil {
-		result, err := slack.NewSlackTrigger(sensorCtx.KubeClient, sensorCtx.Sensor, trigger, sensorCtx.Logger, sensorCtx.slackHttpClient)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).WithField("trigger", trigger.Template.Name).Errorln("failed to invoke the trigger")
-			return nil
-		}
-		return result
+// triggerType returns the type of the trigger, i.e. the name of the template field that is set
+func triggerType(trigger *v1alpha1.Trigger) string {
+	if field := triggers.TemplateField(trigger.Template); field != "" {
+		return field
 	}
+	return "unknown"
+}
 
-	if trigger.Template.CustomTrigger != nil {
-		result, err := customtrigger.NewCustomTrigger(sensorCtx.Sensor, trigger, sensorCtx.Logger, sensorCtx.customTriggerClients)
-		if err != nil {
-			sensorCtx.Logger.WithError(err).WithField("trigger", trigger.Template.Name).Errorln("failed to invoke the trigger")
-			return nil
-		}
-		return result
-	}
-	return nil
+// GetTrigger returns the implementation of a trigger of the sensor, as registered for the type of the trigger
+func (sensorCtx *SensorContext) GetTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger) (triggers.Trigger, error) {
+	return triggers.NewTrigger(sensorCtx.triggerClients, sensor, trigger, sensorCtx.Logger)
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorFake "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/triggers"
+	apacheopenwhisk "github.com/argoproj/argo-events/sensors/triggers/apache-openwhisk"
+	"github.com/argoproj/argo-events/sensors/triggers/http"
+)
+
+func TestCheckRegistry(t *testing.T) {
+	// every trigger of the template is implemented by a trigger package
+	assert.Nil(t, triggers.CheckRegistry())
+}
+
+func TestGetTrigger(t *testing.T) {
+	obj := &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-sensor", Namespace: "fake"},
+		Spec: v1alpha1.SensorSpec{
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "fake-openwhisk-trigger",
+						OpenWhisk: &v1alpha1.OpenWhiskTrigger{
+							Host:       "fake.com",
+							ActionName: "hello",
+						},
+					},
+				},
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "fake-http-trigger",
+						HTTP: &v1alpha1.HTTPTrigger{
+							URL:    "http://fake.com",
+							Method: "POST",
+						},
+					},
+				},
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "fake-empty-trigger",
+					},
+				},
+			},
+		},
+	}
+	sensorCtx := NewSensorContext(sensorFake.NewSimpleClientset(obj), fake.NewSimpleClientset(), dfake.NewSimpleDynamicClient(runtime.NewScheme()), obj, "1")
+
+	triggerImpl, err := sensorCtx.GetTrigger(obj, &obj.Spec.Triggers[0])
+	assert.Nil(t, err)
+	openWhiskTrigger, ok := triggerImpl.(*apacheopenwhisk.TriggerImpl)
+	assert.True(t, ok)
+	assert.Equal(t, "openWhisk", triggerType(&obj.Spec.Triggers[0]))
+
+	// the clients are reused by the next executions of the trigger
+	triggerImpl, err = sensorCtx.GetTrigger(obj, &obj.Spec.Triggers[0])
+	assert.Nil(t, err)
+	assert.Equal(t, openWhiskTrigger.OpenWhiskClient, triggerImpl.(*apacheopenwhisk.TriggerImpl).OpenWhiskClient)
+
+	triggerImpl, err = sensorCtx.GetTrigger(obj, &obj.Spec.Triggers[1])
+	assert.Nil(t, err)
+	_, ok = triggerImpl.(*http.HTTPTrigger)
+	assert.True(t, ok)
+
+	triggerImpl, err = sensorCtx.GetTrigger(obj, &obj.Spec.Triggers[2])
+	assert.NotNil(t, err)
+	assert.Nil(t, triggerImpl)
+	assert.Equal(t, "unknown", triggerType(&obj.Spec.Triggers[2]))
+}
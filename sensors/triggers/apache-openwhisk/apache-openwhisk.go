@@ -16,6 +16,7 @@ limitations under the License.
 package apache_openwhisk
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -44,6 +45,19 @@ type TriggerImpl struct {
 	Logger *logrus.Logger
 }
 
+func init() {
+	triggers.Register("openWhisk", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		openWhiskClients := clients.Store("openWhisk", func() interface{} {
+			return make(map[string]*whisk.Client)
+		}).(map[string]*whisk.Client)
+		result, err := NewTriggerImpl(openWhiskClients, clients.KubeClient, sensor, trigger, logger)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewTriggerImpl returns a new TriggerImpl
 func NewTriggerImpl(openWhiskClients map[string]*whisk.Client, k8sCLient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (*TriggerImpl, error) {
 	openwhisktrigger := trigger.Template.OpenWhisk
@@ -56,13 +70,11 @@ func NewTriggerImpl(openWhiskClients map[string]*whisk.Client, k8sCLient kuberne
 		}).Debugln("OpenWhisk trigger value")
 		logger.WithField("trigger-name", trigger.Template.Name).Infoln("instantiating OpenWhisk client")
 
-		config, err := whisk.GetDefaultConfig()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get default configuration")
+		// the configuration is built from the trigger rather than from the wskprops of the sensor image
+		config := &whisk.Config{
+			Host: openwhisktrigger.Host,
 		}
 
-		config.Host = openwhisktrigger.Host
-
 		if openwhisktrigger.AuthToken != nil {
 			token, err := common.GetSecretValue(k8sCLient, sensor.Namespace, openwhisktrigger.AuthToken)
 			if err != nil {
@@ -80,6 +92,7 @@ func NewTriggerImpl(openWhiskClients map[string]*whisk.Client, k8sCLient kuberne
 
 		logger.WithField("config", *config).Debugln("configuration for OpenWhisk client")
 
+		var err error
 		client, err = whisk.NewClient(http.DefaultClient, config)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to instantiate OpenWhisk client")
@@ -137,7 +150,7 @@ func (t *TriggerImpl) ApplyResourceParameters(sensor *v1alpha1.Sensor, resource
 }
 
 // Execute executes the trigger
-func (t *TriggerImpl) Execute(resource interface{}) (interface{}, error) {
+func (t *TriggerImpl) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	var payload []byte
 	var err error
 
@@ -160,7 +173,11 @@ func (t *TriggerImpl) Execute(resource interface{}) (interface{}, error) {
 
 	response, status, err := t.OpenWhiskClient.Actions.Invoke(openwhisktrigger.ActionName, payload, true, true)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to invoke action %s", openwhisktrigger.ActionName)
+		err = errors.Wrapf(err, "failed to invoke action %s", openwhisktrigger.ActionName)
+		if status != nil {
+			return nil, triggers.MarkStatusError(err, status.StatusCode)
+		}
+		return nil, err
 	}
 
 	t.Logger.WithFields(logrus.Fields{
@@ -168,11 +185,14 @@ func (t *TriggerImpl) Execute(resource interface{}) (interface{}, error) {
 		"response": response,
 	}).Debugln("response for the OpenWhisk action invocation")
 
+	if err := triggers.CheckStatus(t.Trigger, status.StatusCode); err != nil {
+		return nil, err
+	}
 	return status, nil
 }
 
 // ApplyPolicy applies policy on the trigger
-func (t *TriggerImpl) ApplyPolicy(resource interface{}) error {
+func (t *TriggerImpl) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
 		return nil
 	}
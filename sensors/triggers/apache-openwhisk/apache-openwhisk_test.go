@@ -16,6 +16,7 @@ limitations under the License.
 package apache_openwhisk
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -128,12 +129,12 @@ func TestTriggerImpl_ApplyPolicy(t *testing.T) {
 		Status: &v1alpha1.StatusPolicy{Allow: []int32{200, 300}},
 	}
 	response := &http.Response{StatusCode: 200}
-	err := trigger.ApplyPolicy(response)
+	err := trigger.ApplyPolicy(context.Background(), response)
 	assert.Nil(t, err)
 
 	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{
 		Status: &v1alpha1.StatusPolicy{Allow: []int32{300}},
 	}
-	err = trigger.ApplyPolicy(response)
+	err = trigger.ApplyPolicy(context.Background(), response)
 	assert.NotNil(t, err)
 }
@@ -16,13 +16,7 @@ limitations under the License.
 package argo_workflow
 
 import (
-	"encoding/json"
-	"io/ioutil"
-	"os"
-	"os/exec"
-
-	wf_v1alpha1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
-	"github.com/ghodss/yaml"
+	"context"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -53,6 +47,12 @@ type ArgoWorkflowTrigger struct {
 	namespableDynamicClient dynamic.NamespaceableResourceInterface
 }
 
+func init() {
+	triggers.Register("argoWorkflow", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		return NewArgoWorkflowTrigger(clients.KubeClient, clients.DynamicClient, sensor, trigger, logger), nil
+	})
+}
+
 // NewArgoWorkflowTrigger returns a new Argo workflow trigger
 func NewArgoWorkflowTrigger(k8sClient kubernetes.Interface, dynamicClient dynamic.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) *ArgoWorkflowTrigger {
 	return &ArgoWorkflowTrigger{
@@ -67,6 +67,13 @@ func NewArgoWorkflowTrigger(k8sClient kubernetes.Interface, dynamicClient dynami
 // FetchResource fetches the trigger resource from external source
 func (t *ArgoWorkflowTrigger) FetchResource() (interface{}, error) {
 	trigger := t.Trigger
+	if trigger.Template.ArgoWorkflow.Source == nil && trigger.Template.ArgoWorkflow.SubmitFrom != nil {
+		// the workflow is created from the workflow reference only
+		gvr := trigger.Template.ArgoWorkflow.GroupVersionResource
+		wf := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		wf.SetGroupVersionKind(schema.GroupVersionKind{Group: gvr.Group, Version: gvr.Version, Kind: "Workflow"})
+		return wf, nil
+	}
 	return triggers.FetchKubernetesResource(t.K8sClient, trigger.Template.ArgoWorkflow.Source, t.Sensor.Namespace, trigger.Template.ArgoWorkflow.GroupVersionResource)
 }
 
@@ -82,8 +89,11 @@ func (t *ArgoWorkflowTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, r
 	return obj, nil
 }
 
-// Execute executes the trigger
-func (t *ArgoWorkflowTrigger) Execute(resource interface{}) (interface{}, error) {
+// Execute executes the trigger, the errors of the requests which would fail again once retried are marked as non-retryable
+func (t *ArgoWorkflowTrigger) Execute(ctx context.Context, resource interface{}) (result interface{}, err error) {
+	defer func() {
+		err = triggers.MarkKubeAPIError(err)
+	}()
 	trigger := t.Trigger
 
 	obj, ok := resource.(*unstructured.Unstructured)
@@ -91,16 +101,6 @@ func (t *ArgoWorkflowTrigger) Execute(resource interface{}) (interface{}, error)
 		return nil, errors.New("failed to interpret the trigger resource")
 	}
 
-	jObj, err := obj.MarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-
-	var workflow *wf_v1alpha1.Workflow
-	if err := json.Unmarshal(jObj, &workflow); err != nil {
-		return nil, errors.Wrap(err, "internal un-marshalling of the trigger resource failed")
-	}
-
 	namespace := obj.GetNamespace()
 	// Defaults to sensor's namespace
 	if namespace == "" {
@@ -108,71 +108,70 @@ func (t *ArgoWorkflowTrigger) Execute(resource interface{}) (interface{}, error)
 	}
 	obj.SetNamespace(namespace)
 
-	if workflow.Name == "" && workflow.GenerateName == "" {
-		return nil, errors.New("workflow is malformed. neither name nor generateName is specified")
-	}
-
-	name := workflow.Name
-	if name == "" && workflow.GenerateName != "" {
-		name = workflow.GenerateName
-	}
-
 	op := v1alpha1.Submit
 	if trigger.Template.ArgoWorkflow.Operation != "" {
 		op = trigger.Template.ArgoWorkflow.Operation
 	}
 
-	var cmd *exec.Cmd
+	gvk := obj.GroupVersionKind()
+	t.namespableDynamicClient = t.DynamicClient.Resource(schema.GroupVersionResource{
+		Group:    gvk.Group,
+		Version:  gvk.Version,
+		Resource: "workflows",
+	})
+	client := t.namespableDynamicClient.Namespace(namespace)
 
-	switch op {
-	case v1alpha1.Submit:
-		file, err := ioutil.TempFile("/bin/workflows", workflow.Name)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to create a temp file for the workflow %s", name)
+	if op == v1alpha1.Submit {
+		if trigger.Template.ArgoWorkflow.SubmitFrom != nil {
+			var err error
+			if obj, err = t.fromReference(namespace, obj); err != nil {
+				return nil, err
+			}
 		}
-		defer os.Remove(file.Name())
-
-		workflowYaml, err := yaml.Marshal(workflow)
+		if obj.GetName() == "" && obj.GetGenerateName() == "" {
+			return nil, errors.New("workflow is malformed. neither name nor generateName is specified")
+		}
+		t.Logger.WithField("workflow", obj.GetName()+obj.GetGenerateName()).Infoln("submitting the workflow...")
+		result, err := client.Create(obj, metav1.CreateOptions{})
 		if err != nil {
-			return nil, errors.Wrap(err, "internal marshalling to YAML of the trigger resource failed")
+			return nil, errors.Wrap(err, "failed to submit the workflow")
 		}
+		return result, nil
+	}
 
-		if _, err := file.Write(workflowYaml); err != nil {
-			return nil, errors.Wrapf(err, "failed to write workflow yaml %s to the temp file %s", name, file.Name())
-		}
-		cmd = exec.Command("argo", "-n", namespace, "submit", file.Name())
-	case v1alpha1.Resubmit:
-		cmd = exec.Command("argo", "-n", namespace, "resubmit", name)
+	name := obj.GetName()
+	if name == "" {
+		return nil, errors.Errorf("workflow name must be specified for the %s operation", string(op))
+	}
+	t.Logger.WithFields(logrus.Fields{
+		"workflow":  name,
+		"operation": string(op),
+	}).Infoln("executing the operation on the workflow...")
+
+	switch op {
+	case v1alpha1.Suspend:
+		return suspend(client, name)
 	case v1alpha1.Resume:
-		cmd = exec.Command("argo", "-n", namespace, "resume", name)
+		return resume(client, name)
 	case v1alpha1.Retry:
-		cmd = exec.Command("argo", "-n", namespace, "retry", name)
-	case v1alpha1.Suspend:
-		cmd = exec.Command("argo", "-n", namespace, "suspend", name)
+		return t.retryWorkflow(client, namespace, name)
+	case v1alpha1.Resubmit:
+		return resubmit(client, name)
+	case v1alpha1.Terminate:
+		// the workflow exceeds its deadline right away
+		return patchSpec(client, name, `{"activeDeadlineSeconds":0}`)
+	case v1alpha1.Stop:
+		return patchSpec(client, name, `{"shutdown":"Stop"}`)
 	default:
 		return nil, errors.Errorf("unknown operation type %s", string(op))
 	}
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
-		return nil, errors.Wrapf(err, "failed to execute %s command for workflow %s", string(op), name)
-	}
-
-	t.namespableDynamicClient = t.DynamicClient.Resource(schema.GroupVersionResource{
-		Group:    workflow.GroupVersionKind().Group,
-		Version:  workflow.GroupVersionKind().Version,
-		Resource: "workflows",
-	})
-
-	return t.namespableDynamicClient.Namespace(namespace).Get(name, metav1.GetOptions{})
 }
 
 // ApplyPolicy applies the policy on the trigger
-func (t *ArgoWorkflowTrigger) ApplyPolicy(resource interface{}) error {
+func (t *ArgoWorkflowTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	trigger := t.Trigger
 
-	if trigger.Policy == nil || trigger.Policy.K8s == nil || trigger.Policy.K8s.Labels == nil {
+	if trigger.Policy == nil || (trigger.Policy.K8s == nil && trigger.Policy.Completion == nil) {
 		return nil
 	}
 
@@ -181,23 +180,24 @@ func (t *ArgoWorkflowTrigger) ApplyPolicy(resource interface{}) error {
 		return errors.New("failed to interpret the trigger resource")
 	}
 
-	p := policy.NewResourceLabels(trigger, t.namespableDynamicClient, obj)
-	if p == nil {
-		return nil
-	}
-
-	err := p.ApplyPolicy()
-	if err != nil {
-		switch err {
-		case wait.ErrWaitTimeout:
-			if trigger.Policy.K8s.ErrorOnBackoffTimeout {
-				return errors.Errorf("failed to determine status of the triggered resource. setting trigger state as failed")
+	if trigger.Policy.K8s != nil && trigger.Policy.K8s.Labels != nil {
+		p := policy.NewResourceLabels(trigger, t.namespableDynamicClient, obj)
+		if err := p.ApplyPolicy(ctx); err != nil {
+			switch err {
+			case wait.ErrWaitTimeout:
+				if trigger.Policy.K8s.ErrorOnBackoffTimeout {
+					return errors.Errorf("failed to determine status of the triggered resource. setting trigger state as failed")
+				}
+			default:
+				return err
 			}
-			return nil
-		default:
-			return err
 		}
 	}
 
+	if trigger.Policy.Completion != nil {
+		t.Logger.WithField("name", obj.GetName()).Infoln("waiting for the resource to complete...")
+		return policy.NewCompletion(trigger, t.namespableDynamicClient, obj).ApplyPolicy(ctx)
+	}
+
 	return nil
 }
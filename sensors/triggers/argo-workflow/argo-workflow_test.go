@@ -16,12 +16,15 @@ limitations under the License.
 package argo_workflow
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -107,3 +110,169 @@ func TestFetchResource(t *testing.T) {
 func TestApplyResourceParameters(t *testing.T) {
 
 }
+
+var workflowsResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"}
+
+func newWorkflowTrigger(operation v1alpha1.ArgoWorkflowOperation, objects ...runtime.Object) *ArgoWorkflowTrigger {
+	trigger := getFakeWfTrigger()
+	trigger.DynamicClient = dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	trigger.Trigger.Template.ArgoWorkflow.Operation = operation
+	return trigger
+}
+
+func getWorkflow(t *testing.T, trigger *ArgoWorkflowTrigger, name string) *unstructured.Unstructured {
+	wf, err := trigger.DynamicClient.Resource(workflowsResource).Namespace("fake").Get(name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	return wf
+}
+
+func TestExecuteSuspendResume(t *testing.T) {
+	wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+	wf.Object["status"] = map[string]interface{}{
+		"phase": "Running",
+		"nodes": map[string]interface{}{
+			"test-1": map[string]interface{}{"name": "test[0].approve", "type": "Suspend", "phase": "Running"},
+			"test-2": map[string]interface{}{"name": "test[0].run", "type": "Pod", "phase": "Running"},
+		},
+	}
+
+	trigger := newWorkflowTrigger(v1alpha1.Suspend, wf)
+	_, err := trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	suspended, _, _ := unstructured.NestedBool(getWorkflow(t, trigger, "test").Object, "spec", "suspend")
+	assert.True(t, suspended)
+
+	trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Resume
+	_, err = trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	resumed := getWorkflow(t, trigger, "test")
+	_, found, _ := unstructured.NestedFieldNoCopy(resumed.Object, "spec", "suspend")
+	assert.False(t, found)
+	phase, _, _ := unstructured.NestedString(resumed.Object, "status", "nodes", "test-1", "phase")
+	assert.Equal(t, "Succeeded", phase)
+	phase, _, _ = unstructured.NestedString(resumed.Object, "status", "nodes", "test-2", "phase")
+	assert.Equal(t, "Running", phase)
+
+	// the name of the workflow is required
+	_, err = trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", ""))
+	assert.NotNil(t, err)
+}
+
+func TestExecuteTerminateStop(t *testing.T) {
+	trigger := newWorkflowTrigger(v1alpha1.Terminate, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+	_, err := trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	deadline, _, _ := unstructured.NestedInt64(getWorkflow(t, trigger, "test").Object, "spec", "activeDeadlineSeconds")
+	assert.Equal(t, int64(0), deadline)
+
+	trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Stop
+	_, err = trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	shutdown, _, _ := unstructured.NestedString(getWorkflow(t, trigger, "test").Object, "spec", "shutdown")
+	assert.Equal(t, "Stop", shutdown)
+}
+
+func TestExecuteRetryResubmit(t *testing.T) {
+	wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+	wf.SetLabels(map[string]string{
+		"workflows.argoproj.io/completed": "true",
+		"workflows.argoproj.io/phase":     "Failed",
+		"app":                             "fake",
+	})
+	wf.Object["spec"] = map[string]interface{}{
+		"entrypoint":            "main",
+		"activeDeadlineSeconds": int64(0),
+	}
+	wf.Object["status"] = map[string]interface{}{
+		"phase":   "Failed",
+		"message": "child failed",
+		"nodes": map[string]interface{}{
+			"test":   map[string]interface{}{"name": "test", "type": "Steps", "phase": "Failed"},
+			"test-1": map[string]interface{}{"name": "test[0].first", "type": "Pod", "phase": "Succeeded"},
+			"test-2": map[string]interface{}{"name": "test[1].second", "type": "Pod", "phase": "Failed"},
+		},
+	}
+	trigger := newWorkflowTrigger(v1alpha1.Retry, wf)
+	_, err := trigger.K8sClient.CoreV1().Pods("fake").Create(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-2", Namespace: "fake"},
+	})
+	assert.Nil(t, err)
+
+	_, err = trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	retried := getWorkflow(t, trigger, "test")
+	assert.Equal(t, map[string]string{"workflows.argoproj.io/phase": "Running", "app": "fake"}, retried.GetLabels())
+	phase, _, _ := unstructured.NestedString(retried.Object, "status", "phase")
+	assert.Equal(t, "Running", phase)
+	nodes, _, _ := unstructured.NestedMap(retried.Object, "status", "nodes")
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, "Running", nodes["test"].(map[string]interface{})["phase"])
+	assert.Equal(t, "Succeeded", nodes["test-1"].(map[string]interface{})["phase"])
+	_, err = trigger.K8sClient.CoreV1().Pods("fake").Get("test-2", metav1.GetOptions{})
+	assert.NotNil(t, err)
+	_, found, _ := unstructured.NestedFieldNoCopy(retried.Object, "spec", "activeDeadlineSeconds")
+	assert.False(t, found)
+
+	// the running workflow can't be retried
+	_, err = trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.NotNil(t, err)
+
+	trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Resubmit
+	result, err := trigger.Execute(context.Background(), newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	resubmitted := result.(*unstructured.Unstructured)
+	assert.Equal(t, "test-", resubmitted.GetGenerateName())
+	assert.Equal(t, map[string]string{"app": "fake"}, resubmitted.GetLabels())
+	entrypoint, _, _ := unstructured.NestedString(resubmitted.Object, "spec", "entrypoint")
+	assert.Equal(t, "main", entrypoint)
+	_, found, _ = unstructured.NestedFieldNoCopy(resubmitted.Object, "status")
+	assert.False(t, found)
+}
+
+func TestExecuteSubmitFrom(t *testing.T) {
+	template := newUnstructured("argoproj.io/v1alpha1", "WorkflowTemplate", "fake", "hello")
+	template.Object["spec"] = map[string]interface{}{
+		"entrypoint": "whalesay",
+		"arguments": map[string]interface{}{
+			"parameters": []interface{}{
+				map[string]interface{}{"name": "message", "value": "hello"},
+				map[string]interface{}{"name": "subject", "value": "world"},
+			},
+		},
+	}
+	trigger := newWorkflowTrigger(v1alpha1.Submit, template)
+	trigger.Trigger.Template.ArgoWorkflow.Source = nil
+	trigger.Trigger.Template.ArgoWorkflow.SubmitFrom = &v1alpha1.WorkflowReference{
+		Kind: "WorkflowTemplate",
+		Name: "hello",
+	}
+
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	wf := resource.(*unstructured.Unstructured)
+	assert.Equal(t, "Workflow", wf.GetKind())
+	wf.Object["spec"] = map[string]interface{}{
+		"arguments": map[string]interface{}{
+			"parameters": []interface{}{
+				map[string]interface{}{"name": "message", "value": "bonjour"},
+			},
+		},
+	}
+
+	result, err := trigger.Execute(context.Background(), wf)
+	assert.Nil(t, err)
+	submitted := result.(*unstructured.Unstructured)
+	assert.Equal(t, "hello-", submitted.GetGenerateName())
+	assert.Equal(t, "hello", submitted.GetLabels()["workflows.argoproj.io/workflow-template"])
+	entrypoint, _, _ := unstructured.NestedString(submitted.Object, "spec", "entrypoint")
+	assert.Equal(t, "whalesay", entrypoint)
+	parameters, _, _ := unstructured.NestedSlice(submitted.Object, "spec", "arguments", "parameters")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "message", "value": "bonjour"},
+		map[string]interface{}{"name": "subject", "value": "world"},
+	}, parameters)
+
+	trigger.Trigger.Template.ArgoWorkflow.SubmitFrom.Name = "missing"
+	_, err = trigger.Execute(context.Background(), wf)
+	assert.NotNil(t, err)
+}
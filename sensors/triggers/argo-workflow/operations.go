@@ -0,0 +1,353 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argo_workflow
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+// labels and phases of the workflows, as set by the workflow controller
+const (
+	labelPrefix               = "workflows.argoproj.io/"
+	labelCompleted            = labelPrefix + "completed"
+	labelPhase                = labelPrefix + "phase"
+	labelControllerInstanceID = labelPrefix + "controller-instanceid"
+	labelWorkflowTemplate     = labelPrefix + "workflow-template"
+	labelCronWorkflow         = labelPrefix + "cron-workflow"
+
+	phaseRunning   = "Running"
+	phaseSucceeded = "Succeeded"
+	phaseSkipped   = "Skipped"
+	phaseFailed    = "Failed"
+	phaseError     = "Error"
+
+	nodeTypePod     = "Pod"
+	nodeTypeDAG     = "DAG"
+	nodeTypeSuspend = "Suspend"
+
+	kindWorkflowTemplate = "WorkflowTemplate"
+	kindCronWorkflow     = "CronWorkflow"
+)
+
+// isCompleted returns whether the workflow has completed
+func isCompleted(wf *unstructured.Unstructured) bool {
+	if wf.GetLabels()[labelCompleted] == "true" {
+		return true
+	}
+	phase, _, _ := unstructured.NestedString(wf.Object, "status", "phase")
+	return phase == phaseSucceeded || phase == phaseFailed || phase == phaseError
+}
+
+// statusNodes returns the nodes of the status of the workflow, which are updated in place
+func statusNodes(wf *unstructured.Unstructured) map[string]interface{} {
+	nodes, ok, _ := unstructured.NestedFieldNoCopy(wf.Object, "status", "nodes")
+	if !ok {
+		return nil
+	}
+	result, _ := nodes.(map[string]interface{})
+	return result
+}
+
+// resetNode marks the node as running again
+func resetNode(node map[string]interface{}) {
+	node["phase"] = phaseRunning
+	node["message"] = ""
+	delete(node, "finishedAt")
+}
+
+// patchSpec merges the patch into the spec of the workflow
+func patchSpec(client dynamic.ResourceInterface, name string, patch string) (*unstructured.Unstructured, error) {
+	return client.Patch(name, k8stypes.MergePatchType, []byte(`{"spec":`+patch+`}`), metav1.PatchOptions{})
+}
+
+// suspend suspends the workflow
+func suspend(client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	wf, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the workflow %s", name)
+	}
+	if isCompleted(wf) {
+		return nil, errors.Errorf("cannot suspend the completed workflow %s", name)
+	}
+	return patchSpec(client, name, `{"suspend":true}`)
+}
+
+// resume resumes the workflow, marking its suspended nodes as successful
+func resume(client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wf, err := client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(wf.Object, "spec", "suspend")
+		for _, value := range statusNodes(wf) {
+			node, ok := value.(map[string]interface{})
+			if ok && node["type"] == nodeTypeSuspend && node["phase"] == phaseRunning {
+				node["phase"] = phaseSucceeded
+				node["finishedAt"] = time.Now().UTC().Format(time.RFC3339)
+			}
+		}
+		result, err = client.Update(wf, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resume the workflow %s", name)
+	}
+	return result, nil
+}
+
+// retryWorkflow retries the failed workflow, deleting the pods of its failed nodes and its exit handler,
+// so that the workflow controller runs them again
+func (t *ArgoWorkflowTrigger) retryWorkflow(client dynamic.ResourceInterface, namespace, name string) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wf, err := client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		phase, _, _ := unstructured.NestedString(wf.Object, "status", "phase")
+		if phase != phaseFailed && phase != phaseError {
+			return errors.Errorf("workflow must be %s or %s to retry, it is %s", phaseFailed, phaseError, phase)
+		}
+
+		labels := wf.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		delete(labels, labelCompleted)
+		labels[labelPhase] = phaseRunning
+		wf.SetLabels(labels)
+		if err := unstructured.SetNestedField(wf.Object, phaseRunning, "status", "phase"); err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(wf.Object, "status", "message")
+		unstructured.RemoveNestedField(wf.Object, "status", "finishedAt")
+		// the deadline of a terminated workflow is unset
+		if deadline, ok, _ := unstructured.NestedInt64(wf.Object, "spec", "activeDeadlineSeconds"); ok && deadline == 0 {
+			unstructured.RemoveNestedField(wf.Object, "spec", "activeDeadlineSeconds")
+		}
+		unstructured.RemoveNestedField(wf.Object, "spec", "shutdown")
+
+		nodes := make(map[string]interface{})
+		onExitNodeName := name + ".onExit"
+		for id, value := range statusNodes(wf) {
+			node, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			nodeName, _ := node["name"].(string)
+			nodeType, _ := node["type"].(string)
+			nodePhase, _ := node["phase"].(string)
+			onExit := strings.HasPrefix(nodeName, onExitNodeName)
+			switch nodePhase {
+			case phaseSucceeded, phaseSkipped:
+				if !onExit {
+					nodes[id] = node
+					continue
+				}
+			case phaseFailed, phaseError:
+				if !onExit && nodeType == nodeTypeDAG {
+					resetNode(node)
+					nodes[id] = node
+					continue
+				}
+			default:
+				return errors.Errorf("workflow cannot be retried with the node %s in the %s phase", nodeName, nodePhase)
+			}
+			// the node is dropped, as if it never ran
+			if nodeType == nodeTypePod {
+				if err := t.K8sClient.CoreV1().Pods(namespace).Delete(id, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return errors.Wrapf(err, "failed to delete the pod %s", id)
+				}
+			} else if nodeName == name {
+				resetNode(node)
+				nodes[id] = node
+			}
+		}
+		if err := unstructured.SetNestedField(wf.Object, nodes, "status", "nodes"); err != nil {
+			return err
+		}
+
+		result, err = client.Update(wf, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retry the workflow %s", name)
+	}
+	return result, nil
+}
+
+// resubmit creates a new workflow with the spec, the user labels and the annotations of the workflow
+func resubmit(client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	wf, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the workflow %s", name)
+	}
+	newWf := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	newWf.SetAPIVersion(wf.GetAPIVersion())
+	newWf.SetKind(wf.GetKind())
+	newWf.SetNamespace(wf.GetNamespace())
+	// the resubmitted workflow has a generated name
+	if wf.GetGenerateName() != "" {
+		newWf.SetGenerateName(wf.GetGenerateName())
+	} else {
+		newWf.SetGenerateName(name + "-")
+	}
+	labels := make(map[string]string)
+	for key, value := range wf.GetLabels() {
+		if strings.HasPrefix(key, labelPrefix) && key != labelControllerInstanceID {
+			continue
+		}
+		labels[key] = value
+	}
+	newWf.SetLabels(labels)
+	newWf.SetAnnotations(wf.GetAnnotations())
+
+	spec, _, err := unstructured.NestedMap(wf.Object, "spec")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the spec of the workflow %s", name)
+	}
+	// the terminated or stopped workflow runs again
+	if deadline, ok := spec["activeDeadlineSeconds"].(int64); ok && deadline == 0 {
+		delete(spec, "activeDeadlineSeconds")
+	}
+	delete(spec, "shutdown")
+	if err := unstructured.SetNestedMap(newWf.Object, spec, "spec"); err != nil {
+		return nil, err
+	}
+
+	return client.Create(newWf, metav1.CreateOptions{})
+}
+
+// fromReference returns the workflow created from the spec of the workflow template or the cron workflow the trigger
+// refers to, laid over with the metadata and the spec of the workflow of the trigger.
+func (t *ArgoWorkflowTrigger) fromReference(namespace string, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	reference := t.Trigger.Template.ArgoWorkflow.SubmitFrom
+	var resource, label string
+	var specPath []string
+	switch reference.Kind {
+	case kindWorkflowTemplate:
+		resource, label, specPath = "workflowtemplates", labelWorkflowTemplate, []string{"spec"}
+	case kindCronWorkflow:
+		resource, label, specPath = "cronworkflows", labelCronWorkflow, []string{"spec", "workflowSpec"}
+	default:
+		return nil, errors.Errorf("unknown kind %s of the workflow reference", reference.Kind)
+	}
+
+	gvk := obj.GroupVersionKind()
+	source, err := t.DynamicClient.Resource(schema.GroupVersionResource{
+		Group:    gvk.Group,
+		Version:  gvk.Version,
+		Resource: resource,
+	}).Namespace(namespace).Get(reference.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the %s %s", reference.Kind, reference.Name)
+	}
+	spec, ok, err := unstructured.NestedMap(source.Object, specPath...)
+	if err != nil || !ok {
+		return nil, errors.Errorf("%s %s has no workflow spec", reference.Kind, reference.Name)
+	}
+
+	wf := obj.DeepCopy()
+	overlay, _, _ := unstructured.NestedMap(wf.Object, "spec")
+	for key, value := range overlay {
+		if key == "arguments" {
+			value = mergeArguments(spec["arguments"], value)
+		}
+		spec[key] = value
+	}
+	if err := unstructured.SetNestedMap(wf.Object, spec, "spec"); err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string)
+	annotations := make(map[string]string)
+	if reference.Kind == kindCronWorkflow {
+		// the metadata of the workflows created by the cron workflow
+		metadataLabels, _, _ := unstructured.NestedStringMap(source.Object, "spec", "workflowMetadata", "labels")
+		for key, value := range metadataLabels {
+			labels[key] = value
+		}
+		metadataAnnotations, _, _ := unstructured.NestedStringMap(source.Object, "spec", "workflowMetadata", "annotations")
+		for key, value := range metadataAnnotations {
+			annotations[key] = value
+		}
+	}
+	for key, value := range wf.GetLabels() {
+		labels[key] = value
+	}
+	labels[label] = reference.Name
+	wf.SetLabels(labels)
+	for key, value := range wf.GetAnnotations() {
+		annotations[key] = value
+	}
+	if len(annotations) > 0 {
+		wf.SetAnnotations(annotations)
+	}
+	if wf.GetName() == "" && wf.GetGenerateName() == "" {
+		wf.SetGenerateName(reference.Name + "-")
+	}
+	return wf, nil
+}
+
+// mergeArguments returns the arguments of the referenced spec with the parameters and the artifacts
+// of the overlay set by name
+func mergeArguments(base, overlay interface{}) interface{} {
+	baseArguments, ok := base.(map[string]interface{})
+	if !ok {
+		return overlay
+	}
+	overlayArguments, ok := overlay.(map[string]interface{})
+	if !ok {
+		return base
+	}
+	for _, key := range []string{"parameters", "artifacts"} {
+		baseItems, _ := baseArguments[key].([]interface{})
+		overlayItems, _ := overlayArguments[key].([]interface{})
+		for _, overlayItem := range overlayItems {
+			item, ok := overlayItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			replaced := false
+			for i, baseItem := range baseItems {
+				if existing, ok := baseItem.(map[string]interface{}); ok && existing["name"] == item["name"] {
+					baseItems[i] = item
+					replaced = true
+				}
+			}
+			if !replaced {
+				baseItems = append(baseItems, item)
+			}
+		}
+		if len(baseItems) > 0 {
+			baseArguments[key] = baseItems
+		}
+	}
+	return baseArguments
+}
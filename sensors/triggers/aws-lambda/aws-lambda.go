@@ -16,9 +16,11 @@ limitations under the License.
 package aws_lambda
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -44,6 +46,19 @@ type AWSLambdaTrigger struct {
 	Logger *logrus.Logger
 }
 
+func init() {
+	triggers.Register("awsLambda", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		lambdaClients := clients.Store("awsLambda", func() interface{} {
+			return make(map[string]*lambda.Lambda)
+		}).(map[string]*lambda.Lambda)
+		result, err := NewAWSLambdaTrigger(lambdaClients, clients.KubeClient, sensor, trigger, logger)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewAWSLambdaTrigger returns a new AWS Lambda context
 func NewAWSLambdaTrigger(lambdaClients map[string]*lambda.Lambda, k8sClient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (*AWSLambdaTrigger, error) {
 	lambdatrigger := trigger.Template.AWSLambda
@@ -98,7 +113,7 @@ func (t *AWSLambdaTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, reso
 }
 
 // Execute executes the trigger
-func (t *AWSLambdaTrigger) Execute(resource interface{}) (interface{}, error) {
+func (t *AWSLambdaTrigger) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	trigger, ok := resource.(*v1alpha1.AWSLambdaTrigger)
 	if !ok {
 		return nil, errors.New("failed to interpret the trigger resource")
@@ -113,19 +128,25 @@ func (t *AWSLambdaTrigger) Execute(resource interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	response, err := t.LambdaClient.Invoke(&lambda.InvokeInput{
+	response, err := t.LambdaClient.InvokeWithContext(ctx, &lambda.InvokeInput{
 		FunctionName: &trigger.FunctionName,
 		Payload:      payload,
 	})
 	if err != nil {
+		if requestErr, ok := err.(awserr.RequestFailure); ok {
+			return nil, triggers.MarkStatusError(err, requestErr.StatusCode())
+		}
 		return nil, err
 	}
 
+	if err := triggers.CheckStatus(t.Trigger, int(aws.Int64Value(response.StatusCode))); err != nil {
+		return nil, err
+	}
 	return response, nil
 }
 
 // ApplyPolicy applies the policy on the trigger execution response
-func (t *AWSLambdaTrigger) ApplyPolicy(resource interface{}) error {
+func (t *AWSLambdaTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
 		return nil
 	}
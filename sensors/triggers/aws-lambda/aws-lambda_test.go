@@ -16,6 +16,7 @@ limitations under the License.
 package aws_lambda
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -149,6 +150,6 @@ func TestAWSLambdaTrigger_ApplyPolicy(t *testing.T) {
 	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{
 		Status: &v1alpha1.StatusPolicy{Allow: []int32{200, 300}},
 	}
-	err := trigger.ApplyPolicy(response)
+	err := trigger.ApplyPolicy(context.Background(), response)
 	assert.Nil(t, err)
 }
@@ -44,6 +44,19 @@ type CustomTrigger struct {
 	triggerClient triggers.TriggerClient
 }
 
+func init() {
+	triggers.Register("custom", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		customTriggerClients := clients.Store("custom", func() interface{} {
+			return make(map[string]*grpc.ClientConn)
+		}).(map[string]*grpc.ClientConn)
+		result, err := NewCustomTrigger(sensor, trigger, logger, customTriggerClients)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewCustomTrigger returns a new custom trigger
 func NewCustomTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger, customTriggerClients map[string]*grpc.ClientConn) (*CustomTrigger, error) {
 	customTrigger := &CustomTrigger{
@@ -154,7 +167,7 @@ func (ct *CustomTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, resour
 }
 
 // Execute executes the trigger
-func (ct *CustomTrigger) Execute(resource interface{}) (interface{}, error) {
+func (ct *CustomTrigger) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	obj, ok := resource.([]byte)
 	if !ok {
 		return nil, errors.New("failed to interpret the trigger resource for the execution")
@@ -176,7 +189,7 @@ func (ct *CustomTrigger) Execute(resource interface{}) (interface{}, error) {
 		ct.Logger.WithField("payload", string(payload)).Debugln("payload for the trigger execution")
 	}
 
-	result, err := ct.triggerClient.Execute(context.Background(), &triggers.ExecuteRequest{
+	result, err := ct.triggerClient.Execute(ctx, &triggers.ExecuteRequest{
 		Resource: obj,
 		Payload:  payload,
 	})
@@ -189,7 +202,7 @@ func (ct *CustomTrigger) Execute(resource interface{}) (interface{}, error) {
 }
 
 // ApplyPolicy applies the policy on the trigger
-func (ct *CustomTrigger) ApplyPolicy(resource interface{}) error {
+func (ct *CustomTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	obj, ok := resource.([]byte)
 	if !ok {
 		return errors.New("failed to interpret the trigger resource for the policy application")
@@ -197,7 +210,7 @@ func (ct *CustomTrigger) ApplyPolicy(resource interface{}) error {
 
 	ct.Logger.WithField("resource", string(obj)).Debugln("resource to apply policy on")
 
-	result, err := ct.triggerClient.ApplyPolicy(context.Background(), &triggers.ApplyPolicyRequest{
+	result, err := ct.triggerClient.ApplyPolicy(ctx, &triggers.ApplyPolicyRequest{
 		Request: obj,
 	})
 	if err != nil {
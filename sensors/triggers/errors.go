@@ -0,0 +1,119 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// retryable is implemented by the errors telling whether the execution of a trigger failing with them is worth retrying
+type retryable interface {
+	Retryable() bool
+}
+
+// nonRetryableError is the error of an execution which can't succeed once retried
+type nonRetryableError struct {
+	error
+}
+
+// Retryable returns false, the execution isn't retried
+func (e *nonRetryableError) Retryable() bool {
+	return false
+}
+
+// Cause returns the error marked as non-retryable
+func (e *nonRetryableError) Cause() error {
+	return e.error
+}
+
+// NonRetryable marks the error so that the execution failing with it isn't retried
+func NonRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &nonRetryableError{error: err}
+}
+
+// IsRetryable returns whether the execution failing with the error is worth retrying.
+// The errors are retryable, unless an error of their chain of causes tells otherwise.
+func IsRetryable(err error) bool {
+	for err != nil {
+		if r, ok := err.(retryable); ok {
+			return r.Retryable()
+		}
+		causer, ok := err.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+		err = causer.Cause()
+	}
+	return true
+}
+
+// IsRetryableStatus returns whether a request answered with the status code is worth retrying.
+// The client errors aren't, except for the timeouts and the throttling.
+func IsRetryableStatus(code int) bool {
+	if code < http.StatusBadRequest || code >= http.StatusInternalServerError {
+		return true
+	}
+	return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+}
+
+// MarkStatusError marks the error of a request answered with the status code as non-retryable, if retrying the request
+// would be answered with the same status
+func MarkStatusError(err error, code int) error {
+	if err == nil || IsRetryableStatus(code) {
+		return err
+	}
+	return NonRetryable(err)
+}
+
+// CheckStatus returns an error, marked as per MarkStatusError, if the request of a trigger with a status policy is answered
+// with an error status, i.e. 4xx or 5xx, which the policy doesn't allow, so that the request is retried if it's worth it.
+// The policy itself is applied once the trigger is executed.
+func CheckStatus(trigger *v1alpha1.Trigger, code int) error {
+	if code < http.StatusBadRequest || trigger.Policy == nil || trigger.Policy.Status == nil {
+		return nil
+	}
+	for _, allowed := range trigger.Policy.Status.GetAllow() {
+		if allowed == code {
+			return nil
+		}
+	}
+	return MarkStatusError(errors.Errorf("request is answered with the status %d", code), code)
+}
+
+// MarkKubeAPIError marks the kubernetes API errors which can't be solved by retrying the request as non-retryable,
+// i.e. the client errors, such as an already existing resource, except for the conflicts, the timeouts and the throttling.
+func MarkKubeAPIError(err error) error {
+	status, ok := errors.Cause(err).(apierrors.APIStatus)
+	if !ok {
+		return err
+	}
+	if apierrors.IsAlreadyExists(errors.Cause(err)) {
+		return NonRetryable(err)
+	}
+	if code := int(status.Status().Code); code != http.StatusConflict {
+		return MarkStatusError(err, code)
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestIsRetryable(t *testing.T) {
+	err := errors.New("fake error")
+	assert.True(t, IsRetryable(err))
+	assert.True(t, IsRetryable(errors.Wrap(err, "failed")))
+	assert.False(t, IsRetryable(NonRetryable(err)))
+	assert.False(t, IsRetryable(errors.Wrap(NonRetryable(err), "failed")))
+	assert.Equal(t, err, errors.Cause(NonRetryable(err)))
+	assert.Nil(t, NonRetryable(nil))
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	assert.True(t, IsRetryableStatus(http.StatusOK))
+	assert.True(t, IsRetryableStatus(http.StatusServiceUnavailable))
+	assert.True(t, IsRetryableStatus(http.StatusRequestTimeout))
+	assert.True(t, IsRetryableStatus(http.StatusTooManyRequests))
+	assert.False(t, IsRetryableStatus(http.StatusBadRequest))
+	assert.False(t, IsRetryableStatus(http.StatusNotFound))
+}
+
+func TestMarkStatusError(t *testing.T) {
+	err := errors.New("fake error")
+	assert.Nil(t, MarkStatusError(nil, http.StatusBadRequest))
+	assert.True(t, IsRetryable(MarkStatusError(err, http.StatusBadGateway)))
+	assert.False(t, IsRetryable(MarkStatusError(err, http.StatusUnauthorized)))
+}
+
+func TestCheckStatus(t *testing.T) {
+	trigger := &v1alpha1.Trigger{}
+	// without a status policy, the status isn't checked
+	assert.Nil(t, CheckStatus(trigger, http.StatusInternalServerError))
+
+	trigger.Policy = &v1alpha1.TriggerPolicy{
+		Status: &v1alpha1.StatusPolicy{Allow: []int32{http.StatusOK, http.StatusNotFound}},
+	}
+	assert.Nil(t, CheckStatus(trigger, http.StatusOK))
+	assert.Nil(t, CheckStatus(trigger, http.StatusNotFound))
+	// the statuses which aren't errors are left to the policy
+	assert.Nil(t, CheckStatus(trigger, http.StatusAccepted))
+	err := CheckStatus(trigger, http.StatusServiceUnavailable)
+	assert.NotNil(t, err)
+	assert.True(t, IsRetryable(err))
+	err = CheckStatus(trigger, http.StatusBadRequest)
+	assert.NotNil(t, err)
+	assert.False(t, IsRetryable(err))
+}
+
+func TestMarkKubeAPIError(t *testing.T) {
+	resource := schema.GroupResource{Resource: "configmaps"}
+	assert.Nil(t, MarkKubeAPIError(nil))
+	assert.True(t, IsRetryable(MarkKubeAPIError(errors.New("fake error"))))
+	assert.False(t, IsRetryable(MarkKubeAPIError(apierrors.NewAlreadyExists(resource, "fake"))))
+	assert.False(t, IsRetryable(MarkKubeAPIError(errors.Wrap(apierrors.NewNotFound(resource, "fake"), "failed"))))
+	assert.False(t, IsRetryable(MarkKubeAPIError(apierrors.NewBadRequest("fake"))))
+	assert.True(t, IsRetryable(MarkKubeAPIError(apierrors.NewConflict(resource, "fake", errors.New("fake error")))))
+	assert.True(t, IsRetryable(MarkKubeAPIError(apierrors.NewTooManyRequests("fake", 1))))
+	assert.True(t, IsRetryable(MarkKubeAPIError(apierrors.NewInternalError(errors.New("fake error")))))
+}
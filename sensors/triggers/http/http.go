@@ -17,6 +17,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -48,6 +49,19 @@ type HTTPTrigger struct {
 	Logger *logrus.Logger
 }
 
+func init() {
+	triggers.Register("http", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		httpClients := clients.Store("http", func() interface{} {
+			return make(map[string]*http.Client)
+		}).(map[string]*http.Client)
+		result, err := NewHTTPTrigger(httpClients, clients.KubeClient, sensor, trigger, logger)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewHTTPTrigger returns a new HTTP trigger
 func NewHTTPTrigger(httpClients map[string]*http.Client, k8sCLient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (*HTTPTrigger, error) {
 	httptrigger := trigger.Template.HTTP
@@ -131,7 +145,7 @@ func (t *HTTPTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, resource
 }
 
 // Execute executes the trigger
-func (t *HTTPTrigger) Execute(resource interface{}) (interface{}, error) {
+func (t *HTTPTrigger) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	var payload []byte
 	var err error
 
@@ -151,7 +165,7 @@ func (t *HTTPTrigger) Execute(resource interface{}) (interface{}, error) {
 		}
 	}
 
-	request, err := http.NewRequest(trigger.Method, trigger.URL, bytes.NewReader(payload))
+	request, err := http.NewRequestWithContext(ctx, trigger.Method, trigger.URL, bytes.NewReader(payload))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to construct request for %s", trigger.URL)
 	}
@@ -191,11 +205,19 @@ func (t *HTTPTrigger) Execute(resource interface{}) (interface{}, error) {
 
 	t.Logger.WithField("url", trigger.URL).Infoln("making a http request...")
 
-	return t.Client.Do(request)
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := triggers.CheckStatus(t.Trigger, response.StatusCode); err != nil {
+		_ = response.Body.Close()
+		return nil, err
+	}
+	return response, nil
 }
 
 // ApplyPolicy applies policy on the trigger
-func (t *HTTPTrigger) ApplyPolicy(resource interface{}) error {
+func (t *HTTPTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
 		return nil
 	}
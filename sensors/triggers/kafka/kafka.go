@@ -16,6 +16,7 @@ limitations under the License.
 package kafka
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -42,6 +43,19 @@ type KafkaTrigger struct {
 	Logger *logrus.Logger
 }
 
+func init() {
+	triggers.Register("kafka", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		kafkaProducers := clients.Store("kafka", func() interface{} {
+			return make(map[string]sarama.AsyncProducer)
+		}).(map[string]sarama.AsyncProducer)
+		result, err := NewKafkaTrigger(sensor, trigger, kafkaProducers, logger)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewKafkaTrigger returns a new kafka trigger context.
 func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaProducers map[string]sarama.AsyncProducer, logger *logrus.Logger) (*KafkaTrigger, error) {
 	kafkatrigger := trigger.Template.Kafka
@@ -140,7 +154,7 @@ func (t *KafkaTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, resource
 }
 
 // Execute executes the trigger
-func (t *KafkaTrigger) Execute(resource interface{}) (interface{}, error) {
+func (t *KafkaTrigger) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	trigger, ok := resource.(*v1alpha1.KafkaTrigger)
 	if !ok {
 		return nil, errors.New("failed to interpret the trigger resource")
@@ -177,6 +191,6 @@ func (t *KafkaTrigger) Execute(resource interface{}) (interface{}, error) {
 }
 
 // ApplyPolicy applies policy on the trigger
-func (t *KafkaTrigger) ApplyPolicy(resource interface{}) error {
+func (t *KafkaTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	return nil
 }
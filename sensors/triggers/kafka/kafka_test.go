@@ -16,6 +16,7 @@ limitations under the License.
 package kafka
 
 import (
+	"context"
 	"testing"
 
 	"github.com/Shopify/sarama"
@@ -167,7 +168,7 @@ func TestKafkaTrigger_Execute(t *testing.T) {
 
 	producer.ExpectInputAndSucceed()
 
-	result, err := trigger.Execute(trigger.Trigger.Template.Kafka)
+	result, err := trigger.Execute(context.Background(), trigger.Trigger.Template.Kafka)
 	assert.Nil(t, err)
 	assert.Nil(t, result)
 }
@@ -16,6 +16,7 @@ limitations under the License.
 package nats
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -41,6 +42,19 @@ type NATSTrigger struct {
 	Logger *logrus.Logger
 }
 
+func init() {
+	triggers.Register("nats", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		natsConnections := clients.Store("nats", func() interface{} {
+			return make(map[string]*natslib.Conn)
+		}).(map[string]*natslib.Conn)
+		result, err := NewNATSTrigger(sensor, trigger, natsConnections, logger)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewNATSTrigger returns new nats trigger.
 func NewNATSTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, natsConnections map[string]*natslib.Conn, logger *logrus.Logger) (*NATSTrigger, error) {
 	natstrigger := trigger.Template.NATS
@@ -125,7 +139,7 @@ func (t *NATSTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, resource
 }
 
 // Execute executes the trigger
-func (t *NATSTrigger) Execute(resource interface{}) (interface{}, error) {
+func (t *NATSTrigger) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	trigger, ok := resource.(*v1alpha1.NATSTrigger)
 	if !ok {
 		return nil, errors.New("failed to interpret the trigger resource")
@@ -148,6 +162,6 @@ func (t *NATSTrigger) Execute(resource interface{}) (interface{}, error) {
 }
 
 // ApplyPolicy applies policy on the trigger
-func (t *NATSTrigger) ApplyPolicy(resource interface{}) error {
+func (t *NATSTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	return nil
 }
@@ -170,10 +170,18 @@ func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*
 	var value []byte
 	var key string
 	var tmplt string
-	if event, ok := events[src.DependencyName]; ok {
-		// If context or data keys are not set, return the event payload as is
+	if event, ok := events[sourceName(src)]; ok {
+		// If context or data keys are not set, return the event payload as is, or the result of the trigger
 		if src.ContextKey == "" && src.DataKey == "" && src.DataTemplate == "" && src.ContextTemplate == "" {
-			value, err = json.Marshal(&event)
+			if src.TriggerName != "" {
+				if len(event.Data) == 0 {
+					err = errors.Errorf("trigger %s has no result", src.TriggerName)
+				} else {
+					value = event.Data
+				}
+			} else {
+				value, err = json.Marshal(&event)
+			}
 		}
 		// Get the context bytes
 		if src.ContextKey != "" || src.ContextTemplate != "" {
@@ -213,23 +221,33 @@ func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*
 		}
 		return string(value), nil
 	}
-	return "", errors.Wrapf(err, "unable to resolve '%s' parameter value", src.DependencyName)
+	return "", errors.Wrapf(err, "unable to resolve '%s' parameter value", sourceName(src))
+}
+
+// sourceName returns the name of the dependency or of the trigger the parameter value is resolved from
+func sourceName(src *v1alpha1.TriggerParameterSource) string {
+	if src.TriggerName != "" {
+		return src.TriggerName
+	}
+	return src.DependencyName
 }
 
 // ExtractEvents is a helper method to extract the events from the event dependencies nodes associated with the resource params
-// returns a map of the events keyed by the event dependency name
+// returns a map of the events keyed by the event dependency name.
+// The results of the triggers are extracted from the trigger nodes, keyed by the trigger name.
 func ExtractEvents(sensor *v1alpha1.Sensor, params []v1alpha1.TriggerParameter) map[string]*v1alpha1.Event {
 	events := make(map[string]*v1alpha1.Event)
 	for _, param := range params {
 		if param.Src != nil {
-			node := snctrl.GetNodeByName(sensor, param.Src.DependencyName)
+			name := sourceName(param.Src)
+			node := snctrl.GetNodeByName(sensor, name)
 			if node == nil {
 				continue
 			}
 			if node.Event == nil {
 				continue
 			}
-			events[param.Src.DependencyName] = node.Event
+			events[name] = node.Event
 		}
 	}
 	return events
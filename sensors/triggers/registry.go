@@ -0,0 +1,167 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// Trigger interface
+type Trigger interface {
+	// FetchResource fetches the trigger resource from external source
+	FetchResource() (interface{}, error)
+	// ApplyResourceParameters applies parameters to the trigger resource
+	ApplyResourceParameters(sensor *v1alpha1.Sensor, resource interface{}) (interface{}, error)
+	// Execute executes the trigger, it returns once the context is done if the execution can be cancelled
+	Execute(ctx context.Context, resource interface{}) (interface{}, error)
+	// ApplyPolicy applies the policy on the trigger, it returns once the context is done if the policy waits on the resource
+	ApplyPolicy(ctx context.Context, resource interface{}) error
+}
+
+// Factory returns the trigger implementation of a trigger of the sensor
+type Factory func(clients *Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (Trigger, error)
+
+// Clients holds the clients of the sensor, shared by its triggers
+type Clients struct {
+	// KubeClient is the kubernetes client
+	KubeClient kubernetes.Interface
+	// DynamicClient is the dynamic kubernetes client
+	DynamicClient dynamic.Interface
+	lock          sync.Mutex
+	// stores holds the clients of each trigger type, which are reused across the executions of the triggers
+	stores map[string]interface{}
+}
+
+// NewClients returns the clients of the sensor
+func NewClients(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface) *Clients {
+	return &Clients{
+		KubeClient:    kubeClient,
+		DynamicClient: dynamicClient,
+		stores:        make(map[string]interface{}),
+	}
+}
+
+// Store returns the store of the clients of a trigger type, created by newStore on the first call.
+func (c *Clients) Store(triggerType string, newStore func() interface{}) interface{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	store, ok := c.stores[triggerType]
+	if !ok {
+		store = newStore()
+		c.stores[triggerType] = store
+	}
+	return store
+}
+
+var (
+	registryLock sync.RWMutex
+	// registry holds the factories of the trigger implementations, keyed by the JSON name of their template field
+	registry = make(map[string]Factory)
+)
+
+// nonTriggerFields are the fields of the trigger template that don't describe a trigger
+var nonTriggerFields = map[string]bool{
+	"name":   true,
+	"switch": true,
+}
+
+// Register registers the factory of the triggers described by the template field, named as in its JSON tag.
+// The trigger packages register their factories when they are initialized.
+func Register(field string, factory Factory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, ok := registry[field]; ok {
+		panic(errors.Errorf("trigger %s is already registered", field))
+	}
+	registry[field] = factory
+}
+
+// templateFields returns the JSON names of the fields of the trigger template describing a trigger
+func templateFields() []string {
+	var fields []string
+	templateType := reflect.TypeOf(v1alpha1.TriggerTemplate{})
+	for i := 0; i < templateType.NumField(); i++ {
+		name := strings.Split(templateType.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || nonTriggerFields[name] {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+// TemplateField returns the JSON name of the field set in the trigger template, i.e. the type of the trigger.
+// It returns an empty string if no trigger is set.
+func TemplateField(template *v1alpha1.TriggerTemplate) string {
+	if template == nil {
+		return ""
+	}
+	value := reflect.ValueOf(template).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		name := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || nonTriggerFields[name] {
+			continue
+		}
+		if field := value.Field(i); field.Kind() == reflect.Ptr && !field.IsNil() {
+			return name
+		}
+	}
+	return ""
+}
+
+// CheckRegistry checks every trigger of the trigger template has a registered implementation
+func CheckRegistry() error {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	var missing []string
+	for _, field := range templateFields() {
+		if _, ok := registry[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return errors.Errorf("no implementation is registered for the triggers %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// NewTrigger returns the implementation of the trigger, as per the type of its template
+func NewTrigger(clients *Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (Trigger, error) {
+	field := TemplateField(trigger.Template)
+	if field == "" {
+		return nil, errors.Errorf("trigger %s doesn't describe any trigger", trigger.Template.Name)
+	}
+	registryLock.RLock()
+	factory, ok := registry[field]
+	registryLock.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("no implementation is registered for the trigger %s of type %s", trigger.Template.Name, field)
+	}
+	return factory(clients, sensor, trigger, logger)
+}
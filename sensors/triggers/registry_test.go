@@ -0,0 +1,96 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakeTrigger struct {
+	Trigger
+	name string
+}
+
+func TestRegistry(t *testing.T) {
+	defer func(registered map[string]Factory) {
+		registry = registered
+	}(registry)
+	registry = make(map[string]Factory)
+
+	err := CheckRegistry()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "openWhisk")
+
+	Register("openWhisk", func(clients *Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (Trigger, error) {
+		return &fakeTrigger{name: trigger.Template.Name}, nil
+	})
+	assert.Panics(t, func() {
+		Register("openWhisk", nil)
+	})
+	err = CheckRegistry()
+	assert.NotNil(t, err)
+	assert.NotContains(t, err.Error(), "openWhisk")
+
+	clients := NewClients(nil, nil)
+	trigger := &v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name:      "fake-trigger",
+			OpenWhisk: &v1alpha1.OpenWhiskTrigger{},
+		},
+	}
+	result, err := NewTrigger(clients, &v1alpha1.Sensor{}, trigger, common.NewArgoEventsLogger())
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-trigger", result.(*fakeTrigger).name)
+
+	trigger.Template = &v1alpha1.TriggerTemplate{
+		Name:  "fake-trigger",
+		Slack: &v1alpha1.SlackTrigger{},
+	}
+	_, err = NewTrigger(clients, &v1alpha1.Sensor{}, trigger, common.NewArgoEventsLogger())
+	assert.NotNil(t, err)
+}
+
+func TestTemplateField(t *testing.T) {
+	assert.Equal(t, "", TemplateField(nil))
+	assert.Equal(t, "", TemplateField(&v1alpha1.TriggerTemplate{
+		Name:   "fake-trigger",
+		Switch: &v1alpha1.TriggerSwitch{},
+	}))
+	assert.Equal(t, "custom", TemplateField(&v1alpha1.TriggerTemplate{
+		Switch:        &v1alpha1.TriggerSwitch{},
+		CustomTrigger: &v1alpha1.CustomTrigger{},
+	}))
+	assert.Contains(t, templateFields(), "k8s")
+	assert.NotContains(t, templateFields(), "switch")
+}
+
+func TestClientsStore(t *testing.T) {
+	clients := NewClients(nil, nil)
+	store := clients.Store("http", func() interface{} {
+		return make(map[string]string)
+	}).(map[string]string)
+	store["fake"] = "client"
+	assert.Equal(t, "client", clients.Store("http", func() interface{} {
+		return make(map[string]string)
+	}).(map[string]string)["fake"])
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// ResultEventType is the type of the events holding the results of the trigger executions
+	ResultEventType = "trigger-result"
+	// maxResultSize is the maximum size of a response body kept as the result of a trigger execution
+	maxResultSize = 1024 * 1024
+)
+
+// ResultEvent returns the result of the execution of a trigger as an event, so that the triggers depending on it
+// can use the result as a parameter source. The data of the event is empty if the execution has no result.
+func ResultEvent(trigger *v1alpha1.Trigger, resource interface{}) (*v1alpha1.Event, error) {
+	var data []byte
+	subject := ""
+	switch result := resource.(type) {
+	case *unstructured.Unstructured:
+		encoded, err := result.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		data = encoded
+		subject = result.GetName()
+	case *http.Response:
+		if result.Body != nil {
+			body, err := ioutil.ReadAll(io.LimitReader(result.Body, maxResultSize))
+			_ = result.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			data = body
+		}
+	case *lambda.InvokeOutput:
+		data = result.Payload
+	case []byte:
+		data = result
+	}
+
+	contentType := "text/plain"
+	if len(data) > 0 && isJSON(data) {
+		contentType = common.MediaTypeJSON
+	}
+	return &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			ID:              fmt.Sprintf("%x", uuid.New()),
+			Source:          trigger.Template.Name,
+			SpecVersion:     "0.3",
+			Type:            ResultEventType,
+			DataContentType: contentType,
+			Subject:         subject,
+			Time:            metav1.Time{Time: time.Now().UTC()},
+		},
+		Data: data,
+	}, nil
+}
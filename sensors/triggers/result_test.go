@@ -0,0 +1,81 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestResultEvent(t *testing.T) {
+	trigger := &v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "fake-trigger",
+		},
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Namespace")
+	obj.SetName("fake-namespace")
+	obj.SetUID("fake-uid")
+	event, err := ResultEvent(trigger, obj)
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-trigger", event.Context.Source)
+	assert.Equal(t, ResultEventType, event.Context.Type)
+	assert.Equal(t, "fake-namespace", event.Context.Subject)
+	assert.Equal(t, common.MediaTypeJSON, event.Context.DataContentType)
+	events := map[string]*v1alpha1.Event{"fake-trigger": event}
+	uid, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{TriggerName: "fake-trigger", DataKey: "metadata.uid"}, events)
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-uid", uid)
+
+	event, err = ResultEvent(trigger, &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte("created"))),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "text/plain", event.Context.DataContentType)
+	events["fake-trigger"] = event
+	// the result is used as is without key
+	body, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{TriggerName: "fake-trigger"}, events)
+	assert.Nil(t, err)
+	assert.Equal(t, "created", body)
+	_, err = ResolveParamValue(&v1alpha1.TriggerParameterSource{TriggerName: "fake-trigger", DataKey: "name"}, events)
+	assert.NotNil(t, err)
+
+	event, err = ResultEvent(trigger, &lambda.InvokeOutput{Payload: []byte(`{"name": "fake"}`)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name": "fake"}`, string(event.Data))
+
+	// the triggers without result
+	event, err = ResultEvent(trigger, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, event.Data)
+	events["fake-trigger"] = event
+	_, err = ResolveParamValue(&v1alpha1.TriggerParameterSource{TriggerName: "fake-trigger"}, events)
+	assert.NotNil(t, err)
+}
@@ -16,8 +16,10 @@ limitations under the License.
 package slack
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/nlopes/slack"
 	"github.com/pkg/errors"
@@ -42,6 +44,22 @@ type SlackTrigger struct {
 	httpClient *http.Client
 }
 
+func init() {
+	triggers.Register("slack", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		// the http client to send the slack messages is shared by the slack triggers
+		httpClient := clients.Store("slack", func() interface{} {
+			return &http.Client{
+				Timeout: time.Minute * 5,
+			}
+		}).(*http.Client)
+		result, err := NewSlackTrigger(clients.KubeClient, sensor, trigger, logger, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // NewSlackTrigger returns a new Slack trigger context
 func NewSlackTrigger(k8sClient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger, httpClient *http.Client) (*SlackTrigger, error) {
 	return &SlackTrigger{
@@ -82,7 +100,7 @@ func (t *SlackTrigger) ApplyResourceParameters(sensor *v1alpha1.Sensor, resource
 }
 
 // Execute executes the trigger
-func (t *SlackTrigger) Execute(resource interface{}) (interface{}, error) {
+func (t *SlackTrigger) Execute(ctx context.Context, resource interface{}) (interface{}, error) {
 	t.Logger.Infoln("executing SlackTrigger")
 	_, ok := resource.(*v1alpha1.SlackTrigger)
 	if !ok {
@@ -112,14 +130,14 @@ func (t *SlackTrigger) Execute(resource interface{}) (interface{}, error) {
 	}
 
 	api := slack.New(slackToken, slack.OptionDebug(true))
-	_, err = api.JoinChannel(channel)
+	_, err = api.JoinChannelContext(ctx, channel)
 	if err != nil {
 		t.Logger.WithField("channel", channel).Errorf("unable to join channel...")
 		return nil, errors.Wrapf(err, "failed to join channel %s", channel)
 	}
 
 	t.Logger.WithField("channel", channel).Infoln("posting to channel...")
-	channelID, timestamp, err := api.PostMessage(channel, slack.MsgOptionText(message, false))
+	channelID, timestamp, err := api.PostMessageContext(ctx, channel, slack.MsgOptionText(message, false))
 	if err != nil {
 		t.Logger.WithField("channel", channel).Errorf("unable to post to channel...")
 		return nil, errors.Wrapf(err, "failed to post to channel %s", channel)
@@ -131,6 +149,6 @@ func (t *SlackTrigger) Execute(resource interface{}) (interface{}, error) {
 }
 
 // No Policies for SlackTrigger
-func (t *SlackTrigger) ApplyPolicy(resource interface{}) error {
+func (t *SlackTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	return nil
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package standard_k8s
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/imdario/mergo"
@@ -38,6 +39,9 @@ import (
 	"github.com/argoproj/argo-events/store"
 )
 
+// defaultFieldManager is the manager of the fields set by the apply operation, if the trigger doesn't specify one
+const defaultFieldManager = "argo-events"
+
 // StandardK8STrigger implements Trigger interface for standard Kubernetes resources
 type StandardK8sTrigger struct {
 	// K8sClient is kubernetes client
@@ -54,6 +58,12 @@ type StandardK8sTrigger struct {
 	namespableDynamicClient dynamic.NamespaceableResourceInterface
 }
 
+func init() {
+	triggers.Register("k8s", func(clients *triggers.Clients, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) (triggers.Trigger, error) {
+		return NewStandardK8sTrigger(clients.KubeClient, clients.DynamicClient, sensor, trigger, logger), nil
+	})
+}
+
 // NewStandardK8sTrigger returns a new StandardK8STrigger
 func NewStandardK8sTrigger(k8sClient kubernetes.Interface, dynamicClient dynamic.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *logrus.Logger) *StandardK8sTrigger {
 	return &StandardK8sTrigger{
@@ -126,8 +136,11 @@ func (k8sTrigger *StandardK8sTrigger) ApplyResourceParameters(sensor *v1alpha1.S
 	return obj, nil
 }
 
-// Execute executes the trigger
-func (k8sTrigger *StandardK8sTrigger) Execute(resource interface{}) (interface{}, error) {
+// Execute executes the trigger, the errors of the requests which would fail again once retried are marked as non-retryable
+func (k8sTrigger *StandardK8sTrigger) Execute(ctx context.Context, resource interface{}) (result interface{}, err error) {
+	defer func() {
+		err = triggers.MarkKubeAPIError(err)
+	}()
 	trigger := k8sTrigger.Trigger
 
 	obj, ok := resource.(*unstructured.Unstructured)
@@ -200,16 +213,84 @@ func (k8sTrigger *StandardK8sTrigger) Execute(resource interface{}) (interface{}
 
 		return k8sTrigger.namespableDynamicClient.Namespace(namespace).Patch(obj.GetName(), k8sTrigger.Trigger.Template.K8s.PatchStrategy, body, metav1.PatchOptions{})
 
+	case v1alpha1.Delete:
+		return k8sTrigger.delete(namespace, obj)
+
+	case v1alpha1.Apply:
+		k8sTrigger.Logger.Infoln("applying the object...")
+
+		if obj.GetName() == "" {
+			return nil, errors.New("resource name must be specified for applying the object")
+		}
+		body, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, errors.Errorf("failed to marshal object into JSON schema. err: %+v\n", err)
+		}
+		options := metav1.PatchOptions{
+			FieldManager: defaultFieldManager,
+		}
+		if applyOptions := trigger.Template.K8s.ApplyOptions; applyOptions != nil {
+			if applyOptions.FieldManager != "" {
+				options.FieldManager = applyOptions.FieldManager
+			}
+			if applyOptions.Force {
+				force := true
+				options.Force = &force
+			}
+		}
+		// the object is created or updated to converge to the applied fields
+		return k8sTrigger.namespableDynamicClient.Namespace(namespace).Patch(obj.GetName(), k8stypes.ApplyPatchType, body, options)
+
 	default:
 		return nil, errors.Errorf("unknown operation type %s", string(op))
 	}
 }
 
+// delete deletes the object, or the objects selected by the label selector of the delete options
+func (k8sTrigger *StandardK8sTrigger) delete(namespace string, obj *unstructured.Unstructured) (interface{}, error) {
+	deleteOptions := &metav1.DeleteOptions{}
+	var labelSelector string
+	if options := k8sTrigger.Trigger.Template.K8s.DeleteOptions; options != nil {
+		labelSelector = options.LabelSelector
+		if options.PropagationPolicy != "" {
+			propagationPolicy := options.PropagationPolicy
+			deleteOptions.PropagationPolicy = &propagationPolicy
+		}
+		deleteOptions.GracePeriodSeconds = options.GracePeriodSeconds
+	}
+	client := k8sTrigger.namespableDynamicClient.Namespace(namespace)
+
+	if labelSelector != "" {
+		k8sTrigger.Logger.WithField("selector", labelSelector).Infoln("deleting the objects...")
+		if err := client.DeleteCollection(deleteOptions, metav1.ListOptions{LabelSelector: labelSelector}); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete the objects selected by %s", labelSelector)
+		}
+		return obj, nil
+	}
+
+	if obj.GetName() == "" {
+		return nil, errors.New("resource name or label selector must be specified for deleting the object")
+	}
+	k8sTrigger.Logger.WithField("name", obj.GetName()).Infoln("deleting the object...")
+	if err := client.Delete(obj.GetName(), deleteOptions); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to delete the object %s", obj.GetName())
+		}
+		// the repeated events converge to the deleted object
+		k8sTrigger.Logger.WithField("name", obj.GetName()).Infoln("object is already deleted")
+	}
+	return obj, nil
+}
+
 // ApplyPolicy applies the policy on the trigger
-func (k8sTrigger *StandardK8sTrigger) ApplyPolicy(resource interface{}) error {
+func (k8sTrigger *StandardK8sTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	trigger := k8sTrigger.Trigger
 
-	if trigger.Policy == nil || trigger.Policy.K8s == nil || trigger.Policy.K8s.Labels == nil {
+	if trigger.Policy == nil || (trigger.Policy.K8s == nil && trigger.Policy.Completion == nil) {
+		return nil
+	}
+	// the deleted objects don't get any label and don't complete
+	if trigger.Template.K8s.Operation == v1alpha1.Delete {
 		return nil
 	}
 
@@ -218,23 +299,24 @@ func (k8sTrigger *StandardK8sTrigger) ApplyPolicy(resource interface{}) error {
 		return errors.New("failed to interpret the trigger resource")
 	}
 
-	p := policy.NewResourceLabels(trigger, k8sTrigger.namespableDynamicClient, obj)
-	if p == nil {
-		return nil
-	}
-
-	err := p.ApplyPolicy()
-	if err != nil {
-		switch err {
-		case wait.ErrWaitTimeout:
-			if trigger.Policy.K8s.ErrorOnBackoffTimeout {
-				return errors.Errorf("failed to determine status of the triggered resource. setting trigger state as failed")
+	if trigger.Policy.K8s != nil && trigger.Policy.K8s.Labels != nil {
+		p := policy.NewResourceLabels(trigger, k8sTrigger.namespableDynamicClient, obj)
+		if err := p.ApplyPolicy(ctx); err != nil {
+			switch err {
+			case wait.ErrWaitTimeout:
+				if trigger.Policy.K8s.ErrorOnBackoffTimeout {
+					return errors.Errorf("failed to determine status of the triggered resource. setting trigger state as failed")
+				}
+			default:
+				return err
 			}
-			return nil
-		default:
-			return err
 		}
 	}
 
+	if trigger.Policy.Completion != nil {
+		k8sTrigger.Logger.WithField("name", obj.GetName()).Infoln("waiting for the resource to complete...")
+		return policy.NewCompletion(trigger, k8sTrigger.namespableDynamicClient, obj).ApplyPolicy(ctx)
+	}
+
 	return nil
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package standard_k8s
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -26,8 +27,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
@@ -163,7 +166,7 @@ func TestStandardK8sTrigger_Execute(t *testing.T) {
 	client := dynamicFake.NewSimpleDynamicClient(runtimeScheme)
 	impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, sensorObj, &sensorObj.Spec.Triggers[0], common.NewArgoEventsLogger())
 
-	resource, err := impl.Execute(deployment)
+	resource, err := impl.Execute(context.Background(), deployment)
 	assert.Nil(t, err)
 	assert.NotNil(t, resource)
 
@@ -177,7 +180,7 @@ func TestStandardK8sTrigger_Execute(t *testing.T) {
 
 	sensorObj.Spec.Triggers[0].Template.K8s.Operation = v1alpha1.Update
 	impl = NewStandardK8sTrigger(fake.NewSimpleClientset(), client, sensorObj, &sensorObj.Spec.Triggers[0], common.NewArgoEventsLogger())
-	resource, err = impl.Execute(uObj)
+	resource, err = impl.Execute(context.Background(), uObj)
 	assert.Nil(t, err)
 	assert.NotNil(t, resource)
 
@@ -194,10 +197,84 @@ func TestStandardK8sTrigger_Execute(t *testing.T) {
 	sensorObj.Spec.Triggers[0].Template.K8s.PatchStrategy = k8stypes.MergePatchType
 
 	impl = NewStandardK8sTrigger(fake.NewSimpleClientset(), client, sensorObj, &sensorObj.Spec.Triggers[0], common.NewArgoEventsLogger())
-	resource, err = impl.Execute(uObj)
+	resource, err = impl.Execute(context.Background(), uObj)
 	assert.Nil(t, err)
 	assert.NotNil(t, resource)
 	uObj, ok = resource.(*unstructured.Unstructured)
 	assert.Equal(t, true, ok)
 	assert.Equal(t, "bar", uObj.GetLabels()["foo"])
 }
+
+func TestStandardK8sTrigger_ExecuteDelete(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newUnstructured("apps/v1", "Deployment", "fake", "test"),
+		newUnstructured("apps/v1", "Deployment", "fake", "another"),
+	)
+	obj := sensorObj.DeepCopy()
+	trigger := &obj.Spec.Triggers[0]
+	trigger.Template.K8s.Operation = v1alpha1.Delete
+	trigger.Template.K8s.DeleteOptions = &v1alpha1.K8SDeleteOptions{
+		PropagationPolicy: metav1.DeletePropagationForeground,
+	}
+	impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, obj, trigger, common.NewArgoEventsLogger())
+
+	resource, err := impl.Execute(context.Background(), newUnstructured("apps/v1", "Deployment", "fake", "test"))
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+	_, err = client.Resource(gvr).Namespace("fake").Get("test", metav1.GetOptions{})
+	assert.NotNil(t, err)
+	_, err = client.Resource(gvr).Namespace("fake").Get("another", metav1.GetOptions{})
+	assert.Nil(t, err)
+	var deleteAction k8stesting.DeleteActionImpl
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "delete" {
+			deleteAction = action.(k8stesting.DeleteActionImpl)
+		}
+	}
+	assert.Equal(t, "test", deleteAction.GetName())
+
+	// the object is already deleted
+	_, err = impl.Execute(context.Background(), newUnstructured("apps/v1", "Deployment", "fake", "test"))
+	assert.Nil(t, err)
+
+	// either the name or the label selector is required
+	_, err = impl.Execute(context.Background(), newUnstructured("apps/v1", "Deployment", "fake", ""))
+	assert.NotNil(t, err)
+
+	trigger.Template.K8s.DeleteOptions.LabelSelector = "name=another"
+	_, err = impl.Execute(context.Background(), newUnstructured("apps/v1", "Deployment", "fake", ""))
+	assert.Nil(t, err)
+	var deleteCollectionAction k8stesting.DeleteCollectionActionImpl
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "delete-collection" {
+			deleteCollectionAction = action.(k8stesting.DeleteCollectionActionImpl)
+		}
+	}
+	assert.Equal(t, "name=another", deleteCollectionAction.GetListRestrictions().Labels.String())
+}
+
+func TestStandardK8sTrigger_ExecuteApply(t *testing.T) {
+	client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
+	var patchAction k8stesting.PatchActionImpl
+	// the fake client doesn't support the server-side apply
+	client.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patchAction = action.(k8stesting.PatchActionImpl)
+		return true, newUnstructured("apps/v1", "Deployment", "fake", "test"), nil
+	})
+	obj := sensorObj.DeepCopy()
+	trigger := &obj.Spec.Triggers[0]
+	trigger.Template.K8s.Operation = v1alpha1.Apply
+	impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, obj, trigger, common.NewArgoEventsLogger())
+
+	resource, err := impl.Execute(context.Background(), newUnstructured("apps/v1", "Deployment", "fake", "test"))
+	assert.Nil(t, err)
+	assert.Equal(t, "test", resource.(*unstructured.Unstructured).GetName())
+	assert.Equal(t, "test", patchAction.GetName())
+	assert.Equal(t, k8stypes.ApplyPatchType, patchAction.GetPatchType())
+	assert.Contains(t, string(patchAction.GetPatch()), `"replica":"1"`)
+	assert.Contains(t, patchAction.GetResource().String(), "deployments")
+
+	_, err = impl.Execute(context.Background(), newUnstructured("apps/v1", "Deployment", "fake", ""))
+	assert.NotNil(t, err)
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package types
 
 import (
+	"go.opencensus.io/trace"
+
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
@@ -32,4 +34,9 @@ type Notification struct {
 	Sensor *v1alpha1.Sensor
 	// NotificationType for event notification and state update notification
 	NotificationType v1alpha1.NotificationType
+	// Done, if set, is sent the error of the processing, if any, and closed once the notification has been processed.
+	// It must be buffered.
+	Done chan error
+	// Span, if set, traces the handling of the event and is ended once the notification has been processed
+	Span *trace.Span
 }
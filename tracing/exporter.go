@@ -0,0 +1,200 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+// The exporter writes each span as an OTLP/JSON trace export request on a line,
+// i.e. the format of the file exporter of the OpenTelemetry collector, so that the spans can be loaded by a collector later.
+// See https://github.com/open-telemetry/opentelemetry-proto/blob/main/docs/specification.md#json-protobuf-encoding
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// OTLP span kinds and status codes
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+	otlpStatusCodeOK     = 1
+	otlpStatusCodeError  = 2
+)
+
+// jsonExporter exports the spans as OTLP/JSON lines to a writer
+type jsonExporter struct {
+	service string
+	writer  io.Writer
+	lock    sync.Mutex
+}
+
+func newStdoutExporter(service string) (trace.Exporter, error) {
+	return &jsonExporter{
+		service: service,
+		writer:  os.Stdout,
+	}, nil
+}
+
+func newFileExporter(service string) (trace.Exporter, error) {
+	path, ok := os.LookupEnv(common.EnvVarTracingFile)
+	if !ok || path == "" {
+		return nil, errors.Errorf("%s is not provided", common.EnvVarTracingFile)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the directory for the tracing file %s", path)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open the tracing file %s", path)
+	}
+	return &jsonExporter{
+		service: service,
+		writer:  file,
+	}, nil
+}
+
+// ExportSpan writes the span
+func (e *jsonExporter) ExportSpan(s *trace.SpanData) {
+	body, err := json.Marshal(e.toRequest(s))
+	if err != nil {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	_, _ = e.writer.Write(append(body, '\n'))
+}
+
+func (e *jsonExporter) toRequest(s *trace.SpanData) *otlpRequest {
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(s.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.SpanID[:]),
+		Name:              s.Name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+		Status: otlpStatus{
+			Code: otlpStatusCodeOK,
+		},
+	}
+	if s.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanID = hex.EncodeToString(s.ParentSpanID[:])
+	}
+	switch s.SpanKind {
+	case trace.SpanKindServer:
+		span.Kind = otlpSpanKindServer
+	case trace.SpanKindClient:
+		span.Kind = otlpSpanKindClient
+	}
+	if s.Code != trace.StatusCodeOK {
+		span.Status = otlpStatus{
+			Code:    otlpStatusCodeError,
+			Message: s.Message,
+		}
+	}
+	keys := make([]string, 0, len(s.Attributes))
+	for key := range s.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		span.Attributes = append(span.Attributes, toAttribute(key, s.Attributes[key]))
+	}
+
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{toAttribute("service.name", e.service)},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "argo-events"},
+						Spans: []otlpSpan{span},
+					},
+				},
+			},
+		},
+	}
+}
+
+// toAttribute converts a span attribute, i.e. a string, bool or int64, to an OTLP attribute
+func toAttribute(key string, value interface{}) otlpAttribute {
+	switch v := value.(type) {
+	case bool:
+		return otlpAttribute{Key: key, Value: map[string]interface{}{"boolValue": v}}
+	case int64:
+		// int64 values are encoded as strings in OTLP/JSON
+		return otlpAttribute{Key: key, Value: map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}}
+	default:
+		return otlpAttribute{Key: key, Value: map[string]interface{}{"stringValue": fmt.Sprintf("%v", v)}}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+// TraceParent is the key of the W3C trace context in the event metadata and the CloudEvent extensions.
+// See https://www.w3.org/TR/trace-context/#traceparent-header
+const TraceParent = "traceparent"
+
+// ExporterFactory returns a span exporter for the service
+type ExporterFactory func(service string) (trace.Exporter, error)
+
+var (
+	exporterFactories = map[string]ExporterFactory{
+		"stdout": newStdoutExporter,
+		"file":   newFileExporter,
+	}
+	exporterFactoriesLock sync.Mutex
+)
+
+// RegisterExporterFactory makes an exporter available to be selected by name with the tracing exporter env var
+func RegisterExporterFactory(name string, factory ExporterFactory) {
+	exporterFactoriesLock.Lock()
+	defer exporterFactoriesLock.Unlock()
+	exporterFactories[name] = factory
+}
+
+// Init configures tracing for the service with the exporter selected by the tracing exporter env var.
+// If no exporter is selected, spans are not recorded but the tracing context is still propagated.
+func Init(service string) error {
+	name, ok := os.LookupEnv(common.EnvVarTracingExporter)
+	if !ok || name == "" {
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.NeverSample()})
+		return nil
+	}
+
+	exporterFactoriesLock.Lock()
+	factory, ok := exporterFactories[name]
+	exporterFactoriesLock.Unlock()
+	if !ok {
+		return errors.Errorf("unknown tracing exporter %s", name)
+	}
+	exporter, err := factory(service)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the tracing exporter %s", name)
+	}
+	trace.RegisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	return nil
+}
+
+// FormatTraceParent returns the W3C traceparent of the span context
+func FormatTraceParent(sc trace.SpanContext) string {
+	return fmt.Sprintf("00-%s-%s-%02x", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), uint32(sc.TraceOptions)&0xff)
+}
+
+// ParseTraceParent parses a W3C traceparent into a span context
+func ParseTraceParent(traceParent string) (trace.SpanContext, bool) {
+	sc := trace.SpanContext{}
+	parts := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return sc, false
+	}
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(sc.TraceID) {
+		return sc, false
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(sc.SpanID) {
+		return sc, false
+	}
+	options, err := hex.DecodeString(parts[3])
+	if err != nil || len(options) != 1 {
+		return sc, false
+	}
+	copy(sc.TraceID[:], traceID)
+	copy(sc.SpanID[:], spanID)
+	sc.TraceOptions = trace.TraceOptions(options[0])
+	if sc.TraceID == (trace.TraceID{}) || sc.SpanID == (trace.SpanID{}) {
+		return sc, false
+	}
+	return sc, true
+}
+
+// Metadata returns the metadata carrying the tracing context of the span
+func Metadata(span *trace.Span) map[string]string {
+	return map[string]string{
+		TraceParent: FormatTraceParent(span.SpanContext()),
+	}
+}
+
+// StartSpan starts a span that continues the trace of the traceparent, if valid, or starts a new trace
+func StartSpan(ctx context.Context, name, traceParent string, o ...trace.StartOption) (context.Context, *trace.Span) {
+	if parent, ok := ParseTraceParent(traceParent); ok {
+		return trace.StartSpanWithRemoteParent(ctx, name, parent, o...)
+	}
+	return trace.StartSpan(ctx, name, o...)
+}
+
+// EndSpan ends the span, marking it as failed if there is an error
+func EndSpan(span *trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(trace.Status{
+			Code:    trace.StatusCodeUnknown,
+			Message: err.Error(),
+		})
+	}
+	span.End()
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, ok := ParseTraceParent(traceParent)
+	assert.True(t, ok)
+	assert.True(t, sc.IsSampled())
+	assert.Equal(t, traceParent, FormatTraceParent(sc))
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-xyz-00f067aa0ba902b7-01",
+	} {
+		_, ok := ParseTraceParent(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestStartSpan(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	parent, _ := ParseTraceParent(traceParent)
+
+	_, span := StartSpan(context.Background(), "test", traceParent, trace.WithSampler(trace.AlwaysSample()))
+	assert.Equal(t, parent.TraceID, span.SpanContext().TraceID)
+	assert.NotEqual(t, parent.SpanID, span.SpanContext().SpanID)
+
+	metadata := Metadata(span)
+	sc, ok := ParseTraceParent(metadata[TraceParent])
+	assert.True(t, ok)
+	assert.Equal(t, span.SpanContext().TraceID, sc.TraceID)
+	assert.Equal(t, span.SpanContext().SpanID, sc.SpanID)
+	span.End()
+
+	_, span = StartSpan(context.Background(), "test", "")
+	assert.NotEqual(t, parent.TraceID, span.SpanContext().TraceID)
+	span.End()
+}
+
+func TestJSONExporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	exporter := &jsonExporter{
+		service: "test-service",
+		writer:  buf,
+	}
+	trace.RegisterExporter(exporter)
+	defer trace.UnregisterExporter(exporter)
+
+	_, span := trace.StartSpan(context.Background(), "test-span", trace.WithSampler(trace.AlwaysSample()), trace.WithSpanKind(trace.SpanKindClient))
+	span.AddAttributes(trace.StringAttribute("event-source", "test-event-source"), trace.Int64Attribute("attempts", 3))
+	EndSpan(span, errors.New("failed"))
+
+	var request otlpRequest
+	err := json.Unmarshal(buf.Bytes(), &request)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(request.ResourceSpans))
+	assert.Equal(t, "test-service", request.ResourceSpans[0].Resource.Attributes[0].Value["stringValue"])
+
+	exported := request.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	assert.Equal(t, "test-span", exported.Name)
+	assert.Equal(t, otlpSpanKindClient, exported.Kind)
+	assert.Equal(t, otlpStatusCodeError, exported.Status.Code)
+	assert.Equal(t, "failed", exported.Status.Message)
+	assert.Equal(t, 2, len(exported.Attributes))
+	assert.Equal(t, "attempts", exported.Attributes[0].Key)
+	assert.Equal(t, "3", exported.Attributes[0].Value["intValue"])
+}